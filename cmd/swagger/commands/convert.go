@@ -0,0 +1,104 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	"github.com/go-swagger/go-swagger/openapi3"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// ConvertSpec is a command that converts documents between OpenAPI 3.0 and swagger 2.0
+type ConvertSpec struct {
+	To      string         `long:"to" description:"the specification to convert the document to" default:"swagger" choice:"swagger" choice:"openapi3"`
+	Format  string         `long:"format" short:"f" description:"the format of the converted document" default:"json" choice:"json" choice:"yaml"`
+	Compact bool           `long:"compact" description:"when present, doesn't prettify the json"`
+	Output  flags.Filename `long:"output" short:"o" description:"the file to write to"`
+}
+
+// Execute converts the spec, and logs the constructs which could not be represented
+func (c *ConvertSpec) Execute(args []string) error {
+	if len(args) == 0 {
+		return errors.New("The convert command requires the document url to be specified")
+	}
+
+	var data json.RawMessage
+	var warnings []openapi3.Warning
+	var err error
+	target := "swagger 2.0"
+	if c.To == "openapi3" {
+		target = "OpenAPI 3.0"
+		// swagger 2.0 and OpenAPI 3.0 documents are loaded as swagger 2.0
+		specDoc, err := loads.Spec(args[0])
+		if err != nil {
+			return err
+		}
+		data, warnings, err = openapi3.Export(specDoc.Spec())
+		if err != nil {
+			return err
+		}
+	} else {
+		if data, warnings, err = openapi3.Load(args[0]); err != nil {
+			return err
+		}
+		// rendered in the usual order of swagger documents
+		var sw spec.Swagger
+		if err := json.Unmarshal(data, &sw); err != nil {
+			return fmt.Errorf("invalid converted document: %v", err)
+		}
+		if data, err = json.Marshal(&sw); err != nil {
+			return err
+		}
+	}
+
+	if len(warnings) > 0 {
+		log.Printf("%d constructs could not be represented in %s:", len(warnings), target)
+		for _, w := range warnings {
+			log.Printf("- %s", w)
+		}
+	}
+	return writeDocument(data, c.Format, !c.Compact, string(c.Output))
+}
+
+func writeDocument(data json.RawMessage, format string, pretty bool, output string) error {
+	var b []byte
+	var err error
+	switch {
+	case format == "yaml":
+		b, err = openapi3.ToYAML(data)
+	case pretty:
+		var buf bytes.Buffer
+		err = json.Indent(&buf, data, "", "  ")
+		b = buf.Bytes()
+	default:
+		b = data
+	}
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		fmt.Println(string(bytes.TrimSuffix(b, []byte("\n"))))
+		return nil
+	}
+	return ioutil.WriteFile(output, b, 0644)
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/diff"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// DiffCommand is a command that compares two swagger documents
+// and reports the breaking changes between them
+type DiffCommand struct {
+	Format       string         `long:"format" short:"f" description:"the format of the report" default:"txt" choice:"txt" choice:"json"`
+	OnlyBreaking bool           `long:"break" short:"b" description:"when present, only reports breaking changes"`
+	Destination  flags.Filename `long:"dest" short:"d" description:"the file to write the report to, defaults to stdout"`
+}
+
+// Execute compares the specs, reports the changes and fails when breaking changes are found
+func (c *DiffCommand) Execute(args []string) error {
+	if len(args) != 2 {
+		return errors.New("The diff command requires the old and the new swagger document urls to be specified")
+	}
+
+	oldDoc, err := loads.Spec(args[0])
+	if err != nil {
+		return err
+	}
+	newDoc, err := loads.Spec(args[1])
+	if err != nil {
+		return err
+	}
+
+	diffs := diff.Compare(oldDoc.Spec(), newDoc.Spec())
+	if c.OnlyBreaking {
+		diffs = diffs.Filter(diff.Breaking)
+	}
+
+	var w io.Writer = os.Stdout
+	if c.Destination != "" {
+		f, err := os.Create(string(c.Destination))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if c.Format == "json" {
+		err = diffs.ReportJSON(w)
+	} else {
+		err = diffs.ReportText(w)
+	}
+	if err != nil {
+		return err
+	}
+
+	if count := diffs.BreakingChangeCount(); count > 0 {
+		return fmt.Errorf("compatibility check failed: %d breaking changes detected", count)
+	}
+	return nil
+}
@@ -0,0 +1,575 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/analysis"
+	"github.com/go-openapi/spec"
+)
+
+// direction tells which side of the exchange a schema describes.
+//
+// The same change does not have the same impact whether it affects
+// what clients send (requests) or what clients receive (responses).
+// Definitions may be used both ways and get the most conservative impact.
+type direction int
+
+const (
+	request direction = iota
+	response
+	both
+)
+
+// pick the compatibility of a change, depending on the direction of the data
+func (d direction) pick(forRequest, forResponse Compatibility) Compatibility {
+	switch d {
+	case request:
+		return forRequest
+	case response:
+		return forResponse
+	default:
+		if forRequest > forResponse {
+			return forRequest
+		}
+		return forResponse
+	}
+}
+
+// Compare computes the changes from the old spec to the new one.
+//
+// Changes are reported in a deterministic order: global properties first, then
+// endpoints sorted by path and method, then definitions sorted by name.
+func Compare(oldSpec, newSpec *spec.Swagger) SpecDifferences {
+	c := &comparer{
+		old:         oldSpec,
+		new:         newSpec,
+		oldAnalyzed: analysis.New(oldSpec),
+		newAnalyzed: analysis.New(newSpec),
+	}
+	c.compareGlobals()
+	c.compareEndpoints()
+	c.compareDefinitions()
+	return c.diffs
+}
+
+type comparer struct {
+	old, new                 *spec.Swagger
+	oldAnalyzed, newAnalyzed *analysis.Spec
+	diffs                    SpecDifferences
+
+	// endpoint currently being compared
+	method, path string
+}
+
+func (c *comparer) report(code ChangeCode, compat Compatibility, location, format string, args ...interface{}) {
+	c.diffs = append(c.diffs, SpecChange{
+		Code:          code,
+		Compatibility: compat,
+		Method:        c.method,
+		Path:          c.path,
+		Location:      location,
+		Message:       fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *comparer) compareGlobals() {
+	if c.old.BasePath != c.new.BasePath {
+		c.report(ChangedBasePath, Breaking, "basePath", "basePath changed from %q to %q", c.old.BasePath, c.new.BasePath)
+	}
+	if c.old.Host != c.new.Host {
+		c.report(ChangedHost, Informational, "host", "host changed from %q to %q", c.old.Host, c.new.Host)
+	}
+}
+
+type endpoint struct {
+	method, path string
+	op           *spec.Operation
+}
+
+func endpointsOf(an *analysis.Spec) map[string]endpoint {
+	res := make(map[string]endpoint)
+	for method, byPath := range an.Operations() {
+		for pth, op := range byPath {
+			res[strings.ToUpper(method)+" "+pth] = endpoint{method: strings.ToUpper(method), path: pth, op: op}
+		}
+	}
+	return res
+}
+
+func (c *comparer) compareEndpoints() {
+	oldEndpoints, newEndpoints := endpointsOf(c.oldAnalyzed), endpointsOf(c.newAnalyzed)
+
+	for _, k := range unionKeys(oldEndpoints, newEndpoints) {
+		oldEP, inOld := oldEndpoints[k]
+		newEP, inNew := newEndpoints[k]
+		switch {
+		case !inNew:
+			c.method, c.path = oldEP.method, oldEP.path
+			c.report(DeletedEndpoint, Breaking, "", "endpoint has been removed")
+		case !inOld:
+			c.method, c.path = newEP.method, newEP.path
+			c.report(AddedEndpoint, NonBreaking, "", "endpoint has been added")
+		default:
+			c.method, c.path = newEP.method, newEP.path
+			c.compareOperation(oldEP.op, newEP.op)
+		}
+	}
+	c.method, c.path = "", ""
+}
+
+func (c *comparer) compareOperation(oldOp, newOp *spec.Operation) {
+	if oldOp.ID != newOp.ID {
+		c.report(ChangedOperationID, Informational, "", "operationId changed from %q to %q", oldOp.ID, newOp.ID)
+	}
+	if !oldOp.Deprecated && newOp.Deprecated {
+		c.report(DeprecatedOperation, Informational, "", "operation has been deprecated")
+	}
+	if oldOp.Summary != newOp.Summary || oldOp.Description != newOp.Description {
+		c.report(ChangedDescription, Informational, "", "operation documentation has changed")
+	}
+
+	c.compareMediaTypes("consumes", c.oldAnalyzed.ConsumesFor(oldOp), c.newAnalyzed.ConsumesFor(newOp), AddedConsumesMediaType, DeletedConsumesMediaType)
+	c.compareMediaTypes("produces", c.oldAnalyzed.ProducesFor(oldOp), c.newAnalyzed.ProducesFor(newOp), AddedProducesMediaType, DeletedProducesMediaType)
+	c.compareSecurity(oldOp, newOp)
+	c.compareParams()
+	c.compareResponses(oldOp, newOp)
+}
+
+func (c *comparer) compareMediaTypes(location string, oldTypes, newTypes []string, added, deleted ChangeCode) {
+	oldSet, newSet := stringSet(oldTypes), stringSet(newTypes)
+	for _, k := range unionKeys(oldSet, newSet) {
+		_, inOld := oldSet[k]
+		_, inNew := newSet[k]
+		if !inNew {
+			c.report(deleted, Breaking, location, "media type %q is no longer supported", k)
+		} else if !inOld {
+			c.report(added, NonBreaking, location, "media type %q is now supported", k)
+		}
+	}
+}
+
+func securityKeys(reqs [][]analysis.SecurityRequirement) map[string]struct{} {
+	res := make(map[string]struct{}, len(reqs))
+	for _, alternative := range reqs {
+		schemes := make([]string, 0, len(alternative))
+		for _, r := range alternative {
+			if r.Name == "" {
+				continue
+			}
+			scopes := append([]string{}, r.Scopes...)
+			sort.Strings(scopes)
+			if len(scopes) > 0 {
+				schemes = append(schemes, r.Name+"["+strings.Join(scopes, ",")+"]")
+			} else {
+				schemes = append(schemes, r.Name)
+			}
+		}
+		if len(schemes) == 0 {
+			// anonymous access is allowed
+			res[""] = struct{}{}
+			continue
+		}
+		sort.Strings(schemes)
+		res[strings.Join(schemes, " AND ")] = struct{}{}
+	}
+	return res
+}
+
+func allowsAnonymous(reqs map[string]struct{}) bool {
+	_, anonymous := reqs[""]
+	return anonymous || len(reqs) == 0
+}
+
+func (c *comparer) compareSecurity(oldOp, newOp *spec.Operation) {
+	oldReqs := securityKeys(c.oldAnalyzed.SecurityRequirementsFor(oldOp))
+	newReqs := securityKeys(c.newAnalyzed.SecurityRequirementsFor(newOp))
+	oldAnonymous, newAnonymous := allowsAnonymous(oldReqs), allowsAnonymous(newReqs)
+	for _, k := range unionKeys(oldReqs, newReqs) {
+		if k == "" {
+			continue
+		}
+		_, inOld := oldReqs[k]
+		_, inNew := newReqs[k]
+		switch {
+		case !inNew && newAnonymous:
+			c.report(DeletedSecurityRequirement, NonBreaking, "security", "security requirement %q is no longer needed", k)
+		case !inNew:
+			c.report(DeletedSecurityRequirement, Breaking, "security", "security requirement %q has been removed", k)
+		case !inOld && oldAnonymous && !newAnonymous:
+			c.report(AddedSecurityRequirement, Breaking, "security", "security requirement %q is now needed", k)
+		case !inOld:
+			c.report(AddedSecurityRequirement, NonBreaking, "security", "security requirement %q is now accepted", k)
+		}
+	}
+}
+
+func paramLocation(p spec.Parameter) string {
+	return p.In + " param " + p.Name
+}
+
+func paramsByKey(an *analysis.Spec, method, pth string) map[string]spec.Parameter {
+	// unresolved parameters are ignored here: validation reports them
+	params := an.SafeParamsFor(method, pth, func(spec.Parameter, error) bool { return true })
+	res := make(map[string]spec.Parameter, len(params))
+	for _, p := range params {
+		res[p.In+"#"+p.Name] = p
+	}
+	return res
+}
+
+func (c *comparer) compareParams() {
+	oldParams := paramsByKey(c.oldAnalyzed, c.method, c.path)
+	newParams := paramsByKey(c.newAnalyzed, c.method, c.path)
+
+	for _, k := range unionKeys(oldParams, newParams) {
+		oldParam, inOld := oldParams[k]
+		newParam, inNew := newParams[k]
+		switch {
+		case !inNew:
+			c.report(DeletedParam, Breaking, paramLocation(oldParam), "parameter has been removed")
+		case !inOld && newParam.Required:
+			c.report(AddedRequiredParam, Breaking, paramLocation(newParam), "required parameter has been added")
+		case !inOld:
+			c.report(AddedOptionalParam, NonBreaking, paramLocation(newParam), "optional parameter has been added")
+		default:
+			c.compareParam(oldParam, newParam)
+		}
+	}
+}
+
+func (c *comparer) compareParam(oldParam, newParam spec.Parameter) {
+	location := paramLocation(newParam)
+	if !oldParam.Required && newParam.Required {
+		c.report(ChangedOptionalToRequired, Breaking, location, "parameter is now required")
+	}
+	if oldParam.Required && !newParam.Required {
+		c.report(ChangedRequiredToOptional, NonBreaking, location, "parameter is now optional")
+	}
+	if oldParam.Description != newParam.Description {
+		c.report(ChangedDescription, Informational, location, "parameter documentation has changed")
+	}
+
+	if newParam.In == "body" {
+		c.compareSchema(request, location, oldParam.Schema, newParam.Schema)
+		return
+	}
+
+	oldType, newType := simpleTypeName(&oldParam.SimpleSchema), simpleTypeName(&newParam.SimpleSchema)
+	if oldType != newType {
+		c.report(ChangedType, Breaking, location, "type changed from %s to %s", oldType, newType)
+		return
+	}
+	if oldParam.CollectionFormat != newParam.CollectionFormat {
+		c.report(ChangedCollectionFormat, Breaking, location, "collection format changed from %q to %q",
+			oldParam.CollectionFormat, newParam.CollectionFormat)
+	}
+	c.compareEnum(request, location, oldParam.Enum, newParam.Enum)
+}
+
+func resolveResponse(sp *spec.Swagger, resp spec.Response) spec.Response {
+	if ref := resp.Ref.String(); ref != "" {
+		if r, ok := sp.Responses[path.Base(ref)]; ok {
+			return r
+		}
+	}
+	return resp
+}
+
+func responsesOf(sp *spec.Swagger, op *spec.Operation) map[string]spec.Response {
+	res := make(map[string]spec.Response)
+	if op.Responses == nil {
+		return res
+	}
+	if op.Responses.Default != nil {
+		res["default"] = resolveResponse(sp, *op.Responses.Default)
+	}
+	for code, resp := range op.Responses.StatusCodeResponses {
+		res[strconv.Itoa(code)] = resolveResponse(sp, resp)
+	}
+	return res
+}
+
+func (c *comparer) compareResponses(oldOp, newOp *spec.Operation) {
+	oldResponses, newResponses := responsesOf(c.old, oldOp), responsesOf(c.new, newOp)
+
+	for _, k := range unionKeys(oldResponses, newResponses) {
+		location := "response " + k
+		oldResp, inOld := oldResponses[k]
+		newResp, inNew := newResponses[k]
+		switch {
+		case !inNew:
+			c.report(DeletedResponse, Breaking, location, "response has been removed")
+		case !inOld:
+			c.report(AddedResponse, NonBreaking, location, "response has been added")
+		default:
+			c.compareResponse(location, oldResp, newResp)
+		}
+	}
+}
+
+func (c *comparer) compareResponse(location string, oldResp, newResp spec.Response) {
+	if oldResp.Description != newResp.Description {
+		c.report(ChangedDescription, Informational, location, "response documentation has changed")
+	}
+
+	oldType, newType := schemaTypeName(oldResp.Schema), schemaTypeName(newResp.Schema)
+	if oldType != newType {
+		c.report(ChangedResponseType, Breaking, location, "response type changed from %s to %s", displayType(oldType), displayType(newType))
+	} else {
+		c.compareSchema(response, location+" body", oldResp.Schema, newResp.Schema)
+	}
+
+	for _, k := range unionKeys(oldResp.Headers, newResp.Headers) {
+		headerLocation := location + " header " + k
+		oldHeader, inOld := oldResp.Headers[k]
+		newHeader, inNew := newResp.Headers[k]
+		switch {
+		case !inNew:
+			c.report(DeletedResponseHeader, Breaking, headerLocation, "response header has been removed")
+		case !inOld:
+			c.report(AddedResponseHeader, NonBreaking, headerLocation, "response header has been added")
+		default:
+			oldHeaderType, newHeaderType := simpleTypeName(&oldHeader.SimpleSchema), simpleTypeName(&newHeader.SimpleSchema)
+			if oldHeaderType != newHeaderType {
+				c.report(ChangedType, Breaking, headerLocation, "type changed from %s to %s", oldHeaderType, newHeaderType)
+				continue
+			}
+			c.compareEnum(response, headerLocation, oldHeader.Enum, newHeader.Enum)
+		}
+	}
+}
+
+func (c *comparer) compareDefinitions() {
+	for _, k := range unionKeys(c.old.Definitions, c.new.Definitions) {
+		location := "definitions." + k
+		oldSchema, inOld := c.old.Definitions[k]
+		newSchema, inNew := c.new.Definitions[k]
+		switch {
+		case !inNew:
+			c.report(DeletedDefinition, Breaking, location, "definition has been removed")
+		case !inOld:
+			c.report(AddedDefinition, NonBreaking, location, "definition has been added")
+		default:
+			c.compareSchema(both, location, &oldSchema, &newSchema)
+		}
+	}
+}
+
+// compareSchema reports changes between two schemas.
+//
+// References are compared by name only: referenced definitions are compared on their own.
+func (c *comparer) compareSchema(dir direction, location string, oldSchema, newSchema *spec.Schema) {
+	if oldSchema == nil || newSchema == nil {
+		if oldSchema != newSchema {
+			c.report(ChangedType, Breaking, location, "type changed from %s to %s",
+				displayType(schemaTypeName(oldSchema)), displayType(schemaTypeName(newSchema)))
+		}
+		return
+	}
+
+	oldType, newType := schemaTypeName(oldSchema), schemaTypeName(newSchema)
+	if oldType != newType {
+		c.report(ChangedType, Breaking, location, "type changed from %s to %s", displayType(oldType), displayType(newType))
+		return
+	}
+	if oldSchema.Ref.String() != "" {
+		return
+	}
+
+	c.compareEnum(dir, location, oldSchema.Enum, newSchema.Enum)
+
+	if oldSchema.Items != nil && newSchema.Items != nil && oldSchema.Items.Schema != nil && newSchema.Items.Schema != nil {
+		c.compareSchema(dir, location+"[]", oldSchema.Items.Schema, newSchema.Items.Schema)
+	}
+	if oldSchema.AdditionalProperties != nil && newSchema.AdditionalProperties != nil {
+		c.compareSchema(dir, location+"{}", oldSchema.AdditionalProperties.Schema, newSchema.AdditionalProperties.Schema)
+	}
+	for i := 0; i < len(oldSchema.AllOf) && i < len(newSchema.AllOf); i++ {
+		c.compareSchema(dir, fmt.Sprintf("%s.allOf[%d]", location, i), &oldSchema.AllOf[i], &newSchema.AllOf[i])
+	}
+	if len(oldSchema.AllOf) != len(newSchema.AllOf) {
+		c.report(ChangedType, Breaking, location, "composition changed from %d to %d allOf members", len(oldSchema.AllOf), len(newSchema.AllOf))
+	}
+
+	c.compareProperties(dir, location, oldSchema, newSchema)
+}
+
+func (c *comparer) compareProperties(dir direction, location string, oldSchema, newSchema *spec.Schema) {
+	oldRequired, newRequired := stringSet(oldSchema.Required), stringSet(newSchema.Required)
+
+	for _, k := range unionKeys(oldSchema.Properties, newSchema.Properties) {
+		propLocation := location + "." + k
+		oldProp, inOld := oldSchema.Properties[k]
+		newProp, inNew := newSchema.Properties[k]
+		_, wasRequired := oldRequired[k]
+		_, isRequired := newRequired[k]
+		switch {
+		case !inNew:
+			c.report(DeletedProperty, dir.pick(NonBreaking, Breaking), propLocation, "property has been removed")
+		case !inOld && isRequired:
+			c.report(AddedRequiredProperty, dir.pick(Breaking, NonBreaking), propLocation, "required property has been added")
+		case !inOld:
+			c.report(AddedProperty, NonBreaking, propLocation, "property has been added")
+		default:
+			if !wasRequired && isRequired {
+				c.report(ChangedPropertyToRequired, dir.pick(Breaking, NonBreaking), propLocation, "property is now required")
+			}
+			if wasRequired && !isRequired {
+				c.report(ChangedPropertyToOptional, dir.pick(NonBreaking, Breaking), propLocation, "property is now optional")
+			}
+			c.compareSchema(dir, propLocation, &oldProp, &newProp)
+		}
+	}
+}
+
+func (c *comparer) compareEnum(dir direction, location string, oldEnum, newEnum []interface{}) {
+	if len(oldEnum) == 0 && len(newEnum) == 0 {
+		return
+	}
+	oldValues, newValues := enumSet(oldEnum), enumSet(newEnum)
+
+	var removed, added []string
+	for _, k := range unionKeys(oldValues, newValues) {
+		_, inOld := oldValues[k]
+		_, inNew := newValues[k]
+		if !inNew {
+			removed = append(removed, k)
+		} else if !inOld {
+			added = append(added, k)
+		}
+	}
+
+	switch {
+	case len(oldEnum) == 0:
+		// a new enum restricts all previously accepted values
+		c.report(NarrowedEnum, dir.pick(Breaking, NonBreaking), location, "values are now restricted to %s", strings.Join(added, ", "))
+	case len(newEnum) == 0:
+		c.report(WidenedEnum, dir.pick(NonBreaking, Breaking), location, "values are no longer restricted")
+	default:
+		if len(removed) > 0 {
+			c.report(NarrowedEnum, dir.pick(Breaking, NonBreaking), location, "enum values removed: %s", strings.Join(removed, ", "))
+		}
+		if len(added) > 0 {
+			c.report(WidenedEnum, dir.pick(NonBreaking, Breaking), location, "enum values added: %s", strings.Join(added, ", "))
+		}
+	}
+}
+
+// schemaTypeName builds a short type descriptor for a schema, e.g. "array<Pet>" or "integer(int64)"
+func schemaTypeName(s *spec.Schema) string {
+	if s == nil {
+		return ""
+	}
+	if ref := s.Ref.String(); ref != "" {
+		return path.Base(ref)
+	}
+	if len(s.Type) == 0 {
+		switch {
+		case len(s.AllOf) > 0:
+			return "allOf"
+		case len(s.Properties) > 0 || s.AdditionalProperties != nil:
+			return "object"
+		default:
+			return "any"
+		}
+	}
+
+	tpe := strings.Join(s.Type, "|")
+	switch tpe {
+	case "array":
+		if s.Items != nil && s.Items.Schema != nil {
+			return "array<" + schemaTypeName(s.Items.Schema) + ">"
+		}
+		if s.Items != nil && len(s.Items.Schemas) > 0 {
+			return "tuple"
+		}
+		return tpe
+	case "object":
+		if s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil {
+			return "map<" + schemaTypeName(s.AdditionalProperties.Schema) + ">"
+		}
+		return tpe
+	}
+	if s.Format != "" {
+		return tpe + "(" + s.Format + ")"
+	}
+	return tpe
+}
+
+// simpleTypeName builds a short type descriptor for non-body parameters, headers and items
+func simpleTypeName(s *spec.SimpleSchema) string {
+	if s.Type == "array" {
+		if s.Items != nil {
+			return "array<" + simpleTypeName(&s.Items.SimpleSchema) + ">"
+		}
+		return s.Type
+	}
+	if s.Format != "" {
+		return s.Type + "(" + s.Format + ")"
+	}
+	return s.Type
+}
+
+func displayType(tpe string) string {
+	if tpe == "" {
+		return "<none>"
+	}
+	return tpe
+}
+
+func enumSet(values []interface{}) map[string]struct{} {
+	res := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		b, err := json.Marshal(v)
+		if err != nil {
+			res[fmt.Sprintf("%v", v)] = struct{}{}
+			continue
+		}
+		res[string(b)] = struct{}{}
+	}
+	return res
+}
+
+func stringSet(values []string) map[string]struct{} {
+	res := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		res[v] = struct{}{}
+	}
+	return res
+}
+
+// unionKeys returns the sorted keys found in any of two maps keyed by strings
+func unionKeys(left, right interface{}) []string {
+	set := make(map[string]struct{})
+	for _, m := range []interface{}{left, right} {
+		for _, k := range reflect.ValueOf(m).MapKeys() {
+			set[k.String()] = struct{}{}
+		}
+	}
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,107 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadFixtures(t *testing.T) SpecDifferences {
+	base := filepath.FromSlash("../../../../fixtures/diff")
+	oldDoc, err := loads.Spec(filepath.Join(base, "old.yaml"))
+	require.NoError(t, err)
+	newDoc, err := loads.Spec(filepath.Join(base, "new.yaml"))
+	require.NoError(t, err)
+	return Compare(oldDoc.Spec(), newDoc.Spec())
+}
+
+func findChange(diffs SpecDifferences, code ChangeCode, endpoint, location string) (SpecChange, bool) {
+	for _, c := range diffs {
+		if c.Code == code && c.Endpoint() == endpoint && c.Location == location {
+			return c, true
+		}
+	}
+	return SpecChange{}, false
+}
+
+func TestCompare(t *testing.T) {
+	diffs := loadFixtures(t)
+
+	expected := []struct {
+		code     ChangeCode
+		endpoint string
+		location string
+		compat   Compatibility
+	}{
+		{AddedEndpoint, "GET /owners", "", NonBreaking},
+		{DeletedEndpoint, "DELETE /pets/{id}", "", Breaking},
+		{ChangedDescription, "GET /pets", "", Informational},
+		{AddedRequiredParam, "GET /pets", "query param owner", Breaking},
+		{ChangedType, "GET /pets", "query param limit", Breaking},
+		{NarrowedEnum, "GET /pets", "query param status", Breaking},
+		{AddedSecurityRequirement, "POST /pets", "security", Breaking},
+		{AddedResponse, "POST /pets", "response 409", NonBreaking},
+		{DeletedResponse, "GET /pets/{id}", "response 404", Breaking},
+		{ChangedResponseType, "GET /pets/{id}", "response 200", Breaking},
+		{DeletedDefinition, "", "definitions.Legacy", Breaking},
+		{DeletedProperty, "", "definitions.Pet.tag", Breaking},
+		{AddedProperty, "", "definitions.Pet.age", NonBreaking},
+		{ChangedPropertyToRequired, "", "definitions.Pet.kind", Breaking},
+		{WidenedEnum, "", "definitions.Pet.kind", Breaking},
+	}
+	for _, e := range expected {
+		c, ok := findChange(diffs, e.code, e.endpoint, e.location)
+		if assert.True(t, ok, "expected %s on %q %q", e.code, e.endpoint, e.location) {
+			assert.Equal(t, e.compat, c.Compatibility, "unexpected compatibility for %s", c)
+		}
+	}
+	assert.Len(t, diffs, len(expected))
+	assert.Equal(t, 11, diffs.BreakingChangeCount())
+}
+
+func TestCompare_Identical(t *testing.T) {
+	doc, err := loads.Spec(filepath.FromSlash("../../../../fixtures/diff/old.yaml"))
+	require.NoError(t, err)
+	diffs := Compare(doc.Spec(), doc.Spec())
+	assert.Empty(t, diffs)
+
+	var buf bytes.Buffer
+	require.NoError(t, diffs.ReportText(&buf))
+	assert.Equal(t, "No changes detected\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, diffs.ReportJSON(&buf))
+	assert.Equal(t, "[]\n", buf.String())
+}
+
+func TestDirection(t *testing.T) {
+	assert.Equal(t, Breaking, request.pick(Breaking, NonBreaking))
+	assert.Equal(t, NonBreaking, response.pick(Breaking, NonBreaking))
+	assert.Equal(t, Breaking, both.pick(NonBreaking, Breaking))
+	assert.Equal(t, NonBreaking, both.pick(Informational, NonBreaking))
+}
+
+func TestReport(t *testing.T) {
+	diffs := loadFixtures(t)
+
+	var buf bytes.Buffer
+	require.NoError(t, diffs.ReportText(&buf))
+	txt := buf.String()
+	assert.Contains(t, txt, "BREAKING CHANGES:")
+	assert.Contains(t, txt, "NON-BREAKING CHANGES:")
+	assert.Contains(t, txt, "INFORMATIONAL CHANGES:")
+	assert.Contains(t, txt, "GET /pets - query param limit: ChangedType: type changed from integer(int32) to string")
+	assert.Contains(t, txt, "11 breaking, 3 non-breaking and 1 informational changes detected")
+
+	buf.Reset()
+	require.NoError(t, diffs.ReportJSON(&buf))
+	var decoded SpecDifferences
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, diffs, decoded)
+	assert.Contains(t, buf.String(), `"compatibility": "breaking"`)
+}
@@ -0,0 +1,146 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Compatibility classifies the impact of a change on existing API consumers
+type Compatibility int
+
+const (
+	// Informational changes have no effect on the contract (e.g. documentation)
+	Informational Compatibility = iota
+	// NonBreaking changes keep existing consumers working
+	NonBreaking
+	// Breaking changes may break existing consumers
+	Breaking
+)
+
+var compatibilityNames = map[Compatibility]string{
+	Informational: "informational",
+	NonBreaking:   "non-breaking",
+	Breaking:      "breaking",
+}
+
+func (c Compatibility) String() string {
+	if nm, ok := compatibilityNames[c]; ok {
+		return nm
+	}
+	return fmt.Sprintf("unknown(%d)", int(c))
+}
+
+// MarshalJSON renders the compatibility as a string
+func (c Compatibility) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON reads a compatibility from its string representation
+func (c *Compatibility) UnmarshalJSON(data []byte) error {
+	var nm string
+	if err := json.Unmarshal(data, &nm); err != nil {
+		return err
+	}
+	for k, v := range compatibilityNames {
+		if v == nm {
+			*c = k
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown compatibility: %q", nm)
+}
+
+// ChangeCode identifies the kind of change detected between two specs
+type ChangeCode string
+
+// Known change codes
+const (
+	ChangedBasePath            ChangeCode = "ChangedBasePath"
+	ChangedHost                ChangeCode = "ChangedHost"
+	AddedEndpoint              ChangeCode = "AddedEndpoint"
+	DeletedEndpoint            ChangeCode = "DeletedEndpoint"
+	ChangedOperationID         ChangeCode = "ChangedOperationID"
+	DeprecatedOperation        ChangeCode = "DeprecatedOperation"
+	ChangedDescription         ChangeCode = "ChangedDescription"
+	AddedRequiredParam         ChangeCode = "AddedRequiredParam"
+	AddedOptionalParam         ChangeCode = "AddedOptionalParam"
+	DeletedParam               ChangeCode = "DeletedParam"
+	ChangedOptionalToRequired  ChangeCode = "ChangedOptionalToRequired"
+	ChangedRequiredToOptional  ChangeCode = "ChangedRequiredToOptional"
+	ChangedType                ChangeCode = "ChangedType"
+	NarrowedEnum               ChangeCode = "NarrowedEnum"
+	WidenedEnum                ChangeCode = "WidenedEnum"
+	AddedResponse              ChangeCode = "AddedResponse"
+	DeletedResponse            ChangeCode = "DeletedResponse"
+	ChangedResponseType        ChangeCode = "ChangedResponseType"
+	AddedResponseHeader        ChangeCode = "AddedResponseHeader"
+	DeletedResponseHeader      ChangeCode = "DeletedResponseHeader"
+	AddedDefinition            ChangeCode = "AddedDefinition"
+	DeletedDefinition          ChangeCode = "DeletedDefinition"
+	AddedProperty              ChangeCode = "AddedProperty"
+	AddedRequiredProperty      ChangeCode = "AddedRequiredProperty"
+	DeletedProperty            ChangeCode = "DeletedProperty"
+	ChangedPropertyToRequired  ChangeCode = "ChangedPropertyToRequired"
+	ChangedPropertyToOptional  ChangeCode = "ChangedPropertyToOptional"
+	AddedConsumesMediaType     ChangeCode = "AddedConsumesMediaType"
+	DeletedConsumesMediaType   ChangeCode = "DeletedConsumesMediaType"
+	AddedProducesMediaType     ChangeCode = "AddedProducesMediaType"
+	DeletedProducesMediaType   ChangeCode = "DeletedProducesMediaType"
+	AddedSecurityRequirement   ChangeCode = "AddedSecurityRequirement"
+	DeletedSecurityRequirement ChangeCode = "DeletedSecurityRequirement"
+	ChangedCollectionFormat    ChangeCode = "ChangedCollectionFormat"
+)
+
+// SpecChange describes a single change found between two specs
+type SpecChange struct {
+	// Code identifies the kind of change
+	Code ChangeCode `json:"code"`
+	// Compatibility tells whether this change is breaking, non-breaking or informational
+	Compatibility Compatibility `json:"compatibility"`
+	// Method of the affected endpoint, if any
+	Method string `json:"method,omitempty"`
+	// Path of the affected endpoint, if any
+	Path string `json:"path,omitempty"`
+	// Location within the endpoint or the definitions (e.g. "query param limit", "definitions.Pet.name")
+	Location string `json:"location,omitempty"`
+	// Message is a human readable description of the change
+	Message string `json:"message"`
+}
+
+// Endpoint returns a display name for the endpoint affected by this change
+func (c SpecChange) Endpoint() string {
+	if c.Method == "" {
+		return c.Path
+	}
+	return c.Method + " " + c.Path
+}
+
+func (c SpecChange) String() string {
+	var where string
+	switch {
+	case c.Endpoint() != "" && c.Location != "":
+		where = c.Endpoint() + " - " + c.Location
+	case c.Endpoint() != "":
+		where = c.Endpoint()
+	default:
+		where = c.Location
+	}
+	if where == "" {
+		return fmt.Sprintf("%s: %s", c.Code, c.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", where, c.Code, c.Message)
+}
@@ -0,0 +1,24 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package diff compares two swagger specifications and classifies every
+change found as breaking, non-breaking or informational.
+
+Endpoints, parameters, responses and definitions are compared. The impact of a
+change on a schema depends on whether this schema is sent by clients (requests)
+or received by clients (responses): e.g. a narrowed enum breaks clients when it
+constrains a request, but not when it constrains a response.
+*/
+package diff
@@ -0,0 +1,106 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// SpecDifferences lists all the changes found between two specs
+type SpecDifferences []SpecChange
+
+// Count the changes with the given compatibility
+func (d SpecDifferences) Count(compat Compatibility) int {
+	var count int
+	for _, c := range d {
+		if c.Compatibility == compat {
+			count++
+		}
+	}
+	return count
+}
+
+// BreakingChangeCount counts the changes which may break existing consumers
+func (d SpecDifferences) BreakingChangeCount() int {
+	return d.Count(Breaking)
+}
+
+// Filter retains only the changes with one of the given compatibilities
+func (d SpecDifferences) Filter(compats ...Compatibility) SpecDifferences {
+	res := make(SpecDifferences, 0, len(d))
+	for _, c := range d {
+		for _, compat := range compats {
+			if c.Compatibility == compat {
+				res = append(res, c)
+				break
+			}
+		}
+	}
+	return res
+}
+
+// ReportText writes a human readable report, grouping changes by compatibility
+func (d SpecDifferences) ReportText(w io.Writer) error {
+	if len(d) == 0 {
+		_, err := fmt.Fprintln(w, "No changes detected")
+		return err
+	}
+
+	for _, section := range []struct {
+		title  string
+		compat Compatibility
+	}{
+		{"BREAKING CHANGES", Breaking},
+		{"NON-BREAKING CHANGES", NonBreaking},
+		{"INFORMATIONAL CHANGES", Informational},
+	} {
+		changes := d.Filter(section.compat)
+		if len(changes) == 0 {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "%s:\n%s\n", section.title, strings.Repeat("=", len(section.title)+1)); err != nil {
+			return err
+		}
+		for _, c := range changes {
+			if _, err := fmt.Fprintf(w, "%s\n", c); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "%d breaking, %d non-breaking and %d informational changes detected\n",
+		d.Count(Breaking), d.Count(NonBreaking), d.Count(Informational))
+	return err
+}
+
+// ReportJSON writes the changes as a JSON array
+func (d SpecDifferences) ReportJSON(w io.Writer) error {
+	changes := d
+	if changes == nil {
+		changes = SpecDifferences{}
+	}
+	b, err := json.MarshalIndent(changes, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", b)
+	return err
+}
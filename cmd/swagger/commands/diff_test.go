@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCmd_Diff(t *testing.T) {
+	base := filepath.FromSlash("../../../fixtures/diff")
+	output, err := ioutil.TempFile("", "diff")
+	require.NoError(t, err)
+	_ = output.Close()
+	defer os.Remove(output.Name())
+
+	cmd := DiffCommand{Format: "json", Destination: flags.Filename(output.Name())}
+	err = cmd.Execute([]string{filepath.Join(base, "old.yaml"), filepath.Join(base, "new.yaml")})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "11 breaking changes detected")
+	}
+	report, err := ioutil.ReadFile(output.Name())
+	require.NoError(t, err)
+	assert.Contains(t, string(report), `"code": "DeletedEndpoint"`)
+
+	cmd = DiffCommand{Destination: flags.Filename(output.Name())}
+	assert.NoError(t, cmd.Execute([]string{filepath.Join(base, "old.yaml"), filepath.Join(base, "old.yaml")}))
+
+	assert.Error(t, cmd.Execute([]string{filepath.Join(base, "old.yaml")}))
+}
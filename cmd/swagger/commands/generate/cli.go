@@ -0,0 +1,110 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generate
+
+import (
+	"io/ioutil"
+	"log"
+
+	"github.com/go-swagger/go-swagger/generator"
+)
+
+// CLI the command to generate a command line client, built on a swagger client
+type CLI struct {
+	shared
+	Name            string   `long:"name" short:"A" description:"the name of the application, defaults to a mangled value of info.title"`
+	Operations      []string `long:"operation" short:"O" description:"specify an operation to include, repeat for multiple"`
+	Tags            []string `long:"tags" description:"the tags to include, if not specified defaults to all"`
+	Principal       string   `long:"principal" short:"P" description:"the model to use for the security principal"`
+	Models          []string `long:"model" short:"M" description:"specify a model to include, repeat for multiple"`
+	DefaultScheme   string   `long:"default-scheme" description:"the default scheme for this client" default:"http"`
+	DefaultProduces string   `long:"default-produces" description:"the default mime type that API operations produce" default:"application/json"`
+	SkipModels      bool     `long:"skip-models" description:"no models will be generated when this flag is specified"`
+	DumpData        bool     `long:"dump-data" description:"when present dumps the json for the template generator instead of generating files"`
+	SkipValidation  bool     `long:"skip-validation" description:"skips validation of spec prior to generation"`
+	SkipFlattening  bool     `long:"skip-flatten" description:"skips flattening of spec prior to generation"`
+	Concurrency     int      `long:"concurrency" description:"the maximum number of models and operations rendered in parallel, defaults to the number of CPUs"`
+	WithContext     bool     `long:"with-context" description:"the operations take a context as first arg, and call options after their params"`
+}
+
+func (c *CLI) getOpts() (*generator.GenOpts, error) {
+	var copyrightstr string
+	copyrightfile := string(c.CopyrightFile)
+	if copyrightfile != "" {
+		//Read the Copyright from file path in opts
+		bytebuffer, err := ioutil.ReadFile(copyrightfile)
+		if err != nil {
+			return nil, err
+		}
+		copyrightstr = string(bytebuffer)
+	}
+
+	opts := &generator.GenOpts{
+		Spec: string(c.Spec),
+
+		Target:            string(c.Target),
+		APIPackage:        c.APIPackage,
+		ModelPackage:      c.ModelPackage,
+		ServerPackage:     c.ServerPackage,
+		ClientPackage:     c.ClientPackage,
+		Principal:         c.Principal,
+		DefaultScheme:     c.DefaultScheme,
+		DefaultProduces:   c.DefaultProduces,
+		IncludeModel:      !c.SkipModels,
+		IncludeValidator:  !c.SkipModels,
+		IncludeHandler:    true,
+		IncludeParameters: true,
+		IncludeResponses:  true,
+		ValidateSpec:      !c.SkipValidation,
+		FlattenSpec:       !c.SkipFlattening,
+		Tags:              c.Tags,
+		IncludeSupport:    true,
+		TemplateDir:       string(c.TemplateDir),
+		DumpData:          c.DumpData,
+		ExistingModels:    c.ExistingModels,
+		Copyright:         copyrightstr,
+		Concurrency:       c.Concurrency,
+		WithContext:       c.WithContext,
+		IsClient:          true,
+	}
+	// the command line layout must be known before defaults are applied
+	generator.CLISectionOpts(opts)
+	return opts, nil
+}
+
+func (c *CLI) generate(opts *generator.GenOpts) error {
+	return generator.GenerateCLI(c.Name, c.Models, c.Operations, opts)
+}
+
+func (c *CLI) log(rp string) {
+	log.Printf(`Generation completed!
+
+For this generation to compile you need to have some packages in your GOPATH:
+
+	* github.com/go-openapi/runtime
+	* github.com/jessevdk/go-flags
+	* golang.org/x/net/context
+	* golang.org/x/net/context/ctxhttp
+	* golang.org/x/oauth2
+	* gopkg.in/yaml.v2
+
+You can get these now with: go get -u -f %s/...
+`, rp)
+}
+
+// Execute runs this command
+func (c *CLI) Execute(args []string) error {
+	return createSwagger(c)
+}
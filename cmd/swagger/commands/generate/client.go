@@ -36,6 +36,9 @@ type Client struct {
 	DumpData        bool     `long:"dump-data" description:"when present dumps the json for the template generator instead of generating files"`
 	SkipValidation  bool     `long:"skip-validation" description:"skips validation of spec prior to generation"`
 	SkipFlattening  bool     `long:"skip-flatten" description:"skips flattening of spec prior to generation"`
+	Concurrency     int      `long:"concurrency" description:"the maximum number of models and operations rendered in parallel, defaults to the number of CPUs"`
+	WithMocks       bool     `long:"with-mocks" description:"generates a mock of the client of each operation group, for tests"`
+	WithContext     bool     `long:"with-context" description:"the operations take a context as first arg, and call options after their params"`
 }
 
 func (c *Client) getOpts() (*generator.GenOpts, error) {
@@ -76,6 +79,9 @@ func (c *Client) getOpts() (*generator.GenOpts, error) {
 		DumpData:          c.DumpData,
 		ExistingModels:    c.ExistingModels,
 		Copyright:         copyrightstr,
+		Concurrency:       c.Concurrency,
+		WithMocks:         c.WithMocks,
+		WithContext:       c.WithContext,
 		IsClient:          true,
 	}, nil
 }
@@ -92,6 +98,7 @@ For this generation to compile you need to have some packages in your GOPATH:
 	* github.com/go-openapi/runtime
 	* golang.org/x/net/context
 	* golang.org/x/net/context/ctxhttp
+	* golang.org/x/oauth2
 
 You can get these now with: go get -u -f %s/...
 `, rp)
@@ -0,0 +1,70 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generate
+
+import (
+	"log"
+
+	"github.com/go-swagger/go-swagger/generator"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Markdown generates a markdown reference documentation for a swagger spec
+type Markdown struct {
+	shared
+	Output         flags.Filename `long:"output" description:"the file to write the generated markdown, relative to the target directory" default:"markdown.md"`
+	Name           string         `long:"name" short:"A" description:"the name of the application, defaults to a mangled value of info.title"`
+	Operations     []string       `long:"operation" short:"O" description:"specify an operation to include, repeat for multiple"`
+	Tags           []string       `long:"tags" description:"the tags to include, if not specified defaults to all"`
+	Models         []string       `long:"model" short:"M" description:"specify a model to include, repeat for multiple"`
+	DumpData       bool           `long:"dump-data" description:"when present dumps the json for the template generator instead of generating files"`
+	SkipValidation bool           `long:"skip-validation" description:"skips validation of spec prior to generation"`
+	SkipFlattening bool           `long:"skip-flatten" description:"skips flattening of spec prior to generation"`
+}
+
+func (m *Markdown) getOpts() (*generator.GenOpts, error) {
+	opts := &generator.GenOpts{
+		Spec:           string(m.Spec),
+		Target:         string(m.Target),
+		APIPackage:     m.APIPackage,
+		ModelPackage:   m.ModelPackage,
+		ServerPackage:  m.ServerPackage,
+		ClientPackage:  m.ClientPackage,
+		ValidateSpec:   !m.SkipValidation,
+		FlattenSpec:    !m.SkipFlattening,
+		Tags:           m.Tags,
+		TemplateDir:    string(m.TemplateDir),
+		DumpData:       m.DumpData,
+		ExistingModels: m.ExistingModels,
+		Name:           m.Name,
+		LanguageOpts:   generator.MarkdownOpts(),
+	}
+	// the documentation layout must be known before defaults are applied
+	generator.MarkdownSectionOpts(opts, string(m.Output))
+	return opts, nil
+}
+
+func (m *Markdown) generate(opts *generator.GenOpts) error {
+	return generator.GenerateMarkdown(string(m.Output), m.Models, m.Operations, opts)
+}
+
+func (m *Markdown) log(rp string) {
+	log.Printf(`Generation completed!`)
+}
+
+// Execute runs this command
+func (m *Markdown) Execute(args []string) error {
+	return createSwagger(m)
+}
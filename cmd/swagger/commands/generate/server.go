@@ -42,6 +42,8 @@ type Server struct {
 	CompatibilityMode string   `long:"compatibility-mode" description:"the compatibility mode for the tls server" default:"modern" choice:"modern" choice:"intermediate"`
 	SkipValidation    bool     `long:"skip-validation" description:"skips validation of spec prior to generation"`
 	SkipFlattening    bool     `long:"skip-flatten" description:"skips flattening of spec prior to generation"`
+	Concurrency       int      `long:"concurrency" description:"the maximum number of models and operations rendered in parallel, defaults to the number of CPUs"`
+	HandlerInterface  string   `long:"handler-interface" description:"generates an interface for the handlers of each operation group or of the whole API, with an adapter registering its implementation" choice:"tag" choice:"api"`
 }
 
 func (s *Server) getOpts() (*generator.GenOpts, error) {
@@ -89,6 +91,8 @@ func (s *Server) getOpts() (*generator.GenOpts, error) {
 		CompatibilityMode: s.CompatibilityMode,
 		ExistingModels:    s.ExistingModels,
 		Copyright:         copyrightstr,
+		Concurrency:       s.Concurrency,
+		HandlerInterface:  s.HandlerInterface,
 	}, nil
 }
 
@@ -107,7 +111,7 @@ func (s *Server) log(rp string) {
 For this generation to compile you need to have some packages in your GOPATH:
 
 	* github.com/go-openapi/runtime
-	* github.com/tylerb/graceful
+	* golang.org/x/net/netutil
 	* `+flagsPackage+`
 
 You can get these now with: go get -u -f %s/...
@@ -58,7 +58,7 @@ For this generation to compile you need to have some packages in your vendor or
 
   * github.com/go-openapi/runtime
   * github.com/asaskevich/govalidator		
-  * github.com/tylerb/graceful		
+  * golang.org/x/net/netutil		
   * github.com/jessevdk/go-flags		
   * golang.org/x/net/context/ctxhttp
 
@@ -0,0 +1,92 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/lint"
+	"github.com/go-swagger/go-swagger/generator"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// LintCmd is a command that checks a swagger document against style rules
+type LintCmd struct {
+	Format      string         `long:"format" short:"f" description:"the format of the report" default:"txt" choice:"txt" choice:"json"`
+	ConfigFile  flags.Filename `long:"config-file" short:"C" description:"configuration file to use for overriding the severity of rules"`
+	Destination flags.Filename `long:"dest" short:"d" description:"the file to write the report to, defaults to stdout"`
+	ListRules   bool           `long:"list-rules" description:"when present, lists the rules and their severity instead of linting"`
+}
+
+// Execute lints the spec and fails when findings with the error severity are found
+func (c *LintCmd) Execute(args []string) error {
+	linter := lint.New()
+	cfg, err := generator.ReadConfig(string(c.ConfigFile))
+	if err != nil {
+		return err
+	}
+	if err := linter.Configure(cfg); err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if c.Destination != "" {
+		f, err := os.Create(string(c.Destination))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if c.ListRules {
+		for _, rule := range linter.Rules() {
+			if _, err := fmt.Fprintf(w, "%-28s %-8s %s\n", rule.Name(), linter.Severity(rule), rule.Description()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(args) != 1 {
+		return errors.New("The lint command requires the swagger document url to be specified")
+	}
+	specDoc, err := loads.Spec(args[0])
+	if err != nil {
+		return err
+	}
+
+	findings, err := linter.Lint(specDoc)
+	if err != nil {
+		return err
+	}
+	if c.Format == "json" {
+		err = findings.ReportJSON(w)
+	} else {
+		err = findings.ReportText(w)
+	}
+	if err != nil {
+		return err
+	}
+
+	if count := findings.Count(lint.Error); count > 0 {
+		return fmt.Errorf("lint failed: %d errors found", count)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package lint checks a swagger specification against style rules.
+
+Unlike validation, linting does not tell whether a spec is valid: it enforces
+conventions, such as camel cased operation ids or documented operations.
+
+Each rule walks the analyzed spec and reports findings at JSON pointers. The
+severity of a rule may be overridden from the configuration file, e.g.:
+
+	lint:
+	  rules:
+	    operation-tags: error
+	    description-required: "off"
+
+Findings are suppressed with the x-lint-ignore extension, set on the faulty
+object or any of its parents. The extension accepts true to ignore all rules,
+or the name or list of names of the rules to ignore.
+*/
+package lint
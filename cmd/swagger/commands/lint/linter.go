@@ -0,0 +1,224 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/analysis"
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/loads"
+	"github.com/spf13/viper"
+)
+
+// IgnoreExtension suppresses findings on the object carrying it and its children
+const IgnoreExtension = "x-lint-ignore"
+
+// Linter checks specs against a set of rules
+type Linter struct {
+	rules      []Rule
+	severities map[string]Severity
+}
+
+// New creates a linter for the given rules, or for the built-in rules when none is given
+func New(rules ...Rule) *Linter {
+	if len(rules) == 0 {
+		rules = DefaultRules()
+	}
+	return &Linter{rules: rules, severities: make(map[string]Severity)}
+}
+
+// Rules returns the rules of this linter
+func (l *Linter) Rules() []Rule {
+	return l.rules
+}
+
+// SetSeverity overrides the severity of a rule
+func (l *Linter) SetSeverity(rule string, severity Severity) error {
+	for _, r := range l.rules {
+		if r.Name() == rule {
+			l.severities[rule] = severity
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown lint rule %q", rule)
+}
+
+// Severity returns the severity of a rule, once configured
+func (l *Linter) Severity(rule Rule) Severity {
+	if sev, ok := l.severities[rule.Name()]; ok {
+		return sev
+	}
+	return rule.DefaultSeverity()
+}
+
+// Configure overrides the severities of rules with the lint.rules section of a configuration
+func (l *Linter) Configure(v *viper.Viper) error {
+	if v == nil {
+		return nil
+	}
+	for rule, name := range v.GetStringMapString("lint.rules") {
+		sev, err := ParseSeverity(name)
+		if err != nil {
+			return fmt.Errorf("lint rule %q: %v", rule, err)
+		}
+		if err := l.SetSeverity(rule, sev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Lint checks a spec against the enabled rules
+func (l *Linter) Lint(doc *loads.Document) (Findings, error) {
+	var raw interface{}
+	if err := json.Unmarshal(doc.Raw(), &raw); err != nil {
+		return nil, err
+	}
+
+	ctx := &Context{Spec: doc.Spec(), Analyzer: analysis.New(doc.Spec())}
+	findings := make(Findings, 0, 10)
+	for _, rule := range l.rules {
+		sev := l.Severity(rule)
+		if sev == Off {
+			continue
+		}
+		for _, f := range rule.Check(ctx) {
+			f.Rule = rule.Name()
+			f.Severity = sev
+			if isIgnored(raw, f.Pointer, f.Rule) {
+				continue
+			}
+			findings = append(findings, f)
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Pointer != findings[j].Pointer {
+			return findings[i].Pointer < findings[j].Pointer
+		}
+		return findings[i].Rule < findings[j].Rule
+	})
+	return findings, nil
+}
+
+// isIgnored tells if the x-lint-ignore extension of a node on the pointer path suppresses a rule
+func isIgnored(raw interface{}, pointer, rule string) bool {
+	node := raw
+	tokens := []string{""}
+	if pointer != "" {
+		tokens = append(tokens, strings.Split(strings.TrimPrefix(pointer, "/"), "/")...)
+	}
+	unescaper := strings.NewReplacer("~1", "/", "~0", "~")
+	for i, token := range tokens {
+		if i > 0 {
+			switch n := node.(type) {
+			case map[string]interface{}:
+				node = n[unescaper.Replace(token)]
+			case []interface{}:
+				var idx int
+				if _, err := fmt.Sscanf(token, "%d", &idx); err != nil || idx < 0 || idx >= len(n) {
+					return false
+				}
+				node = n[idx]
+			default:
+				return false
+			}
+		}
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if ignores(obj[IgnoreExtension], rule) {
+			return true
+		}
+	}
+	return false
+}
+
+func ignores(ext interface{}, rule string) bool {
+	switch v := ext.(type) {
+	case bool:
+		return v
+	case string:
+		return v == rule
+	case []interface{}:
+		for _, r := range v {
+			if nm, ok := r.(string); ok && nm == rule {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// pointer builds a JSON pointer from unescaped tokens
+func pointer(tokens ...string) string {
+	if len(tokens) == 0 {
+		return ""
+	}
+	escaped := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		escaped = append(escaped, jsonpointer.Escape(token))
+	}
+	return "/" + strings.Join(escaped, "/")
+}
+
+// Findings lists the breaches of rules found in a spec
+type Findings []Finding
+
+// Count the findings with the given severity
+func (f Findings) Count(severity Severity) int {
+	var count int
+	for _, finding := range f {
+		if finding.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
+
+// ReportText writes a human readable report, one line per finding
+func (f Findings) ReportText(w io.Writer) error {
+	if len(f) == 0 {
+		_, err := fmt.Fprintln(w, "No lint findings")
+		return err
+	}
+	for _, finding := range f {
+		if _, err := fmt.Fprintln(w, finding); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "\n%d errors, %d warnings and %d infos found\n", f.Count(Error), f.Count(Warning), f.Count(Info))
+	return err
+}
+
+// ReportJSON writes the findings as a JSON array
+func (f Findings) ReportJSON(w io.Writer) error {
+	findings := f
+	if findings == nil {
+		findings = Findings{}
+	}
+	b, err := json.MarshalIndent(findings, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", b)
+	return err
+}
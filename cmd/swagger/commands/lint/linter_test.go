@@ -0,0 +1,121 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-swagger/go-swagger/generator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fixture(t *testing.T) *loads.Document {
+	doc, err := loads.Spec(filepath.Join("..", "..", "..", "..", "fixtures", "lint", "style.yml"))
+	require.NoError(t, err)
+	return doc
+}
+
+func TestLint_DefaultRules(t *testing.T) {
+	findings, err := New().Lint(fixture(t))
+	require.NoError(t, err)
+
+	expected := Findings{
+		{Rule: DescriptionRequired, Severity: Info, Pointer: "/definitions/Pet"},
+		{Rule: OperationIDCamelCase, Severity: Error, Pointer: "/paths/~1pets/get/operationId"},
+		{Rule: DescriptionRequired, Severity: Info, Pointer: "/paths/~1pets/get/parameters/0"},
+		{Rule: NoInlineResponse, Severity: Warning, Pointer: "/paths/~1pets/get/responses/200/schema/items"},
+		{Rule: ErrorResponseSchema, Severity: Warning, Pointer: "/paths/~1pets/get/responses/404"},
+		{Rule: OperationIDCamelCase, Severity: Error, Pointer: "/paths/~1pets/post"},
+	}
+	if assert.Len(t, findings, len(expected)) {
+		for i, f := range findings {
+			assert.Equal(t, expected[i].Rule, f.Rule)
+			assert.Equal(t, expected[i].Severity, f.Severity)
+			assert.Equal(t, expected[i].Pointer, f.Pointer)
+			assert.NotEmpty(t, f.Message)
+		}
+	}
+	assert.Equal(t, 2, findings.Count(Error))
+}
+
+func TestLint_Configure(t *testing.T) {
+	cfg, err := generator.ReadConfig(filepath.Join("..", "..", "..", "..", "fixtures", "lint", "config.yml"))
+	require.NoError(t, err)
+
+	linter := New()
+	require.NoError(t, linter.Configure(cfg))
+	findings, err := linter.Lint(fixture(t))
+	require.NoError(t, err)
+	assert.Equal(t, 0, findings.Count(Info))
+	assert.Equal(t, 2, findings.Count(Error))
+
+	assert.Error(t, linter.SetSeverity("no-such-rule", Error))
+}
+
+func TestLint_CustomRule(t *testing.T) {
+	rule := NewRule("always", "always reports", Warning, func(ctx *Context) []Finding {
+		return []Finding{{Pointer: pointer("definitions", "Error"), Message: "found"}, {Pointer: "/info", Message: "found"}}
+	})
+	findings, err := New(rule).Lint(fixture(t))
+	require.NoError(t, err)
+	// the finding on the Error definition is not suppressed, since x-lint-ignore only lists description-required
+	assert.Len(t, findings, 2)
+
+	rule = NewRule(DescriptionRequired, "suppressed", Warning, func(ctx *Context) []Finding {
+		return []Finding{{Pointer: pointer("definitions", "Error", "type"), Message: "found"}}
+	})
+	findings, err = New(rule).Lint(fixture(t))
+	require.NoError(t, err)
+	assert.Empty(t, findings)
+}
+
+func TestLint_Reports(t *testing.T) {
+	findings, err := New().Lint(fixture(t))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, findings.ReportText(&buf))
+	assert.Contains(t, buf.String(), `error: /paths/~1pets/get/operationId [operation-id-camel-case] operationId "ListPets" is not camel cased`)
+	assert.Contains(t, buf.String(), "2 errors, 2 warnings and 2 infos found")
+
+	buf.Reset()
+	require.NoError(t, findings.ReportJSON(&buf))
+	var decoded Findings
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, findings, decoded)
+
+	buf.Reset()
+	require.NoError(t, Findings(nil).ReportJSON(&buf))
+	assert.Equal(t, "[]\n", buf.String())
+}
+
+func TestLint_Severity(t *testing.T) {
+	for _, nm := range []string{"off", "false", "info", "warning", "Error"} {
+		_, err := ParseSeverity(nm)
+		assert.NoError(t, err)
+	}
+	_, err := ParseSeverity("fatal")
+	assert.Error(t, err)
+	assert.Equal(t, "unknown(12)", Severity(12).String())
+}
+
+func TestLint_Pointer(t *testing.T) {
+	assert.Equal(t, "/paths/~1a~0b/get", pointer("paths", "/a~b", "get"))
+}
@@ -0,0 +1,135 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/analysis"
+	"github.com/go-openapi/spec"
+)
+
+// Severity of the findings of a rule
+type Severity int
+
+const (
+	// Off disables a rule
+	Off Severity = iota
+	// Info findings are suggestions
+	Info
+	// Warning findings should be fixed
+	Warning
+	// Error findings fail the lint command
+	Error
+)
+
+var severityNames = map[Severity]string{
+	Off:     "off",
+	Info:    "info",
+	Warning: "warning",
+	Error:   "error",
+}
+
+func (s Severity) String() string {
+	if nm, ok := severityNames[s]; ok {
+		return nm
+	}
+	return fmt.Sprintf("unknown(%d)", int(s))
+}
+
+// ParseSeverity reads a severity from its name
+func ParseSeverity(name string) (Severity, error) {
+	nm := strings.ToLower(strings.TrimSpace(name))
+	if nm == "false" {
+		// yaml reads an unquoted off as false
+		return Off, nil
+	}
+	for k, v := range severityNames {
+		if v == nm {
+			return k, nil
+		}
+	}
+	return Off, fmt.Errorf("unknown severity %q", name)
+}
+
+// MarshalJSON renders the severity as a string
+func (s Severity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON reads a severity from its string representation
+func (s *Severity) UnmarshalJSON(data []byte) error {
+	var nm string
+	if err := json.Unmarshal(data, &nm); err != nil {
+		return err
+	}
+	sev, err := ParseSeverity(nm)
+	if err != nil {
+		return err
+	}
+	*s = sev
+	return nil
+}
+
+// Finding is a breach of a rule
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	// Pointer is the JSON pointer to the faulty part of the spec
+	Pointer string `json:"pointer"`
+	Message string `json:"message"`
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s [%s] %s", f.Severity, f.Pointer, f.Rule, f.Message)
+}
+
+// Context is the spec checked by rules
+type Context struct {
+	Spec     *spec.Swagger
+	Analyzer *analysis.Spec
+}
+
+// Rule checks a spec against a convention
+type Rule interface {
+	// Name identifies the rule in the configuration and in x-lint-ignore
+	Name() string
+	Description() string
+	// DefaultSeverity applies unless the configuration overrides it
+	DefaultSeverity() Severity
+	// Check returns the findings, the linter sets their rule and severity
+	Check(*Context) []Finding
+}
+
+// NewRule builds a rule from a check function
+func NewRule(name, description string, severity Severity, check func(*Context) []Finding) Rule {
+	return &funcRule{name: name, description: description, severity: severity, check: check}
+}
+
+type funcRule struct {
+	name        string
+	description string
+	severity    Severity
+	check       func(*Context) []Finding
+}
+
+func (r *funcRule) Name() string              { return r.name }
+func (r *funcRule) Description() string       { return r.description }
+func (r *funcRule) DefaultSeverity() Severity { return r.severity }
+func (r *funcRule) Check(ctx *Context) []Finding {
+	return r.check(ctx)
+}
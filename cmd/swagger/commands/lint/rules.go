@@ -0,0 +1,230 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+// Names of the built-in rules
+const (
+	OperationIDCamelCase = "operation-id-camel-case"
+	OperationTags        = "operation-tags"
+	ErrorResponseSchema  = "error-response-schema"
+	DescriptionRequired  = "description-required"
+	NoInlineResponse     = "no-inline-response-object"
+)
+
+var camelCase = regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`)
+
+// DefaultRules returns the built-in rules
+func DefaultRules() []Rule {
+	return []Rule{
+		NewRule(OperationIDCamelCase, "operation ids are set and camel cased", Error, checkOperationIDs),
+		NewRule(OperationTags, "operations have at least one tag", Warning, checkOperationTags),
+		NewRule(ErrorResponseSchema, "4xx responses have an error schema", Warning, checkErrorResponses),
+		NewRule(DescriptionRequired, "operations, parameters and definitions are described", Info, checkDescriptions),
+		NewRule(NoInlineResponse, "response schemas refer to definitions rather than declare anonymous objects", Warning, checkInlineResponses),
+	}
+}
+
+type operationRef struct {
+	Method    string
+	Path      string
+	Operation *spec.Operation
+}
+
+func (o operationRef) Pointer(tokens ...string) string {
+	return pointer(append([]string{"paths", o.Path, o.Method}, tokens...)...)
+}
+
+// operations lists the operations of a spec, sorted by path and method
+func operations(ctx *Context) []operationRef {
+	var res []operationRef
+	for method, paths := range ctx.Analyzer.Operations() {
+		for path, op := range paths {
+			res = append(res, operationRef{Method: strings.ToLower(method), Path: path, Operation: op})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Path != res[j].Path {
+			return res[i].Path < res[j].Path
+		}
+		return res[i].Method < res[j].Method
+	})
+	return res
+}
+
+func sortedResponseCodes(responses *spec.Responses) []int {
+	if responses == nil {
+		return nil
+	}
+	codes := make([]int, 0, len(responses.StatusCodeResponses))
+	for code := range responses.StatusCodeResponses {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	return codes
+}
+
+func checkOperationIDs(ctx *Context) []Finding {
+	var res []Finding
+	for _, op := range operations(ctx) {
+		switch {
+		case op.Operation.ID == "":
+			res = append(res, Finding{Pointer: op.Pointer(), Message: "operation has no operationId"})
+		case !camelCase.MatchString(op.Operation.ID):
+			res = append(res, Finding{Pointer: op.Pointer("operationId"), Message: fmt.Sprintf("operationId %q is not camel cased", op.Operation.ID)})
+		}
+	}
+	return res
+}
+
+func checkOperationTags(ctx *Context) []Finding {
+	var res []Finding
+	for _, op := range operations(ctx) {
+		if len(op.Operation.Tags) == 0 {
+			res = append(res, Finding{Pointer: op.Pointer(), Message: "operation has no tag"})
+		}
+	}
+	return res
+}
+
+func checkErrorResponses(ctx *Context) []Finding {
+	var res []Finding
+	for _, op := range operations(ctx) {
+		for _, code := range sortedResponseCodes(op.Operation.Responses) {
+			if code < 400 || code >= 500 {
+				continue
+			}
+			resp := op.Operation.Responses.StatusCodeResponses[code]
+			if resp.Ref.String() != "" {
+				resolved, err := spec.ResolveResponse(ctx.Spec, resp.Ref)
+				if err != nil {
+					continue
+				}
+				resp = *resolved
+			}
+			if resp.Schema == nil {
+				res = append(res, Finding{
+					Pointer: op.Pointer("responses", strconv.Itoa(code)),
+					Message: fmt.Sprintf("response %d has no error schema", code),
+				})
+			}
+		}
+	}
+	return res
+}
+
+func checkDescriptions(ctx *Context) []Finding {
+	var res []Finding
+	checkParams := func(params []spec.Parameter, prefix ...string) {
+		for i, param := range params {
+			if param.Ref.String() == "" && param.Description == "" {
+				res = append(res, Finding{
+					Pointer: pointer(append(prefix, strconv.Itoa(i))...),
+					Message: fmt.Sprintf("parameter %q has no description", param.Name),
+				})
+			}
+		}
+	}
+
+	for _, op := range operations(ctx) {
+		if op.Operation.Description == "" && op.Operation.Summary == "" {
+			res = append(res, Finding{Pointer: op.Pointer(), Message: "operation has neither a summary nor a description"})
+		}
+		checkParams(op.Operation.Parameters, "paths", op.Path, op.Method, "parameters")
+	}
+	if ctx.Spec.Paths != nil {
+		for path, item := range ctx.Spec.Paths.Paths {
+			checkParams(item.Parameters, "paths", path, "parameters")
+		}
+	}
+	for name, param := range ctx.Spec.Parameters {
+		if param.Description == "" {
+			res = append(res, Finding{
+				Pointer: pointer("parameters", name),
+				Message: fmt.Sprintf("parameter %q has no description", param.Name),
+			})
+		}
+	}
+	for name, def := range ctx.Spec.Definitions {
+		if def.Description == "" && def.Title == "" {
+			res = append(res, Finding{
+				Pointer: pointer("definitions", name),
+				Message: fmt.Sprintf("definition %q has no description", name),
+			})
+		}
+	}
+	return res
+}
+
+func checkInlineResponses(ctx *Context) []Finding {
+	var res []Finding
+	check := func(resp spec.Response, tokens ...string) {
+		if resp.Schema == nil {
+			return
+		}
+		if at, ok := inlineObject(resp.Schema); ok {
+			res = append(res, Finding{
+				Pointer: pointer(append(append(tokens, "schema"), at...)...),
+				Message: "response declares an anonymous object, refer to a definition instead",
+			})
+		}
+	}
+
+	for _, op := range operations(ctx) {
+		if op.Operation.Responses == nil {
+			continue
+		}
+		if op.Operation.Responses.Default != nil {
+			check(*op.Operation.Responses.Default, "paths", op.Path, op.Method, "responses", "default")
+		}
+		for _, code := range sortedResponseCodes(op.Operation.Responses) {
+			check(op.Operation.Responses.StatusCodeResponses[code], "paths", op.Path, op.Method, "responses", strconv.Itoa(code))
+		}
+	}
+	for name, resp := range ctx.Spec.Responses {
+		check(resp, "responses", name)
+	}
+	return res
+}
+
+// inlineObject tells if a schema declares an object with properties, possibly as items of arrays
+func inlineObject(sch *spec.Schema) ([]string, bool) {
+	if sch.Ref.String() != "" {
+		return nil, false
+	}
+	if len(sch.Properties) > 0 || len(sch.AllOf) > 0 {
+		return nil, true
+	}
+	if sch.Items != nil && sch.Items.Schema != nil {
+		if at, ok := inlineObject(sch.Items.Schema); ok {
+			return append([]string{"items"}, at...), true
+		}
+	}
+	if sch.AdditionalProperties != nil && sch.AdditionalProperties.Schema != nil {
+		if at, ok := inlineObject(sch.AdditionalProperties.Schema); ok {
+			return append([]string{"additionalProperties"}, at...), true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,31 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package mock serves a mock implementation of an API described by a swagger
+specification.
+
+Requests are routed, authenticated and validated against the spec with the
+untyped API of the go-openapi runtime. Credentials are required whenever the
+spec requires them, but any value is accepted.
+
+Each operation answers with the examples declared for its response, or with
+the example of the response schema, or else with a value synthesized from
+this schema.
+
+The status code of the response defaults to the first successful response
+declared by the operation. Another status code may be picked with the
+X-Mock-Status header or the _mock_status query parameter.
+*/
+package mock
@@ -0,0 +1,222 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+// sample values for string formats
+var formatSamples = map[string]string{
+	"date":         "2006-01-02",
+	"date-time":    "2006-01-02T15:04:05.000Z",
+	"datetime":     "2006-01-02T15:04:05.000Z",
+	"duration":     "1s",
+	"email":        "user@example.com",
+	"hostname":     "example.com",
+	"ipv4":         "127.0.0.1",
+	"ipv6":         "::1",
+	"mac":          "01:23:45:67:89:ab",
+	"uri":          "http://example.com",
+	"url":          "http://example.com",
+	"uuid":         "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+	"uuid3":        "6ba7b810-9dad-31d1-80b4-00c04fd430c8",
+	"uuid4":        "6ba7b810-9dad-41d1-80b4-00c04fd430c8",
+	"uuid5":        "6ba7b810-9dad-51d1-80b4-00c04fd430c8",
+	"byte":         "ZXhhbXBsZQ==",
+	"password":     "secret",
+	"isbn":         "0321751043",
+	"isbn10":       "0321751043",
+	"isbn13":       "978-0321751041",
+	"creditcard":   "4111111111111111",
+	"ssn":          "111-11-1111",
+	"hexcolor":     "#ffffff",
+	"rgbcolor":     "rgb(255,255,255)",
+	"bsonobjectid": "5b4f3e4d7b2c6a1e8c9d0f1a",
+}
+
+// exampleBuilder synthesizes values conforming to a schema.
+//
+// The refs of the schemas are resolved against the root document as they are met,
+// so that recursive definitions do not need to be expanded.
+type exampleBuilder struct {
+	root *spec.Swagger
+}
+
+func newExampleBuilder(root *spec.Swagger) *exampleBuilder {
+	return &exampleBuilder{root: root}
+}
+
+// FromSchema returns the example declared by a schema, or a value synthesized from this schema
+func (e *exampleBuilder) FromSchema(sch *spec.Schema) interface{} {
+	// the builder is shared by concurrent requests: the refs being synthesized are tracked per call
+	return e.fromSchema(sch, make(map[string]bool))
+}
+
+// fromSchema synthesizes a value, stopping on the refs being synthesized to break circular definitions
+func (e *exampleBuilder) fromSchema(sch *spec.Schema, visiting map[string]bool) interface{} {
+	if sch == nil {
+		return nil
+	}
+
+	if ref := sch.Ref.String(); ref != "" {
+		if visiting[ref] {
+			return nil
+		}
+		resolved, err := spec.ResolveRefWithBase(e.root, &sch.Ref, nil)
+		if err != nil {
+			return nil
+		}
+		visiting[ref] = true
+		defer delete(visiting, ref)
+		return e.fromSchema(resolved, visiting)
+	}
+
+	switch {
+	case sch.Example != nil:
+		return sch.Example
+	case sch.Default != nil:
+		return sch.Default
+	case len(sch.Enum) > 0:
+		return sch.Enum[0]
+	}
+
+	if len(sch.AllOf) > 0 {
+		res := make(map[string]interface{})
+		for i := range sch.AllOf {
+			if obj, ok := e.fromSchema(&sch.AllOf[i], visiting).(map[string]interface{}); ok {
+				for k, v := range obj {
+					res[k] = v
+				}
+			}
+		}
+		for k, v := range e.properties(sch, visiting) {
+			res[k] = v
+		}
+		return res
+	}
+
+	tpe := ""
+	if len(sch.Type) > 0 {
+		tpe = sch.Type[0]
+	}
+
+	switch tpe {
+	case "object":
+		return e.object(sch, visiting)
+	case "array":
+		var items *spec.Schema
+		if sch.Items != nil {
+			items = sch.Items.Schema
+			if items == nil && len(sch.Items.Schemas) > 0 {
+				items = &sch.Items.Schemas[0]
+			}
+		}
+		n := 1
+		if sch.MinItems != nil && *sch.MinItems > 1 {
+			n = int(*sch.MinItems)
+		}
+		res := make([]interface{}, 0, n)
+		for i := 0; i < n; i++ {
+			res = append(res, e.fromSchema(items, visiting))
+		}
+		return res
+	case "":
+		if len(sch.Properties) > 0 || sch.AdditionalProperties != nil {
+			return e.object(sch, visiting)
+		}
+		return nil
+	default:
+		return simpleValue(tpe, sch.Format, sch.Minimum, sch.Maximum, sch.ExclusiveMinimum, sch.ExclusiveMaximum, sch.MinLength)
+	}
+}
+
+// FromSimpleSchema returns the example, default or a synthesized value for a header or items
+func (e *exampleBuilder) FromSimpleSchema(s *spec.SimpleSchema, v *spec.CommonValidations) interface{} {
+	switch {
+	case s.Example != nil:
+		return s.Example
+	case s.Default != nil:
+		return s.Default
+	case len(v.Enum) > 0:
+		return v.Enum[0]
+	}
+	return simpleValue(s.Type, s.Format, v.Minimum, v.Maximum, v.ExclusiveMinimum, v.ExclusiveMaximum, v.MinLength)
+}
+
+// FromHeader returns a value for a response header
+func (e *exampleBuilder) FromHeader(h *spec.Header) interface{} {
+	if h.Type == "array" && h.Items != nil {
+		return []interface{}{e.FromSimpleSchema(&h.Items.SimpleSchema, &h.Items.CommonValidations)}
+	}
+	return e.FromSimpleSchema(&h.SimpleSchema, &h.CommonValidations)
+}
+
+func (e *exampleBuilder) object(sch *spec.Schema, visiting map[string]bool) map[string]interface{} {
+	res := e.properties(sch, visiting)
+	if len(res) == 0 && sch.AdditionalProperties != nil && sch.AdditionalProperties.Schema != nil {
+		res["key"] = e.fromSchema(sch.AdditionalProperties.Schema, visiting)
+	}
+	return res
+}
+
+func (e *exampleBuilder) properties(sch *spec.Schema, visiting map[string]bool) map[string]interface{} {
+	res := make(map[string]interface{}, len(sch.Properties))
+	for name, prop := range sch.Properties {
+		p := prop
+		res[name] = e.fromSchema(&p, visiting)
+	}
+	return res
+}
+
+// simpleValue synthesizes a value for a primitive type, within the bounds of numbers and strings
+func simpleValue(tpe, format string, minimum, maximum *float64, exclusiveMinimum, exclusiveMaximum bool, minLength *int64) interface{} {
+	switch tpe {
+	case "boolean":
+		return true
+	case "integer", "number":
+		var val float64
+		if minimum != nil {
+			val = *minimum
+			if exclusiveMinimum {
+				val++
+			}
+		} else if maximum != nil && *maximum < 0 {
+			val = *maximum
+			if exclusiveMaximum {
+				val--
+			}
+		}
+		if tpe == "integer" {
+			return int64(val)
+		}
+		return val
+	case "string":
+		if sample, ok := formatSamples[strings.ToLower(format)]; ok {
+			return sample
+		}
+		str := "string"
+		if minLength != nil && int64(len(str)) < *minLength {
+			str += strings.Repeat("x", int(*minLength)-len(str))
+		}
+		return str
+	case "file":
+		return ""
+	default:
+		return nil
+	}
+}
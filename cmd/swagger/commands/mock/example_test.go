@@ -0,0 +1,61 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExample_FromSchema(t *testing.T) {
+	root := new(spec.Swagger)
+	root.Definitions = spec.Definitions{
+		"node": *spec.MapProperty(spec.RefProperty("#/definitions/node")),
+	}
+	e := newExampleBuilder(root)
+
+	assert.Nil(t, e.FromSchema(nil))
+	assert.Equal(t, "x", e.FromSchema(spec.StringProperty().WithExample("x").WithDefault("y")))
+	assert.Equal(t, "y", e.FromSchema(spec.StringProperty().WithDefault("y")))
+	assert.Equal(t, "b", e.FromSchema(spec.StringProperty().WithEnum("b", "a")))
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", e.FromSchema(spec.StrFmtProperty("uuid")))
+	assert.Equal(t, "stringxx", e.FromSchema(spec.StringProperty().WithMinLength(8)))
+	assert.Equal(t, int64(4), e.FromSchema(spec.Int64Property().WithMinimum(3, true)))
+	assert.Equal(t, float64(-2), e.FromSchema(spec.Float64Property().WithMaximum(-2, false)))
+	assert.Equal(t, true, e.FromSchema(spec.BoolProperty()))
+	assert.Equal(t, []interface{}{"string", "string"}, e.FromSchema(spec.ArrayProperty(spec.StringProperty()).WithMinItems(2)))
+
+	composed := new(spec.Schema).
+		WithAllOf(*new(spec.Schema).SetProperty("a", *spec.BoolProperty()), *new(spec.Schema).SetProperty("b", *spec.Int32Property()))
+	assert.Equal(t, map[string]interface{}{"a": true, "b": int64(0)}, e.FromSchema(composed))
+
+	// circular definitions stop at the first cycle
+	assert.Equal(t, map[string]interface{}{"key": nil}, e.FromSchema(spec.RefProperty("#/definitions/node")))
+}
+
+func TestExample_FromHeader(t *testing.T) {
+	e := newExampleBuilder(new(spec.Swagger))
+
+	h := spec.ResponseHeader().Typed("integer", "int32")
+	h.Minimum = new(float64)
+	*h.Minimum = 5
+	assert.Equal(t, int64(5), e.FromHeader(h))
+
+	h = spec.ResponseHeader().CollectionOf(spec.NewItems().Typed("string", ""), "csv")
+	assert.Equal(t, []interface{}{"string"}, e.FromHeader(h))
+	assert.Equal(t, "string,1", headerValue([]interface{}{"string", 1}))
+}
@@ -0,0 +1,330 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/analysis"
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/runtime/middleware/untyped"
+	"github.com/go-openapi/runtime/security"
+	"github.com/go-openapi/spec"
+)
+
+const (
+	// StatusHeader is the request header to pick the status code of a mocked response
+	StatusHeader = "X-Mock-Status"
+	// StatusParam is the query parameter to pick the status code of a mocked response
+	StatusParam = "_mock_status"
+)
+
+// NewHandler builds a handler mocking the API described by a spec.
+//
+// Requests are validated against the spec, then answered with the examples found in the spec.
+// The builders wrap the mocker after the routing, e.g. to validate the mocked responses.
+func NewHandler(doc *loads.Document, builders ...middleware.Builder) (http.Handler, error) {
+	// the examples are built from a flattened copy of the spec, which schemas are all local definitions:
+	// their refs are resolved by the example builder, as recursive definitions cannot be expanded
+	flat := doc.Pristine()
+	if err := analysis.Flatten(analysis.FlattenOpts{Spec: analysis.New(flat.Spec()), BasePath: doc.SpecFilePath()}); err != nil {
+		return nil, err
+	}
+
+	an := analysis.New(doc.Spec())
+	api := untyped.NewAPI(doc)
+	for _, mt := range an.RequiredConsumes() {
+		api.RegisterConsumer(normalizeMediaType(mt), consumerFor(mt))
+	}
+	for _, mt := range an.RequiredProduces() {
+		api.RegisterProducer(normalizeMediaType(mt), producerFor(mt))
+	}
+	for name, scheme := range doc.Spec().SecurityDefinitions {
+		api.RegisterAuth(name, authenticatorFor(name, scheme))
+	}
+
+	// responses are written by the mocker, these handlers only declare the routes
+	notImplemented := runtime.OperationHandlerFunc(func(params interface{}) (interface{}, error) {
+		return middleware.NotImplemented("operation is not mocked"), nil
+	})
+	for method, paths := range an.Operations() {
+		for path := range paths {
+			api.RegisterOperation(method, path, notImplemented)
+		}
+	}
+	if err := api.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := middleware.NewContext(doc, api, nil)
+	var handler http.Handler = &mocker{
+		ctx:      ctx,
+		spec:     flat.Spec(),
+		examples: newExampleBuilder(flat.Spec()),
+	}
+	for _, builder := range builders {
+		handler = builder(handler)
+	}
+	return middleware.NewRouter(ctx, handler), nil
+}
+
+// mocker answers requests for routes matched by the router
+type mocker struct {
+	ctx      *middleware.Context
+	spec     *spec.Swagger
+	examples *exampleBuilder
+}
+
+func (m *mocker) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := m.ctx.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+
+	_, rCtx, err := m.ctx.Authorize(r, route)
+	if err != nil {
+		m.ctx.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if rCtx != nil {
+		r = rCtx
+	}
+
+	_, r, err = m.ctx.BindAndValidate(r, route)
+	if err != nil {
+		m.ctx.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	resp, err := m.respond(r, m.operation(r.Method, route))
+	if err != nil {
+		m.ctx.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	m.ctx.Respond(rw, r, route.Produces, route, resp)
+}
+
+// operation looks up the flattened version of a routed operation
+func (m *mocker) operation(method string, route *middleware.MatchedRoute) *spec.Operation {
+	if m.spec.Paths != nil {
+		if pi, ok := m.spec.Paths.Paths[strings.TrimPrefix(route.PathPattern, route.BasePath)]; ok {
+			var op *spec.Operation
+			switch strings.ToUpper(method) {
+			case http.MethodGet:
+				op = pi.Get
+			case http.MethodHead:
+				op = pi.Head
+			case http.MethodPost:
+				op = pi.Post
+			case http.MethodPut:
+				op = pi.Put
+			case http.MethodPatch:
+				op = pi.Patch
+			case http.MethodDelete:
+				op = pi.Delete
+			case http.MethodOptions:
+				op = pi.Options
+			}
+			if op != nil {
+				return op
+			}
+		}
+	}
+	return route.Operation
+}
+
+// respond picks the response to mock: the one for the requested status code, or the first successful one
+func (m *mocker) respond(r *http.Request, operation *spec.Operation) (*mockResponse, error) {
+	requested := r.Header.Get(StatusHeader)
+	if requested == "" {
+		requested = r.URL.Query().Get(StatusParam)
+	}
+
+	var responses spec.Responses
+	if operation.Responses != nil {
+		responses = *operation.Responses
+	}
+
+	var code int
+	var response *spec.Response
+	if requested != "" {
+		c, err := strconv.Atoi(requested)
+		if err != nil || c < 100 || c > 599 {
+			return nil, errors.New(http.StatusBadRequest, "invalid mock status %q", requested)
+		}
+		code = c
+		if resp, ok := responses.StatusCodeResponses[code]; ok {
+			response = &resp
+		} else if responses.Default != nil {
+			response = responses.Default
+		} else {
+			return nil, errors.New(http.StatusBadRequest, "status %d is not declared for operation %s", code, operation.ID)
+		}
+	} else {
+		codes := make([]int, 0, len(responses.StatusCodeResponses))
+		for c := range responses.StatusCodeResponses {
+			codes = append(codes, c)
+		}
+		sort.Ints(codes)
+		for _, c := range codes {
+			if c >= 200 && c < 300 {
+				code = c
+				break
+			}
+		}
+		switch {
+		case code != 0:
+			resp := responses.StatusCodeResponses[code]
+			response = &resp
+		case responses.Default != nil:
+			code = http.StatusOK
+			response = responses.Default
+		case len(codes) > 0:
+			code = codes[0]
+			resp := responses.StatusCodeResponses[code]
+			response = &resp
+		default:
+			code = http.StatusOK
+			response = new(spec.Response)
+		}
+	}
+
+	if response.Ref.String() != "" {
+		resolved, err := spec.ResolveResponse(m.spec, response.Ref)
+		if err != nil {
+			return nil, err
+		}
+		response = resolved
+	}
+
+	return &mockResponse{
+		code:     code,
+		method:   r.Method,
+		response: response,
+		examples: m.examples,
+	}, nil
+}
+
+// mockResponse writes the examples of a response
+type mockResponse struct {
+	code     int
+	method   string
+	response *spec.Response
+	examples *exampleBuilder
+}
+
+func (m *mockResponse) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+	for name, header := range m.response.Headers {
+		h := header
+		rw.Header().Set(name, headerValue(m.examples.FromHeader(&h)))
+	}
+
+	rw.WriteHeader(m.code)
+	if m.code == http.StatusNoContent || m.method == http.MethodHead {
+		return
+	}
+
+	mediaType := normalizeMediaType(rw.Header().Get(runtime.HeaderContentType))
+	body, ok := m.response.Examples[mediaType]
+	if !ok {
+		if m.response.Schema == nil {
+			return
+		}
+		body = m.examples.FromSchema(m.response.Schema)
+	}
+
+	if str, isString := body.(string); isString && !strings.Contains(mediaType, "json") {
+		if _, err := io.WriteString(rw, str); err != nil {
+			log.Printf("could not write mocked response: %v", err)
+		}
+		return
+	}
+	if err := producer.Produce(rw, body); err != nil {
+		log.Printf("could not write mocked response: %v", err)
+	}
+}
+
+func headerValue(value interface{}) string {
+	if values, ok := value.([]interface{}); ok {
+		strs := make([]string, 0, len(values))
+		for _, v := range values {
+			strs = append(strs, headerValue(v))
+		}
+		return strings.Join(strs, ",")
+	}
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+func normalizeMediaType(mt string) string {
+	return strings.ToLower(strings.TrimSpace(strings.SplitN(mt, ";", 2)[0]))
+}
+
+func consumerFor(mediaType string) runtime.Consumer {
+	mt := normalizeMediaType(mediaType)
+	switch {
+	case strings.Contains(mt, "json"):
+		return runtime.JSONConsumer()
+	case strings.Contains(mt, "xml"):
+		return runtime.XMLConsumer()
+	case strings.HasPrefix(mt, "text/"):
+		return runtime.TextConsumer()
+	default:
+		return runtime.ByteStreamConsumer()
+	}
+}
+
+func producerFor(mediaType string) runtime.Producer {
+	mt := normalizeMediaType(mediaType)
+	switch {
+	case strings.Contains(mt, "json"):
+		return runtime.JSONProducer()
+	case strings.Contains(mt, "xml"):
+		return runtime.XMLProducer()
+	case strings.HasPrefix(mt, "text/"):
+		return runtime.TextProducer()
+	default:
+		return runtime.ByteStreamProducer()
+	}
+}
+
+// authenticatorFor accepts any credentials, provided they are present
+func authenticatorFor(name string, scheme *spec.SecurityScheme) runtime.Authenticator {
+	switch strings.ToLower(scheme.Type) {
+	case "basic":
+		return security.BasicAuth(func(user, _ string) (interface{}, error) {
+			return user, nil
+		})
+	case "apikey":
+		return security.APIKeyAuth(scheme.Name, scheme.In, func(token string) (interface{}, error) {
+			return token, nil
+		})
+	default:
+		return security.BearerAuth(name, func(token string, _ []string) (interface{}, error) {
+			return token, nil
+		})
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-swagger/go-swagger/contract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testHandler(t *testing.T) http.Handler {
+	doc, err := loads.Spec("../../../../fixtures/mock/petstore.yml")
+	require.NoError(t, err)
+	handler, err := NewHandler(doc)
+	require.NoError(t, err)
+	return handler
+}
+
+func serve(handler http.Handler, method, target, body string, headers map[string]string) *httptest.ResponseRecorder {
+	var req *http.Request
+	if body != "" {
+		req = httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+	} else {
+		req = httptest.NewRequest(method, target, nil)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMock_Examples(t *testing.T) {
+	handler := testHandler(t)
+
+	rec := serve(handler, http.MethodGet, "/api/pets", "", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `[{"id":1,"name":"rex"}]`, rec.Body.String())
+	assert.Equal(t, "1", rec.Header().Get("X-Total"))
+
+	// the default response is used for undeclared status codes
+	rec = serve(handler, http.MethodGet, "/api/pets?_mock_status=503", "", nil)
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.JSONEq(t, `{"code":42,"message":"string"}`, rec.Body.String())
+}
+
+func TestMock_SynthesizedResponse(t *testing.T) {
+	handler := testHandler(t)
+
+	rec := serve(handler, http.MethodPost, "/api/pets", `{"name":"rex the dog"}`, map[string]string{"X-API-Key": "anything"})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	var pet map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &pet))
+	assert.EqualValues(t, 1, pet["id"])
+	assert.Equal(t, "stringxxxx", pet["name"])
+	assert.Equal(t, "dog", pet["tag"])
+	assert.Equal(t, "2006-01-02", pet["born"])
+	assert.Contains(t, pet, "parent")
+
+	rec = serve(handler, http.MethodPost, "/api/pets", `{"name":"rex the dog"}`, map[string]string{"X-API-Key": "anything", StatusHeader: "409"})
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Empty(t, rec.Body.String())
+}
+
+func TestMock_NoContent(t *testing.T) {
+	handler := testHandler(t)
+
+	rec := serve(handler, http.MethodDelete, "/api/pets/12", "", nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Empty(t, rec.Body.String())
+
+	rec = serve(handler, http.MethodDelete, "/api/pets/12", "", map[string]string{StatusHeader: "404"})
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.JSONEq(t, `{"code":42,"message":"string"}`, rec.Body.String())
+}
+
+func TestMock_Errors(t *testing.T) {
+	handler := testHandler(t)
+
+	// credentials are required
+	rec := serve(handler, http.MethodPost, "/api/pets", `{"name":"rex the dog"}`, nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// requests are validated
+	rec = serve(handler, http.MethodGet, "/api/pets?limit=1000", "", nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	rec = serve(handler, http.MethodPost, "/api/pets", `{}`, map[string]string{"X-API-Key": "anything"})
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	// routes come from the spec
+	rec = serve(handler, http.MethodGet, "/api/stores", "", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	rec = serve(handler, http.MethodPut, "/api/pets", "", nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	// the status code must be declared
+	rec = serve(handler, http.MethodDelete, "/api/pets/12", "", map[string]string{StatusHeader: "500"})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = serve(handler, http.MethodDelete, "/api/pets/12", "", map[string]string{StatusHeader: "abc"})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestMock_ValidateResponses(t *testing.T) {
+	doc, err := loads.Spec("../../../../fixtures/mock/petstore.yml")
+	require.NoError(t, err)
+	validator := contract.NewResponseValidator(doc, strfmt.Default, contract.Warn)
+	validator.Logger = func(string, ...interface{}) {}
+	handler, err := NewHandler(doc, validator.Middleware)
+	require.NoError(t, err)
+
+	// the example of the response is shorter than the name of a pet may be
+	rec := serve(handler, http.MethodGet, "/api/pets", "", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Warning"), "name in body should be at least 10 chars long")
+
+	rec = serve(handler, http.MethodDelete, "/api/pets/12", "", nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Empty(t, rec.Header().Get("Warning"))
+}
+
+func TestMock_RecursiveDefinitions(t *testing.T) {
+	doc, err := loads.Spec("../../../../fixtures/mock/recursive.yml")
+	require.NoError(t, err)
+	handler, err := NewHandler(doc)
+	require.NoError(t, err)
+
+	rec := serve(handler, http.MethodGet, "/api/nodes", "", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"name":"string","a":null}`, rec.Body.String())
+
+	// the example builder is shared by the concurrent requests
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rec := serve(handler, http.MethodGet, "/api/trees", "", nil)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Contains(t, rec.Body.String(), `"children":[`)
+		}()
+	}
+	wg.Wait()
+}
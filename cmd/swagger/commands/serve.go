@@ -14,7 +14,10 @@ import (
 
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-swagger/go-swagger/cmd/swagger/commands/mock"
+	"github.com/go-swagger/go-swagger/contract"
 	"github.com/gorilla/handlers"
 	"github.com/toqueteos/webbrowser"
 	"github.com/tylerb/graceful"
@@ -29,6 +32,9 @@ type ServeCmd struct {
 	NoUI     bool   `long:"no-ui" description:"when present, only the swagger spec will be served"`
 	Port     int    `long:"port" short:"p" description:"the port to serve this site" env:"PORT"`
 	Host     string `long:"host" description:"the interface to serve this site, defaults to 0.0.0.0" env:"HOST"`
+	Mock     bool   `long:"mock" description:"when present, the operations of the spec are served with mocked responses built from the examples"`
+
+	ValidateResponses string `long:"validate-responses" description:"with --mock, check the mocked responses against the spec, and log them, add a warning header or fail when they do not match" choice:"log" choice:"warn" choice:"fail"`
 }
 
 // Execute the serve command
@@ -36,6 +42,9 @@ func (s *ServeCmd) Execute(args []string) error {
 	if len(args) == 0 {
 		return errors.New("specify the spec to serve as argument to the serve command")
 	}
+	if s.ValidateResponses != "" && !s.Mock {
+		return errors.New("--validate-responses checks the mocked responses, and requires --mock")
+	}
 
 	specDoc, err := loads.Spec(args[0])
 	if err != nil {
@@ -65,6 +74,19 @@ func (s *ServeCmd) Execute(args []string) error {
 
 	visit := s.DocURL
 	handler := http.NotFoundHandler()
+	if s.Mock {
+		var builders []middleware.Builder
+		if s.ValidateResponses != "" {
+			strictness, err := contract.ParseStrictness(s.ValidateResponses)
+			if err != nil {
+				return err
+			}
+			builders = append(builders, contract.NewResponseValidator(specDoc, strfmt.Default, strictness).Middleware)
+		}
+		if handler, err = mock.NewHandler(specDoc, builders...); err != nil {
+			return err
+		}
+	}
 	if !s.NoUI {
 		if s.Flavor == "redoc" {
 			handler = middleware.Redoc(middleware.RedocOpts{
@@ -106,5 +128,8 @@ func (s *ServeCmd) Execute(args []string) error {
 		}
 	}
 	log.Println("serving docs at", visit)
+	if s.Mock {
+		log.Println("serving mocked API at", fmt.Sprintf("http://%s:%d%s", sh, sp, specDoc.BasePath()))
+	}
 	return <-errFuture
 }
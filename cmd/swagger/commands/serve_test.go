@@ -0,0 +1,15 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmd_Serve_ValidateResponsesWithoutMock(t *testing.T) {
+	s := ServeCmd{ValidateResponses: "warn"}
+	err := s.Execute([]string{"../../../fixtures/mock/petstore.yml"})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "requires --mock")
+	}
+}
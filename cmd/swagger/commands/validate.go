@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"os"
 
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/strfmt"
@@ -30,14 +31,27 @@ const (
 	validSpecMsg   = "\nThe swagger spec at %q is valid against swagger specification %s\n"
 	invalidSpecMsg = "\nThe swagger spec at %q is invalid against swagger specification %s.\nSee errors below:\n"
 	warningSpecMsg = "\nThe swagger spec at %q showed up some valid but possibly unwanted constructs."
+	loadSpecMsg    = "The swagger spec at %q could not be loaded: %v"
+)
+
+// Exit codes of the validate command
+const (
+	// ExitInvalidSpec is the exit code when the spec has validation errors
+	ExitInvalidSpec = 1
+	// ExitSpecWarnings is the exit code when the spec is valid but has warnings, with --fail-on-warnings
+	ExitSpecWarnings = 2
+	// ExitLoadFailure is the exit code when the spec could not be loaded
+	ExitLoadFailure = 3
 )
 
 // ValidateSpec is a command that validates a swagger document
 // against the swagger specification
 type ValidateSpec struct {
 	// SchemaURL string `long:"schema" description:"The schema url to use" default:"http://swagger.io/v2/schema.json"`
-	SkipWarnings bool `long:"skip-warnings" description:"when present will not show up warnings upon validation"`
-	StopOnError  bool `long:"stop-on-error" description:"when present will not continue validation after critical errors are found"`
+	SkipWarnings   bool   `long:"skip-warnings" description:"when present will not show up warnings upon validation"`
+	StopOnError    bool   `long:"stop-on-error" description:"when present will not continue validation after critical errors are found"`
+	Format         string `long:"format" short:"f" description:"the format of the report: text logs, or a json, junit or sarif document written to stdout" default:"text" choice:"text" choice:"json" choice:"junit" choice:"sarif"`
+	FailOnWarnings bool   `long:"fail-on-warnings" description:"when present exits with a status of 2 when the spec is valid but has warnings"`
 }
 
 // Execute validates the spec
@@ -50,7 +64,13 @@ func (c *ValidateSpec) Execute(args []string) error {
 
 	specDoc, err := loads.Spec(swaggerDoc)
 	if err != nil {
-		log.Fatalln(err)
+		err = fmt.Errorf(loadSpecMsg, swaggerDoc, err)
+		if c.writesReport() {
+			if rerr := writeValidationReport(os.Stdout, c.Format, loadFailureReport(swaggerDoc, err)); rerr != nil {
+				return rerr
+			}
+		}
+		return &ExitError{Code: ExitLoadFailure, Err: err}
 	}
 
 	// Attempts to report about all errors
@@ -60,8 +80,29 @@ func (c *ValidateSpec) Execute(args []string) error {
 	result, _ := v.Validate(specDoc) // returns fully detailed result with errors and warnings
 	//result := validate.Spec(specDoc, strfmt.Default)		// returns single error
 
+	if c.writesReport() {
+		report := newValidationReport(swaggerDoc, specDoc, result, c.SkipWarnings)
+		if err := writeValidationReport(os.Stdout, c.Format, report); err != nil {
+			return err
+		}
+		if err := report.exitError(); err != nil {
+			return err
+		}
+		return c.warningsError(swaggerDoc, result)
+	}
+	return c.logResult(swaggerDoc, specDoc.Version(), result)
+}
+
+// writesReport is true when the outcome is written to stdout as a json, junit or sarif document,
+// and false when it is logged as text, the default
+func (c *ValidateSpec) writesReport() bool {
+	return c.Format != "" && c.Format != "text"
+}
+
+// logResult logs the outcome of the validation as text
+func (c *ValidateSpec) logResult(swaggerDoc, version string, result *validate.Result) error {
 	if result.IsValid() {
-		log.Printf(validSpecMsg, swaggerDoc, specDoc.Version())
+		log.Printf(validSpecMsg, swaggerDoc, version)
 	}
 	if result.HasWarnings() {
 		log.Printf(warningSpecMsg, swaggerDoc)
@@ -73,12 +114,19 @@ func (c *ValidateSpec) Execute(args []string) error {
 		}
 	}
 	if result.HasErrors() {
-		str := fmt.Sprintf(invalidSpecMsg, swaggerDoc, specDoc.Version())
+		str := fmt.Sprintf(invalidSpecMsg, swaggerDoc, version)
 		for _, desc := range result.Errors {
 			str += fmt.Sprintf("- %s\n", desc.Error())
 		}
-		return errors.New(str)
+		return &ExitError{Code: ExitInvalidSpec, Err: errors.New(str)}
 	}
+	return c.warningsError(swaggerDoc, result)
+}
 
-	return nil
+// warningsError returns the error for the warnings of a valid spec, when the command fails on warnings
+func (c *ValidateSpec) warningsError(swaggerDoc string, result *validate.Result) error {
+	if !c.FailOnWarnings || !result.HasWarnings() {
+		return nil
+	}
+	return &ExitError{Code: ExitSpecWarnings, Err: fmt.Errorf("the swagger spec at %q is valid, with %d warnings", swaggerDoc, len(result.Warnings))}
 }
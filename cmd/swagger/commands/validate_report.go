@@ -0,0 +1,354 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/validate"
+)
+
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// validationIssue is an error or a warning found in a spec
+type validationIssue struct {
+	// Pointer is the JSON pointer to the faulty part of the spec, when known
+	Pointer  string `json:"pointer"`
+	Message  string `json:"message"`
+	Code     int32  `json:"code,omitempty"`
+	Severity string `json:"severity"`
+}
+
+// validationReport is the machine readable result of the validation of a spec
+type validationReport struct {
+	Spec     string            `json:"spec"`
+	Version  string            `json:"version,omitempty"`
+	Valid    bool              `json:"valid"`
+	Loaded   bool              `json:"loaded"`
+	Errors   int               `json:"errors"`
+	Warnings int               `json:"warnings"`
+	Issues   []validationIssue `json:"issues"`
+}
+
+func newValidationReport(specPath string, doc *loads.Document, result *validate.Result, skipWarnings bool) *validationReport {
+	pointers := newPointerResolver(doc)
+	report := &validationReport{
+		Spec:    specPath,
+		Version: doc.Version(),
+		Valid:   result.IsValid(),
+		Loaded:  true,
+		Issues:  make([]validationIssue, 0, len(result.Errors)+len(result.Warnings)),
+	}
+	for _, err := range flattenErrors(result.Errors) {
+		report.Issues = append(report.Issues, newValidationIssue(err, severityError, pointers))
+		report.Errors++
+	}
+	if !skipWarnings {
+		for _, err := range flattenErrors(result.Warnings) {
+			report.Issues = append(report.Issues, newValidationIssue(err, severityWarning, pointers))
+			report.Warnings++
+		}
+	}
+	return report
+}
+
+func loadFailureReport(specPath string, err error) *validationReport {
+	return &validationReport{
+		Spec:   specPath,
+		Errors: 1,
+		Issues: []validationIssue{{Message: err.Error(), Severity: severityError}},
+	}
+}
+
+// exitError returns the error matching the exit code of the validate command for a spec which could not be
+// loaded or is invalid, if any: the warnings only fail the command with --fail-on-warnings
+func (r *validationReport) exitError() error {
+	switch {
+	case !r.Loaded:
+		return &ExitError{Code: ExitLoadFailure, Err: fmt.Errorf("the swagger spec at %q could not be loaded", r.Spec)}
+	case r.Errors > 0:
+		return &ExitError{Code: ExitInvalidSpec, Err: fmt.Errorf("the swagger spec at %q is invalid, with %d errors", r.Spec, r.Errors)}
+	default:
+		return nil
+	}
+}
+
+func newValidationIssue(err error, severity string, pointers *pointerResolver) validationIssue {
+	issue := validationIssue{
+		Message:  err.Error(),
+		Severity: severity,
+	}
+	if e, ok := err.(errors.Error); ok {
+		issue.Code = e.Code()
+	}
+	if e, ok := err.(*errors.Validation); ok {
+		issue.Pointer = pointers.Resolve(e.Name)
+	}
+	return issue
+}
+
+// flattenErrors unwraps composite errors
+func flattenErrors(errs []error) []error {
+	res := make([]error, 0, len(errs))
+	for _, err := range errs {
+		if composite, ok := err.(*errors.CompositeError); ok && len(composite.Errors) > 0 {
+			res = append(res, flattenErrors(composite.Errors)...)
+			continue
+		}
+		res = append(res, err)
+	}
+	return res
+}
+
+// pointerResolver converts the dotted names of validation errors to JSON pointers.
+//
+// Keys of paths, definitions, parameters and responses may contain dots:
+// these are matched against the keys declared in the spec.
+type pointerResolver struct {
+	keys map[string][]string
+}
+
+func newPointerResolver(doc *loads.Document) *pointerResolver {
+	r := &pointerResolver{keys: make(map[string][]string)}
+	if doc == nil {
+		return r
+	}
+	sp := doc.Spec()
+	if sp.Paths != nil {
+		for k := range sp.Paths.Paths {
+			r.keys["paths"] = append(r.keys["paths"], k)
+		}
+	}
+	for k := range sp.Definitions {
+		r.keys["definitions"] = append(r.keys["definitions"], k)
+	}
+	for k := range sp.Parameters {
+		r.keys["parameters"] = append(r.keys["parameters"], k)
+	}
+	for k := range sp.Responses {
+		r.keys["responses"] = append(r.keys["responses"], k)
+	}
+	// longest keys first, so the most specific key is matched
+	for _, keys := range r.keys {
+		sort.Slice(keys, func(i, j int) bool { return len(keys[i]) > len(keys[j]) })
+	}
+	return r
+}
+
+// Resolve returns the JSON pointer for a dotted name, such as "definitions.Pet.properties.name"
+func (r *pointerResolver) Resolve(name string) string {
+	if name == "" || name == "." {
+		return ""
+	}
+	parts := strings.SplitN(name, ".", 2)
+	tokens := []string{parts[0]}
+	rest := ""
+	if len(parts) > 1 {
+		rest = parts[1]
+	}
+	for _, key := range r.keys[parts[0]] {
+		if rest == key || strings.HasPrefix(rest, key+".") {
+			tokens = append(tokens, key)
+			rest = strings.TrimPrefix(strings.TrimPrefix(rest, key), ".")
+			break
+		}
+	}
+	if rest != "" {
+		tokens = append(tokens, strings.Split(rest, ".")...)
+	}
+
+	for i, token := range tokens {
+		tokens[i] = jsonpointer.Escape(token)
+	}
+	return "/" + strings.Join(tokens, "/")
+}
+
+// writeValidationReport writes a report as a json, junit or sarif document
+func writeValidationReport(w io.Writer, format string, report *validationReport) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	case "junit":
+		return writeJUnitReport(w, report)
+	case "sarif":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(newSarifLog(report))
+	default:
+		return fmt.Errorf("unsupported report format %q", format)
+	}
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Body    string `xml:",chardata"`
+}
+
+// writeJUnitReport reports each issue as a test case: errors fail, warnings are skipped
+func writeJUnitReport(w io.Writer, report *validationReport) error {
+	suite := junitTestSuite{Name: "swagger validate"}
+	for _, issue := range report.Issues {
+		name := issue.Pointer
+		if name == "" {
+			name = issue.Message
+		}
+		tc := junitTestCase{ClassName: report.Spec, Name: name}
+		msg := &junitMessage{Message: issue.Message, Type: issue.Severity, Body: issue.Message}
+		switch {
+		case !report.Loaded:
+			tc.Error = msg
+			suite.Errors++
+		case issue.Severity == severityError:
+			tc.Failure = msg
+			suite.Failures++
+		default:
+			tc.Skipped = msg
+			suite.Skipped++
+		}
+		suite.Cases = append(suite.Cases, tc)
+	}
+	if len(suite.Cases) == 0 {
+		suite.Cases = append(suite.Cases, junitTestCase{ClassName: report.Spec, Name: "valid against swagger specification " + report.Version})
+	}
+	suite.Tests = len(suite.Cases)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(junitTestSuites{Suites: []junitTestSuite{suite}}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string `json:"name"`
+	Version        string `json:"version,omitempty"`
+	InformationURI string `json:"informationUri"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId,omitempty"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+func newSarifLog(report *validationReport) sarifLog {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "swagger",
+			Version:        Version,
+			InformationURI: "https://goswagger.io",
+		}},
+		Results: make([]sarifResult, 0, len(report.Issues)),
+	}
+	for _, issue := range report.Issues {
+		res := sarifResult{
+			Level:   issue.Severity,
+			Message: sarifMessage{Text: issue.Message},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: report.Spec}},
+			}},
+		}
+		if issue.Code != 0 {
+			res.RuleID = strconv.Itoa(int(issue.Code))
+		}
+		if issue.Pointer != "" {
+			res.Locations[0].LogicalLocations = []sarifLogicalLocation{{FullyQualifiedName: issue.Pointer}}
+		}
+		run.Results = append(run.Results, res)
+	}
+	return sarifLog{Version: sarifVersion, Schema: sarifSchema, Runs: []sarifRun{run}}
+}
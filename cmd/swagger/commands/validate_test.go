@@ -1,13 +1,22 @@
 package commands
 
 import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
 	"testing"
 
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/validate"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Test proper validation: items in object error
@@ -90,3 +99,135 @@ func TestCmd_Validate_Issue342_Correct(t *testing.T) {
 	result := v.Execute([]string{specDoc})
 	assert.NoError(t, result)
 }
+
+func testValidationResult() *validate.Result {
+	return &validate.Result{
+		Errors: []error{
+			errors.Required("definitions.Pet.properties.name", "body"),
+			errors.CompositeValidationError(
+				errors.InvalidType("paths./v1.0/pets.get.parameters", "body", "array", nil),
+				errors.New(422, "operation %q has invalid params", "listPets"),
+			),
+		},
+		Warnings: []error{
+			errors.New(200, "definition %q is not used anywhere", "Unused"),
+		},
+	}
+}
+
+func testValidationDoc(t *testing.T) *loads.Document {
+	doc, err := loads.Analyzed(json.RawMessage(`{
+		"swagger": "2.0",
+		"info": {"title": "report", "version": "1.0"},
+		"paths": {"/v1.0/pets": {}, "/v1": {}},
+		"definitions": {"Pet": {}, "Unused": {}}
+	}`), "")
+	require.NoError(t, err)
+	return doc
+}
+
+func TestCmd_Validate_Report(t *testing.T) {
+	report := newValidationReport("swagger.json", testValidationDoc(t), testValidationResult(), false)
+	assert.False(t, report.Valid)
+	assert.Equal(t, 3, report.Errors)
+	assert.Equal(t, 1, report.Warnings)
+	if assert.Len(t, report.Issues, 4) {
+		assert.Equal(t, "/definitions/Pet/properties/name", report.Issues[0].Pointer)
+		assert.EqualValues(t, errors.RequiredFailCode, report.Issues[0].Code)
+		assert.Equal(t, "/paths/~1v1.0~1pets/get/parameters", report.Issues[1].Pointer)
+		assert.Empty(t, report.Issues[2].Pointer)
+		assert.EqualValues(t, 422, report.Issues[2].Code)
+		assert.Equal(t, "warning", report.Issues[3].Severity)
+	}
+	err := report.exitError()
+	if assert.IsType(t, &ExitError{}, err) {
+		assert.Equal(t, ExitInvalidSpec, err.(*ExitError).ExitCode())
+	}
+
+	warnings := &validate.Result{Warnings: testValidationResult().Warnings}
+	report = newValidationReport("swagger.json", testValidationDoc(t), warnings, false)
+	assert.NoError(t, report.exitError())
+	assert.Equal(t, 1, report.Warnings)
+	report = newValidationReport("swagger.json", testValidationDoc(t), warnings, true)
+	assert.NoError(t, report.exitError())
+	assert.Empty(t, report.Issues)
+
+	report = loadFailureReport("swagger.json", fmt.Errorf("no such file"))
+	assert.Equal(t, ExitLoadFailure, report.exitError().(*ExitError).ExitCode())
+}
+
+func TestCmd_Validate_ReportFormats(t *testing.T) {
+	report := newValidationReport("swagger.json", testValidationDoc(t), testValidationResult(), false)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeValidationReport(&buf, "json", report))
+	var decoded validationReport
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, *report, decoded)
+
+	buf.Reset()
+	require.NoError(t, writeValidationReport(&buf, "junit", report))
+	var suites junitTestSuites
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &suites))
+	if assert.Len(t, suites.Suites, 1) {
+		assert.Equal(t, 4, suites.Suites[0].Tests)
+		assert.Equal(t, 3, suites.Suites[0].Failures)
+		assert.Equal(t, 1, suites.Suites[0].Skipped)
+		assert.Equal(t, "/definitions/Pet/properties/name", suites.Suites[0].Cases[0].Name)
+	}
+
+	buf.Reset()
+	require.NoError(t, writeValidationReport(&buf, "sarif", report))
+	var sarif sarifLog
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &sarif))
+	assert.Equal(t, "2.1.0", sarif.Version)
+	if assert.Len(t, sarif.Runs, 1) && assert.Len(t, sarif.Runs[0].Results, 4) {
+		res := sarif.Runs[0].Results[0]
+		assert.Equal(t, "error", res.Level)
+		assert.Equal(t, strconv.Itoa(errors.RequiredFailCode), res.RuleID)
+		assert.Equal(t, "swagger.json", res.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+		assert.Equal(t, "/definitions/Pet/properties/name", res.Locations[0].LogicalLocations[0].FullyQualifiedName)
+		assert.Equal(t, "warning", sarif.Runs[0].Results[3].Level)
+	}
+
+	assert.Error(t, writeValidationReport(&buf, "yaml", report))
+}
+
+func TestCmd_Validate_LoadFailure(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	v := ValidateSpec{}
+	err := v.Execute([]string{filepath.Join("..", "..", "..", "fixtures", "no-such-spec.yaml")})
+	if assert.IsType(t, &ExitError{}, err) {
+		assert.Equal(t, ExitLoadFailure, err.(*ExitError).ExitCode())
+	}
+}
+
+func TestCmd_Validate_Warnings(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	warnings := &validate.Result{Warnings: testValidationResult().Warnings}
+
+	// the specs with warnings only are valid
+	v := ValidateSpec{}
+	assert.NoError(t, v.logResult("swagger.json", "2.0", warnings))
+	v = ValidateSpec{SkipWarnings: true}
+	assert.NoError(t, v.logResult("swagger.json", "2.0", warnings))
+
+	v = ValidateSpec{FailOnWarnings: true}
+	err := v.logResult("swagger.json", "2.0", warnings)
+	if assert.IsType(t, &ExitError{}, err) {
+		assert.Equal(t, ExitSpecWarnings, err.(*ExitError).ExitCode())
+	}
+	// the warnings left out of the logs still fail the command
+	v = ValidateSpec{SkipWarnings: true, FailOnWarnings: true}
+	err = v.logResult("swagger.json", "2.0", warnings)
+	if assert.IsType(t, &ExitError{}, err) {
+		assert.Equal(t, ExitSpecWarnings, err.(*ExitError).ExitCode())
+	}
+
+	err = v.logResult("swagger.json", "2.0", testValidationResult())
+	if assert.IsType(t, &ExitError{}, err) {
+		assert.Equal(t, ExitInvalidSpec, err.(*ExitError).ExitCode())
+	}
+}
@@ -20,13 +20,14 @@ import (
 	"os"
 
 	"github.com/go-openapi/loads"
-	"github.com/go-openapi/loads/fmts"
 	"github.com/go-swagger/go-swagger/cmd/swagger/commands"
+	"github.com/go-swagger/go-swagger/openapi3"
 	"github.com/jessevdk/go-flags"
 )
 
 func init() {
-	loads.AddLoader(fmts.YAMLMatcher, fmts.YAMLDoc)
+	// loads json and yaml documents, converting OpenAPI 3.0 documents to swagger 2.0
+	loads.AddLoader(openapi3.Matcher, openapi3.Loader)
 }
 
 var (
@@ -99,6 +100,21 @@ It aims to represent the contract of your API with a language agnostic descripti
 		log.Fatal(err)
 	}
 
+	_, err = parser.AddCommand("diff", "diff swagger documents", "diff specs showing which changes will break existing clients", &commands.DiffCommand{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = parser.AddCommand("convert", "convert between OpenAPI 3.0 and swagger 2.0", "convert an OpenAPI 3.0 document to swagger 2.0, or a swagger 2.0 document to OpenAPI 3.0, listing the constructs which could not be represented", &commands.ConvertSpec{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = parser.AddCommand("lint", "lint a swagger document", "check a swagger document against style rules, beyond its validity", &commands.LintCmd{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	genpar, err := parser.AddCommand("generate", "generate go code", "generate go code for the swagger spec file", &commands.Generate{})
 	if err != nil {
 		log.Fatalln(err)
@@ -123,6 +139,12 @@ It aims to represent the contract of your API with a language agnostic descripti
 		case "operation":
 			cmd.ShortDescription = "generate one or more server operations from the swagger spec"
 			cmd.LongDescription = cmd.ShortDescription
+		case "markdown":
+			cmd.ShortDescription = "generate a markdown representation from the swagger spec"
+			cmd.LongDescription = cmd.ShortDescription
+		case "cli":
+			cmd.ShortDescription = "generate a command line client, with the client library it is built on"
+			cmd.LongDescription = cmd.ShortDescription
 		}
 	}
 
@@ -138,6 +160,9 @@ It aims to represent the contract of your API with a language agnostic descripti
 	}
 
 	if _, err := parser.Parse(); err != nil {
+		if e, ok := err.(*commands.ExitError); ok {
+			os.Exit(e.ExitCode())
+		}
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,39 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package contract checks that the responses of an API match its swagger
+specification, so that an implementation does not drift from its contract
+unnoticed.
+
+The response validator is a middleware of the go-openapi runtime, set after
+the routing, e.g. as the builder of the handler of an untyped API:
+
+	validator := contract.NewResponseValidator(doc, strfmt.Default, contract.Warn)
+	ctx := middleware.NewContext(doc, api, nil)
+	handler := ctx.APIHandler(validator.Middleware)
+
+The following is checked for the response of each operation:
+
+	status code    it is declared for the operation, or the operation has a default response
+	headers        the headers declared without a default value are set, and their values are valid
+	content type   it is produced by the operation, when the response has a body
+	body           a JSON body is valid against the schema of the response
+
+The responses are buffered until they are checked, so the validation is meant
+for development and contract test environments. Depending on the strictness,
+the responses which do not match the spec are logged, get a Warning header
+as well, or are replaced with a 500 error.
+*/
+package contract
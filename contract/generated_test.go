@@ -0,0 +1,178 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package contract_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/build"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-swagger/go-swagger/contract"
+	"github.com/go-swagger/go-swagger/generator"
+)
+
+// responseCase is a response checked by both the contract package and a generated server
+type responseCase struct {
+	Method string
+	Path   string
+	Code   int
+	Header http.Header
+	Body   []byte
+}
+
+func responseCases() []responseCase {
+	json := http.Header{"Content-Type": []string{runtime.JSONMime}}
+	return []responseCase{
+		{Method: "DELETE", Path: "/pets/{id}", Code: http.StatusNoContent, Header: http.Header{}},
+		{Method: "DELETE", Path: "/pets/{id}", Code: http.StatusOK, Header: http.Header{}},
+		{Method: "GET", Path: "/pets", Code: http.StatusOK, Header: json, Body: []byte(`[]`)},
+		{Method: "GET", Path: "/pets", Code: http.StatusOK, Header: http.Header{"X-Total": []string{"1"}, "Content-Type": []string{runtime.JSONMime}}, Body: []byte(`[{"id":1,"name":"rex the dog"}]`)},
+		{Method: "GET", Path: "/pets", Code: http.StatusOK, Header: http.Header{"X-Total": []string{"1"}, "Content-Type": []string{"application/json; charset=utf-8"}}, Body: []byte(`[{"id":1,"name":"rex"}]`)},
+		{Method: "GET", Path: "/pets", Code: http.StatusOK, Header: http.Header{"X-Total": []string{"many"}, "Content-Type": []string{"text/plain"}}, Body: []byte(`[]`)},
+		{Method: "POST", Path: "/pets", Code: http.StatusConflict, Header: json, Body: []byte(`{}`)},
+		{Method: "POST", Path: "/pets", Code: http.StatusCreated, Header: json, Body: []byte(`{"name":`)},
+		{Method: "POST", Path: "/pets", Code: http.StatusCreated, Header: json, Body: []byte(`{"id":"1"}`)},
+		{Method: "PUT", Path: "/pets", Code: http.StatusOK, Header: json},
+	}
+}
+
+// checkGeneratedMain runs the response cases read on stdin through the generated server
+const checkGeneratedMain = `package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/go-openapi/loads"
+
+	"%[1]s/restapi"
+	"%[1]s/restapi/operations"
+)
+
+func main() {
+	var cases []struct {
+		Method string
+		Path   string
+		Code   int
+		Header http.Header
+		Body   []byte
+	}
+	if err := json.NewDecoder(os.Stdin).Decode(&cases); err != nil {
+		log.Fatal(err)
+	}
+	spec, err := loads.Embedded(restapi.SwaggerJSON, restapi.FlatSwaggerJSON)
+	if err != nil {
+		log.Fatal(err)
+	}
+	api := operations.NewMockedPetstoreAPI(spec)
+	results := make([][]string, 0, len(cases))
+	for _, c := range cases {
+		messages := []string{}
+		for _, err := range api.ValidateResponse(c.Method, c.Path, c.Code, c.Header, c.Body) {
+			messages = append(messages, err.Error())
+		}
+		results = append(results, messages)
+	}
+	if err := json.NewEncoder(os.Stdout).Encode(results); err != nil {
+		log.Fatal(err)
+	}
+}
+`
+
+// TestResponseValidator_Generated checks that the generated servers validate the responses
+// as the contract package does, since they carry their own copy of the validation
+func TestResponseValidator_Generated(t *testing.T) {
+	if testing.Short() {
+		t.Skip("generates and runs a server")
+	}
+	defer log.SetOutput(os.Stdout)
+	log.SetOutput(ioutil.Discard)
+
+	spec, err := filepath.Abs(filepath.FromSlash("../fixtures/mock/petstore.yml"))
+	require.NoError(t, err)
+	generated, err := ioutil.TempDir(filepath.Dir(spec), "generated")
+	require.NoError(t, err)
+	defer os.RemoveAll(generated)
+
+	pkg, err := build.ImportDir(generated, build.FindOnly)
+	require.NoError(t, err)
+	if pkg.ImportPath == "." {
+		t.Skip("the generated server must be built from a GOPATH")
+	}
+
+	opts := &generator.GenOpts{
+		Spec:              spec,
+		Target:            generated,
+		APIPackage:        "operations",
+		ModelPackage:      "models",
+		ServerPackage:     "restapi",
+		DefaultScheme:     "http",
+		IncludeModel:      true,
+		IncludeValidator:  true,
+		IncludeHandler:    true,
+		IncludeParameters: true,
+		IncludeResponses:  true,
+		IncludeURLBuilder: true,
+		IncludeSupport:    true,
+		FlattenSpec:       true,
+	}
+	require.NoError(t, opts.EnsureDefaults())
+	require.NoError(t, generator.GenerateServer("", nil, nil, opts))
+
+	main := filepath.Join(generated, "check", "main.go")
+	require.NoError(t, os.MkdirAll(filepath.Dir(main), 0755))
+	require.NoError(t, ioutil.WriteFile(main, []byte(fmt.Sprintf(checkGeneratedMain, pkg.ImportPath)), 0644))
+
+	cases := responseCases()
+	input, err := json.Marshal(cases)
+	require.NoError(t, err)
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", "run", main)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("go run %s: %s\n%s", main, err, stderr.String())
+	}
+	var results [][]string
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &results))
+	require.Len(t, results, len(cases))
+
+	doc, err := loads.Spec(spec)
+	require.NoError(t, err)
+	validator := contract.NewResponseValidator(doc, nil, contract.Fail)
+	for i, c := range cases {
+		expected := []string{}
+		for _, err := range validator.Validate(c.Method, c.Path, c.Code, c.Header, c.Body) {
+			expected = append(expected, err.Error())
+		}
+		assert.Equal(t, expected, results[i], "%s %s %d", c.Method, c.Path, c.Code)
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package contract
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/analysis"
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Strictness tells what happens to the responses which do not match the spec
+type Strictness string
+
+const (
+	// Off disables the validation of the responses
+	Off Strictness = ""
+	// Log logs the responses which do not match the spec
+	Log Strictness = "log"
+	// Warn logs the responses which do not match the spec, and adds a Warning header to them
+	Warn Strictness = "warn"
+	// Fail logs the responses which do not match the spec, and replaces them with a 500 error
+	Fail Strictness = "fail"
+)
+
+// ParseStrictness parses the strictness of the validation of the responses: log, warn, fail, or off when empty
+func ParseStrictness(value string) (Strictness, error) {
+	switch strictness := Strictness(strings.ToLower(value)); strictness {
+	case Off, Log, Warn, Fail:
+		return strictness, nil
+	case "off":
+		return Off, nil
+	default:
+		return Off, fmt.Errorf("invalid strictness %q for the validation of the responses, expected log, warn, fail or off", value)
+	}
+}
+
+// ResponseValidator checks the responses of the operations of an API against its spec
+type ResponseValidator struct {
+	Strictness Strictness
+	// Logger logs the responses which do not match the spec, defaults to log.Printf
+	Logger func(string, ...interface{})
+	// ServeError writes the error replacing the responses which do not match the spec, defaults to errors.ServeError
+	ServeError func(http.ResponseWriter, *http.Request, error)
+
+	root     *spec.Swagger
+	analyzer *analysis.Spec
+	formats  strfmt.Registry
+}
+
+// NewResponseValidator creates a validator of the responses of the API described by a spec
+func NewResponseValidator(doc *loads.Document, formats strfmt.Registry, strictness Strictness) *ResponseValidator {
+	if formats == nil {
+		formats = strfmt.Default
+	}
+	analyzer := doc.Analyzer
+	if analyzer == nil {
+		// the embedded documents are not analyzed
+		analyzer = analysis.New(doc.Spec())
+	}
+	return &ResponseValidator{
+		Strictness: strictness,
+		root:       doc.Spec(),
+		analyzer:   analyzer,
+		formats:    formats,
+	}
+}
+
+// Middleware checks the responses of the next handler, with the strictness of the validator when it is called.
+//
+// It is set after the routing, as the operation of a request is taken from its matched route:
+// the requests without route are not checked. All the requests are answered with a 500 error
+// when the strictness is not valid.
+func (v *ResponseValidator) Middleware(next http.Handler) http.Handler {
+	strictness, err := ParseStrictness(string(v.Strictness))
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if err != nil {
+			v.serveError(rw, r, errors.New(http.StatusInternalServerError, "%v", err))
+			return
+		}
+		route := middleware.MatchedRouteFrom(r)
+		if strictness == Off || route == nil {
+			next.ServeHTTP(rw, r)
+			return
+		}
+
+		recorder := newResponseRecorder(rw.Header())
+		next.ServeHTTP(recorder, r)
+
+		path := strings.TrimPrefix(route.PathPattern, route.BasePath)
+		errs := v.Validate(r.Method, path, recorder.code, recorder.header, recorder.body.Bytes())
+		if len(errs) == 0 {
+			recorder.writeTo(rw)
+			return
+		}
+
+		messages := make([]string, 0, len(errs))
+		for _, err := range errs {
+			messages = append(messages, err.Error())
+		}
+		message := "the response does not match the spec: " + strings.Join(messages, "; ")
+		v.logf("%s %s: %s", r.Method, r.URL.Path, message)
+
+		switch strictness {
+		case Fail:
+			v.serveError(rw, r, errors.New(http.StatusInternalServerError, "%s", message))
+			return
+		case Warn:
+			recorder.header.Add("Warning", fmt.Sprintf("199 - %q", message))
+		}
+		recorder.writeTo(rw)
+	})
+}
+
+// Validate checks a response of the operation at a path of the spec, and returns the ways it does not match the spec.
+//
+// The generated servers check their responses with a copy of this validation, which must give the same results.
+func (v *ResponseValidator) Validate(method, path string, code int, header http.Header, body []byte) []error {
+	operation, ok := v.analyzer.OperationFor(method, path)
+	if !ok {
+		return []error{fmt.Errorf("operation %s %s is not declared", strings.ToUpper(method), path)}
+	}
+
+	var response *spec.Response
+	if operation.Responses != nil {
+		if resp, ok := operation.Responses.StatusCodeResponses[code]; ok {
+			response = &resp
+		} else {
+			response = operation.Responses.Default
+		}
+	}
+	if response == nil {
+		return []error{fmt.Errorf("status code %d is not declared", code)}
+	}
+	if response.Ref.String() != "" {
+		resolved, err := spec.ResolveResponse(v.root, response.Ref)
+		if err != nil {
+			return []error{err}
+		}
+		response = resolved
+	}
+
+	names := make([]string, 0, len(response.Headers))
+	for name := range response.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var errs []error
+	for _, name := range names {
+		declared := response.Headers[name]
+		errs = append(errs, v.validateHeader(name, &declared, header)...)
+	}
+
+	if method == http.MethodHead || len(body) == 0 {
+		return errs
+	}
+	if response.Schema == nil {
+		return append(errs, fmt.Errorf("the response has a body, but no schema is declared for status code %d", code))
+	}
+
+	produces := v.analyzer.ProducesFor(operation)
+	mediaType := normalizeMediaType(header.Get(runtime.HeaderContentType))
+	if len(produces) > 0 && !containsMediaType(produces, mediaType) {
+		errs = append(errs, fmt.Errorf("the content type %q is not produced by the operation", mediaType))
+	}
+	if !strings.Contains(mediaType, "json") {
+		return errs
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return append(errs, fmt.Errorf("the body is not valid JSON: %v", err))
+	}
+	if result := validate.NewSchemaValidator(response.Schema, v.root, "", v.formats).Validate(data); result != nil {
+		errs = append(errs, result.Errors...)
+	}
+	return errs
+}
+
+// validateHeader checks that a header declared without a default value is set, and that its value is valid
+func (v *ResponseValidator) validateHeader(name string, declared *spec.Header, header http.Header) []error {
+	value := header.Get(name)
+	if value == "" {
+		if declared.Default == nil {
+			return []error{fmt.Errorf("the header %s is not set", name)}
+		}
+		return nil
+	}
+
+	var data interface{} = value
+	switch declared.Type {
+	case "integer":
+		i, err := swag.ConvertInt64(value)
+		if err != nil {
+			return []error{errors.InvalidType(name, "header", declared.Type, value)}
+		}
+		data = i
+	case "number":
+		f, err := swag.ConvertFloat64(value)
+		if err != nil {
+			return []error{errors.InvalidType(name, "header", declared.Type, value)}
+		}
+		data = f
+	case "boolean":
+		b, err := swag.ConvertBool(value)
+		if err != nil {
+			return []error{errors.InvalidType(name, "header", declared.Type, value)}
+		}
+		data = b
+	case "array":
+		values := swag.SplitByFormat(value, declared.CollectionFormat)
+		items := make([]interface{}, 0, len(values))
+		for _, item := range values {
+			items = append(items, item)
+		}
+		data = items
+	}
+	if result := validate.NewHeaderValidator(name, declared, v.formats).Validate(data); result != nil {
+		return result.Errors
+	}
+	return nil
+}
+
+func (v *ResponseValidator) logf(format string, args ...interface{}) {
+	if v.Logger != nil {
+		v.Logger(format, args...)
+		return
+	}
+	log.Printf(format, args...)
+}
+
+func (v *ResponseValidator) serveError(rw http.ResponseWriter, r *http.Request, err error) {
+	if v.ServeError != nil {
+		v.ServeError(rw, r, err)
+		return
+	}
+	errors.ServeError(rw, r, err)
+}
+
+func normalizeMediaType(mediaType string) string {
+	return strings.ToLower(strings.TrimSpace(strings.SplitN(mediaType, ";", 2)[0]))
+}
+
+func containsMediaType(mediaTypes []string, mediaType string) bool {
+	for _, mt := range mediaTypes {
+		if normalizeMediaType(mt) == mediaType {
+			return true
+		}
+	}
+	return false
+}
+
+// responseRecorder buffers a response until it is checked
+type responseRecorder struct {
+	header      http.Header
+	code        int
+	body        bytes.Buffer
+	wroteHeader bool
+}
+
+func newResponseRecorder(header http.Header) *responseRecorder {
+	recorder := &responseRecorder{header: make(http.Header, len(header)), code: http.StatusOK}
+	for name, values := range header {
+		recorder.header[name] = append([]string(nil), values...)
+	}
+	return recorder
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(code int) {
+	if !r.wroteHeader {
+		r.code = code
+		r.wroteHeader = true
+	}
+}
+
+func (r *responseRecorder) Write(p []byte) (int, error) {
+	r.wroteHeader = true
+	return r.body.Write(p)
+}
+
+// writeTo writes the buffered response
+func (r *responseRecorder) writeTo(rw http.ResponseWriter) {
+	header := rw.Header()
+	for name := range header {
+		if _, ok := r.header[name]; !ok {
+			header.Del(name)
+		}
+	}
+	for name, values := range r.header {
+		header[name] = values
+	}
+	rw.WriteHeader(r.code)
+	if _, err := r.body.WriteTo(rw); err != nil {
+		log.Printf("could not write the response: %v", err)
+	}
+}
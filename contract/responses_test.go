@@ -0,0 +1,193 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package contract
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/runtime/middleware/untyped"
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testResponder writes a response as is
+type testResponder struct {
+	code   int
+	header map[string]string
+	body   string
+}
+
+func (r testResponder) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	for name, value := range r.header {
+		rw.Header().Set(name, value)
+	}
+	rw.WriteHeader(r.code)
+	fmt.Fprint(rw, r.body)
+}
+
+func testHandler(t *testing.T, strictness Strictness, response testResponder) (http.Handler, *[]string) {
+	doc, err := loads.Spec("../fixtures/mock/petstore.yml")
+	require.NoError(t, err)
+
+	api := untyped.NewAPI(doc)
+	api.RegisterConsumer(runtime.JSONMime, runtime.JSONConsumer())
+	api.RegisterProducer(runtime.JSONMime, runtime.JSONProducer())
+	api.RegisterAuth("key", runtime.AuthenticatorFunc(func(interface{}) (bool, interface{}, error) { return true, "key", nil }))
+	api.RegisterOperation("GET", "/pets", runtime.OperationHandlerFunc(func(interface{}) (interface{}, error) {
+		return response, nil
+	}))
+
+	validator := NewResponseValidator(doc, strfmt.Default, strictness)
+	var logged []string
+	validator.Logger = func(format string, args ...interface{}) {
+		logged = append(logged, fmt.Sprintf(format, args...))
+	}
+	return middleware.NewContext(doc, api, nil).APIHandler(validator.Middleware), &logged
+}
+
+func serve(handler http.Handler) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/pets", nil))
+	return rec
+}
+
+func TestResponseValidator_Valid(t *testing.T) {
+	handler, logged := testHandler(t, Fail, testResponder{
+		code:   http.StatusOK,
+		header: map[string]string{"X-Total": "1", "Content-Type": runtime.JSONMime},
+		body:   `[{"id":1,"name":"rex the dog","tag":"dog"}]`,
+	})
+
+	rec := serve(handler)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `[{"id":1,"name":"rex the dog","tag":"dog"}]`, rec.Body.String())
+	assert.Equal(t, "1", rec.Header().Get("X-Total"))
+	assert.Empty(t, rec.Header().Get("Warning"))
+	assert.Empty(t, *logged)
+
+	// the default response covers the undeclared status codes
+	handler, logged = testHandler(t, Fail, testResponder{
+		code:   http.StatusServiceUnavailable,
+		header: map[string]string{"Content-Type": runtime.JSONMime},
+		body:   `{"code":503,"message":"unavailable"}`,
+	})
+	rec = serve(handler)
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Empty(t, *logged)
+}
+
+func TestResponseValidator_Strictness(t *testing.T) {
+	invalid := testResponder{
+		code:   http.StatusOK,
+		header: map[string]string{"X-Total": "0", "Content-Type": runtime.JSONMime},
+		body:   `[{"id":1,"name":"rex","tag":"bird"}]`,
+	}
+
+	handler, logged := testHandler(t, Log, invalid)
+	rec := serve(handler)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, invalid.body, rec.Body.String())
+	assert.Empty(t, rec.Header().Get("Warning"))
+	if assert.Len(t, *logged, 1) {
+		assert.Contains(t, (*logged)[0], "GET /api/pets: the response does not match the spec")
+		assert.Contains(t, (*logged)[0], "X-Total in response should be greater than or equal to 1")
+		assert.Contains(t, (*logged)[0], "name in body should be at least 10 chars long")
+		assert.Contains(t, (*logged)[0], "tag in body should be one of [dog cat]")
+	}
+
+	handler, logged = testHandler(t, Warn, invalid)
+	rec = serve(handler)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, invalid.body, rec.Body.String())
+	assert.Contains(t, rec.Header().Get("Warning"), `199 - "the response does not match the spec:`)
+	assert.Len(t, *logged, 1)
+
+	handler, logged = testHandler(t, Fail, invalid)
+	rec = serve(handler)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Contains(t, rec.Body.String(), "the response does not match the spec")
+	assert.Empty(t, rec.Header().Get("X-Total"))
+	assert.Len(t, *logged, 1)
+
+	handler, logged = testHandler(t, Off, invalid)
+	rec = serve(handler)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, *logged)
+}
+
+func TestResponseValidator_Validate(t *testing.T) {
+	doc, err := loads.Spec("../fixtures/mock/petstore.yml")
+	require.NoError(t, err)
+	validator := NewResponseValidator(doc, nil, Fail)
+
+	json := http.Header{"Content-Type": []string{runtime.JSONMime}}
+	assert.Empty(t, validator.Validate("DELETE", "/pets/{id}", http.StatusNoContent, http.Header{}, nil))
+
+	errs := validator.Validate("DELETE", "/pets/{id}", http.StatusOK, http.Header{}, nil)
+	if assert.Len(t, errs, 1) {
+		assert.EqualError(t, errs[0], "status code 200 is not declared")
+	}
+
+	errs = validator.Validate("GET", "/pets", http.StatusOK, json, []byte(`[]`))
+	if assert.Len(t, errs, 1) {
+		assert.EqualError(t, errs[0], "the header X-Total is not set")
+	}
+
+	errs = validator.Validate("GET", "/pets", http.StatusOK, http.Header{"X-Total": []string{"many"}, "Content-Type": []string{"text/plain"}}, []byte(`[]`))
+	if assert.Len(t, errs, 2) {
+		assert.Contains(t, errs[0].Error(), "X-Total in header must be of type integer")
+		assert.EqualError(t, errs[1], `the content type "text/plain" is not produced by the operation`)
+	}
+
+	errs = validator.Validate("POST", "/pets", http.StatusConflict, json, []byte(`{}`))
+	if assert.Len(t, errs, 1) {
+		assert.EqualError(t, errs[0], "the response has a body, but no schema is declared for status code 409")
+	}
+
+	errs = validator.Validate("POST", "/pets", http.StatusCreated, json, []byte(`{"name":`))
+	if assert.Len(t, errs, 1) {
+		assert.Contains(t, errs[0].Error(), "the body is not valid JSON")
+	}
+
+	errs = validator.Validate("PUT", "/pets", http.StatusOK, json, nil)
+	if assert.Len(t, errs, 1) {
+		assert.EqualError(t, errs[0], "operation PUT /pets is not declared")
+	}
+}
+
+func TestParseStrictness(t *testing.T) {
+	for value, expected := range map[string]Strictness{"": Off, "off": Off, "log": Log, "Warn": Warn, "fail": Fail} {
+		strictness, err := ParseStrictness(value)
+		if assert.NoError(t, err, value) {
+			assert.Equal(t, expected, strictness, value)
+		}
+	}
+	_, err := ParseStrictness("strict")
+	assert.Error(t, err)
+}
+
+func TestResponseValidator_InvalidStrictness(t *testing.T) {
+	handler, _ := testHandler(t, Strictness("strict"), testResponder{code: http.StatusOK, body: "[]"})
+	rec := serve(handler)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Contains(t, rec.Body.String(), `invalid strictness \"strict\"`)
+}
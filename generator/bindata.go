@@ -1,12 +1,25 @@
 // Code generated by go-bindata.
 // sources:
 // templates/additionalpropertiesserializer.gotmpl
+// templates/cli/cli.gotmpl
+// templates/cli/commands.gotmpl
+// templates/cli/main.gotmpl
+// templates/cli/params.gotmpl
+// templates/client/auth.gotmpl
+// templates/client/cassette.gotmpl
 // templates/client/client.gotmpl
 // templates/client/facade.gotmpl
+// templates/client/files.gotmpl
+// templates/client/interceptors.gotmpl
+// templates/client/mock.gotmpl
+// templates/client/options.gotmpl
 // templates/client/parameter.gotmpl
 // templates/client/response.gotmpl
+// templates/client/retry.gotmpl
+// templates/client/signature.gotmpl
 // templates/docstring.gotmpl
 // templates/header.gotmpl
+// templates/markdown/docs.gotmpl
 // templates/model.gotmpl
 // templates/modelvalidator.gotmpl
 // templates/schema.gotmpl
@@ -16,12 +29,16 @@
 // templates/server/builder.gotmpl
 // templates/server/configureapi.gotmpl
 // templates/server/doc.gotmpl
+// templates/server/handlers.gotmpl
+// templates/server/health.gotmpl
 // templates/server/main.gotmpl
+// templates/server/metrics.gotmpl
 // templates/server/operation.gotmpl
 // templates/server/parameter.gotmpl
 // templates/server/responses.gotmpl
 // templates/server/server.gotmpl
 // templates/server/urlbuilder.gotmpl
+// templates/server/validation.gotmpl
 // templates/structfield.gotmpl
 // templates/swagger_json_embed.gotmpl
 // templates/tuplefield.gotmpl
@@ -116,7 +133,127 @@ func templatesAdditionalpropertiesserializerGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesClientClientGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x4d\x6f\xe3\x36\x10\xbd\xf3\x57\x4c\xdd\x74\x61\x05\x8e\xd4\x5e\x5d\xe4\xb0\x88\xb7\xd8\x1c\x76\x13\xc4\x46\xf7\x58\x30\xd2\x48\x22\x22\x91\x5a\x72\x14\xd7\x2b\xe8\xbf\x17\xa4\x28\xda\xf2\x47\xb2\xc7\x5e\x12\x8b\xf3\xe6\x91\xf3\xe6\x83\x4c\x12\xb8\x53\x19\x42\x81\x12\x35\x27\xcc\xe0\x79\x07\x85\xba\x31\x5b\x5e\x14\xa8\xff\x84\xd5\x03\x7c\x7d\xd8\xc0\xa7\xd5\xfd\x26\x66\x8c\x75\x1d\x88\x1c\xe2\x3b\xd5\xec\xb4\x28\x4a\x82\x9b\xbe\x4f\x12\xe8\x3a\x48\x55\x5d\xa3\xa4\x23\x5b\xd7\x01\xca\x0c\xfa\x9e\x31\xd6\xf0\xf4\x85\x17\x68\xc1\xf1\x57\x5e\xa3\x5b\x4d\x12\xd8\x94\xc2\x40\x2e\x2a\x84\x2d\x37\xd3\x93\x50\x89\xe0\x8f\x02\xa4\x54\x15\x5b\xfc\xa7\x4c\x90\x90\x05\x50\xf0\xab\xdd\x76\x8d\x56\xaf\x08\x79\x4b\x8e\xaa\x44\x09\x3b\xd5\x82\xc6\x1b\xdd\xca\x09\xd3\xb8\x85\x3b\x33\x97\x19\x63\xa2\x6e\x94\x26\x98\x33\x80\x99\x44\x4a\x4a\xa2\x66\x66\x3f\x0a\x41\x65\xfb\x1c\xa7\xaa\x4e\x0a\x75\xa3\x1a\x94\xbc\x11\x09\x6a\xad\xb4\x79\x03\x60\x77\x7a\xc3\xac\x5b\x49\xa2\xc6\x37\x10\xaf\xbc\x12\x19\x27\x9c\x31\x06\x60\x48\xe7\x35\x5d\xdc\xcb\x59\x1d\xb0\xeb\x40\x73\x59\x20\xc4\x2b\xcc\x79\x5b\xd1\xbd\x8b\xcb\x80\x4b\x44\xa3\x85\xa4\x1c\x66\xbf\x7d\x9f\x41\xdc\xf7\x03\xde\x67\xe7\xc0\xf7\xea\x05\x77\x0b\xb8\x7a\xe5\x55\x8b\xb0\xbc\x85\x78\x42\x62\xad\xd0\xf7\x70\xc4\xe7\xe1\x47\xac\x91\xcb\xef\x57\xdc\x42\xaa\x91\x13\x1a\xe0\x20\x71\x6b\x11\x65\x5b\x73\x29\x7e\x60\x28\x05\xf8\xf8\x78\x0f\x69\x25\x50\x52\xcc\xf2\x56\xa6\xd6\x6f\x4e\x9a\x4b\xe3\x72\xe3\x35\x8b\xef\x1c\x64\x33\xae\x2f\x20\x57\xba\xe6\x64\xbc\x4a\xf1\x13\x16\xc2\x90\xde\x45\x70\x3d\x40\xa1\x63\x00\x1a\xa9\xd5\x12\x3e\x0c\x4b\x5d\xa0\x5d\x02\x9d\x30\x2d\xc7\x1f\x3d\xb3\x05\x7a\xcd\x46\x9e\xa1\xf6\xd7\x6d\x5d\x73\xbd\x1b\xe4\x98\x7e\x59\xf3\x0a\x4d\xaa\x45\x43\x42\x49\x57\xe0\x5d\x07\xcf\x95\x4a\x5f\x42\x7f\x4c\x01\x41\x2c\xfb\xa3\x32\x78\xcc\xe1\x0c\xef\x11\x58\xbf\xbe\xcf\x95\xbe\xa8\xec\x3e\x27\xd7\x09\xa3\x5d\x83\xe0\x83\x32\xa4\xdb\x74\xd0\xe8\x5d\xad\x19\x5c\x12\x9b\x0d\x81\xfa\xe2\x7b\x68\x6c\x7b\x09\x25\x6d\xcd\x24\xd7\xd6\xd2\x70\x93\xf2\x6a\x72\xaa\x73\x72\x36\x55\xab\x1d\xec\x2f\xa1\x0d\x7d\x53\x3a\x83\xf9\x3e\x1e\x0f\x8d\xfe\x0f\x62\xff\x94\xd0\xae\x8c\xe7\x7c\xac\xc4\x08\xce\x2a\x31\x6f\xb8\xe6\xb5\x81\xeb\xb3\xd6\x47\x67\xf4\xf1\x7e\x6c\xa9\x54\x5a\xfc\x40\xbb\xc3\x02\x78\x4b\xe5\xbd\xcc\xd5\x51\xc2\x3e\xfa\xe5\x6f\x5a\x10\xea\xae\x43\x99\x05\xc5\x3e\x73\xb3\x26\x8d\xbc\x16\xb2\x78\x42\xd3\x28\xe9\xc2\x59\xc0\xd6\x81\x41\xa8\x78\x74\xf3\x81\x44\xfb\x4c\xa5\x29\x1a\x73\xe0\x35\xdf\xe7\xfc\xc8\x68\x33\x7f\x3e\x9e\x05\x4c\xb2\xe0\x7e\xb8\x79\x7a\x71\x97\x28\xe0\x5c\x99\xda\x1b\xe3\x61\xf5\xb0\x84\xbf\xfd\x8c\x74\xb3\xdd\x6b\xf8\x8c\xb9\xd2\x08\x06\x65\x26\x64\xc1\xc0\x52\x7a\xd3\xed\x2d\x48\x51\x39\x0a\x08\x6b\x76\xc8\xbc\x21\xfb\x3c\x62\x00\x7e\xa6\x5d\x55\x28\x0b\x2a\xed\x48\xac\x50\x9e\x8d\x78\x00\x9e\x8f\x42\xa3\x69\x2b\xea\x3a\x5b\x3f\x7d\xff\x4f\x88\x69\x01\xa8\xb5\x25\xe5\x71\x68\xc0\x78\xdd\x3e\xd7\x82\xe6\x1f\xa6\x79\x0d\x7d\x35\xc4\x70\xbf\x5a\x1e\x8f\xe1\x20\xb2\x03\x7c\x41\x2a\x55\x76\x0a\x1a\xd6\x03\xec\x91\x53\xf9\xc8\x89\x50\xcb\x53\xac\x35\xee\x91\x5a\x65\x6d\x8a\xe6\x0b\x66\x82\x6f\x76\x0d\x9a\xa9\xc3\xaf\xaf\xd6\xe3\x04\x14\xfc\xef\x94\x34\x6d\xfd\x8e\xff\x29\x28\xf8\xaf\xd3\x12\xeb\xb3\x4e\xde\x72\x10\x93\x4d\xdf\xd2\xe7\x79\x58\x7b\x42\x9e\xa1\x5e\xc2\x87\xb3\x09\x1f\xac\x5d\xb8\x03\x78\xec\x7f\xfe\x5c\xe3\x2c\xfd\xff\x90\xd7\x7e\x71\xae\x67\xdd\x41\xc6\xfe\x5c\x86\x06\x5e\x0c\x6e\xde\x7e\xa7\x24\xe1\xbf\x34\x9e\x3e\xf6\xdf\x5e\x43\x57\x0a\xc1\xf6\x79\xb3\x79\x1c\x96\xac\xb9\x8f\x86\x92\xb7\x25\xf5\xcb\x61\xbd\xfb\xfb\xef\x62\x75\x3a\x49\xb2\x75\xab\xb5\x6a\x65\x06\x33\x29\xaa\x99\xff\xfb\x7b\xa8\xfc\x49\xf3\xa2\xd6\xfb\xde\xb8\x48\x6a\xcf\xf2\x3d\x10\xfc\xe1\xfa\xc0\x9d\x64\x68\x87\x78\x7e\x34\x24\x8e\x48\xc6\xe4\x44\x0b\x1b\xcb\x7e\xfa\x9a\xad\xa0\xb4\x84\xf0\x46\x19\xd9\xec\xcd\x16\x41\x77\xf0\x98\x11\xf6\x29\xe3\x9e\x31\xe7\xfb\x15\x20\xe5\x06\x8f\x66\xef\xd5\xeb\xb8\xf1\xd2\x41\x0e\xf5\x9b\xc8\xe4\x0e\x30\x0a\x75\x25\x26\x4a\xf9\x03\x0f\x83\xab\x67\x17\x39\x2e\x4a\x7d\x48\xe0\x9f\x55\x95\x1f\x25\x8e\x68\x62\x67\x3d\x3b\xf8\x48\x12\x58\xe3\xfe\xe2\x86\xb4\xb4\x6a\x18\x37\x29\xf7\xd7\xbc\x1a\x9e\xc5\xc3\xa3\xeb\xf4\xb2\x3a\x64\x78\xff\x21\x16\xb9\x4a\x3b\x18\x62\x70\xbb\xdf\x8a\xf5\xec\xbf\x00\x00\x00\xff\xff\x3a\x63\x6b\x72\x6a\x0c\x00\x00")
+var _templatesCliCliGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x3b\x5d\x73\xdb\xb6\x96\xef\xfa\x15\xa7\x9c\x75\x4b\x66\x68\x3a\xed\xed\x74\x76\xdc\xd1\x83\x13\xbb\x5b\xdd\x36\xb6\x27\xb6\x6f\x67\xc7\xcd\x24\xb0\x08\x49\xa8\x29\x80\x05\x40\xa9\x5e\x5d\xfd\xf7\x9d\x73\x00\x90\xa0\xbe\xec\xe4\xde\x9d\xcd\x43\x22\x02\xe7\x1c\x9c\xef\x0f\x90\x39\x39\x81\xb7\xaa\xe4\x30\xe5\x92\x6b\x66\x79\x09\x0f\x4f\x30\x55\xc7\x66\xc9\xa6\x53\xae\x7f\x84\xf3\x2b\xb8\xbc\xba\x85\x8b\xf3\xd1\x6d\x31\x18\x0c\x56\x2b\x10\x13\x28\xde\xaa\xfa\x49\x8b\xe9\xcc\xc2\xf1\x7a\x7d\x72\x02\xab\x15\x8c\xd5\x7c\xce\xa5\xdd\xd8\x5b\xad\x80\xcb\x12\xd6\xeb\xc1\x60\x50\xb3\xf1\x23\x9b\x72\x18\x57\x62\x30\x38\x39\x81\xdb\x99\x30\x30\x11\x15\x87\x25\x33\x7d\x0e\xec\x8c\x83\x67\x01\xac\x52\x55\x81\xf0\x17\xa5\xb0\x42\x4e\xc1\xb6\x78\x73\x62\xa1\xd6\x6a\xc1\x61\xd2\x58\x22\x35\xe3\x12\x9e\x54\x03\x9a\x1f\xeb\x46\xf6\x28\x85\x23\x88\x57\x26\x4b\x12\xe7\x3f\xb8\x5c\xc0\xe9\x10\x9a\xba\xe6\x1a\x52\x23\xd9\xa3\xf8\x1f\x0e\x69\xcd\xcc\x98\x55\xf8\xb3\xb8\x64\x73\x9e\x65\x28\x84\x98\xd7\x4a\x5b\x48\x07\x00\xc9\xc3\x93\xe5\x26\xc1\x5f\x5c\x8e\x55\x29\xe4\xb4\xf7\x70\xf2\x87\x51\x92\x56\x26\x73\x4b\xff\x0a\xe5\xff\x39\x11\x0a\x99\xa5\x27\xe5\x48\x68\x3e\xa9\xf8\xd8\xc1\x19\xa5\xfd\x0f\xab\xc7\x4a\x2e\xc2\x6f\x21\xa7\x0e\xd8\xf2\xbf\xec\x89\x65\x0f\x4b\x2d\x2c\xd7\x6e\x49\xcc\x79\x32\xc0\x5f\x53\x61\x67\xcd\x43\x31\x56\xf3\x93\xa9\x3a\x56\x35\x97\xac\x16\x27\xba\x91\x0e\x04\x60\x66\x6d\x6d\x35\x93\x86\x44\x39\x0c\x7f\x32\xae\x04\x97\x36\xd9\x4f\x18\xad\x84\xdb\x93\x8a\x4d\x4d\x0f\xe8\x0f\x6e\x0c\x5f\x94\x8f\x08\x4d\xbb\x08\xf6\xc4\xe6\x15\x24\x53\x55\x3f\x4e\x0b\x21\x4f\xf0\xb1\x58\x7c\x47\x9c\x1b\xab\x27\xf3\xbd\x0c\xb9\x5d\x02\x64\xb5\x70\x6c\xa1\xdb\xd5\x5a\x48\x3b\x81\xe4\xe8\xcf\x04\xd2\xf6\xc1\x9c\x1c\x99\x04\x8a\x5b\xa6\xa7\xdc\x8e\xc8\x68\xd7\xcc\xce\xa0\xb8\x76\x3e\x48\xc6\xcc\x06\x83\x93\x57\x83\xb3\xba\x06\x61\xc8\x4f\xbc\x5b\x40\x25\x24\x79\x29\xba\xb3\x9a\xd0\xd6\x6a\x05\xb3\x66\xce\x64\xeb\x0f\xb0\x5e\xc3\xd9\xf5\xc8\xc7\xc3\x48\x4e\x14\xac\xd7\xd1\x53\x71\xce\xcd\x58\x8b\xda\x0a\x25\xf1\x30\x74\xb5\x87\x4a\x8d\x1f\xdb\x38\xd9\x01\xd5\x46\x4b\x14\x36\x23\x0b\x33\x66\x80\x05\xa7\x05\x74\x53\x55\x63\xac\x20\xe9\xa9\x56\x4d\x9d\xc3\x52\xd8\x19\x30\x30\xcd\xc3\x4e\xb0\x62\xf0\xea\x64\x60\x9f\x6a\x0e\x28\xae\xb1\xba\x19\x5b\x58\x0d\x00\x7e\x56\xc6\x02\xfe\x71\xfe\x05\xfe\xcf\xa7\x4a\xc9\xe9\x69\x32\x53\xc6\x26\xc0\xe5\xe2\x34\x09\x91\xb2\x5e\x7f\xfc\xf9\xea\xe6\x36\x81\xb2\xe3\xfc\x34\x41\x15\x21\x70\x50\xd7\xd9\xf5\x08\x21\x26\xac\xa9\xec\x29\x26\x87\x4a\xdc\xb2\xe9\x3f\x58\xd5\x70\x28\xe8\xcc\xf5\xfa\xd3\x00\xe0\x0d\x33\x9c\x0c\xb3\xf3\xf8\x07\x66\xf8\x71\xcd\xec\x6c\x9b\x87\x37\x67\x37\x17\x1f\xaf\xcf\x6e\x7f\xde\xc1\x08\xa2\x01\xa2\xbd\x88\x9b\x96\x05\xc7\xd1\xcd\x78\xc6\xe7\x7c\x9f\x42\x0c\xed\x6e\xb3\x73\xf3\xf6\xe7\x8b\x77\x17\x3b\x78\x71\x08\x31\x23\xab\x15\x68\x26\xa7\x1c\x0a\x77\x94\x41\x4f\x1a\xcf\x94\x18\xf3\x2d\xde\x62\x4f\xd8\x27\x40\x2a\x64\xc9\xff\xea\xa8\xbd\x46\xdf\x46\x49\xae\x1a\x5b\x37\x76\x9f\x24\x8a\x76\x13\x30\x33\xa5\xed\x69\xa2\x76\xf0\x3e\x51\x7a\xce\x5a\x93\x6a\x6e\x6a\x25\x0d\x37\x49\xe0\x36\xa1\x0c\xd7\x3e\x61\x2c\x77\x7b\x96\x3d\x54\xbc\xd3\xba\x83\x45\xb6\x6e\xc5\x9c\x2b\xe4\x0b\xf3\x4b\x71\xde\x78\x3f\xf6\x6c\xe1\xa2\x6a\xec\x0e\x6e\xfc\x4e\xc7\xce\x9f\x0d\x37\xd6\x44\x47\xfc\xed\xb5\xa1\x13\xce\xf9\x43\x43\x86\x7b\x50\xaa\x02\xd8\x10\xbc\xc4\xdd\x0d\xfa\x94\x37\x4c\x8f\x2e\x60\x0c\xf5\xe5\x46\xda\xab\xd5\x71\x6b\x3e\x3e\x6e\xb4\xb0\x4f\xe7\x7c\x22\xa4\x40\x21\xd0\x94\x1e\x06\x8b\xe4\xc8\xbc\x61\x46\x8c\xcf\x1a\x72\x2e\xcc\x5c\xae\x52\x46\x95\x65\x74\x0e\xeb\xf5\x9d\xe1\x5a\x62\x4a\xf1\x69\xa8\x09\xcf\x5e\xd4\xd5\xca\x03\x82\xf1\x47\x7a\xb7\x1a\xc0\x41\x72\xde\xee\x5e\xee\xd5\x0a\x4a\x66\x66\x5c\x77\x90\xc7\xe1\xa4\x6d\x87\x5e\xad\x36\x0b\x62\x31\x3a\x47\xcf\xfa\x78\x77\x73\xf1\xfe\xf2\x6c\xd3\xd9\x37\x9d\x32\x64\xe2\x5d\xf2\x1c\x99\x4d\x41\x92\x40\xfd\xd3\x5e\x25\x5d\x33\x63\x96\x4a\x97\x41\x49\x75\x78\xfe\x32\x25\xb5\xe4\x9e\x57\x52\x38\xe9\x73\x94\x74\x7d\x76\x73\xf3\xdb\xd5\xfb\xf3\x97\x2b\x69\x53\x9e\x67\x94\x84\x7e\xc8\x2b\xc3\x5d\xbd\x31\x67\xd7\xa3\x5f\xf8\xd3\xb3\x9e\x16\x94\xf7\xc8\x9f\xbe\x50\x6f\xcf\xeb\xeb\x33\xd4\xf4\x72\xed\x44\x0c\x6f\x2b\x26\x07\x83\x05\x55\xb8\x66\xef\xe8\x4f\xa0\xda\x3f\x3a\xf7\x85\xba\x18\xc9\x3d\x5a\xbb\x42\x85\x7d\x77\x50\x63\xb7\xea\x91\xcb\xa0\x36\x36\x1e\x73\x63\xc0\xd2\xda\x97\xe9\xcf\xd1\x7b\xde\xe9\xe8\x8c\xcf\xf1\xb8\xdb\xab\x5f\x2e\x2e\x5f\xae\xd0\x5d\xa2\x1c\x19\xf0\x1a\x79\xd6\xf3\x5c\x6f\xb2\xf5\x10\x52\xe3\x55\xe8\x3b\xfe\x0b\xbb\x93\x36\x2d\xc6\xda\x08\x3d\xd4\xab\x9d\xcb\x6f\x5d\x13\x63\xe0\x93\x6f\x67\x36\xb5\xe4\xe1\xb6\x04\xc6\x70\xd8\xea\xa9\x62\x2d\xec\xe8\xb8\x30\x8c\xd6\xeb\x43\xda\x3a\x32\xa1\xfb\x32\x09\xa4\xfd\x46\x30\xcb\xe2\x2a\xbd\xa1\xa1\x01\x84\x3e\xf2\x55\xdb\xb5\x16\x3b\x25\xa6\x06\xb8\x14\x12\x40\xa8\xe2\x3d\x67\x25\xd7\x6e\x09\x6b\x9e\x50\xc5\x6f\xd4\xeb\xbb\x25\xae\x75\xb4\xb4\xa6\x61\xea\x92\x2f\x61\xac\x39\xb3\xfc\x8b\x7b\x59\xdf\x45\x22\xb6\xb1\x4c\x96\x0c\xb3\xad\xc4\x1e\x02\x09\xb9\x86\xc1\x0c\x26\x8d\x1c\xc3\x25\x5f\xa6\x19\xbc\xc2\x66\x12\xbb\x48\x56\xd7\x38\x42\x7d\x7d\x56\xd7\x2b\x63\x4b\x21\x4f\x41\x99\xe2\x06\x7f\xe5\x5e\x86\xb0\xa2\x1a\x9b\x7b\x19\xc2\x12\xd7\x7a\xdd\x2f\xad\x3b\xfd\x87\xd5\xf5\x6e\xd5\xc1\x10\x24\x5f\x1e\xf4\xa3\x94\xd5\x75\xd6\x37\x0d\x80\xe6\xb6\xd1\x12\x58\x5d\x7b\x1d\xfe\x26\xec\x6c\x74\x05\x86\x5b\xf3\x8c\x16\x82\x2a\x83\x57\x38\xad\xa4\x8c\x54\x92\x79\x42\x29\xa9\xa2\xb3\x67\x50\x45\xbe\x65\xc3\x58\x95\x05\x61\xe5\xee\x07\x69\x8b\x7e\xa1\xcd\x87\xe0\xf7\xfa\x74\x22\x51\xbc\x20\xd7\x4c\x1b\xae\x7b\xfe\x50\xbb\xa5\x3e\xdf\x34\xe9\x6c\xf0\xee\x70\xd1\xba\x34\xb4\x15\x9e\x16\x9a\xd9\xd3\x38\x1d\x82\xdb\xba\xe4\x4b\x0f\xcd\x72\xbf\x74\xee\x9a\xd4\xac\x85\x76\x46\x1a\x1e\x18\xd5\x8e\xc7\x95\x48\x20\xed\x82\x7b\x7b\xf4\xc6\x10\xeb\x28\xde\x60\xcb\x1a\x87\xf1\x7e\xea\x07\xa2\xe0\xc8\xe0\xfc\xb6\x3b\x9c\xbd\xa7\xbc\x6c\xb2\x6b\xf9\xfa\x55\xc9\xe9\x41\xb6\xf6\xa0\x77\x4e\xb9\x5a\x6d\x79\xa7\xa3\xed\xed\xfa\xd6\x49\x30\x0d\x0e\x7a\x76\x3d\x0a\x52\x8d\x95\x9c\x88\x69\xa3\x79\xd9\x45\xb1\xa2\x43\xcc\x0b\x8c\xee\x08\xa7\xd9\xb3\x69\x8a\x7c\x54\x4c\x80\x15\xfe\xdc\xaf\x86\x20\x45\x45\xcb\x9d\x1b\xfa\xcd\x01\x00\x95\x56\x6d\x31\x3b\xf4\xee\x1d\x0a\xcc\x20\x8c\x46\x41\x74\xf5\x30\x84\xe5\x70\xff\xc1\x15\xc7\x15\xf3\x13\xcd\x3a\x0b\x27\x9e\x63\xe7\x1e\x4e\xb2\xc5\x0d\xb7\xb4\x92\x5a\xdd\x70\x04\x42\xad\x9d\x9c\x40\x18\x5d\x0c\x30\xcd\xe1\xa1\x11\x95\x0d\x57\x4a\xfe\x56\x23\x87\xe5\x4c\x8c\x67\x60\xac\xa8\x2a\xea\xf8\x85\xe6\x38\x62\xd7\x5a\x95\xcd\x98\x6b\xc4\x47\x3a\x42\xc3\x9c\x97\x82\x01\x8e\xce\x86\x24\x29\xae\x3d\x8c\xb9\xf7\xd4\x8a\x77\x4d\x65\x45\xcd\xb4\xfd\x49\xe9\xf9\x3b\x31\xe7\x1f\x60\x18\x8e\x2a\x6e\xf9\x5f\x36\xa0\xa4\xd9\x3e\x12\x77\xef\x7f\xe5\x72\xac\x4a\x5e\xbe\x80\x06\x16\x15\xcd\x4b\x2e\xad\x60\x95\x41\xd5\xce\xd9\x23\x4f\xe7\xac\xbe\x77\xba\xfb\x10\x10\x9d\x59\xb1\xa6\xa3\xe7\xf9\x5c\xd3\x4f\xb5\x9f\x3b\xc5\x78\x5b\x1c\x9a\x3b\xbe\x1a\x42\x92\x78\x3b\x45\x8c\xde\xef\x73\x2d\xc2\x76\xc6\x46\xa9\x0f\x82\xa1\x2c\xe9\xc1\xe3\xf3\xdd\xdc\x85\x86\x3f\x38\xca\xc1\x06\x7a\xbf\x90\xff\x4f\xc2\xed\xe1\xba\x6b\x60\xf7\x73\xec\x3a\xce\x7f\x91\xed\x7e\xec\xbe\xe1\x4c\x73\x4d\x84\x77\xb3\x4b\x5b\x3d\x9e\x65\xb9\x99\xeb\x30\x31\xb4\x59\x24\x56\x0c\x65\x86\xf6\x09\x4b\xe9\xdb\x8e\xe1\x54\xdb\x3c\x16\x20\xc3\x22\x88\x37\x83\x71\xe5\xd9\x4c\x43\x2e\x79\x52\x4d\x00\xba\x33\xa5\x58\x67\x4f\x95\x62\x25\x58\xd5\xaf\xf5\xae\xcf\xc9\xc3\x38\xd1\xbf\x04\x71\x9b\x3e\xb1\x6e\xe4\x50\xa2\x9f\x06\xb2\x42\x5a\xae\x27\x6c\xcc\x57\xeb\x0c\xb8\xd6\x4a\xc3\x2a\x66\x8d\xf8\x48\xbb\x22\xef\xf1\x32\xcf\xec\x84\x89\x2a\xf0\x8a\x07\x07\xb2\x6a\x02\x4c\x7a\x7a\xe1\x66\x62\x4b\x04\xda\xce\xa9\x5f\x71\xaa\x70\x34\x68\x79\x83\x69\x3c\x27\xc5\xde\x82\x36\x63\x4e\xd1\x9f\x6a\x71\xa1\x75\x0e\xea\x11\x93\x0c\xd7\xba\x88\x0c\x73\x76\x3d\xba\x20\x9c\x1f\x71\x1f\x31\x08\x67\x89\xc4\x4e\x87\x7d\x09\x39\x52\x71\xd4\x0a\x42\xba\x76\xe2\xa4\x59\xf6\xa3\xc3\xe8\x95\x91\x56\x4d\xb8\x45\x2b\x6b\xef\x4b\x7e\x1d\x97\xd7\x83\x0d\x51\x48\x5b\xe9\xb2\xeb\xaa\x5a\xa5\xee\x33\x86\x98\x80\x30\x97\xa2\x0a\x46\xcb\xfc\xf9\xfe\x14\x29\x2a\x7f\x2c\xea\xa2\xf0\xf7\x69\xc3\x21\xb8\xcb\x2c\xf8\xe7\x3f\xfb\xab\x21\xbe\x4a\x66\x59\x0e\x5e\x0f\x08\x5a\xbc\x63\xda\xcc\x58\x35\x92\x25\xef\x5c\x24\x87\x24\xc9\x21\x01\x48\xb2\xa0\xbc\xfd\x9a\x88\x15\x01\xf0\xd1\x91\x1f\x02\x3a\xfe\x4f\xe4\x78\x93\x74\x99\xe3\xc5\xf7\xef\x32\xc9\x89\x83\x2c\x16\xc5\xa1\x87\x39\x17\x9d\x41\xd9\x99\xab\x73\x73\x66\x0d\x68\x2e\x4b\xae\xc9\x4b\xfe\x7e\x73\x75\x09\x9a\xd7\x9a\xe3\x54\x4d\x5d\x78\xf0\x7e\xcf\xf8\xe0\x80\x88\xad\x2a\x07\xbb\x05\xda\xe0\x07\x60\xc1\x34\x2c\x68\xe2\x8a\x8c\x84\x27\x70\x2c\x87\x1d\xfd\x4b\xbe\x3c\x77\x4b\x29\xbd\x78\xc1\x06\xc2\xb5\xd5\x29\x32\x93\x65\x1d\x4e\x71\x67\xf8\x65\x33\x7f\xf0\x05\xd7\xb3\x71\x3a\x6c\xf7\x1d\xa1\xf4\x6b\x3a\x37\xfb\xf1\x45\x5c\x22\x87\xce\x9a\x8e\xb4\x49\x1d\xf6\x0e\xef\xf0\x57\x9f\x3d\x52\xe4\x9c\xb7\xb8\x81\x76\x6a\x51\xd7\x3d\x5d\x0e\x01\xef\x50\x5b\x55\xc6\x07\x3c\xcf\x62\xeb\x13\x4b\x37\x2b\xa6\xc1\x09\x22\x48\x97\x5b\x3a\x5e\xda\x6c\x28\x81\x69\xcd\xe8\x8e\x45\x3d\xfc\xc1\xc7\xd6\x00\xbd\x73\x20\x51\xc2\xcb\x85\xb1\xaa\x9a\xb9\xa4\xf7\x0f\xb5\xc6\x57\x0b\xf6\x09\x93\x8c\xc7\x88\x11\xd4\x04\x84\x35\x01\x4a\x70\x93\xe3\xb9\x98\x8f\x98\x7c\xf2\xae\x47\xd2\x21\x92\xf0\xd3\x53\xac\xa2\x38\x88\xb7\xbc\x23\x0e\x61\xbb\x44\x0f\x69\xdf\x85\xa1\x57\x38\x3c\x54\xf3\xeb\x1c\xbe\xcf\xe1\xbb\x1c\xbe\x81\x6f\x72\x78\x8d\x2e\x62\x96\xc2\x8e\x67\x40\x6f\xfc\x88\x70\x91\x62\x6f\xe7\x22\x7f\x8c\x2f\x0e\xee\x3f\x44\x47\x9d\x92\xae\xd1\x47\x9d\xf0\xa6\xed\x4f\x69\xc3\x70\x2e\x77\xf5\x5f\x78\xf1\x8c\xa7\x01\x06\x18\x46\xab\x56\xc4\xa7\x1b\x6f\x17\xde\x84\xe4\x39\x4e\x79\x21\xc7\x6a\xb5\x2c\x62\x42\xb1\xd4\x51\x9e\x0d\x94\xbd\x45\x5a\xca\xde\x12\x1d\x10\x1d\xf1\x15\xb2\x79\xef\x60\x3f\x44\x14\x82\x04\xed\xd6\x10\xb0\x95\xee\xed\x07\xb1\xb1\x40\xd7\x5c\x96\xa9\x5f\xc8\xfd\xd9\x59\x04\xbd\x1e\x6c\xfe\x5a\x47\x39\x0b\xdf\x6a\x16\x37\x24\x96\x09\x54\xda\xc4\x57\x71\xd9\xae\x61\x0c\xbd\x6e\xd9\x3c\xac\x40\x88\x32\x60\x25\x53\xbb\xcc\x9d\x07\xbe\xe5\x55\x95\x6a\xb5\xcc\xb2\x41\x9f\xa3\x07\xcd\xd9\x63\xc4\xd5\x16\xba\x7f\xcf\x5a\xdc\xaa\x3b\xbc\x68\x4b\xc3\xf3\xdf\x95\x68\x59\xcc\x21\xf9\xdd\x26\x59\xf6\x12\x13\x07\xfb\x7e\x7c\xce\xbc\x1e\x7e\xcc\xab\xa8\xa7\x0f\xde\x96\xf7\x34\x14\x60\x51\x37\x22\x58\xa2\x3b\xdc\x83\x45\x4a\x22\xa2\xf7\x02\x1b\xd0\x4e\x3d\x8e\xb3\x60\xfc\x4d\x45\xed\x55\x8c\x53\x04\x12\x0c\x6a\x68\xd5\x49\xf1\xb3\x5b\x3c\x17\x48\x8f\xfc\x69\x97\x6c\xaf\x9d\x78\x0b\x4f\x0a\xc5\xc2\x0b\xdf\x1d\xda\x24\x02\xad\x2f\xe2\x53\x0e\x8f\xfc\x29\xdb\xe7\x67\x08\x91\x0d\x76\xca\x93\x5c\xbf\xbf\xba\xbe\x78\x7f\xfb\xdf\xbf\xdb\x7f\x9c\xfd\x7a\x77\x91\xf4\xac\xd9\x3b\x1f\xa9\xc0\x6a\x4b\x31\x13\x47\xe7\xc8\xfc\x6e\x7d\xdd\x7d\xe4\x4f\xb1\x03\x2e\xee\x1f\xf9\xd3\x87\x48\x41\xe1\x55\xd5\x6e\x8e\x22\xc4\x2c\x14\x07\x9f\xbc\xed\xb2\xf8\xa9\x6a\xcc\x2c\x0d\xfd\x61\x54\x88\x00\xbf\x11\xe0\xda\x5f\x09\x50\xf9\x96\x7e\x07\xbb\x45\x9f\x3f\xad\x02\xf4\xb6\x29\x22\xd0\x37\x12\xb5\x32\x46\x3c\x54\x1c\x53\x38\x75\xc1\x93\x4a\x61\x2b\x40\xd9\x79\x29\x0c\xa7\x9c\x6d\xb0\xb5\x64\x16\x2a\x7c\x93\xde\x51\xc0\xc9\x5a\x2a\xeb\xfb\x86\x70\xe7\x80\x9d\xe9\x5f\xb5\x92\xd8\x73\x53\x4a\xdf\x2a\x97\xfd\x34\x1e\x3d\xc0\xea\x45\xc9\x19\x09\x16\x8e\xe2\x69\xc8\x1d\xa2\x6d\x44\x16\xc5\x48\xda\x1f\xbe\x4f\x7d\x49\x1f\xc6\xf5\xb2\x55\xa5\x88\x7c\x65\xe2\xe3\x72\x51\xfc\x84\xc2\x23\x6a\x5c\x5c\x27\x07\x6a\x02\x3a\xa9\xd8\xe5\xa2\x0b\x17\x67\x3d\xd1\xef\xc5\x87\xcf\x88\x92\x03\xfe\xef\x3c\x6a\x8b\x3c\x2e\x66\xbb\x1a\x65\x52\xba\x77\x99\xd6\xbd\xda\xae\x2f\xf8\x86\x90\xf8\x79\x01\xee\x90\xc3\x10\x60\xee\xcb\x3a\x36\x07\xa5\xeb\x0e\x0c\x3c\x70\x7c\xf7\xd3\x1a\x9d\x19\x72\x37\x57\xbe\x23\xef\xdd\xb6\xb4\x7f\xe1\xf1\x32\x23\x4b\x51\x9d\xc6\x66\x48\x92\xb0\xe3\xc8\xf4\x36\x17\x61\x4f\xa0\xe9\x7b\x5b\xfe\xf3\x99\x02\xaf\x54\x98\x1d\x49\x9b\x2e\x72\xf8\x96\xda\x00\xa2\x46\x0e\x7f\x10\x87\xbc\x02\xb1\xbe\x99\x7c\x93\xc3\xf1\xb7\x39\xfc\xf0\x7d\x8b\x8e\x45\xfe\x00\xee\x1b\xa5\x30\x90\x37\xa3\x7e\x7f\xe7\xbc\x78\xc1\x18\x80\x59\xec\x86\x72\x46\x00\x5f\x6f\x70\x20\xe4\xb4\xed\xfc\xd6\xed\x90\xe4\xc6\x9c\x1d\x21\x88\x42\x84\x59\xc8\x37\xb9\xf1\xc1\x9e\xae\x6f\x0b\xf0\x2c\x0a\x4e\xff\xb5\x52\x41\xaf\x54\xae\x26\x5d\x2b\x1c\x8c\x5b\xfc\x22\x64\x99\x46\x36\x0d\x18\xd7\x56\xe7\xed\xc3\x3b\x56\x77\x0f\x37\x95\x18\xf3\xee\x71\x14\xb8\xec\xa9\x78\x51\x8c\x48\x92\x8d\xf4\x38\x61\x95\x09\x6e\x8e\x1f\x26\xd1\x5f\x2e\x1f\xd2\x77\x62\xe4\xd6\x78\x41\x9d\x43\x72\x9c\x78\x37\xee\x8d\xcc\x74\xc3\xbf\x31\x50\x22\x91\x34\x7a\x93\x9e\x41\x1a\x2e\xd6\xf0\x36\xb4\xc4\xf1\xe3\x6d\xa5\x0c\x36\xa9\x7e\x7c\xf6\x9a\x24\x24\x1c\x09\x8f\x37\xc6\x80\x2d\x7c\xae\xd3\x84\x2e\xf5\x13\x7f\xcf\x2f\x64\x96\x47\x93\xa7\xc7\x53\xa6\xb8\x0a\xdc\x84\x12\xa0\x39\x2b\xe9\xaf\x7f\x59\x50\x24\xb2\x21\xe8\xfd\x07\x9c\xb2\x7a\x72\xe1\x01\xad\xe7\xb2\xa2\xd5\xce\x33\x13\x8a\x14\x55\x1e\x8d\x29\x25\x9f\xe0\xdc\x29\x2a\xbc\x9d\x54\x86\xa7\xd1\x88\xe2\x3e\x8b\xa3\xb7\x5f\x67\x55\x95\xe2\x81\x41\x58\x5f\xe3\xc8\xe1\xfa\x05\x8f\x7c\xaf\x13\x3c\x5c\x86\x60\xe1\x70\xab\x35\xd3\x6c\x8e\xd5\x15\xbf\x05\xf3\x2a\x61\x50\x2b\x8a\x83\x00\x4e\x40\x4e\x2d\xf1\x51\x3e\x64\x42\xa3\xe2\x69\xec\x99\x45\x76\x86\x86\x43\xc9\x8a\x8b\x8a\xcf\xc3\x38\xda\x86\xc7\xb0\x83\xbf\xb6\xda\xeb\x4d\x4c\x3a\x37\xf7\x4b\x00\x0b\xbc\xee\x4e\x03\x30\x5e\x91\x2d\x8a\xdb\xa7\x9a\xa7\x81\x72\xd4\x65\x60\x90\x0e\x61\xd1\x1d\xe9\xaf\x30\x1a\x39\x77\x93\x25\x6f\xef\x74\x16\xc5\x59\x59\xea\x34\xeb\xc2\x2d\xcd\x8a\x34\x7c\xc2\x48\x97\xd7\x77\x1d\x56\x3c\x82\x78\x93\x45\x34\x8b\x16\x12\xd1\xbc\x07\xf9\xd4\x90\x85\xdb\x87\x67\x13\xc4\x4d\x94\xe2\x49\x68\xb7\xd0\xa5\x98\x1e\xf4\x9b\x36\x07\x3f\xb4\xae\x19\xd2\x30\xbd\x9a\x42\x80\x0e\xf7\xb3\x2e\x59\xe8\x74\xc2\x7f\xd8\x3a\x77\x24\x6d\x2f\x55\xfd\x67\xef\xe9\xdb\x1f\x7a\x8f\x7f\xfb\xae\xf7\x18\x2a\x8e\xd8\xcd\x31\xd5\x29\x64\x18\x6b\x55\x0e\xad\x99\xdf\x08\x6b\xd2\xec\xcb\xa4\x40\x9a\x62\x4b\x88\x3b\x11\x4b\x71\x27\x7a\x62\xdc\x89\xbe\x1c\x77\xa2\x2f\xc8\x5d\x54\x6f\x9b\xdd\x92\xdc\x89\xff\x03\x51\x88\x68\xb3\x25\x0b\x55\xea\x98\x3f\xdf\xd0\xf9\x8e\x6a\x37\x83\xbe\xbc\x3b\x0e\xff\x2d\xdc\x39\x8a\x93\xad\xaa\xef\x31\xb0\x7c\xd3\xfd\xe7\x24\x4d\xc8\x29\xdd\xbb\x39\x4c\x55\x47\x5d\x53\x6d\x9a\x1a\x3f\x58\xe5\x25\x28\xb9\xf5\xe2\x2e\xe9\x38\xdd\x28\x82\x58\x34\xb6\x93\xa5\xd9\x91\x2d\xfd\x60\xa0\x79\x8d\xdf\x0b\x94\xbd\xbc\x29\x2c\x9f\x1b\x7f\xcd\x4c\x5d\xdf\x67\xe4\x4f\xfc\x4a\x1b\xcf\xbf\x60\x18\xe2\x78\x12\x7e\x39\x63\xea\x4a\xe0\xd7\x39\x38\x81\x10\xf1\xf6\xfd\xe4\x58\x55\x68\x3c\xbc\x61\xec\x5f\xb3\x7b\x6a\x5b\xe9\xd8\x4f\x11\xdd\x2d\x4c\x1e\xd1\x70\x6d\xde\xbf\x37\x57\x13\xbf\x11\xe4\x3b\xf6\xc8\xa9\x51\x69\xd3\x6f\x37\xbd\xa2\xbc\x6e\x36\xf7\x33\x24\xf1\x1a\x75\xf1\xf8\x18\xe6\x48\xa7\x94\xd3\x61\x2b\xc9\x8a\xa0\xd7\xc1\xeb\xb6\xc4\xc2\x17\x38\x73\x7c\xd1\x18\x3a\x8a\x40\x63\x48\x9f\xb7\x17\x37\x48\xf0\xcd\x93\x83\x0e\x3e\xbd\x49\x25\xae\x11\x9e\x49\xb4\x77\xc7\xa3\x23\x19\x0e\xf0\xaa\xe7\x65\xac\xac\x5d\xb5\xc7\xc3\xfb\x68\x39\x1d\xf6\x8c\x96\xe2\x11\x79\x47\x2c\xae\x35\xbb\x2e\x5f\xf7\xc4\x8b\x90\x0b\x56\x89\x92\x6c\x02\x47\x7f\x9e\xc2\xd1\x22\xc9\xe9\x29\x47\x1a\x9b\xf7\x17\xb8\x61\xa0\x63\xfb\xcc\x5d\x19\xd0\x72\xcc\x4c\x2c\x41\x18\x9e\x28\x94\x89\x6d\x93\xed\x8a\x2f\x8c\x97\x0b\xff\x0a\x06\x23\x15\x67\x24\x08\xfc\xe1\xa6\x73\x7e\xd7\x4e\xb4\xc0\x71\x87\x45\x2c\x87\xe6\xaa\xf3\x4a\x7f\xd2\xd7\x88\x63\x9c\xe4\x2b\xd4\xf4\xa9\xff\xba\xe2\x42\x6b\x3f\x2d\xe4\xf0\x8e\x1b\xc3\xa6\xfc\x34\x9a\x09\x22\x2d\x31\x3d\x6d\xe8\xbf\x63\xa0\x99\x11\x19\x3e\x1d\x1f\x1f\x99\x6f\xbc\xda\x90\x95\x1c\xb8\xd6\xd9\x7a\xb0\x1e\xfc\xef\x00\xfd\x19\x22\x6b\x05\x32\x00\x00")
+
+func templatesCliCliGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesCliCliGotmpl,
+		"templates/cli/cli.gotmpl",
+	)
+}
+
+func templatesCliCliGotmpl() (*asset, error) {
+	bytes, err := templatesCliCliGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/cli/cli.gotmpl", size: 12805, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesCliCommandsGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x4f\x6f\xe3\xb6\x13\xbd\xeb\x53\xcc\x4f\xc8\xaf\x90\x02\x47\xba\xbb\xc8\x21\x1b\x27\xbb\x3e\x34\x09\x62\xa3\x7b\x28\x8a\x86\x91\xc6\x12\x1b\x8a\x64\x48\x6a\x13\x57\xe0\x77\x2f\x48\xd1\x92\xe5\x24\xce\x5e\x0a\xf8\x60\x91\xf3\xe7\xcd\x7b\x33\xc3\x3c\x87\x4b\x51\x22\x54\xc8\x51\x11\x83\x25\x3c\x6e\xa1\x12\x67\xfa\x85\x54\x15\xaa\x5f\x61\x71\x0b\x37\xb7\x6b\xb8\x5a\x2c\xd7\x59\x14\x45\x5d\x07\x74\x03\xd9\xa5\x90\x5b\x45\xab\xda\xc0\x99\xb5\x79\x0e\x5d\x07\x85\x68\x1a\xe4\xe6\xe0\xae\xeb\x00\x79\x09\xd6\x46\x51\x24\x49\xf1\x44\x2a\x84\x82\xd1\x28\xca\x73\x58\xd7\x54\xc3\x86\x32\x84\x17\xa2\xa7\x08\x4c\x8d\x10\x20\x80\x11\x82\x65\xce\xfe\xaa\xa4\x86\xf2\x0a\xcc\xe0\xd7\x78\x08\x52\x89\x1f\x08\x9b\xd6\xf8\x50\x35\x72\xd8\x8a\x16\x14\x9e\xa9\x96\x4f\x22\xed\x52\x78\xac\x84\x97\xbe\x9c\x93\x4a\x89\x56\xc2\xfc\x1c\xb2\x1b\xd2\xa0\x83\x4a\x1b\x29\x94\x81\x24\x02\x88\x1f\xb7\x06\x75\xec\xfe\x21\x2f\x44\x49\x79\x95\xff\xad\x05\x8f\x23\x77\x54\x51\x53\xb7\x8f\x59\x21\x9a\xbc\x12\x67\x42\x22\x27\x92\xe6\xaa\xe5\x86\x36\xe8\x4d\xba\x0e\xa4\xa2\xdc\x6c\x20\xfe\xff\x73\x0c\xc9\xf0\xa1\x73\xff\x8b\x21\x5b\x13\x55\xa1\x59\xfa\x9c\x77\xc4\xd4\x90\xdd\x0b\x61\xee\x02\x59\x1e\x54\xea\x50\x81\x63\xf9\x85\x3a\x83\xaf\xc8\x6f\xa5\xd1\x60\x6d\xd0\xe3\xea\x95\x6a\xc7\xcd\x6f\xa2\x44\x16\xce\xf7\xf3\xbe\x6b\xd0\x0b\x33\x2a\xe4\x13\x28\xc2\x2b\x84\x93\x27\xdc\xce\xe0\xe4\x07\x61\x2d\x7a\x6a\x7a\x78\xc1\xd1\xdd\x82\xb5\x70\x90\x24\x98\xef\x22\x85\xa8\xa9\xd7\xda\x99\x12\x5d\x10\x46\xff\x09\x35\x81\xb5\x97\xbd\x0c\x1a\x88\x42\x2f\x54\xd0\x45\x83\xd8\xf8\xef\xae\x83\xba\x6d\x08\xdf\x77\x02\x21\x5d\x9f\x50\xc1\x75\x64\xb6\x12\x3f\x09\xad\x8d\x6a\x0b\x03\x9d\xc7\x74\x16\xca\xcb\x6e\x87\x18\x43\xe1\x6f\x63\xc0\xe9\xb1\xd0\xf0\x10\xd0\xce\xe3\xae\x83\x92\xe8\x1a\xd5\xbe\x59\x1c\xa4\x59\xb5\x4d\x43\x94\xe7\xab\x44\x5d\x28\x2a\x5d\xde\xb9\x9b\x18\x46\xd7\xa4\xfa\xdd\x93\xb6\x67\xe6\x04\x61\x1a\xbd\xae\x8b\xd1\xe3\xb3\x00\x53\xd3\x81\xff\x87\x50\x78\x50\xc3\x46\xd1\xa6\xe5\x05\x70\x7c\x39\x56\x9c\x4e\x88\x94\x70\x7a\x21\x65\x7a\x9c\x05\xed\x99\x55\x68\x5a\xc5\xe1\x97\xa3\x96\xce\xf0\xa8\x08\x1f\xc8\x30\x3f\x1e\xb7\x23\x52\xce\x81\x48\x69\x67\x43\x82\x50\x2c\x80\x8d\x6c\xd4\x75\xef\x27\xcc\x4f\xa3\x63\x71\xe1\x8d\x7e\xce\x9a\xb5\xca\x5b\x5f\x53\xa5\xcd\x77\xa1\x4a\x48\xc6\x0e\x0d\xa6\xe9\x9e\x88\xd6\x16\x84\x31\xfd\x13\xdd\x3c\x4e\xcc\x69\xfe\x79\x63\x7f\xd0\xd7\x77\x44\x91\x26\xd0\x39\x75\x5f\x2e\xc2\xc4\x1a\x6c\x24\x23\x06\x21\x2e\x18\xbd\x66\xa4\x5a\x6f\x25\xc6\x90\xb9\xeb\x07\x26\x78\x75\xd8\xcf\xcb\xc5\xd8\xcd\x42\x41\xb6\xd4\x6e\x4b\xf9\x4c\x90\x38\xa2\xb2\x7b\x7c\x6e\xa9\xc2\x12\x12\x2e\x0c\x64\xdf\x88\x5e\xe0\x86\xb4\xcc\xa4\x8e\x0a\x50\xe1\x7a\x1e\x6f\x51\xc7\x43\x9d\x7d\x44\x17\xa0\x77\x5b\xea\x0b\xa5\xc8\x36\x1d\x3e\xbf\x88\x72\xeb\xd3\x8c\x47\xd7\x94\x61\x38\xb2\x76\x14\xf6\x8a\xb7\x8d\xcb\x54\xd4\x82\x16\xf8\x66\x34\xf6\xe7\xa1\xcf\x8a\xcf\x90\x7d\x15\xae\x72\x88\x1f\x85\x60\xf1\x70\xe3\x73\x1f\xc4\x8b\x8d\x6a\x31\x1e\xbe\x36\x84\x69\x9c\xd4\x71\xf0\xe7\xd8\x9c\x26\x81\xf5\xfd\x71\xcd\xd2\xb7\x83\x1a\x01\xec\x46\x30\xb2\x7e\x85\x5e\xbd\x62\xd1\xba\xc7\xeb\x27\x1b\xaa\x7f\x2b\x5c\xe3\x49\xc7\xd8\xb0\x54\x37\x8c\x54\x7a\xe6\x89\xf7\xdb\xbb\x6f\x4e\x85\x5a\x0a\xae\xb1\x5f\x0f\x49\x71\x7c\xec\xd3\x1d\x9a\x84\xa8\x4a\xc3\x1f\x7f\x6a\xa3\x28\xaf\x52\x40\xa5\x84\xf2\x5d\x19\x92\xce\xcf\x61\x7c\x64\xad\xcd\x6e\x3e\x58\x3c\x5e\x55\x9d\xa4\x83\x67\xb6\x42\x33\xb5\x5c\xd3\x06\x45\x6b\x82\x43\x52\x64\x44\xca\xdd\x61\xfa\xc1\x1c\x1c\x36\xfc\x0a\x8d\xcf\x14\x4f\xbb\x22\x9a\x3c\x7e\x74\x06\x27\x3b\x3e\xfc\xeb\xb7\x6a\x8b\x02\xb5\xbe\x0f\x67\x7a\xd2\xbd\x83\x69\xb6\x2a\x6a\x6c\x48\xdf\xac\x87\xa7\xd9\x52\xaf\x8c\x42\xd2\x38\xad\x15\x6a\x47\x0a\x0d\x10\xfa\x45\xf1\xd7\x80\x66\x36\x3e\x9f\xa8\x94\x43\xd0\xd7\x7a\xc9\x28\x72\x93\xa4\xd9\x84\x97\x91\xdb\x77\x89\x4d\xc2\x1e\xfb\x4e\x4d\x7d\x29\xb8\xc1\x57\x03\xd6\x16\xfd\xbf\xec\x0b\x29\x9e\x9c\x3f\x2f\x93\x74\x16\x98\x1f\x21\x0d\xdf\x6e\x11\x5e\xb4\xa6\x16\x6e\x25\x38\x5c\x33\xe0\x94\x0d\x28\xf7\xff\x38\xd3\x6f\x24\x14\x4b\x79\xb5\x23\xcd\x3b\xf5\x75\x68\x53\x8a\xd6\x0c\x4e\x4e\x3b\xba\x71\xad\x03\xff\x3b\x77\x71\x7d\xff\x0c\xaf\x4a\xef\xb3\x21\x94\x25\xa8\x94\x33\xb6\x13\xb1\xff\x7b\xb9\x7a\x7c\x7b\xa2\x1d\xc1\xe9\x47\x2a\xd9\xb3\xcd\xee\xc8\x96\x09\x52\xee\x03\x3f\x60\x6d\x7c\x41\x39\x65\xfd\x8b\x85\xbc\x04\x6b\xa3\x7f\x07\x00\x57\x85\xb6\x81\x9f\x0b\x00\x00")
+
+func templatesCliCommandsGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesCliCommandsGotmpl,
+		"templates/cli/commands.gotmpl",
+	)
+}
+
+func templatesCliCommandsGotmpl() (*asset, error) {
+	bytes, err := templatesCliCommandsGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/cli/commands.gotmpl", size: 2975, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesCliMainGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x50\xc1\x6e\xdb\x3a\x10\xbc\xef\x57\x4c\x04\xbc\x40\x7a\x88\xc9\xf6\x5a\xc3\xa7\xc4\x40\x73\x68\x92\x83\xef\x05\x2d\xad\x68\xd6\x94\xa8\x2e\xa9\xa8\x86\xa0\x7f\x2f\xc4\xa6\x6e\xda\x1b\xb9\x33\xb3\xb3\x33\x5a\xe3\x3e\x34\x0c\xcb\x3d\x8b\x49\xdc\xe0\x78\x81\x0d\x9b\x38\x19\x6b\x59\xb6\x78\x78\xc6\xd3\xf3\x01\xfb\x87\xc7\x83\x22\xa2\x79\x86\x6b\xa1\xee\xc3\x70\x11\x67\x4f\x09\x9b\x65\xd1\x1a\xf3\x8c\x3a\x74\x1d\xf7\xe9\x1f\x6c\x9e\xc1\x7d\x83\x65\x21\xa2\xc1\xd4\x67\x63\x19\x9d\x71\x3d\x91\xeb\x86\x20\x09\x25\x01\x45\x88\x05\x11\xd0\x7a\x63\x23\x0a\xeb\xd2\x69\x3c\xaa\x3a\x74\xfa\x1b\xc7\xc8\xaf\xcd\x59\xdb\xb0\xc9\x68\xe6\xcd\x33\x06\x71\x7d\x6a\x51\xfc\xf7\xbd\x40\x79\xfd\x44\x5d\x7b\x57\x40\x1d\x8c\x58\x4e\x8f\xd9\xe1\xc5\xa4\x53\xb5\x1e\x50\x11\x69\x8d\xc3\xc9\x45\xb4\xce\x33\x26\x13\xff\x8e\x9d\x4e\x8c\xb7\xdc\x48\x21\x78\xb5\xf2\xbf\x98\x33\x23\x8e\xc2\xe8\x43\x42\x0a\x08\xaf\x2c\x93\xb8\xc4\x48\xd7\x55\xa6\x4d\x2c\xb8\x84\xf1\xdd\x42\x97\x70\xe4\xda\x8c\x91\x61\xbc\x5f\x41\x01\x37\x2e\x45\x4c\x61\xf4\x0d\x8e\x0c\x1f\x62\xba\x21\x6a\xc7\xbe\xce\xa5\x94\x15\x66\xc2\x5a\xf0\xd7\x3b\xb0\x08\x3e\xed\x50\x7b\xa7\x9e\x78\x2a\x2b\xf5\x62\x24\xb2\xfc\x7e\x94\xd5\x36\x53\x6e\x76\xe8\x9d\xcf\x3a\x40\xeb\x1c\x82\x45\x82\x44\x18\xe1\x5f\x3d\xfd\x89\x37\xac\x52\xc9\x5c\xd7\xa2\xe5\x3b\x84\xf3\xea\xc2\x22\xaa\xfc\x3f\x37\xac\xf6\xab\xba\xda\xae\xc8\xed\x2d\x5a\x56\x87\xcb\xc0\xd8\xed\x70\x85\x3f\xb3\x1f\xde\x1c\x81\x10\xd5\xfe\x87\x4b\xe5\x87\x2a\x0f\x16\x7a\x3f\xfc\x58\x11\xb0\xd0\x42\x3f\x07\x00\xfa\x96\x10\xa4\x68\x02\x00\x00")
+
+func templatesCliMainGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesCliMainGotmpl,
+		"templates/cli/main.gotmpl",
+	)
+}
+
+func templatesCliMainGotmpl() (*asset, error) {
+	bytes, err := templatesCliMainGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/cli/main.gotmpl", size: 616, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesCliParamsGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x51\x6f\xd3\x30\x10\x7e\xdf\xaf\xb8\x45\x02\x25\x53\x89\x78\x1e\x2a\x12\x74\x0c\x8a\xb4\x31\x51\xe0\x05\x21\x74\x4d\x2e\x9d\xc1\xb1\xc3\xd9\x1d\x2a\x96\xff\x3b\xb2\x93\xac\x6b\x97\xc2\x40\x8c\xed\x2d\xb6\xef\xce\xdf\xf7\xf9\x3b\x3b\xce\x41\x49\x95\x50\x04\x49\x21\xc5\xb1\xc4\xc5\xbb\x55\x43\x09\x78\xbf\x07\xe0\xdc\x23\x10\x15\x68\x86\x7c\x6a\x9e\xeb\x72\x75\x86\x8c\x75\x18\x1c\x0b\x49\xed\xc0\x7b\x63\x59\xa8\x45\x17\x4e\xd2\x50\xc8\xc9\xa7\xe6\x19\x33\xae\xc0\xfb\x8f\x9f\x06\x23\x50\x95\xa1\xd2\x19\x8b\x5a\x58\x71\x41\x90\x2a\x6d\xc3\xcc\x64\x69\xac\xae\x8f\x35\xd7\x68\x2d\x71\x06\xa9\x22\xc8\x5f\xea\x00\x0c\x92\xb9\xd6\x32\xc9\xc0\xfb\x03\xe7\x2e\x67\x2f\xd1\xc6\xed\xbd\x3f\xd8\xdc\x51\x95\x81\xcf\xc6\xe7\x55\xd6\x33\xb2\x91\xcb\x26\xeb\x0d\xca\x71\x41\x54\x50\xe4\xce\x41\x83\xa6\x40\x29\x7e\x10\xe4\xd3\x23\xf0\x1e\xf6\xc7\x90\x24\xe0\xf6\x00\xd6\xd9\xb3\xe2\x9c\x6a\xcc\xa7\x66\x66\x99\xfa\x0a\x00\x73\x5d\xae\x46\x40\xcc\x70\x38\x86\x22\xc7\xa6\xc9\x75\x43\x2a\x1d\x2c\x9c\xc5\x8a\xa2\x8a\xf1\xfb\x63\x50\x42\x76\xbb\x00\x30\xd9\x25\x2b\xa8\x24\x2e\x5e\x30\x6b\x4e\x43\x3e\x0b\x65\x2b\x48\x1e\x7c\x4b\x20\x2d\xd1\x9c\x13\x77\xc5\x82\x60\x71\xdb\xb6\x64\x8b\xa5\xa4\x8a\x38\x22\xca\x27\x52\x1b\x4a\xdb\xc5\x26\x30\x36\x83\x44\xc7\x31\xfa\x92\x67\xa7\x76\x1c\x97\x68\x71\x8b\x18\x13\x96\x77\x42\xac\x3f\x83\x57\x68\x8e\x84\x29\x82\xc3\x14\x5a\xcd\x83\x87\x10\x6c\x74\xa2\x4b\x92\xe6\x0c\x8b\xaf\xb8\x20\xf0\x3e\x7f\xaf\x6a\x64\x73\x8e\xd2\x39\x08\x5e\x6a\xfa\xb5\xde\x71\xd7\x52\x9c\xdb\xb2\xfd\x4c\x8a\x82\x9c\xeb\x2c\x97\xce\x57\x96\x4c\x7e\x4a\xdf\xdf\x12\x96\xc4\x69\xd0\x2b\x1b\x01\x2f\x95\x15\x35\xe5\xaf\x67\x6f\x4e\x27\x5a\x99\x65\x4d\x9c\x66\xb7\xac\xcf\xdf\x9d\x70\x07\xe7\x70\x0c\x5f\x8c\x56\x6b\x89\x22\x95\x11\x3c\xdc\x5d\x35\x7b\x72\x8b\x07\xdd\xb5\xf4\xb5\xe1\xc6\x95\x20\xaa\xed\x5b\xeb\xa6\xfd\x5c\x09\x49\xf7\xab\x61\x03\xa2\x9b\x37\x6c\x88\xde\xad\x46\xbc\xa3\xaf\x48\x52\x69\x86\xcf\x23\x50\x58\x53\xb8\xa0\x18\xd5\x82\x76\x88\xf4\x6b\x79\x42\x85\x7b\xa8\x06\x36\x0d\xa9\x32\xdd\x1d\x33\x8a\x05\xb3\x61\xc5\xfa\xd6\x6e\xbd\x23\x77\xba\x00\x9e\xc2\x63\x70\x57\xd9\x07\x71\xb4\xba\x20\xb6\x1f\x50\x2e\xc9\x0c\x27\x8e\x60\x4b\x85\x7c\xa2\xa5\xa4\xc2\x0a\xad\xda\xf7\x30\xba\xe2\xff\x77\x9a\xff\xc7\x6f\xf7\x6f\x9a\x6f\x8d\x7d\x37\x53\x18\x07\xb1\x44\x05\x61\xe7\x34\x00\xea\x31\x9c\x60\x93\x75\x83\xed\x07\xa0\x9f\x9f\x9a\xa9\xb2\xc4\x15\x16\xb4\x9e\x6a\x1f\xea\x2c\x7c\x9e\x2e\xa5\xc4\xb9\xa4\xfe\x3f\xa3\xbd\x54\x06\xf1\x6e\xab\xf3\x07\xe4\x86\xbd\x91\x1e\x0c\xa6\xde\xe9\xb1\x5f\xfb\x81\xfa\x39\x00\x51\xf1\xd8\x10\x37\x0a\x00\x00")
+
+func templatesCliParamsGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesCliParamsGotmpl,
+		"templates/cli/params.gotmpl",
+	)
+}
+
+func templatesCliParamsGotmpl() (*asset, error) {
+	bytes, err := templatesCliParamsGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/cli/params.gotmpl", size: 2615, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesClientAuthGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\x4f\x6f\xdb\x3a\x12\xbf\xeb\x53\xcc\xf3\x66\x03\xa9\x4f\x4f\x7e\x78\x47\x17\x3e\x78\x9b\x76\xd7\xd8\xa2\x0d\x92\xb4\x3d\x04\x41\xc1\xc8\xa3\x98\xb5\x4c\x2a\x24\x15\xc3\x55\xf5\xdd\x17\x43\x51\x32\xa5\xc8\x6e\x80\xee\xee\x29\x16\x67\x38\xf3\x9b\xdf\xfc\xe1\xa4\xaa\x60\x85\x19\x17\x08\x93\x34\xe7\x28\x0c\x2b\xcd\x7a\x02\x75\x1d\x54\xd5\x1f\xc0\x33\x48\xae\x31\x2d\x15\x37\xfb\x0b\x52\xe3\x86\x4b\xa1\x49\x3c\x9d\x42\x2b\x01\x9d\xae\x71\x8b\x1a\x64\x06\x66\x8d\x50\x55\xb0\x2e\xb7\x4c\xf0\xef\x08\xc9\x07\xb6\x45\xa8\x6b\x58\x5c\x2e\x63\x30\x12\x34\x1a\xab\xb4\xc2\x8c\x95\xb9\x81\x54\xe1\x0a\x85\xe1\x2c\xef\xee\xcb\x02\x15\x6b\x1c\xed\xb8\x59\xc3\x8d\x62\x42\x17\x52\x99\x37\x52\x64\xfc\x21\xf9\xc2\xcd\xfa\xcd\xe1\x5a\x90\x4a\xa1\x0d\x84\x01\x00\x61\x56\x4c\x3c\xe0\x51\xd8\x00\xd3\x29\x01\x2c\x98\x4e\x59\x6e\x11\x2e\x2f\xa0\xae\xaf\x6d\x08\xc0\x75\x1b\x41\x73\x0c\xba\x1f\x63\x55\x59\x4e\x2e\x50\xa7\x8a\x17\x64\x15\xea\x7a\x46\xfa\xa9\xdc\x6e\x51\x98\xa1\xac\xaa\x00\xc5\x8a\x08\x83\x13\x6e\xe7\x56\xa6\xb8\x30\x19\x4c\xfe\xfe\x38\x71\x52\x17\x91\x33\x10\x05\xde\x47\x55\xfd\x34\xd0\x36\x81\x4b\xfd\x0f\xa6\x79\xba\x28\xcd\xda\x25\x6e\x04\x88\x95\x52\xee\x29\x17\x29\x33\xa8\x47\x53\xd1\x27\xe7\x9e\xec\x0e\x29\x0a\xb2\x52\xa4\x63\xb1\x92\x8b\xb0\xd4\xa8\x04\xdb\x62\x4c\x08\xf4\x4e\xaa\x15\x68\xa3\xb8\x78\x88\x40\x95\xc2\xf0\x2d\x26\x6f\x6c\x1d\x92\xf6\x52\x64\xf2\x8b\xe2\x06\x15\x54\x01\x80\x42\x53\x2a\x01\x6b\x63\x0a\xd3\x96\x44\xd2\xc5\x36\x62\x3a\x0a\x5a\x1e\x30\xd7\xe8\xc8\x58\x5c\x2e\xff\x8d\xfb\xff\x01\x1b\x8b\xcb\x25\x6c\x70\x3f\xe4\x23\x0e\xa6\x53\xaa\x7a\xc3\xc5\x83\xbd\x41\x3a\x5c\xb4\x97\x7b\x59\x6f\x9b\xc5\x52\xec\xca\x87\x67\x80\x8f\xf6\x73\xf2\x58\xa2\xda\x53\x73\x42\xc1\x14\xdb\x1e\x6a\xeb\x24\xe5\xe4\xef\xd7\x38\x3e\x50\x16\x1e\x41\x1c\x3f\x2f\x60\x82\x1f\x13\x21\xa3\x59\xf8\x48\xd6\xfe\x3a\x9e\x81\xeb\x54\x16\xa8\x81\x29\xb4\x44\xe9\xe6\x53\x66\x03\xce\x9d\x99\x61\x09\x3e\x31\x75\xc2\x68\xbf\xdb\xfe\xf6\x34\x81\xc4\x49\xea\x3a\x38\x82\xe7\xad\x58\x15\x92\x0b\xd3\x4e\x08\x6c\xbf\x5f\x08\x29\xee\x57\xcc\xbb\x5c\xee\x28\x8f\x59\x2e\x77\xc7\xd0\x76\x2e\xe7\x20\xa9\x33\xff\x4a\xda\x13\x6a\x06\xf2\xf2\xe9\xea\xfd\x0c\x9e\x31\x4f\x12\xa9\xf8\x77\xdb\xb7\x9f\xae\xde\x53\x1e\x02\x80\x1b\xb9\x41\x61\x6f\x0c\x2f\xb4\x12\xab\x58\x07\xff\xcd\x9e\x38\x42\x06\xb9\xe8\xb4\x0d\xb9\xb7\xb9\x65\xcd\x6f\xd0\xb2\x54\x29\xc6\x80\xc9\x43\x02\xf7\x25\xcf\x4d\x63\x9b\x89\x96\x89\xe6\x25\x80\x52\x73\xf1\x70\x92\x3b\x26\x56\x63\xf2\x26\xdf\xa7\xfb\xa6\x41\xd1\x7a\xb4\x24\x5d\xdb\xa3\x97\xb7\xd1\x29\xbd\x77\xa5\x48\x43\x02\x10\xaa\x81\xde\x15\x3e\x96\xa8\x4d\x0c\x5f\x69\x36\x66\x5b\x93\x5c\xe1\x03\xd7\x46\xed\x23\x40\xa5\x64\x33\x0d\xa1\x21\x2b\xa6\x23\x98\xcd\x1d\x69\x0d\xce\x30\xb2\x0a\x34\x3a\x94\x82\xdf\xe6\x20\x78\xee\x2e\x75\xd8\x50\x29\x7b\x50\x07\xde\xa1\x4a\xae\xd1\xfc\x0b\xd9\x0a\xd5\x25\x8d\x98\x70\xd2\xab\xa6\x09\x3d\xe1\x1b\x14\xc9\xcd\xbe\xc0\x30\xfa\x7d\x02\x93\xdf\x9b\x83\x45\x9a\xa2\xd6\xd6\x39\xf9\xae\xbd\xa6\xef\x1e\x2c\x37\xac\x28\xf9\x6d\x41\x50\xa8\x5c\x21\xbd\x9c\x5e\xb3\xb7\xd5\xa2\x7c\xa9\xcc\x06\xf5\x36\x23\x43\x56\xff\xb0\x7e\x30\xb1\x6f\x15\x59\x6e\xe8\xa9\x31\xfc\x09\x35\x6c\x4b\x6d\x80\xe5\x39\xdc\x23\x94\x1a\x57\xb1\x2d\x0c\x26\x7c\x2d\x5b\x63\xb2\x34\x9d\x3d\x96\xe7\x72\xa7\x81\x09\x29\xf6\x5b\x59\x6a\x48\x59\x9e\x6b\xdb\xae\xa3\xf8\xe7\xb0\x65\xc5\x6d\x33\x6a\xef\x6e\xef\x6e\xef\x9a\x9f\x55\x7f\x31\xf9\xd8\x06\xf0\x4f\x25\xcb\x82\xde\xea\xaa\x7a\x26\x73\xc7\xfe\xfe\xd5\x3e\xea\xa3\xf3\x77\x06\x95\xe7\xe3\x8c\xc7\x70\xe6\x47\x36\x9b\xf7\xec\x34\x96\xcf\x78\x3b\xb8\x9b\xc4\xf4\x2c\x7c\x8b\xe1\xcc\xa3\x9f\x0a\xac\x67\xb1\x83\xe7\x00\x38\x93\xdf\x06\x26\xc7\xd1\xfa\x72\x52\xfc\xa3\xae\xa1\xf7\x3b\xee\xd5\x4e\x55\x0d\x7f\xb8\x29\x35\x58\x04\x61\xa7\x58\xa1\x81\x41\xf7\x72\xc5\xa0\xe5\x70\x4a\x69\x0a\x87\x52\x0d\xdc\x74\x29\xf7\xe6\x1a\x97\x82\x6c\x53\x31\xfa\xd3\x6e\x75\x18\x58\xc7\x57\x57\x7e\xa8\x8c\xb6\x8a\x92\x60\x3a\x25\x7b\x37\x6b\xec\x5d\x20\xfb\x54\x89\x90\x49\x45\x57\x21\xe3\xca\xd6\xe8\x81\x63\x57\xc8\x47\xdb\x81\x89\x43\x5c\xdd\x44\xf5\x7d\x90\x69\x2a\x7a\x6e\xf4\x01\x0e\x8d\x9c\x21\x73\x61\xc7\xd8\x60\x14\x75\xab\x77\xdc\x03\xef\xd5\xf9\xa9\x11\x17\x1d\xb3\xe6\xcf\xc8\x73\xcf\x70\xa7\x50\x0d\x2e\xcc\xc0\x8c\x22\x99\xf9\x1f\xb6\x2c\xcc\xbe\x40\x18\x33\x49\xb3\xb4\x4c\x9d\xeb\x71\x58\x01\xf8\x37\xfd\x6e\x3e\x15\xa5\x2b\xc6\xeb\x0d\x2f\xf4\x67\x96\xf3\x95\xed\x60\x30\x98\xe7\x9a\x9a\x82\x92\x4b\x95\x59\xe0\xea\x10\x05\xac\xb8\x66\xf7\xb9\x7b\x44\x9f\x0e\xd7\x64\xd6\x2c\x77\xee\x71\x0a\x0d\xbc\x1a\x8b\x26\x1a\xfa\x0b\x23\xb8\x97\xb2\x99\xf2\x7a\xc3\x8b\x02\x55\x0c\x72\x43\x8d\x6b\x86\x71\x26\x21\x17\x06\x55\xc6\x52\xac\x8e\xd9\xa9\xa3\x43\x8a\xe4\x06\xce\xcf\x5b\xab\xc9\xb3\x0b\x2d\x01\xe5\xfd\x96\x1b\xd0\x28\x56\xba\x57\x9b\xf1\xe9\xd6\xd9\xad\x51\x50\x2f\xae\x99\x06\x21\x87\xad\xf8\x33\x16\xac\xd3\x50\x16\xf0\xaa\x9f\xa3\x6e\x94\x46\xe0\x45\x5b\xc7\xf4\x22\x4a\x15\x59\x9e\x78\x06\xb2\x48\xda\x6c\xf6\xdf\x49\x17\xfa\x73\xee\x3a\x8f\x44\x10\x8d\x64\x9e\x59\xcc\x0d\xd3\xf4\xeb\x9d\x54\xa1\x2c\x92\xe5\x45\xf4\xba\x91\xf4\x0c\xf7\x87\xca\x6c\x0e\xaf\x64\xf1\x5c\x70\x40\x35\xb7\x82\x17\x61\x3a\xef\x99\x68\xf1\xbd\x24\x92\x26\x83\x0e\x3c\x64\x9c\x52\x68\x06\x13\x4b\x66\xff\xc7\x39\xf5\xb3\xbc\x1f\x78\x76\x86\x97\x17\x2f\xfd\x27\x67\xf1\xfe\xe6\xed\xd5\x87\xc5\xcd\xf2\xf3\xdb\xeb\x59\x00\x76\x00\x7f\x8d\x5b\xc7\x94\xc6\xe6\x0d\x1c\x7b\xe3\x6f\x3d\x7f\x77\x2e\xa1\x3c\x83\x1c\x45\xe8\xee\x47\x30\x9f\xc3\x9f\x4e\x04\x90\x4a\x61\xb8\x28\xd1\x5b\xb5\x76\x16\x88\x75\xb4\x65\x1b\x0c\x6f\x4f\x4e\x97\x18\xfe\x8c\x7b\xf6\x29\xad\x03\xd0\x1e\x66\x17\x44\xeb\x7e\xe7\x6c\xb4\x83\xc0\x23\xf3\xb6\x01\x7c\xe7\x34\x79\x06\xbf\xc9\x0d\xfc\xf8\xe1\xee\xc0\xdc\x2f\x5a\x3f\x14\xf0\x09\x74\xd2\xda\xfd\x6d\x63\x9b\x03\xcd\x3b\xb1\x0a\xdd\x41\xec\x24\xd1\xc8\xca\xf9\x6b\x3b\x72\x26\xd5\x96\x19\x7d\x7a\x53\xee\xf8\x72\xb1\x75\x7c\xb5\x78\x0f\x51\xba\x9d\x79\x36\x77\x88\x93\x85\xd7\x53\xce\x6b\xa8\x3a\xbf\xd1\xeb\xb1\x15\x7b\x64\xcd\xf6\x59\xaa\x83\x9e\x8a\xe0\xb9\x3d\xa8\x07\x1d\x4b\xe7\xfe\xe2\xfc\x9f\x01\x00\x5b\x3a\x42\xe2\xac\x13\x00\x00")
+
+func templatesClientAuthGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesClientAuthGotmpl,
+		"templates/client/auth.gotmpl",
+	)
+}
+
+func templatesClientAuthGotmpl() (*asset, error) {
+	bytes, err := templatesClientAuthGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/client/auth.gotmpl", size: 5036, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesClientCassetteGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x3a\xef\x73\xdb\x36\xb2\xdf\xf9\x57\x6c\x34\x13\x0f\x99\xe1\xa3\xfb\xa1\xed\xeb\x28\xa3\x0f\x69\x9c\xf4\xf9\x5d\x9d\xe6\x62\xf7\xee\x66\x3c\x9e\x14\x26\x57\x16\xcf\x24\xc0\x00\xa0\x5d\x9d\xaa\xff\xfd\x66\xf1\x8b\x20\x25\xd9\x4a\xef\xd2\x4e\x42\x81\xfb\x7b\x17\xbb\x8b\x05\x37\x1b\xa8\x70\x59\x73\x84\x59\xd9\xd4\xc8\x75\xc9\x94\x42\xad\x71\x06\xdb\x6d\x72\x7a\x0a\x6f\xdd\xef\x0b\x51\x21\xd4\x0a\xf4\x0a\xa1\xa5\x67\xb1\x04\x06\x1e\x3a\xd1\xeb\x0e\xc7\xb0\x4a\xcb\x9a\xdf\x25\x49\x29\xb8\xd2\x90\x26\x00\x11\xb5\x8f\x4c\x29\xbd\x92\xa2\xbf\x5b\x81\x42\x5e\x59\xba\xa2\x43\xc9\x74\x2d\xb8\x02\xa6\xa0\x56\x39\x3c\xd6\x7a\x25\x7a\x0d\x12\x4b\x21\xab\x9a\xdf\x11\x5c\x9b\xc0\x5e\x42\x7e\xcd\x88\xba\x80\x59\x37\xbc\x9b\x8d\xd9\x7f\x32\xe4\xf6\x72\xce\x81\xf1\xca\xf1\x33\x52\xb5\x46\x08\x7a\xaa\x25\x48\x54\x9d\xe0\x0a\x55\x02\x53\x62\x53\xee\x96\xc4\x0e\xe3\xae\x61\x6b\xa8\x04\x2a\xe0\x42\x1b\x09\x76\x04\xb8\x35\x0a\x13\xa0\x0a\x6c\x89\x16\x56\x07\xf8\x13\xe8\x1e\xfe\xb4\x3c\x4b\xb2\x64\xea\xc6\x77\xfc\xc1\x7b\x12\xf9\x43\x2d\x05\x6f\x91\x6b\x78\x60\xb2\x66\xb7\x0d\xc2\xe3\xaa\x2e\xc9\x2d\x7a\xec\x6d\x7a\xf6\xfe\x56\x50\x4a\x64\x1a\xab\xe0\x21\x66\xa2\xc2\x79\x7b\xca\x6d\x01\x9b\x0d\x74\xb2\xe6\x7a\x09\xb3\x97\x5f\x66\x90\x86\x1f\xea\xf3\xdb\x37\x97\x97\xef\xae\xae\xde\x7d\xbe\xf8\xe5\xec\xdd\x0c\xd2\xbe\xeb\x50\x42\xaa\x38\xbb\xaf\xff\x85\x90\x76\x4c\x95\xac\xa1\xc7\xe2\x03\x6b\x31\xcb\xb2\x8c\x62\x33\xd6\x8a\xd4\x61\xa0\x25\xe3\xaa\x13\x52\x3b\x0d\x22\x27\x46\xf6\xf5\xaa\x6c\x36\xb0\xea\x5b\xc6\x03\x61\xd8\x6e\xc1\xee\x81\xbd\x1e\x07\x2d\x80\xc1\xb2\x6e\x30\x27\xd6\x36\x4a\x82\x93\xda\x1c\xb0\xb8\x2b\x08\x48\xf6\x1c\x34\x2a\xad\x82\x6d\x88\xdd\x9b\x8f\xe7\x45\x72\x7a\x4a\xa8\xe7\xdc\x61\x1a\x93\x51\xc0\x0d\xe2\x91\x26\x2d\xd3\xe5\xca\x99\xd6\x78\x20\xb8\x5f\x70\x54\x40\x40\x5a\xc1\xf9\x99\x89\xd4\x5a\x2b\xe8\x98\x64\xad\x22\x42\x15\xdc\x79\xb7\x79\xc1\x9d\xbe\xc4\x78\x59\x4b\xe5\xf7\x12\x56\x11\x53\x6b\xaf\x47\x66\x83\xd2\x0a\x87\x15\xac\x51\xcf\xa7\xd6\x2b\x59\xd3\x60\x05\x0a\x1f\x50\xb2\x06\x74\xdd\xa2\x22\xa6\x3b\xe6\xaa\xb9\x67\x4b\xa1\x2b\xbd\xd9\xc3\x4e\x2e\xc6\x39\x03\x94\x96\x7d\xa9\x61\xe3\x36\x8c\xe0\xaa\x6f\x51\x2a\x90\xc8\x2a\x87\xe9\xc4\x0a\x3c\x72\xb8\x5d\x43\x8b\x55\xcd\x80\x68\x25\x10\xa1\xb5\xac\xbb\xb6\x29\xe8\x46\xf6\x9c\xc4\x2c\xfc\xcb\x24\x81\x28\x56\xc2\x5b\xe3\xfa\x2b\xbf\x9e\x00\x74\x4c\xaf\xc0\xfc\x71\xb9\x0c\x8c\xc3\x68\x61\xd8\x7d\x14\xe2\x09\xc0\x52\xc8\x96\x69\x65\x61\x97\xad\x2e\x3e\xe1\x5d\xad\xb4\x5c\x13\xb3\xb6\x87\xe1\x8f\x5a\xf3\xb2\xb8\xe8\x35\xfe\x9e\x00\x34\x82\x55\x58\xb9\x37\xb7\x42\x34\x09\x40\xcd\x35\x4a\x56\x5a\x73\x5f\xdf\x78\x46\xe7\xc3\x72\x02\x83\x31\x08\xf3\xfa\xc6\xa0\x8e\xf7\x44\x04\x4f\x41\x35\x0a\xb2\x10\x02\x35\x8f\x92\xb8\x4d\xb7\x26\xb8\xbc\x89\xc7\x3e\x8a\x49\x46\xee\xfa\xc5\xd3\x3d\x3f\x73\x59\xdf\x29\x44\xff\xff\xf6\x4f\x25\xf8\x7c\x16\x78\x9f\x57\xb3\xdf\x12\x80\x4f\xf8\xa5\x47\xa5\x47\xa6\x0c\x6b\x0e\x47\xda\xdf\x0e\xde\x45\xf3\x08\xde\xad\x05\x78\xfb\x7b\xf6\xdb\xc4\x14\x9e\xb0\x4b\x7a\x9c\x7c\x45\x09\xa5\x72\x3b\x87\x62\x93\xed\xd9\x17\x63\xed\x3d\x95\x48\xf3\x0b\xd4\x2b\x51\x45\x21\xe2\xb5\x1e\xe9\xde\x1a\x28\xa3\xc6\xc7\x10\x52\x4f\x21\x50\xe0\x05\xf0\x8f\x56\xc4\x28\x9e\x03\x66\x04\x6e\xa1\x72\xd1\xd6\x1a\xdb\x4e\xaf\x0d\xfa\x5f\x7b\x94\x6b\x43\x38\x46\xbf\xf6\x04\x1c\xfa\x17\x82\x9a\x60\xfe\x1f\xb2\x8a\x36\xdf\xd3\x98\x2b\x0b\x35\xc1\x7d\x2f\x64\x0b\xcf\x72\xa5\x0d\x33\x41\x3c\x3d\x85\xf7\x75\x83\x0a\x98\x44\xeb\x28\xd6\x62\xc8\xd7\x7d\xe7\x36\x0b\xa5\x60\xaa\x7f\x16\xf6\x59\x3e\x04\xb5\xcb\xe8\x47\x51\xad\x7d\x3c\xfc\xff\xe5\x2f\x1f\x68\x43\x49\x54\xc8\xb5\xf1\xbc\x67\x7a\x2b\xaa\x75\x0e\x3d\x6f\x50\x29\xa8\x4d\x04\x31\xf2\x1c\x32\x6a\x42\x0c\x11\xe2\x0f\x40\xcc\x8a\x4f\xec\xf1\x02\x95\x62\x77\x18\x7b\xd3\xd0\x18\x0b\x70\x69\x28\x84\x4d\xef\xac\x15\xfe\x73\x88\x96\xcf\x48\xf6\x69\x5c\xbb\x0d\x50\xab\x38\x7e\xfd\x3e\x70\xfb\x99\xf4\x70\x9e\xb2\xc9\xf4\x76\x6d\x0c\x6a\x6b\xdd\x34\xc6\x1d\xc5\x28\xc8\xdf\x86\x9c\x47\xa9\xc9\x3d\x4d\x44\x2d\x45\x85\xc6\xb4\x91\xfe\xce\x0f\xbb\xc0\xad\x85\x39\x2a\xe4\x8e\x09\xb8\xc8\x0d\x07\x79\xee\x71\xc2\xe9\x29\xfc\xc8\x14\x7e\xff\x6d\x1c\x0b\x06\x0e\x1e\x57\xc8\x9d\xbb\xa9\x20\x3e\xb0\xa6\xae\xe0\xd7\xab\xf7\xff\xf3\x43\x02\x31\xd2\xf5\xcd\xed\x5a\xe3\x3e\x76\x01\x66\xc4\xd4\xba\xef\x03\x3e\x7a\x7b\xbb\x36\x4a\x45\x59\xd8\xe6\x64\x0a\xf2\x7c\xd2\xc7\x08\xe9\xd2\xfe\x4e\x4b\xa3\x42\xd3\x12\x75\x41\xbe\xdd\xb8\xf2\x3d\x5c\xed\x8a\xe9\x52\x8a\xd6\x50\x98\xb6\x69\x07\xba\xc1\x60\x0b\x63\x72\xdb\x66\x54\xb8\x64\x7d\x43\xad\x86\x70\x42\x11\x3b\x78\xe3\x84\xa5\xcc\xa6\x34\x93\xae\x09\xa2\x16\xc7\x20\x47\xb5\x86\xa8\x3c\xca\x9a\x7a\x49\x92\x85\x14\x06\xb6\xd4\x28\x01\x59\xb9\x1a\xb4\x2b\x92\x65\xcf\xcb\xd8\x68\xe9\xb3\xc5\x3b\xb7\xb5\xdb\x46\x43\x6e\xb5\x8f\x95\xcd\x43\xbd\x9e\x54\xeb\x0c\x5e\x79\x38\x13\xfb\xf5\xd2\x22\x2f\x16\x30\x9b\x99\x15\xd7\x03\x2c\x46\xf4\x52\xa1\x8a\x9f\x50\x23\x7f\x48\xe3\xe5\x77\xfc\x21\xcb\x12\x80\xed\x71\x94\x6c\x23\x3f\xc0\x7b\x19\x17\x0b\xe0\x75\xe3\x70\xc2\xa2\x6f\x34\xce\xac\x27\x1c\x9a\x44\xdd\x4b\x0e\x27\x9e\xa6\x45\x0a\x6d\xd1\x3c\xde\x5b\xd3\xbe\xc8\xc2\x42\x30\x2a\xe5\xc5\x8b\xba\xc5\xf9\x74\xd1\x23\xa4\x59\x3e\x41\xf9\xc7\xc5\xcf\x01\x23\x5e\x3c\x8c\x71\x85\xbf\xeb\x1d\x26\xb4\x78\x18\xc5\x69\x6c\xb1\xfc\xe2\x8f\x6b\x8d\x36\xad\x4e\x11\xb7\xf6\x9f\x10\x34\xf3\xe1\xd1\xbe\xa1\x58\x31\xec\xed\x63\x1e\x7c\xe3\x16\xe9\x31\x8f\x8d\x3f\x1f\x1e\x69\x7d\xeb\xda\x0d\x72\x39\xdc\x3d\x75\x6c\xb2\xa1\x9c\x96\x43\x94\x65\x06\x2b\xcd\x46\xe1\x04\x9b\xc1\x93\x65\x41\x84\x1c\x87\xcb\xfb\xba\x53\x7f\xa3\x6c\x64\x36\x3e\x68\x6c\x1a\x45\xa1\x42\x4c\x1e\x25\xeb\x3a\xac\x06\xed\xa0\xaa\x15\x6d\x61\x2b\xcf\xc3\x80\x26\x96\xae\xf1\xd9\x27\xcf\x84\x47\x9a\xd9\x12\x45\x22\xa9\xfb\x9a\xce\x66\x39\x88\x7b\x98\x2f\xa0\x2c\x02\xab\x22\x35\x5d\xeb\x92\x95\xb8\x39\x44\x61\x9b\x0d\x5a\x89\x7b\x38\x39\xf1\xf4\x8a\x1d\x04\xaf\x6e\x7f\xdb\xd6\xfe\xc0\xa2\xf2\x90\xfd\x84\x74\x47\xf7\xb8\xa9\xcd\xa1\xc2\x0e\xb9\x19\x12\x08\xfe\x55\x2e\xb0\x7c\x52\xd1\xc1\x2b\x1f\x4e\x36\xa1\x84\xce\x36\x83\x48\xc1\x6d\x0e\x28\xa5\x90\x99\x4f\x11\xd6\x47\xb4\xb5\xf7\x8d\x25\x08\x28\x72\xe7\x60\xb3\xc0\x36\xca\x11\x8e\xd4\x8b\x38\x2b\x90\xf6\x64\xae\x7d\xef\x28\x63\x8c\x39\xf0\xba\xc9\x81\x12\xc3\x3b\x92\x71\x99\xce\x7a\x7e\xcf\xc5\x23\x0f\x16\xb0\x69\xe7\xe5\x97\x59\xee\x28\x5a\xf6\xc6\x39\xb6\xc3\x9d\x2f\xe0\xa4\x1c\x77\xbd\x9b\x81\xa5\xf9\x3d\x8f\x64\x30\x0b\x1b\xdb\x0b\xcf\x41\x74\x85\x7d\xcc\x4d\xfb\x6a\x16\xe8\xe1\x23\xd3\x1a\x25\xdf\x3a\x45\xcd\x2a\xf5\xac\xf0\x22\x4e\x6f\xf5\x92\x6c\x4b\xd1\x15\x00\x8a\xbf\x53\x95\xb8\x12\x8e\x51\xea\xa4\x24\xe9\xdd\x26\xcc\x5e\x1b\xa4\x11\xa1\xb1\x3d\x50\x4a\xb3\xba\xdd\xb1\xf4\x62\xc7\xd2\x13\x7f\xd9\xe8\x4b\x45\x97\x7b\xfb\x14\x13\xd5\xbd\xfb\x22\x14\x72\xcb\xd3\x28\xdb\x64\x5f\x24\x06\x66\x07\x23\x31\x90\x9c\x3a\xe0\x89\x10\x7d\x60\xc3\xc1\x1c\x5e\x4d\x7b\xbd\x04\xa2\xa2\x3d\x5f\xc0\x2b\xd1\xc5\x4b\xc5\x27\xd3\x98\xc1\x22\xa4\x5a\x2b\x91\x47\xb7\xaf\xdf\xf7\xbc\x4c\x49\xa1\x54\xa2\x3a\x00\x99\x43\xe9\x52\xf3\x00\xe0\x16\x9e\x10\x1e\x5c\x4f\xe6\xc2\xa2\x16\xbd\xae\x1b\x23\xd4\x9b\xa6\x21\x6e\x05\xf5\x6f\x69\x96\xc5\xf1\x73\x64\x28\xc0\x60\x96\xc5\x50\x33\xbd\xbc\x1b\xea\x7c\xe7\x04\x52\xd0\x53\x9a\xe5\xbe\xbd\xb5\x8b\xee\x07\xad\xbb\xe6\x95\xca\xeb\x3d\xa6\x3b\xfd\x6b\xb6\xf5\xc2\xf5\x7a\xf9\x43\x61\x72\x63\x4a\x6a\x79\x15\x07\x41\x8c\x36\xb0\x70\xbd\x8b\x05\x32\xc8\x5b\xc0\x46\xe1\x1e\xf8\xa1\x1d\x5d\x18\x53\x8d\xb4\xa3\x70\x42\x63\xb8\x13\x7f\x3e\x18\xd4\x1b\x79\xc7\xe8\x94\x07\xba\xf3\xf0\x94\x1b\xaa\x73\xf3\xf7\x98\x2c\xf5\x3c\x56\xf3\xd4\x3b\xd4\xfe\x7c\x2b\xb8\xa6\x61\xca\xba\xc3\xcc\x61\x98\xad\x21\x3a\x17\x4d\xe6\x1f\xcf\x39\xf5\xf4\x86\x00\x21\xac\x6d\x46\xb3\x13\x89\xaa\x6f\x74\x70\xff\x9e\xfc\xe9\x14\x23\x33\xdb\xd4\xe2\x05\x1f\x37\x4e\xa7\xa7\xe3\xae\x19\xaa\xba\x32\xe3\x2e\x1a\x5e\x31\xbe\x8e\xf4\x35\x93\x3c\x73\x06\x20\x0c\x85\xf2\x01\x25\x94\xa2\x6f\x2c\xc2\x2d\x52\x1f\x4d\x23\xba\x58\xb7\x48\x50\x9f\x4f\xcb\xa2\xed\x8b\x9f\x45\x79\x9f\x92\x3e\x15\x2e\x89\x0c\xad\xfd\xca\x1b\xbf\x5a\x16\xa3\x79\xcf\x02\xa8\x88\xf3\x2a\x1d\xaf\xe7\xfb\x66\x30\x9b\x90\x14\xce\xcf\x4c\x9a\x3d\x3f\xcb\xfd\x60\x65\xee\xd3\x44\x1e\x46\x27\x73\x78\xe5\x55\xdc\x3a\x4b\xa9\x60\x55\xc5\x1e\x30\xcd\x5e\x83\x8a\x76\xcf\xc9\x89\xb1\xfa\xc8\x8a\x66\xc1\x40\x8d\x93\x5e\xac\xfd\xa1\xd4\xe6\x92\xe2\x7f\x35\xb5\xdd\xe3\x90\x1a\xe8\xe8\x55\x5c\x30\xa9\x56\xac\xf1\x55\x22\x4b\xf6\xe7\x84\xdd\x8c\xb0\xc7\x63\x0e\xd1\x18\x88\x86\x0f\xe9\xbe\x42\x33\x75\xe8\x7e\xda\x47\x24\xe1\xa5\x90\x50\xe7\xf1\xf8\x8f\x94\x92\x8c\xdf\xe1\x34\x4a\x42\xad\xf4\xb5\x06\xab\xeb\xfa\x06\xfe\xf8\x23\xc6\x2e\xa2\xf0\x20\xe5\x4d\x80\x38\x54\xa0\x9d\xa6\x6b\xde\xe3\x6e\xb6\xa8\xf6\x1b\x34\xa6\xec\xfd\xe2\xc5\x88\xc2\xe4\xe4\x04\xe8\x4c\xac\x8a\x77\x5f\x7a\xe3\x06\x4f\xf3\x1e\xa3\x6c\x37\x96\x7b\x01\x5a\xf6\x38\x49\x6c\x40\x4d\xc8\x48\xed\xeb\xfa\xa6\x88\xec\x45\xd0\xb7\x12\xd9\x7d\x50\x61\x9b\xec\xba\xe3\x60\x3e\x38\xd4\x2e\xf9\xc6\x07\x5e\xaa\x39\x70\x11\xac\x02\x2f\x55\x94\x3c\x1a\x5c\x0e\xd7\x04\x7e\x8c\xf8\x52\x99\x9e\xca\x1c\x22\xfc\x7e\x24\xb5\x43\x74\x99\xd6\xca\x8b\xe3\x72\xa5\xba\x3e\x98\x3b\x6f\x5c\xa7\xa2\x47\xa7\xc6\x52\x47\xb5\xd8\x9f\xd1\x9c\xf6\xad\xce\xe1\x73\xf0\x5f\x4b\x54\x3f\x32\xa9\xf0\x82\x86\xe4\x57\xeb\x0e\xd3\xf2\xa8\x2d\x11\x1b\xa4\x23\x02\x26\x5e\x68\x2e\x40\xe3\xa2\xb9\xd5\x14\xa5\xf4\xad\x8f\xcf\xdb\xc3\xd1\xc0\x57\x76\x75\xdd\x6a\xa7\xc7\x0b\x71\xff\x0c\x2b\x2e\x02\xa5\xb9\xeb\x50\xa7\xdd\xd5\xc1\x12\x62\xc3\xc9\x2f\x6c\xbc\x95\xb7\x71\x51\xb1\xc7\x0a\xda\xca\x94\xc1\xdd\xd5\x50\x70\x70\xf0\x6e\x98\x39\x9a\xa1\x84\xe0\xe5\xde\x53\x03\x91\x49\x33\xb2\xb5\x90\xc3\x49\xc0\x0d\x29\xa7\x7a\x3a\x25\x2a\xa6\xd9\xbe\x56\x86\x66\x99\xa9\x8d\x9c\x67\xdc\x33\x4e\x28\x21\x58\xfd\x03\x11\x1a\x08\xf8\x3d\xfc\x2b\x6f\xdd\x2e\xb6\x02\x84\xee\x3e\x7b\x7d\x90\x51\xec\x17\x0f\x6e\xf6\xc4\xcb\x87\x28\xca\xe3\x18\x18\xe7\xa8\x45\x10\xae\x88\x6a\x96\x4a\xe2\xcd\x0f\x0b\xdb\x30\xd9\x0b\x8c\x1c\x1a\xe4\x93\xc2\x67\xda\xba\x60\xd5\x90\x28\x9c\x8c\xbc\xf6\xb7\x1e\x54\xbf\xe2\x71\xd2\x3e\xa7\x6a\x11\x9c\xba\xcf\x9f\x44\x62\xe4\xcf\x91\xb3\xe2\x5c\x78\xce\x2b\xe4\x3a\xf5\xfa\x91\xcd\x37\xb1\x8e\xf3\x89\x2d\xb6\x39\xcc\x66\x39\xcc\x00\x66\x47\x7b\x77\xf0\xa0\x50\xc5\xc5\x7d\x55\x4b\x6a\x77\x29\x20\x29\x48\x8a\xb3\x5a\xfa\x78\xc9\xe1\x9b\xff\xfd\xee\xbb\xec\xf5\x11\x44\xdd\x82\x0b\x3c\x73\xae\x8a\x22\x2f\x77\x1a\x7f\xf3\xfd\xb7\xdf\x9a\xbd\x42\x7b\x3d\x78\x91\x00\xe3\xbb\x8f\xf3\x67\xaf\xa9\xfc\x30\x34\x36\x45\x18\x82\x96\xe3\x12\x3f\x5c\xca\xa8\x38\xa3\x92\x47\x35\x72\xba\xe7\x8b\xcf\xfa\x63\xc9\x3c\x89\x78\x66\x3d\x7e\x35\xb4\x24\x12\x5e\x4d\xd0\x32\xb8\xf4\x5d\xac\xb9\x44\x49\x39\xdd\x05\xfb\xd1\xe1\x03\x6b\x7a\x54\x50\x14\x85\xeb\xe4\xc7\xfb\x5d\xfa\x24\x3e\xa9\x2d\xc3\xf2\xce\x89\xc0\x5f\x50\x84\xbc\xe6\x61\xaf\x57\x5a\x77\xc5\x5b\xc6\x05\xaf\x4b\xd6\xd8\xd5\xbf\xe0\xda\x08\x94\x51\x95\xb4\xc2\xec\x84\xff\x93\x9a\x99\x3b\xa0\x3f\xa3\x98\x41\x9c\xaa\xe5\x16\x8f\x50\xca\x40\x5e\x13\xcb\x3f\x2b\x39\xdd\x23\xfd\x19\xc1\x09\x6f\x2a\xb7\x5d\x3b\x42\x6c\x02\xfc\x8f\xa4\x0e\x57\x76\x7b\xa4\x86\x03\x12\x47\xd7\x7c\x13\xb9\xe3\x37\x3b\xd2\x4f\x65\x1f\x80\xc7\x1a\x7c\x85\x02\x3f\xc5\x01\xa3\xd2\x0c\x7a\xd9\xd0\x71\x95\x2c\x1e\x0d\x25\x87\xd5\xd4\x79\x3a\x7b\x9a\xee\x25\x6a\xca\x1f\x7b\x0c\x43\x19\xcd\x78\xd3\x77\x34\xf4\x8d\x47\x45\x35\xf1\x6d\x23\x14\xca\x5d\xf7\x1a\x84\xa9\x7f\xed\xe2\xb3\x0e\xa6\x1e\xfb\x73\x6e\x4a\xc0\xd0\x5c\xd3\x2f\x35\x26\x15\x0c\xe8\x4e\x63\xa3\x65\x8b\x6f\xbe\x19\x49\xc3\x7c\xff\x58\x0b\x5f\xa2\xa6\x13\xbe\xb5\x44\xc7\xd6\x54\xd9\x20\x3a\xdd\x4c\xf4\xfd\x9c\x43\xad\xdc\x04\x66\xbe\x00\x87\x50\xa4\xb5\x70\x6d\x50\xf6\x7a\x00\xf0\x2a\xb8\xeb\xc5\x50\x2d\x47\xd2\x59\x71\xdd\x69\x98\xce\xf4\x70\x47\x5f\x23\xf9\x69\x25\x83\x3b\xe4\x28\xeb\xd2\xc6\x4e\x0e\x8a\xaa\x26\xd3\xee\x0a\xa8\x14\x6d\xc7\xa4\xf9\x08\xe3\x8e\xc9\xca\xdc\x8b\xba\x66\x29\x7c\xe6\x41\x1f\x10\x74\x92\xba\x29\x5d\xa3\x7a\xaa\x90\x7a\x03\x7c\x55\xcf\x63\xe4\x8a\x4d\x76\x44\xbf\x63\x70\x8e\x2b\x91\x66\x00\x63\xcb\xfe\x44\x58\x4b\x64\xf0\xf5\xe8\xac\x7b\xc0\xd7\x57\x75\x8b\xa2\xd7\xa9\x69\xd6\xcf\x7a\x3f\x4d\x1e\x7c\x7c\x6c\xdc\xfc\x84\xda\x8e\x55\xd3\xcc\xed\x9d\x18\x5d\xba\x99\xeb\xb3\x34\x28\x47\x1c\xa0\x40\xaf\x9e\xc5\x27\xe3\xa4\x99\xbf\xf8\x1c\xe1\xd3\x2b\x57\xdc\x7d\xf7\xe5\xbb\x71\xd7\x8e\xa9\xdd\xbb\x68\x73\x17\xc9\xc2\x11\xcd\x16\xf6\x1d\xf4\xa8\xb2\xef\x1f\x2d\x26\xd1\xb9\x31\xb4\x74\xd1\xcb\x5b\x7f\x45\x6c\x05\x1f\xa9\x39\xe5\x96\xc1\x30\xd6\x8a\x52\xd5\xc8\x68\x26\x14\x28\x9a\xe5\x44\x90\x68\x24\x46\xb8\x2e\xb0\x2d\xf8\x8b\xe8\x10\x27\x0b\x2f\xef\x57\x96\x7f\x1f\xa6\xd6\xef\x76\xe9\x69\x6d\x9c\xcb\x5c\xc6\xb0\x59\x15\x36\x3b\xdd\xe0\x07\xd1\xd9\x77\x29\x59\x48\x15\x1f\xf0\xf1\x93\x9b\xed\x15\x64\xbe\xcc\x1f\x9f\xa6\x47\xad\x83\x5f\x1f\xf8\x8f\x0d\x76\x3b\xb7\x1d\x12\xb1\x83\x0f\xfb\x31\xd2\x73\x4a\x21\x03\x3b\xa2\xa5\xbc\x10\x2b\x17\xd9\xf9\xad\xa8\x9e\x21\x11\x06\xba\xb1\xab\x77\x09\x39\xb0\xa7\x69\x3d\x1f\x43\xbb\x84\x8f\x8b\x84\xa7\xf9\x1e\xf4\x76\xbd\x8c\x59\x45\x1f\x31\xec\xcb\x87\x47\xc4\xc4\x1e\x52\xd3\x62\xb8\x43\xc5\x9a\xf5\x10\x1d\x1f\x64\x9b\x0d\x20\xaf\x60\xbb\x4d\xfe\x3d\x00\xe8\xc8\x37\x38\x9e\x2c\x00\x00")
+
+func templatesClientCassetteGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesClientCassetteGotmpl,
+		"templates/client/cassette.gotmpl",
+	)
+}
+
+func templatesClientCassetteGotmpl() (*asset, error) {
+	bytes, err := templatesClientCassetteGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/client/cassette.gotmpl", size: 11422, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesClientClientGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\xdf\x6f\xdb\x46\xf2\x7f\xe7\x5f\x31\xf5\x37\x5f\x43\x34\x68\x2a\xb9\x47\x25\x2a\x90\x73\xd2\x36\x0f\x4d\x8c\xd8\xb8\x3e\x14\x45\xb1\x26\x47\xd2\xc2\xd4\x2e\xbb\xbb\xb4\xa3\xb2\xfc\xdf\x0f\xb3\xbf\x48\x4a\x94\xec\x14\xb9\xc3\x21\x40\x24\x73\x67\x67\x66\xe7\xc7\x67\x66\x87\x9a\xcf\xe1\x4a\x96\x08\x6b\x14\xa8\x98\xc1\x12\xee\x76\xb0\x96\x97\xfa\x91\xad\xd7\xa8\x5e\xc3\xbb\x4f\xf0\xf1\xd3\x2d\xbc\x7f\xf7\xe1\x36\x4f\x92\xa4\x6d\x81\xaf\x20\xbf\x92\xf5\x4e\xf1\xf5\xc6\xc0\x65\xd7\xcd\xe7\xd0\xb6\x50\xc8\xed\x16\x85\xd9\x5b\x6b\x5b\x40\x51\x42\xd7\x25\x49\x52\xb3\xe2\x9e\xad\x91\x88\xf3\x8f\x6c\x8b\xf6\xe9\x7c\x0e\xb7\x1b\xae\x61\xc5\x2b\x84\x47\xa6\xc7\x9a\x98\x0d\x82\x57\x05\x8c\x94\x55\x9e\xcc\xe7\xf0\xbe\xe4\x86\x8b\x35\x98\xb8\x6f\x6b\x55\xa9\x95\x7c\x40\x58\x35\xc6\xb2\xda\xa0\x80\x9d\x6c\x40\xe1\xa5\x6a\xc4\x88\x53\x10\x61\x75\x66\xa2\x4c\x12\xbe\xad\xa5\x32\x30\x4b\x00\xce\x04\x9a\xf9\xc6\x98\xfa\x8c\xfe\x58\x73\xb3\x69\xee\xf2\x42\x6e\xe7\x6b\x79\x29\x6b\x14\xac\xe6\x73\x54\x4a\x2a\x7d\x82\x80\x74\x3e\xb1\xac\x1a\x61\xf8\x16\x4f\x50\x3c\xb0\x8a\x97\xcc\xe0\x59\x92\x00\x68\xa3\x56\x5b\x73\x8c\xd4\xad\x5a\xc2\xb6\x05\xc5\xc4\x1a\x21\x7f\x87\x2b\xd6\x54\xe6\x83\x3d\x97\x86\xae\x6b\x5b\xa8\x15\x17\x66\x05\x67\xff\xff\xc7\x19\xe4\x5d\xe7\xe8\xbd\x77\x06\x7b\x5f\xdc\xe3\x2e\x83\x17\x0f\xac\x6a\x10\x16\x4b\xc8\x47\x4c\x68\x15\xba\x0e\xf6\xf8\x79\xf2\x3d\xae\x69\x42\xfe\xfa\x88\x8f\x50\x28\x64\x06\x35\x30\x10\xf8\x48\x14\x9b\x66\xcb\x04\xff\x13\x63\x28\xc0\xdb\xeb\x0f\x50\x54\x1c\x85\xc9\x93\x55\x23\x0a\xf8\x88\x8f\x33\xa3\x98\xd0\x24\x1e\xbc\xcd\xf2\x2b\x4b\x72\x1b\x9e\x67\xb0\x92\x6a\xcb\x8c\xf6\x56\xca\x3f\xe3\x9a\x6b\xa3\x76\x29\x38\xca\x1b\x54\x0f\xbc\x40\x68\x13\x00\x85\xa6\x51\x02\xce\xdd\x4a\x1b\x99\x2f\xc0\x1c\xf0\x5b\x84\x2f\x5d\x42\x61\x7a\x91\xb8\x4d\xe0\x33\xe0\xa6\xd9\x6e\x99\xda\x39\xcb\x8e\xff\xa2\xe5\x77\xa8\x0b\xc5\x6b\xc3\xa5\xb0\x61\xde\xb6\x70\x57\xc9\xe2\x3e\x66\xc9\x98\x20\x9a\x8c\xbe\x54\x1a\xf7\x79\x74\xdd\x33\x18\xd0\xbe\xae\x5b\x49\x75\xd4\xbe\xbd\x67\x2e\xe6\x89\xd9\xd5\xe8\x6d\x44\xb6\x6b\x0a\x63\x6d\xf4\xa4\xc5\x13\x38\x66\x72\x6b\xa8\xf9\x9e\xdd\xb9\xb6\xb9\xc7\x85\x41\xb5\x62\x05\xd2\x66\xfb\xe4\x89\x20\xc8\x80\x6f\xeb\x0a\x09\x53\x1c\x16\x38\xb6\x43\xb5\xa3\x88\xc8\x9b\x0e\xd0\xb6\x97\x21\x0b\x3e\xd5\x04\x25\x5c\x0a\x1d\x63\xdc\xe0\xb6\xae\x98\x41\x38\x73\xb1\x16\x49\x6e\xf8\x5a\x30\xd3\x28\x3c\x83\x3c\x50\x5f\x5a\x3f\xfc\xc4\xf4\x8d\x51\xc8\xb6\x5c\xac\x3f\xa3\xae\xa5\xd0\xf8\x0c\x7e\x76\xcb\x34\x57\xef\x84\x5e\xc4\x35\x5b\x73\xc1\xbc\x2f\x9f\x60\x7c\xcd\xd6\xa8\x9e\xc1\xd7\xff\x91\x00\xdc\x60\xef\xbd\xa7\x33\x2a\x25\x37\xf6\x48\x12\xe5\x92\x0d\xe7\x17\x54\x01\x6a\xa6\x0b\x56\x8d\xfc\x36\x95\x15\x75\xd5\x28\x4b\xf6\x03\x57\xda\xfc\x22\x55\x09\xb3\xde\xe3\x9e\x34\xfd\x5f\xc8\x99\x67\xe5\x8b\xc5\xa4\x19\x83\x0b\x17\x7c\xe9\x69\xef\x8f\xfd\x63\x33\x8b\xd0\xb3\x42\xb1\x36\x1b\x82\xd5\x0a\x05\x19\xa1\x28\x50\xeb\x10\x55\x3e\x4c\xf9\x0a\x6a\xa6\xd8\x56\xc3\x72\x09\x82\x57\x76\x37\xc4\x67\x04\x8c\x93\x5e\xb8\xb6\x04\xb3\x34\x01\x08\x41\x40\x36\xf9\x85\x9b\xcd\x95\x14\x06\xbf\x98\xc8\xbf\x30\x5f\xc6\xcc\xed\x03\x2f\x22\xf7\xd4\x03\x3e\xde\x0c\x76\xef\x77\xfa\x9e\xd7\xfa\x5f\xae\x42\x71\x29\x66\x2c\x8f\x41\x95\x7a\x76\x7c\x05\xa8\x14\x2c\x22\x4b\x4f\x8e\x33\x96\x7b\xf4\x48\x5f\x5b\x92\xef\x86\x5a\x44\x88\x8e\xf1\x34\xb2\x8f\x8f\x2b\x56\xde\x34\x4a\xc9\x46\x94\x70\x26\x78\x75\xe6\xff\x7f\x19\xcd\xdb\x75\x59\x5f\x85\x50\x29\xab\x12\x9d\xbc\xf3\x55\xf2\xd0\x2c\xb2\x1e\x85\xc3\xb4\x70\x85\xba\xa9\x4c\xdb\x62\xa5\xb1\xeb\x7e\x8f\x22\x32\x3a\x49\xfc\x8b\x4e\xed\x58\x08\x69\xf6\xe5\xb0\x5c\x37\x77\x5b\x6e\x66\x91\xfc\x7c\x9c\x87\x31\x84\x9c\x45\x3e\xbc\x5b\xec\xd7\xda\xe0\xee\xcc\x12\xfc\x8c\x66\x23\xcb\x43\x22\xf7\x3c\x92\x5d\x33\xb3\xb9\x66\xc6\xa0\x12\x87\xb4\xb4\xd8\x53\x2a\x59\x36\x05\xea\x9f\xb1\xe4\xec\x76\x57\xa3\x1e\x6f\xf8\xbf\x87\x33\xc8\x0f\x89\xe2\xfe\x2b\x29\x74\xb3\x7d\x62\xff\x21\x51\xdc\x7f\x53\x6c\x70\x3b\xb9\xc9\xaf\x44\x4a\x17\xef\x0b\x1f\x62\xce\x1c\x9f\x91\x95\xa8\x16\x70\x3e\x99\x21\x6e\xb5\xf5\x11\xb8\x80\x18\x8c\x6d\x7b\x0a\xea\x33\x78\x54\xdc\x10\x5b\xf7\x19\x7d\xd7\x65\x6e\x23\x13\x25\xe4\x6f\x1b\xb3\x91\x8a\xff\x89\x25\xcc\xf6\x3d\x4f\x30\x67\xf5\x23\xa2\x0f\x62\x25\x17\xc0\xfc\x37\x62\x81\xa2\xf4\xeb\x9e\x7e\x31\x1d\xa5\x85\xf9\xd2\x87\xe9\x38\x59\xa3\x4e\xde\x0b\x16\xa4\x82\x6d\xf2\x9f\x6e\x6f\xaf\x5d\x7c\xd1\x72\x37\xc9\xdc\x15\x76\xf8\x3d\x03\x59\x1b\x0a\x62\x57\x45\x65\x6d\xb4\x4f\x4f\x59\x9b\x99\xac\x03\xbe\x14\x4c\x14\x58\x11\xe1\x23\x37\x9b\x5b\xbe\x45\xd9\xd0\x7a\x16\x84\xd2\xad\x80\x6d\xd1\x79\xc0\xaf\x7b\x47\x10\x8f\x12\x57\xa8\x3c\x17\x0b\x5a\x7f\x2f\xef\x48\x81\x98\x55\x03\xe9\x1f\xa8\x2b\x28\xb0\x36\x52\xe9\xb4\xb7\xda\xf1\xf5\x01\xc4\x1d\x00\xd3\x7f\x0a\x96\xba\x53\xc7\x76\x0b\xf8\x47\x64\xf0\xca\xda\xc2\x6a\xe2\xa0\x28\x9f\x5d\x8c\xe3\x7c\x8f\x49\x88\xfb\x34\xa3\xb3\xf4\x46\xd0\x8f\xdc\x14\x1b\x88\x3d\x7e\xe0\x46\xcd\x55\x0a\xed\xe0\x32\xc0\xe9\x2a\x40\x26\x3e\x52\xab\x28\x0c\x34\xc2\x58\x8d\x17\x0f\x41\xf0\xe2\x00\xd6\x47\x66\xb2\x0a\x04\x43\xbd\xe0\x23\x4b\x79\x85\xad\x57\xa0\x4b\x8e\xf2\x38\x6a\xea\x21\x03\x7f\x2d\xa9\x7c\x38\x59\x46\xa3\xf5\xa4\x4b\x4e\x63\x40\x72\xac\xf7\x71\x80\x01\x05\xab\x2a\x0d\x93\x14\x99\xc5\x07\x27\xd5\x75\xc3\x77\xb2\xdc\x81\x5c\x01\xa7\x26\xda\xd9\x75\xd5\x54\xa0\xbc\xb8\x0c\x8c\x04\x85\xac\x04\xa6\x81\x1b\xe0\x1a\x14\x16\xc8\x1f\xb0\x4c\xb8\xd0\x86\x56\xe4\xca\x82\x12\x5d\x84\xb9\x21\x7a\xe6\xc1\x29\x4f\x92\xdb\x0d\x5a\x7d\x50\xc1\xb6\xd1\x06\x8a\x4a\x6a\x8c\x82\x73\xa0\x75\x19\xea\x0c\xf5\x81\x1a\xa8\x06\x55\x56\x1f\xab\x9b\x95\x48\x52\x94\xdb\x5c\x66\xa0\x25\x98\x0d\x33\x96\x0d\xf5\x8d\xb2\x31\x74\x04\xfa\xd3\x65\x54\x52\xc8\x07\x54\xee\x80\xa4\x3c\xa9\x26\x57\x7b\x62\xed\xd5\x39\x1e\x54\x03\x53\xe8\x2d\x83\xf6\xb4\x76\x5d\x67\xf4\x95\x50\x65\xda\xa0\xf9\xd7\xf7\x63\x53\xdd\x38\xb4\xdf\xae\xd9\x22\xab\x85\x32\x41\xe9\xc2\x65\x7e\xcd\x6b\xb4\xb8\xa6\x43\x38\xd1\xc2\x96\xdd\xe3\xac\xd8\x30\xe1\x2f\x5d\x6d\x47\x24\xa5\x14\x38\x5e\xb5\x86\xc8\xe0\x55\x4a\x2d\xcb\x7c\x1e\xcd\xe8\x82\xa1\x37\x98\x96\x52\xd0\x27\x9b\x0a\xa4\x61\xe4\x64\x70\x87\x2b\xa9\x30\x06\x14\x2b\x13\x80\x07\xa6\x40\x8a\x02\x41\xef\x44\x91\x7f\x12\x05\x46\x85\x5d\x79\x25\xad\x2e\xbc\x83\xc7\x2b\x23\x04\x85\x25\xb0\xba\x46\x51\xce\xfc\xc7\xaf\xbf\x0d\x96\x67\x82\x57\xe9\x24\xf2\xe6\x79\x9e\x66\x40\xbe\x9c\xc9\x1a\x2e\x8e\xf4\x42\x19\x08\xaa\x51\x96\xec\x18\x4d\x0a\xb3\x78\xc9\x6c\x5d\x69\x90\x2a\x9d\x7e\x1a\x51\x9d\xda\x01\x3a\xa1\xac\x73\xd7\x1b\xd8\xe7\xee\x94\x58\x7e\xaa\x69\xed\x42\xd6\x7b\x4f\x3d\x2d\x2c\xf7\xee\x50\x01\x2e\xdc\xf2\x0f\xa4\xad\x55\x39\xba\x63\x9a\x3c\x83\xc2\xb5\x43\xaa\xe7\xe7\x1f\x9c\x54\xdf\x96\xaa\xc0\x3b\xa7\x39\xde\x2c\x9d\xbf\x7a\xf9\x92\x22\xf9\x1f\x91\x06\xac\x7f\xf3\x77\xd2\xe9\x42\xf0\x6e\x53\x7a\x16\xc3\x32\x05\x1b\x83\xa1\x49\x1e\xe0\x35\xa5\x31\x2a\x7b\xdc\xa0\xec\x4c\x1d\x68\xed\x36\x7b\x1e\x01\x5c\xf1\x8b\x99\x9d\xf7\x26\xa3\x45\x4b\x11\x13\xcb\x2f\xba\x58\xa2\x20\x5f\x4b\x08\x1a\x5a\x4e\xfd\x1d\x74\xa2\xf4\xfc\x3e\x2e\xa5\xe4\x27\x96\x4f\x26\xea\xec\x14\x2e\x5c\xb1\xaa\x7a\xab\xd6\xda\x01\x02\xe9\x07\x01\x46\xaf\xc8\x48\xd4\x21\xbd\xa7\x40\x9a\xa1\xf2\xe7\xb4\xa9\xfa\xe6\x12\x7c\x01\x77\x19\x8e\x15\xfa\xf9\x89\x2d\x87\x6f\x2e\xa3\x71\x17\x43\xab\x38\x94\x10\xbc\x0a\x84\x5e\xf5\x37\x97\xc4\x75\x31\xbc\x3a\x4d\xdf\x8b\x04\xaf\x32\x2f\x39\x38\x6b\x82\x35\x8d\xac\xa2\x75\xda\x76\x62\xc4\x70\xac\x98\xbd\xad\xa8\x08\x90\x71\x50\x03\xc1\xb9\xc7\xf7\x35\xfd\xb9\x3a\x56\xde\xb4\x61\xca\x8e\x64\x2d\x64\x87\x1d\xe3\xea\xe0\xcb\x12\x2d\x38\xd4\x5f\xa1\x29\x36\x58\x02\x99\xf3\x6e\x47\x1f\x16\xf5\x2b\x29\xd6\xf4\x49\x3b\x43\xa8\xc1\xc6\x3f\x30\xf2\x1e\x05\xf1\xa5\x39\xe2\x17\x63\x15\x5b\x50\xec\xd0\x17\x6b\x49\x3f\x41\x3c\x76\xb8\x59\x61\xbe\x04\x1c\x9a\x6c\x82\x6d\xf3\xab\xf3\x3c\x0f\xed\x02\x5f\x8d\x3a\x7b\x3a\x6e\xe8\xdc\xa3\x89\x53\xdf\x3a\x5b\x2d\xf2\x8f\x14\xfa\x21\x88\xe9\x11\xe9\x45\x9f\x8a\x9c\x80\xb1\x64\x78\x47\xc7\xc5\xf7\x4a\xcd\x0e\x2f\xc5\x5f\x3f\x7b\x98\x1a\x10\x0d\xc7\x9f\xe2\x68\x45\x5b\xa3\x1a\x1a\xc8\xe3\xb2\xcf\xd8\x8b\x23\x9b\x68\x31\x85\x67\x36\xa2\x07\x00\x6c\x63\xf7\xef\x64\xae\x3d\xe5\x0f\x14\x43\xe3\xfc\xed\x52\x3f\x86\x9c\x64\x69\x77\x7d\x75\x84\xbb\xa1\xe3\x09\x86\x83\xf9\xe9\x33\xac\x45\xd1\x42\x8a\x83\x2f\x68\xdf\xd2\xb0\x3e\x17\x00\xe0\x79\xfb\x12\x20\x8f\x50\xa4\xda\xfd\xa1\x15\xa1\x86\x46\x56\x64\x49\x52\xf1\xe9\x90\xa1\x0a\x46\x19\x14\x6e\xa3\xd9\x73\xec\x90\x79\x33\x7c\x7b\x2b\xa4\x47\xe5\x92\xfb\xbf\x61\xdb\xe7\x1b\x33\xbf\xcd\x03\x9e\x6f\xbd\x09\xe4\x2a\x5c\x19\x68\x04\x75\x74\x6b\x2c\xbd\x73\xa6\x1a\x2b\x3f\x93\x1b\xa1\x7e\x4f\x1b\xcc\x0a\x4b\xa2\xf2\xb2\x7d\xf2\x4c\x4f\x3a\x6c\xa0\xb7\xb5\x9f\x90\x9c\xf7\xac\xbc\xd1\x17\xee\xa3\xf3\xc9\x42\x98\xe5\x11\xd9\x21\x6d\x84\xd7\x8c\xfa\xc5\x70\x77\x59\x31\xba\x41\xd9\x77\x6b\x66\x83\x0a\x2d\x90\x0b\x09\x5b\xea\x2b\x49\x86\xce\xe8\xca\x60\x09\x42\x0f\x0b\xb2\x28\x1a\xa5\xb0\xf4\x28\x56\x9f\xf2\x4d\x0a\x1e\x3d\x29\xfc\xa2\xa3\x72\xaa\x8e\x63\xc8\xb0\x9a\x78\x43\x90\x2f\xf3\x7a\x6c\xa8\x91\x21\x07\x48\xbb\x47\x77\x04\x74\xe9\x5f\x9d\x53\x62\x2c\x63\xb5\x85\xa0\xc7\x12\x8c\x6a\xd0\x3f\xdb\x53\x67\x30\x66\x24\x73\xc4\x01\x45\x9d\x5b\xe3\xce\x82\x9e\xe9\x91\x39\xc3\xbe\xd0\x43\x91\x13\xe7\x27\xa6\x6b\x04\x57\x47\x12\x3f\xb1\xb5\xa5\x78\xaf\xea\xfb\x7e\xdf\x56\xd1\x0c\xac\x57\xdc\x1b\xad\xfc\x47\x49\x93\xbe\xfe\xe5\x01\x55\xbd\x5b\x22\xfb\xb1\x61\xaa\x9f\x8b\xb4\xed\xde\x63\x68\xf7\x07\xc4\xa3\xdd\x1f\xf4\xc7\xa6\xaa\xd8\x5d\x85\x87\x2c\x68\xf7\xe8\xec\x56\x2d\x58\xc2\xc5\x90\xc4\x1f\x91\xb8\xfa\xd9\x45\xd2\x53\xee\x11\x1e\xd7\x63\x70\x8a\xa3\x84\x36\x3d\x26\x14\x8e\xa1\x45\xd2\x2c\xd1\x81\xe6\xc1\x92\x94\xd0\x53\xd4\x93\x87\xe8\x37\x1d\xdb\x33\x50\xd3\x7b\xee\x4f\x54\xf2\xc0\x5f\x7c\x15\x0c\xb2\x74\x04\x7f\xfd\xd5\x3f\x08\x52\xc8\x4f\xf6\x1e\x29\x64\x9f\xdb\x93\x21\xd6\x39\x1d\x43\x3c\x4e\xa8\x06\x61\xbe\x3d\x61\xb3\xf3\xd8\x14\x59\x15\xa6\x0c\xee\x23\xd8\x8a\x73\xe8\x43\x60\x05\x6b\x34\x3a\xd6\xe2\xd8\x1d\xde\xed\x2c\x22\x3c\x0f\x3b\x88\xcf\x2c\x85\xe7\xd5\x8a\x61\x4b\x44\xc7\x5c\x07\x6d\xde\x2b\xd5\x2b\x63\xeb\x09\x3c\x6e\x78\xb1\x01\x6d\x64\x5d\x63\x69\x95\x74\x2d\x84\xbd\x9a\xda\xf9\xef\xee\x79\x1a\x5a\xb8\xf1\x4c\x47\xf2\x29\xe1\x07\x4d\xfb\xe0\x1b\xa9\x34\x7c\x83\x07\xae\x98\xf8\x86\x38\x3e\x95\x16\x92\xfd\xcb\xd3\xc3\x86\xf1\x2b\xdf\x01\x5a\xe3\x0c\xde\xeb\x58\x04\xf2\xdf\xc9\x4c\x21\x69\xf6\x26\xc8\xf1\xed\xef\x27\xf7\x92\x2d\xa8\xca\xc4\x60\xde\x34\x1a\x43\xb8\xb9\xad\xa1\xf1\x04\x75\xd0\x0c\x34\x17\xeb\xca\x8d\xaf\x32\xd0\xe8\xc6\x56\x23\xa6\xa1\xca\xda\x67\xe0\x7f\x55\x32\x7c\x2f\xec\xe9\x96\x4f\x8e\x15\xdc\xef\x13\x06\xd3\x6b\xd0\xc1\xef\x25\xb2\xb2\xe2\x22\x5e\x62\x7c\x7f\x43\x7f\x0e\x0f\x93\xf5\x57\x9e\xc1\x4c\x8c\xc6\x68\xbe\x25\x68\x44\x85\xda\x1b\x80\x36\x38\x09\x4c\x48\xaa\x9f\x74\xe9\xa1\x9f\xb4\x90\x16\xb7\x23\x6f\x96\x12\x35\xd0\x4b\x04\x56\xd7\xd5\x6e\x6a\xe6\xa6\xf0\x8f\x06\xb5\x89\xc5\x78\x60\x60\xba\x2d\xb1\xd8\x91\xb9\x58\x18\x8f\xe8\x4f\x0c\x5a\x82\x18\xfa\xcc\xdf\x35\x61\xb6\x12\xb8\x5d\xd9\x71\x3d\x8d\x36\x42\x75\x26\xc2\x32\x03\x79\xef\xe7\x28\xbe\x63\x19\xcc\x2d\xe0\xb3\xd3\x35\xc8\x4f\xc7\xcc\xa1\x4b\x5f\xd3\xf6\xf3\x73\xfb\xbc\xcc\x0f\xc8\xbf\x87\x97\x1e\x8f\x82\x76\xcb\x23\xa4\x1e\x6f\xbc\x5a\x44\xf9\x66\x19\x37\xfb\x64\x0b\x43\x86\x00\xcb\xf4\xea\xd3\xa9\xee\x23\xf9\xe4\x2b\xd2\x60\x88\x7f\xb2\xe2\x7e\x6d\x47\xd0\x51\x2a\xcd\xd2\xfc\x4b\x91\x43\x73\x25\x30\x90\x90\x05\xba\x9e\xdf\x2f\x03\x07\xd9\x3b\x98\x3f\x40\xda\xa3\x84\xdb\x33\x82\x89\xf9\x1c\x06\xd3\x34\x1a\xf7\x51\xe2\xd0\x64\xd0\xaa\x66\x23\xc5\xa6\x98\xd6\x3e\x75\xc6\xd1\x1b\x12\x6c\xc8\xe4\x44\x7e\x0d\xc9\x96\xff\xf5\xa9\x9d\xcd\x56\x7b\x1a\x03\x1a\x45\xb9\x87\x2b\x7d\x26\x86\x34\xca\xc0\x6c\x94\x6c\xd6\x9b\xfe\x27\x28\x61\x5c\x19\x0e\xc9\xaa\xea\x10\x2c\xe3\xcb\xa4\x13\x47\x1b\x71\x1b\x0d\x3b\xa7\x95\xb7\x41\x64\xed\x61\x47\x55\x51\xc7\xfc\xc6\x0a\xf3\x13\x04\xee\x7f\x1a\x30\x1b\xb2\x4f\xe1\x12\x5e\xbd\x06\x0e\xdf\x2f\xe1\xe5\x6b\xe0\x97\x97\x3e\x22\x07\x44\x99\x57\x9a\xf6\x0f\xf7\xfe\xca\x7f\x73\x6e\xb0\x1b\xe8\xcb\x33\x1c\x77\xe2\x04\x83\x3c\x1a\x88\xb1\x6f\xfd\x9c\x02\x7e\x18\xe8\x53\xc2\x93\x92\x5c\xfb\xe6\xd0\x55\xd8\xbd\x1f\x10\x80\x41\x7a\x77\x42\x75\x74\x08\x81\x5c\x53\x4f\xe1\xe0\xd8\xff\x1e\x6e\x00\xff\x1e\x5f\x7d\x31\x31\x1b\xdc\xd9\xcb\x88\x8e\xd5\x6f\x4f\xc8\x73\x8a\x5e\xbc\x74\xd0\xde\x1a\x55\x40\xb5\xb8\x35\x1f\xd8\x05\x6e\xf6\x04\xf8\xfd\xdd\x20\x63\x1d\xaa\x79\x6e\xf9\xc1\x86\xa4\x4b\xfe\x3d\x00\x25\xff\x01\xed\x81\x29\x00\x00")
 
 func templatesClientClientGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -131,12 +268,12 @@ func templatesClientClientGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/client/client.gotmpl", size: 3178, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	info := bindataFileInfo{name: "templates/client/client.gotmpl", size: 10625, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesClientFacadeGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xc1\x6e\xe3\x36\x10\xbd\xeb\x2b\x06\xe9\xb6\x90\x17\x8e\x74\x4f\xe1\x4b\x93\x45\x77\x0f\x4d\x82\xae\x81\x1e\x8a\x1e\x68\x7a\x24\x11\x91\x48\x95\xa4\x6c\x78\x0d\xfd\x7b\x31\x14\x29\x4b\x8a\xec\x38\xdd\xcd\x29\x26\x87\xc3\xf7\xe6\x8d\xde\x30\x4d\xe1\x5e\x6d\x11\x72\x94\xa8\x99\xc5\x2d\x6c\x0e\x90\xab\x5b\xb3\x67\x79\x8e\xfa\x57\x78\x78\x82\xc7\xa7\x35\x7c\x7a\xf8\xb2\x4e\xa2\x28\x3a\x1e\x41\x64\x90\xdc\xab\xfa\xa0\x45\x5e\x58\xb8\x6d\xdb\x34\x85\xe3\x11\xb8\xaa\x2a\x94\x76\xb2\x77\x3c\x02\xca\x2d\xb4\x6d\x14\x45\x35\xe3\x2f\x2c\x47\x0a\x4e\x9e\xfd\xff\xb4\x91\xa6\xb0\x2e\x84\x81\x4c\x94\x08\x7b\x66\xc6\x60\x6c\x81\xe0\xd1\x80\x55\xaa\x4c\x28\xfe\xd3\x56\x58\x21\x73\xb0\xfd\xb9\xca\xdd\x58\x6b\xb5\x43\xc8\x1a\xeb\x52\x15\x28\xe1\xa0\x1a\xd0\x78\xab\x1b\x39\xca\x14\xae\x70\xb0\x99\xdc\x46\x51\x24\xaa\x5a\x69\x0b\x71\x04\x70\x23\xd1\xa6\x85\xb5\xf5\x0d\xfd\xc8\x85\x2d\x9a\x4d\xc2\x55\x95\xe6\xea\x56\xd5\x28\x59\x2d\x52\xdd\x48\x2b\x2a\xa4\x08\x8a\xb4\x9a\x49\xe3\x12\x5c\x8e\x4f\x79\x29\x50\xda\x0b\x89\x09\xe2\xa5\xed\x1a\xf9\x85\x6d\xd4\x5a\x69\x73\x0d\xee\x08\xc0\x58\x9d\x55\x67\x11\x77\xbb\x2e\xf0\x78\x04\xcd\x64\x8e\x90\x3c\x60\xc6\x9a\xd2\x7e\x71\xc5\x32\xe0\x14\xae\xb5\x90\x36\x83\x9b\x9f\xff\xbd\x81\xa4\x6d\xbb\x78\x2f\xfb\xe0\xec\x87\x17\x3c\x2c\xe1\xc3\x8e\x95\x0d\xc2\xdd\x0a\x92\x51\x12\xda\x85\xb6\x85\x49\x3e\x1f\x3e\xc9\xba\x70\x5d\xe3\xb1\xd0\x7a\xd1\x54\x4c\x8a\x6f\x08\xc9\x23\xab\x28\x1c\x3e\xaf\xd7\xcf\xd0\x15\x3b\x89\x76\x4c\xf7\xd1\x2b\x78\xc4\x3d\xed\xde\xbb\xcd\x58\x8a\x72\x11\x45\x5c\x49\xd3\x89\x0f\x70\x4a\xfd\x59\x19\x0b\xc2\xb8\xd6\xd9\xfa\xf3\xb4\x16\xc2\x32\xd5\xc8\x2d\x08\x09\x7f\xa0\x65\x10\x0b\x99\xa9\x05\x18\xe4\x56\x28\x09\x2a\x03\x12\xcb\xf5\xa7\x3b\x30\x4c\x6a\xac\xa6\x06\x5e\x8d\xf8\xfe\xb4\xbb\x81\xc4\x6d\x3b\xbe\x43\x24\xbf\x31\x83\xcf\xcc\x16\x53\x34\x61\xfd\xbb\x10\xf5\xc9\xcf\xa3\xea\x43\xa6\xd5\xff\xca\x0b\xac\xd0\x00\xd3\x38\x02\x66\xfc\xfa\xf5\x80\x06\x22\x85\xa4\x33\x40\xc2\x96\x77\x8e\x91\x96\xc0\x35\x32\x4b\x60\x40\xe2\xfe\x8a\xbe\xc8\x1a\xc9\x27\xed\x90\x29\x5d\x31\x6b\xfc\xb7\x91\xfc\x89\xb9\x30\x56\x1f\x16\xf0\x91\xa0\x30\xc3\x59\x39\xca\x77\x8c\x00\x34\xda\x46\xcb\x71\xa2\xbf\x84\x2d\xee\x95\xcc\x44\x1e\x52\x2e\xc1\xb5\xda\x0c\xee\x53\xec\x3b\x19\x2c\x29\x55\x63\x48\x33\x06\xbc\x31\x56\x55\xe2\x1b\xdb\x94\x08\x27\x3f\xe2\x2e\xf1\x1c\xd7\xd7\x10\xa7\xac\x97\xc0\xb3\x1c\x3e\xae\x43\xb2\x2e\xfa\x62\x2d\xd2\x14\x50\x9a\x46\x23\xc8\xa6\x2c\x1d\x96\x9a\x69\x56\xa1\x45\x6d\xa0\x60\xbb\xbe\x45\x22\xa0\x59\x42\x17\xac\x56\x54\x1a\x77\x1c\xba\x85\xd0\x08\x93\x9b\xe3\x45\x04\xd0\x46\xdd\x35\x5d\xa9\x06\x4c\x99\xdc\xfa\xba\x44\x30\x58\xbe\x5b\x8d\x1d\x3a\x79\xc4\x7d\xcc\xb3\xdc\x7d\x69\x8e\x61\xdf\xdd\xdd\x2f\xdf\x62\x8b\x91\xb2\x71\x7f\x7e\x09\xbe\x5a\x03\x2d\xaf\xd1\xcd\x43\x0b\x3a\x9c\x12\x82\xf7\xe4\xa4\x93\x65\xfd\xea\xa2\x77\x35\xe3\x7b\x05\x08\x77\x8c\x44\xe8\x17\xc3\xd5\x0f\xfd\x11\x57\x7e\x5e\x0a\xaa\xab\xc4\x7d\x3c\x8b\x64\xd1\xc5\x24\x3d\x17\x58\x9d\x14\x19\x0d\x94\xa7\x9a\x06\xb1\x50\xf2\x77\xad\x9a\xda\x74\xce\x47\x47\xe7\x19\x3a\x47\x08\xbf\x92\x73\xba\x8c\x27\x90\x17\x91\x97\xc2\x0b\x36\xdf\x5c\x03\x0d\xa7\x3b\x7b\x61\x0b\x72\x37\x3a\xdc\x1b\x1c\x5a\x7a\x83\x18\xb0\xec\x05\x25\x64\x5a\x55\xce\x00\x2b\xf2\xb9\x81\xc1\xb9\x67\x47\x30\x39\xff\x19\x9e\xeb\xee\x57\x9f\x9a\x97\xc3\x33\xf8\x65\x7e\x97\xfe\xa8\x97\xef\x86\x13\x66\xd9\x6f\x85\xe6\xbe\x9b\xda\xfd\x29\xc4\x77\xfc\xdd\xc4\x7f\xbb\x80\xd6\x57\xed\x55\xb9\x94\xb4\x4c\xc8\x6e\x1e\x0d\x9a\x19\x4b\xf7\x76\x23\xa7\x77\xfe\xd4\x4f\x80\x2b\xaa\x63\x0f\x35\xbe\xba\xc8\x58\xdd\x70\xeb\xc9\x0e\xa6\x67\x34\x64\x37\x5c\x0b\x33\xe2\xef\x7f\xfc\x62\x47\x80\xfc\xce\x1d\x57\x3b\xd4\x5a\x6c\x71\x3c\x4a\x0b\x57\xb5\x34\x75\xaf\x48\xb1\x3d\x3d\x3f\xaf\x51\x34\x9e\x37\xca\x70\x65\x5c\x9c\x60\x9f\x55\x39\x78\x12\xac\x1c\x96\xa1\xf2\x3c\x1b\x92\xe8\x39\xcf\x13\xd9\xf4\xfa\xfe\x78\x32\xe1\xea\x78\x33\xae\xfb\x45\x52\x3d\xde\x55\x8f\xed\x3c\xb9\x20\xde\x3c\x37\x13\x3a\xf3\xc7\x53\xf3\x17\xc7\x66\xd2\x3d\x17\xa9\x9d\x5e\x2a\xfe\xd8\x3c\xb1\x79\x33\x13\xe4\x35\xdd\x58\x20\xef\x9a\x1d\x1b\xdd\x27\x31\x7f\x7e\xf0\x61\xbc\x61\xa8\xf3\xe7\x69\x8a\x18\xc9\x5e\x86\x8b\x7e\x08\x4d\x2c\x74\xfd\xd6\xac\x22\x9e\x44\xf4\x2b\x9e\xd6\x80\x17\x04\x69\x6a\x10\xaa\x73\x02\xcf\x9b\x86\x36\x2b\x4b\x10\x34\xe6\x9a\x8d\x46\xa3\x1a\xcd\xd1\x04\xb9\x08\xe4\x04\x7a\xdb\x2e\x46\xf7\xbc\x3d\x49\x17\xae\x46\xfc\x7f\x8f\xa3\xf9\x61\x94\xcc\x83\x18\x8f\x9f\x36\xfa\x2f\x00\x00\xff\xff\x57\x24\xfe\xf8\x64\x0f\x00\x00")
+var _templatesClientFacadeGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x5a\xdd\x92\xdb\x36\xb2\xbe\xd7\x53\x74\xe6\xe4\xa4\x24\x97\x4c\xce\x39\xeb\x6c\xed\x7a\x57\xa9\xca\x8e\x9d\xcd\x54\xad\x1d\x97\x3d\xde\x5c\xb8\x7c\x81\x01\x9b\x12\xd6\x24\xc0\x00\xa0\xc6\x8a\x4a\xef\xbe\xd5\xf8\x21\x41\x8a\xd2\xcc\x38\x49\xf9\x62\x44\x02\xf8\xfa\xeb\x1f\x74\x37\x40\xe7\x39\x5c\xa9\x02\x61\x8d\x12\x35\xb3\x58\xc0\xed\x0e\xd6\xea\xa9\xb9\x63\xeb\x35\xea\xbf\xc1\x8b\x9f\xe0\xf5\x4f\x37\xf0\xf2\xc5\xf5\x4d\x36\x9b\xcd\xf6\x7b\x10\x25\x64\x57\xaa\xd9\x69\xb1\xde\x58\x78\x7a\x38\xe4\x39\xec\xf7\xc0\x55\x5d\xa3\xb4\xa3\xb1\xfd\x1e\x50\x16\x70\x38\xcc\x66\xb3\x86\xf1\x4f\x6c\x8d\x34\x39\x7b\x13\x7e\xd3\x40\x9e\xc3\xcd\x46\x18\x28\x45\x85\x70\xc7\xcc\x90\x8c\xdd\x20\x04\x36\x60\x95\xaa\xb2\x59\x9e\xc3\xcb\x42\x58\x21\xd7\x60\xbb\x75\xb5\x63\xd3\x68\xb5\x45\x28\x5b\xeb\xa0\x36\x28\x61\xa7\x5a\xd0\xf8\x54\xb7\x72\x80\x14\x45\x38\xda\x4c\x16\xb3\xd9\x4c\xd4\x8d\xd2\x16\xe6\x33\x80\x8b\xdb\x9d\x45\x73\x41\xbf\x50\x72\x55\x08\xb9\xce\xff\x63\x94\x74\x6f\xca\xda\xba\xbf\x42\x85\x3f\xb9\x50\x24\xd0\x3d\xd5\xcc\x6e\x72\xcd\x64\xe1\x9f\x44\x8d\xee\x87\x44\x1b\xff\xe6\x1b\x6b\x9b\xee\xa1\xd5\x95\xfb\xad\xbc\xb4\x86\x96\x93\x3e\xf4\xc3\xbd\x31\x4a\xfb\xa5\xc6\x6a\xae\xe4\x36\xfe\x16\x72\xed\x97\x98\x9d\xe4\xee\x87\x8d\xc2\x5a\x29\xb8\x2a\x30\x6f\x6d\xf9\x97\x8b\x19\xbd\x59\xab\x8a\xc9\x75\xa6\xf4\x3a\xff\x9c\x2b\xd6\xda\xcd\xff\xbb\x25\x6b\x61\x37\xed\x6d\xc6\x55\x9d\xaf\xd5\x53\xd5\xa0\x64\x8d\xc8\x75\x2b\x23\x16\x71\xb5\x9a\x49\xe3\x4c\x73\x7e\x7e\xce\x2b\x81\xd2\xde\x0f\x9c\x57\x8a\x7c\x70\x66\x22\xf9\xfb\xdc\x70\x83\xfc\xcc\x30\x6a\xad\xb4\xb9\x9f\x87\xb3\x8d\xb1\xba\xac\x4f\xaa\xe6\x47\xdd\xc4\xfd\x1e\x34\x93\x6b\x84\xec\x05\x96\xac\xad\xec\xb5\x8b\x17\x03\x87\xc3\x7e\x0f\x8d\x16\xd2\x96\x70\xf1\xbf\xbf\x5c\x40\x76\x38\xf8\xf9\x21\xf2\x93\xb5\x5f\x7f\xc2\xdd\x12\xbe\xde\xb2\xaa\x45\x78\xbe\x82\x6c\x00\x42\xa3\x70\x38\xc0\x08\x2f\x4c\x1f\xa1\x2e\xdc\xc6\x09\x5c\xe8\xfd\xa6\xad\x99\x14\xbf\x22\x64\xaf\x59\x8d\x84\xf3\xe3\xcd\xcd\x1b\xf0\x5e\xc9\x66\x5b\xa6\xbb\xd9\x2b\x78\x8d\x77\x34\x7a\xe5\x06\xe7\x52\x54\x8b\xd9\x8c\x2b\x69\x7c\xfc\x03\xf4\xd0\x3f\x2a\x63\x41\x18\xb7\x7b\x8a\xb0\x9e\xde\xc5\x69\xa5\x6a\x65\x01\x42\xc2\x2b\xb4\x0c\xe6\x42\x96\x6a\x01\x06\xb9\x15\x4a\x82\x2a\xc1\x34\xc8\xdd\xd6\x76\x0b\x52\x50\x1f\xc5\xb0\x1a\xe8\xfb\x3f\xdb\x0b\xc8\x08\x9f\x72\xc6\x90\xc9\x3f\x98\xc1\x37\xcc\x6e\xc6\x6c\xe2\xfb\xdf\xc4\xa8\x03\x3f\xcd\xaa\x9b\x32\xb6\xfe\x3b\xbe\xc1\x1a\x0d\x30\x8d\x03\x62\x26\xbc\x7f\x38\xa1\xc4\x49\x11\x74\x82\x48\x1c\x0a\xc9\x73\xe0\x4b\xe0\x1a\x99\x25\x32\x20\xf1\xee\x01\x71\x51\xb6\x92\x8f\xc2\xa1\x54\xba\x66\xd6\x80\x8f\xfe\xec\x2d\xae\x85\xb1\x7a\xb7\x80\x27\x44\x85\x19\xce\xaa\x01\xde\x7e\x06\xa0\xd1\xb6\x5a\x0e\x81\x7e\x16\x76\x73\xa5\x64\x29\xd6\x11\x72\x09\x2e\xd4\x26\x78\xf7\x73\x1f\xa9\xc1\x92\xa0\x5a\x43\x91\xc4\x80\xb7\xc6\xaa\x5a\xfc\xca\x6e\x2b\x84\x3e\x71\x71\x47\x62\x4a\xd7\x63\x8a\x63\xad\x97\xc0\xcb\x35\x3c\xb9\x89\x60\x7e\xf6\x59\x5b\xe4\x39\xa0\x34\xad\x46\x90\x6d\x55\x39\x2e\x0d\xd3\xac\x46\x8b\xda\xc0\x86\x6d\xbb\x10\x99\x01\x95\x53\x12\xb0\x5a\x91\x69\xdc\x72\x70\x12\x57\x31\x10\x46\x92\xe7\x8b\x19\xc0\x81\x32\x52\x9e\x07\x53\x25\x9a\x32\x59\x04\xbb\x90\x4f\x2c\xe5\x98\x41\x0e\xcf\x5e\xe3\xdd\x9c\x97\x6b\xb7\xc5\x9c\x6a\x5d\x58\xfb\xa7\x10\x5b\x24\x24\xcf\x5d\x34\x93\xeb\x7c\x6c\xdf\x69\x61\x2d\xca\x58\x95\x43\x1a\x05\x61\x0d\x56\xe5\x12\xee\x36\x82\x6f\x40\xc9\x6a\x07\x1a\x7f\x69\x85\x76\x3e\x6c\xb4\x2a\x5a\x8e\x1a\x4a\xa5\x09\x4f\x68\xa8\xb1\x10\x0c\xec\xae\x41\xe3\x58\x66\x6f\xc2\x1c\xf3\x21\x60\x66\xaf\xda\xca\x8a\x86\x69\xfb\x83\xd2\xf5\x2b\x51\xe3\x47\x58\x45\x81\xd9\x0d\x7e\xb6\x71\xc9\x7c\x71\x0a\xe2\xfd\xdb\x7f\xb9\xe2\x8d\xc5\x83\x30\x68\xeb\xf5\x76\x8c\xd3\x7c\x94\x74\x2e\x20\x12\xbd\xd3\xb2\x2b\x66\x0c\x5a\x8b\xf0\xd5\x0a\x2e\x2e\xa2\xf3\xe2\xcb\xe7\x2e\xcd\xc6\x39\x73\x6d\x97\x83\x45\xc3\xa7\x57\xaa\xc0\x25\x84\x18\x5c\x0c\x80\xb2\x2b\x25\x4d\x5b\x53\xec\x90\xf8\xfe\xd1\xcd\xea\x39\xaf\xba\x15\x2e\x42\x1c\xcb\x0a\xa5\x73\xf7\x95\xc6\x02\xa5\x15\xac\x32\x0b\xf8\x0e\x2e\x61\x7f\xb4\xd8\x6d\x85\x7e\xda\xbc\x1b\x5b\xc2\x18\xa1\xc7\xa7\x91\xb7\x68\xf5\x0e\xbe\x4a\x03\x78\x8c\xeb\xa6\x8c\x11\xdd\xcb\x11\xd6\xbb\x4f\xa2\xf9\x37\xab\x44\xc1\x5c\x72\x9c\x46\x53\xad\xed\xe7\xf4\xa8\x11\x8a\x57\x22\xd8\x3e\x95\x98\x98\x36\xb1\xcb\xb5\xb4\xa8\x39\x36\x56\xe9\x81\x61\x78\x25\xb2\xf7\x06\x8f\xe6\x64\x59\x16\xc5\x84\x8c\xc7\x2b\xd1\xe7\xb3\x87\xe4\xae\xb0\x3d\x63\x2e\x9a\xdf\x1b\x74\x4b\x38\x91\x9a\x7e\xd7\x24\x14\x65\x0c\x12\x51\xf7\x32\x8a\x0e\x39\x29\xa6\xa0\x60\x6a\x89\x77\xf3\x49\x26\x64\x2b\x32\x65\xba\x81\x3a\x7d\x07\x4d\xd5\x4f\x0d\xb5\xfc\x42\xc9\x7f\x6a\xd5\x36\xae\xb6\xf9\xa5\xd3\x1a\xba\xaa\x18\x9f\xb2\xd3\xae\x4e\xbb\xb0\x23\x87\x05\x65\x3a\x72\x47\xf5\x67\x3c\x72\x27\xec\x86\x72\x18\x79\x3b\x18\x0f\x68\xc3\x51\x33\x0e\x96\x7d\x42\x09\xa5\x56\x35\x4d\x81\x9a\x6a\x7d\x52\xe4\xe9\x5d\x57\xe8\x43\x29\x9a\x26\x30\x5f\x1c\x95\x9b\xe0\x8e\xa0\xc1\x37\xd3\xa3\xf4\x8f\xd2\xfa\xf3\x58\x39\xe8\x61\xd9\x0d\xc5\x3c\xdf\x0d\x77\x89\xbf\x9b\x12\x92\x7f\x37\x23\x3c\x7b\x8c\x43\xb0\xda\x58\x38\x57\xd2\x32\x21\x7d\x4f\xd6\x79\x01\x34\x56\xee\x08\x47\x0d\xe1\x72\x96\xb6\x65\x0f\xb0\x0e\xd5\x86\x23\x41\xc6\xea\x96\xdb\xa0\x6c\xd2\x41\xce\x52\xed\xd2\x77\x81\x3e\x7c\xf8\x98\xbc\xcc\x73\x18\x25\x99\x42\x18\xaa\xd1\x5e\x81\x6d\xff\x3e\xd0\x72\xbb\xc6\xc4\x27\x15\xe3\xd4\xc0\x2d\x96\xca\x77\x7c\x3b\x57\x1e\x8d\xaf\xba\x30\xc6\xbf\x55\xaa\x8a\xa2\x5d\xce\x8b\x0d\x6c\xa3\x2a\xc1\x77\x60\x15\x75\x4f\x7a\x37\xc6\xf7\xf5\xb4\x64\xa2\xc2\x62\x99\x0e\x90\x30\xa9\xac\x5b\x25\xb0\xf0\x07\x5d\xe1\xba\x74\x29\xbc\x28\x2f\xe7\x89\xfb\xf3\xc6\x89\x89\x0c\x92\x3c\x7e\xd4\xb0\xf2\x64\xec\x48\xdf\x25\xd5\x7d\x83\xbc\xd5\xc2\xee\x42\x6f\xeb\x40\x53\xc4\x9a\x35\x1f\xbc\xb1\x3f\x0e\xb3\xd9\xf7\xad\xdd\x5c\xcb\x52\xfd\xac\x85\x45\x1d\xc9\xa4\xa9\xd5\xb1\xe1\xac\xaa\xb0\x00\xa6\x5d\xb0\x90\x41\x4c\x7b\x5b\x0b\x63\x12\x87\xa4\x94\x84\x04\xa5\x0b\xd4\x4b\x50\x92\x23\x34\xa8\x1d\x84\xc3\x1f\x80\x7f\xf8\x98\x3c\x76\xb6\x08\xf5\x32\x3a\xa4\x0c\xd7\x06\x1a\x47\x82\x1c\x37\x8d\x9c\x64\x15\xa0\x34\x68\x6c\x2a\xb6\xc3\xc2\xed\xf7\x23\xe7\x50\x24\x00\x33\x04\x9b\xf8\x06\xeb\xc6\xee\x9c\xe8\x58\xf4\xd3\x60\x4d\xe8\x50\x2f\x10\x29\xd5\xf4\x3b\x28\x1e\xcb\xfb\x12\x34\xb2\xa2\x4f\x35\xe9\xba\x97\x72\x0b\x28\xb7\x42\x2b\xe9\xae\x63\xb6\x4c\x0b\x8a\xee\xf3\x44\x68\xe5\xe0\x21\xec\x75\xaa\xb6\x6e\xa7\xa9\x2d\x6a\x2d\x0a\x34\x83\x78\xd9\xb8\x04\x93\xe7\xee\xde\x45\x14\xfd\x85\xcd\x43\x92\xdf\x7c\xba\xaf\x8e\x22\xe7\x9b\x7e\x87\x9f\x4c\x88\xb1\x93\x85\x15\xd0\xf4\x34\x49\xf2\x72\x9d\x28\xd1\xa5\x87\x69\x45\x6e\xc3\xf0\x1f\xa1\x4c\x14\x3d\x8f\x42\x1e\xa2\x54\xc7\x77\xd5\x71\x3b\xad\x5c\xcc\x73\xd3\xba\x85\x53\xe8\x1f\xa1\x5a\x10\x3c\x37\xa3\x44\x7b\x56\xb5\xc8\x76\x15\x99\x9d\x51\xec\x8f\x4b\xd4\xce\x1c\x15\xb2\x2d\x1d\x1c\x47\x78\x56\xd1\x5c\x30\xa8\xb7\xa8\x1f\x60\x85\x01\xcb\xb9\xf9\x24\x1a\x97\xf3\xcf\x5b\x61\xa8\xda\x0a\x68\xd9\x69\x4b\xb8\x44\x4e\xbd\xc6\xa3\x2b\xc7\xfd\xfc\x1d\xf6\x3c\x40\xa6\x25\xe3\xac\x02\x6e\x1e\xac\x02\x95\xd3\xcc\xd3\xea\xd0\xf1\x3f\x51\x70\xd8\xb8\xbe\x84\xc3\x7d\x1f\xb0\x47\x4a\x86\x93\xa6\xbf\x09\xf6\x01\xe5\x0e\xc1\x49\x31\xa1\xae\x4d\xb5\x16\xe8\xde\x93\x84\x71\x07\x70\xbf\x5d\x12\xe6\x21\xc2\xc3\xce\x5d\x3a\x28\x38\x57\xe0\x4e\x9a\x2e\x9c\x73\x52\xab\x0c\x3a\xee\x68\xde\xc1\x04\xa8\xd9\x27\x9c\x3f\xb0\xb6\x2e\x42\xb7\x36\x81\xf4\xc1\xab\x41\xe7\x60\xd2\xe0\xb4\xd3\x06\xa5\x93\x15\x85\x01\x91\xbc\x59\x7e\x71\x99\xbe\xdf\xe8\xa9\xe4\x79\x2a\x14\xb2\x2c\x3d\x89\x9d\x8d\xcd\x14\x84\x74\x6d\x1a\x94\xc5\xd1\x59\x6e\x09\xe2\xf8\x64\x77\x2a\x8a\x43\x6d\x0c\x4d\x80\x39\x0a\xc6\x70\x32\x10\xd4\x1a\x98\x46\x49\x43\x69\x4a\x01\x73\x15\x6f\xd9\xb7\x0c\x6e\x65\x1d\x6b\x77\xf8\x7a\xb1\x84\x02\x89\x22\xe5\x22\x15\x1a\x64\x55\x20\x7d\xea\x20\x8f\xdc\xc4\x3e\x40\x98\xdf\xa3\xf2\x2f\xdd\x06\x09\x5b\xd0\xb1\xf4\xd4\x1e\xb0\x23\x82\xb8\x79\xd3\x97\xb1\xa5\xa7\x96\x32\x39\xeb\x9b\x38\x91\x52\x47\xac\x6a\xe3\x8b\x10\x58\x39\xd0\xd3\xee\x18\xdc\x00\xc0\x9d\x66\x0d\x5d\x35\x25\x47\x40\xa3\xce\x97\x04\x2a\x01\xde\x69\xc2\x12\x68\x6c\xa9\x43\x15\xc0\x62\xb2\x68\x04\x0b\x9d\xb9\x83\x38\x75\x86\x5f\x9c\x1a\x48\x2f\x4f\xa5\xea\x21\xbb\x09\xfb\xd1\x82\xe7\xbd\x9a\xee\x44\xe6\x8e\x4a\x93\x2b\xd3\x03\xd3\x09\xe9\xc1\xa4\x74\x60\x31\x3d\x02\x58\xac\xaa\xa3\x10\x0f\x46\xf4\xd7\x17\xce\x58\x56\x75\xf6\x0a\xb1\xef\xed\x1d\xe2\x68\x92\xd4\x62\x2c\x6c\xbe\x70\xc5\x32\xb5\x83\xd5\x2d\x06\x66\xd3\xa7\x7f\x41\xde\x0e\x44\xe8\x5a\x71\xea\x9e\xc5\x1b\x66\x7a\x7d\x62\x98\x7b\x6e\x20\xa6\xd7\xef\xf7\x60\x24\xfb\x94\xbe\x0b\x96\x7d\x87\x7a\x2b\x38\x8e\x6e\x1e\x3a\xed\x4f\xfa\x61\x06\x83\x64\x34\x3a\xae\xd0\xd7\x53\x72\x13\xf6\x2b\x80\x6f\x88\xf6\xf8\xd4\xad\x64\xea\x24\xda\xe7\xac\xaa\xe8\x86\x96\x12\xb4\x46\xa3\x5a\xcd\x43\x2f\x78\xec\x5e\x9a\x1a\x72\x79\x37\xb5\x8b\x7d\xb0\x1b\xad\xda\xb5\xcb\x72\x43\xae\x83\xc0\x88\x39\x84\xae\xa6\x46\x96\x3b\x1c\x16\x03\x15\x1e\xb2\x6b\x28\x2a\xf8\xc9\xeb\xa3\x78\x91\x97\x89\x13\xd7\x78\xbd\x84\xd5\x71\x75\xe9\x06\x97\xc0\xb3\x89\x52\x70\xb8\x3f\x3c\xf8\xf4\xf5\x54\x36\xad\xe6\xf0\x42\xca\x07\xf8\x7b\x83\xc7\x05\x36\x76\xa0\xe1\x0b\xc7\x23\xcb\x6d\x74\xe5\x91\xd7\xbb\x32\x7c\x8f\x93\xde\x1b\x3c\x5f\x7a\xc9\xb8\x43\x93\x25\x55\x36\x13\xf7\xd5\x58\x3e\xb4\x4f\xe2\xdf\xf8\x69\xe8\xfb\x37\xd7\x2f\xe9\x2b\x2e\x9d\x56\x45\xdd\x54\x48\x15\xad\xef\x02\xfb\x12\x1b\x94\x3f\x73\xd1\xea\x62\xdd\x5f\xa5\x50\x28\xfb\x14\x43\x57\x0c\x06\xfc\x87\x62\x7f\x32\x26\x58\x63\x99\x6d\x0d\xf0\x50\x6a\x29\xc5\x31\x30\x2d\xe7\x68\x4c\xb8\x93\xea\x89\x91\x5a\x25\xe3\xe8\xb2\x88\x43\xf2\x97\xae\xe1\xbf\x53\x58\x73\x04\xa9\xca\x01\xf9\x19\xb8\xb9\xf3\x05\x61\xf9\xb5\x5d\x8c\x5d\xbf\xe8\x21\xae\x5f\x1c\xb9\xb8\xeb\x7d\x83\x2e\x23\xd8\x04\x66\xbe\x08\x65\xda\x0b\x70\x46\x7d\xc3\x76\x95\x62\x45\x2f\xa1\x09\x2f\x46\x04\x97\x74\xc1\xcf\x24\xdd\x14\xa4\xeb\x3c\x61\xaf\xfc\x9e\xf6\x48\x9e\xc3\x5b\x76\xf7\x23\xb2\x02\xb5\xe9\x51\xdd\x57\xeb\xce\x43\x9b\x30\x5c\x20\xaf\x98\xa6\x8b\x13\xa5\x47\xd2\x18\x75\x37\x1c\xc5\x16\x8b\x19\x24\x90\xf3\x85\xfb\x86\x95\xf9\xc7\x10\x21\xef\x9c\x61\xc9\x80\xc1\x0c\x93\xf6\x66\xd2\x3b\xb9\x37\xd5\xed\x0e\x98\x4c\x2c\x79\x7f\x00\xc5\x2e\xec\xda\x76\x92\x2e\xfb\x98\xf1\xf0\x85\x42\xe3\x4a\x22\x57\x35\xfa\xe6\x8e\x25\x9a\x61\xb6\xce\x92\x30\x73\xe7\x4a\xe0\xaa\xad\x0a\xb7\xe8\x16\xa9\xad\xe3\x9b\xee\xb0\xd6\x2b\x37\x47\xad\xbd\x0a\xce\xea\x2e\xd8\xcc\x9d\xb0\x7c\x03\xee\x03\x13\x6a\x9d\xcd\x29\x32\xc3\xa6\x64\xa6\x8f\xd1\xe7\x69\xff\x84\x99\x83\x5b\xc4\x49\x4f\x62\xca\x3d\x33\x7b\x06\xf1\x12\x61\x30\x78\x39\xeb\x2f\x83\xaf\xcd\x6b\x65\x7f\xf0\x69\xc9\x35\x0d\x22\x31\xba\x30\x89\x15\xba\x16\x19\x9e\x5d\x3e\x4b\xfd\xe4\x93\x51\x0f\x94\xaa\x3c\xee\x0c\x86\x86\x59\xc0\x6a\xe5\x43\xc3\xbf\x8f\x08\x1d\xb7\xf7\x92\x8e\x39\x4a\x8b\x5f\xf1\x51\xfc\xfe\x6f\x8a\x5f\x0a\xf6\xe5\x1c\x53\x94\x8e\xe7\x0f\x4a\xdf\x8a\xa2\x40\xf9\x18\x92\x7f\x9a\x22\xd9\x21\x7d\x39\xc3\x0e\xa2\xa3\x77\xa5\x64\x59\x09\x6e\x1f\xc3\xee\xaf\x53\xec\x22\xd0\x97\x93\x8b\x08\x3d\x37\x97\xe4\x5d\x7e\x7a\x18\x3d\x06\xcf\x3e\x7f\x9e\x24\xd7\x23\x4d\xf2\xa3\xb9\xb4\xe5\x46\x04\x7b\xe6\x6e\xc2\x77\x2b\x78\x76\x79\x09\xdf\x7c\xe3\x73\xd0\xdf\xe1\xdb\xcb\xcb\x8e\x2c\x75\x87\xa8\x1f\x45\xf6\xdb\x69\xb2\x09\xd2\x6f\x23\xfb\xed\x80\xec\x9f\x1d\xd9\xfd\x1e\x2c\xd6\x0d\x7d\xbf\x81\x0b\x9f\x04\xdd\x35\xd3\x05\x64\x70\x98\x1c\xa6\x98\x3e\x3d\x9a\xb6\x00\xa7\x67\xc5\xdb\xed\xd3\x33\xe8\x28\xdd\x03\x3c\x25\x4f\x67\xe1\x3f\x70\x58\xfc\x6c\x4f\xac\x52\x0d\x25\xfa\x74\x1d\xca\x02\x0e\x87\xd9\x7f\x07\x00\xeb\x08\x5e\x84\xfc\x28\x00\x00")
 
 func templatesClientFacadeGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -151,12 +288,92 @@ func templatesClientFacadeGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/client/facade.gotmpl", size: 3940, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	info := bindataFileInfo{name: "templates/client/facade.gotmpl", size: 10492, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesClientFilesGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x57\x51\x8f\xdb\x36\x0c\x7e\xf7\xaf\x60\x0f\x58\x61\x77\x3e\x5f\x0b\x14\x7d\xc8\x90\x3d\xb4\x68\x81\xbd\x74\xc3\xda\xa1\x0f\xc3\x30\xe8\x6c\x3a\x11\xce\x96\x0c\x49\x39\x37\x0d\xf2\xdf\x07\x52\xb2\x2d\x5d\xe2\x76\xf7\x70\x09\x14\x8a\xfc\xf8\x91\xfa\x28\x9d\x4e\xd0\x60\x2b\x15\xc2\x4d\xdd\x49\x54\xae\x95\x1d\xda\x1b\x38\x9f\xb3\xbb\x3b\xf8\xc3\xe8\x9d\x41\x6b\x3f\x1c\x54\x0d\xd2\x42\x2d\xba\x0e\x1b\x10\x16\x04\x90\x21\xad\x39\x23\x94\x6d\xd1\x18\x6c\x4a\x18\xa5\xdb\x83\xdb\x23\xa8\x43\x7f\x8f\x06\x74\x0b\xf7\x47\x87\x89\x15\x58\x0d\xad\x30\x25\x05\x10\xaa\x61\x73\xa7\x9d\xe8\xc0\xca\x6f\x48\x5b\x68\x85\xdc\x97\xa0\x0d\xdc\xbe\x82\x71\x8f\x0a\xa4\xa3\x68\x07\xf5\xa0\xf4\xa8\x32\x77\x1c\x30\x85\xd7\x1e\x54\x9d\x27\x60\xbc\x53\xa9\xdc\x9b\xd7\x45\x46\xd1\xfe\x1a\x3a\x2d\x9a\x0f\x01\x78\x48\xe1\xc0\x8b\xd8\xc0\xfd\x11\x84\x02\x3d\xa0\x11\x4e\x6a\x15\x92\x91\xce\x42\x8f\x4e\x34\xc2\x09\x86\x2b\x60\x08\x61\x99\x8e\x7b\x51\x3f\x54\xd9\xdd\x1d\xf9\xff\x8d\x21\xee\xe4\x23\x2a\x70\x7a\x4e\x03\x06\x61\x44\x6f\xa7\xcc\xe6\x08\xb6\x84\x71\x2f\xeb\x3d\x88\xba\xc6\xc1\x81\x50\x47\x30\x07\xe5\x64\x8f\xd5\x47\xd1\x63\xf3\x27\x8a\xe6\x5d\xa7\x2d\x2e\x64\x51\x11\x68\x85\xe1\x92\x37\xce\x78\xd0\xc6\x81\x56\x35\x4e\x2c\x85\x9c\x2a\xcf\x53\x94\xb7\x75\xe6\x50\x3b\x38\x65\x00\xb5\x56\x0e\x95\x03\xfa\x93\xba\xa2\x60\x68\x32\x00\x25\x7a\x84\xf0\x67\x9d\x91\x6a\xb7\x18\x7f\x26\x77\xf3\x22\xd7\x2b\x58\x32\xcd\x19\x2c\xe4\x00\x24\xf5\xc9\x20\xe9\x01\x6f\x7e\xce\xb2\x47\x61\xe0\xdf\xb5\xb4\x61\x0b\x0a\xc7\x7c\xc1\xef\xeb\xf8\x11\xc7\x28\xa5\xda\xa0\x70\x38\xd7\xd3\xe9\x90\x7e\x54\x40\x4e\x89\xe9\x73\x76\x4a\x25\xa3\x86\x49\x5d\xe5\x6c\xe7\xd3\x2b\x27\xbb\x85\x9b\x02\x5e\x44\x61\x89\x42\x83\xee\x60\x14\x3c\x5f\x96\x4f\x61\xd7\x66\xda\x5e\x32\x9f\x1b\xfe\x5f\x72\x87\x6f\xe0\xf6\xd5\x39\x3b\x73\x26\xbf\x0f\xa8\x22\x9f\x7a\x40\x35\x27\x12\x1d\x04\xb0\x47\xeb\xb0\xbf\x9a\x1b\xb9\x2c\x9f\x26\x07\x5c\xf7\xdd\x01\x2d\xb5\x4a\x6b\x74\xcf\xbf\xe2\x57\x87\xca\x4a\xad\x7c\xf2\x69\xf4\x7c\x10\x6e\x1f\x8a\x5b\x40\x1e\xe5\x5a\x02\x1a\xa3\x4d\xc1\x6d\xd3\x4e\x0b\xb0\xd9\x82\xb6\x15\x39\xe1\xad\x45\x06\x20\x5b\x32\x85\x67\x5b\x50\xb2\x63\xf3\x99\x24\x25\x3b\xde\x96\x01\x9c\xc9\x52\xb5\x7a\x76\x43\x3e\xab\x4f\x4e\xb8\x7c\xcd\x09\x5b\x70\x53\xe4\xc5\x77\xbc\x86\xd5\xb4\xaa\xb4\x97\x00\x56\x6f\x85\x45\x0f\xb5\x64\x5a\x8b\xea\x8b\x74\xfb\x4f\xf2\x1b\xe6\x52\xb5\xba\xe2\x6f\x85\x5f\x7d\xb7\x34\x7c\xde\xd3\x89\xa4\x6f\x6f\x8f\xef\x27\x06\x17\xaf\xef\xbf\x3a\xef\xb4\x28\x4a\x4a\x3b\x94\xf6\x89\x13\xb0\xe8\x2c\x17\x34\x29\x51\x54\x64\x5f\x93\xbc\x8d\xbb\xac\x80\xa7\x58\x2e\x0f\xe2\x65\x5b\xb6\x55\x6c\xb5\x9d\x9a\x82\x32\x58\x38\x6a\x23\x9c\x94\xf8\x02\xf0\x52\x86\xbd\xa4\x09\x1b\x69\x75\xd0\xb7\x0b\x2d\x5c\xcf\x82\x82\xe4\xec\x9b\x0f\xff\x35\xd8\xfc\xeb\x96\x4f\xc9\x75\xa0\x93\xa2\x78\xb0\x62\x8e\x5a\x46\xa3\x69\x82\x1d\x0b\xe1\x3a\xaa\xc9\x63\x3e\x67\x32\xad\xd0\xd0\xbb\x06\x72\x36\xdc\xce\x62\x77\x09\x96\xf4\x1b\x76\x13\xa3\x74\xf8\x53\x46\x2d\x75\x40\x04\x76\xb2\xa0\x53\x3a\x08\xe3\x66\x6b\x6d\xfa\xeb\xe0\x29\x42\x5e\x84\x1e\x88\xd5\xa8\xad\xc8\x59\xc0\x11\x77\xe0\x0c\x67\xad\x03\x4b\x3a\xbf\x3c\x5f\xa7\x89\xf6\x79\x8f\xd0\x1f\x3a\x27\x19\xd3\x68\xa4\xf3\x43\x9d\x76\xec\xf4\x2d\xe9\x95\x18\xe4\x24\xdf\x60\x51\x35\x16\x44\xd7\x71\x18\xda\x63\xf9\xb2\x30\x0c\x9d\xac\x79\xe6\xdd\xe9\xda\xa1\xbb\xb5\xce\xa0\xe8\x37\x14\xe2\x02\x90\xb4\xd0\x6a\x93\x0e\x37\x1b\x26\x25\x23\x88\x9c\xbb\x3d\xf6\x16\xbb\x47\xb4\xd5\x75\x96\xe2\xa3\x73\x9d\xac\x10\x9b\x0f\x87\xe7\x8c\x1a\x15\x76\xeb\xa7\x61\xf5\x52\x72\x15\x01\x79\xcb\x0b\xdf\xf3\x69\x64\xf2\x1c\xca\x44\x03\x06\x0c\x8a\x26\x2d\x50\x12\x96\x24\xde\xa0\x67\x23\x39\x7a\xc1\xca\xf7\xfa\x75\x1a\xc8\x7f\x3e\xc0\xdf\xff\xd0\x6d\xac\x80\x5c\x2a\x97\x88\xba\x5a\xa4\x78\x22\x84\x2f\x04\xf9\x10\x04\x59\xc1\xaf\xf0\x72\xd2\xe2\x2a\x9e\xe4\x3f\x6f\x7d\x6e\xb9\x22\x53\x36\x8e\xce\x48\x22\xe2\xf1\xe9\xc9\x13\x2f\x65\xa0\xc3\xbb\x38\xa7\x6a\xee\xc1\x05\xa6\x3e\x21\x3e\x40\xaf\x1f\xd1\x82\x54\x6b\x64\xc5\xc5\x11\x60\x11\x1f\xd0\x94\x80\xd5\xae\xa2\x11\x6a\x70\x94\xaa\x79\x62\x1c\x5f\xfd\x68\x9b\x41\x67\xe4\x9a\x70\x10\x88\x5c\xb7\xad\x45\xe7\x93\x2f\x39\x22\xdd\xbf\x94\xf3\xf4\xbe\x79\x9d\x10\x3c\x61\xd0\x0f\x29\xc9\xb9\xd4\x15\x79\x43\x13\x88\x7e\xa6\x1f\x02\x5b\x21\xfb\x97\x25\xb4\xbd\xab\xde\xd3\x04\x6e\xf3\x1b\x4e\xef\x27\xba\x8a\x2b\xa5\x1d\xdc\x23\x18\x1c\xf5\x41\x35\x37\x65\x38\xf9\x45\x60\x6f\xd0\x56\x52\x36\x73\x69\x3d\x86\x2a\x02\x3f\xc1\x0e\xb1\xc9\x6e\x9b\x4c\xdd\xa4\xd2\xdb\xd9\x65\x5a\x9f\x24\x50\x28\x13\x8f\x6a\x7f\x55\xfd\x4e\x4b\x27\x65\x62\x63\x73\x9d\xf0\x30\xf9\x29\x13\x6d\x98\x1f\xd9\x7a\xef\x2b\x9c\xf2\x06\x53\xfc\x02\x4f\xe9\xf4\x9b\xa2\xab\x44\xdc\x68\xf3\xf0\xfe\x88\xe3\x34\x06\xbe\x90\xe4\x18\x18\x8d\x18\xa8\x99\x58\x81\x4c\xe9\xfb\x88\x0e\xe3\xc5\x59\x14\xd0\xe8\x51\x11\xf6\xa9\xe5\xf6\x18\xb6\x85\x41\xea\x74\xf2\xd2\xf0\xf7\x54\x41\xd2\xe3\x75\x91\x04\xdd\xa0\x1d\xb4\xb2\x5e\x6e\x22\x0f\x22\xd8\xd0\x83\x45\x37\x47\x6a\xd5\x5a\x0f\x12\x1b\x70\x3a\x56\xed\xf0\xf8\xf1\xe4\xe3\xd7\x01\x6b\x87\x4d\x22\x66\x0b\xca\x35\x41\x0b\x9a\x7a\x41\x46\x1e\xb0\x48\x5d\x7d\x99\xe9\x98\xdf\x5a\xe5\xc2\xc6\xb4\xcd\x8f\xd2\xd9\x3c\x96\xc1\xe7\x93\xb1\xf7\x74\xf2\xae\x37\x30\xc6\x8e\x37\xfe\x63\xf1\xbc\x99\xbf\xf1\x4d\x9a\xe7\xc6\x90\x16\x2c\x7a\xe9\x04\xb8\xd3\x4b\xc7\x1b\xd0\x83\x84\x9c\x3e\x79\xbf\xc4\x0d\xff\xc3\x37\xcd\x39\x0b\xed\x3a\xc2\x8b\x34\x7c\x01\xfc\xf9\xff\x34\x77\xac\x3c\xc2\x2a\xec\xb9\xa2\xb9\xe3\x8f\x34\x77\x5c\xd5\xdc\xe5\x97\x3c\xf1\x52\xc2\x58\x31\xad\xdf\x17\xdd\xd3\x09\x50\x35\x70\x3e\x67\xff\x0d\x00\x94\xb5\xb6\x10\x2c\x10\x00\x00")
+
+func templatesClientFilesGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesClientFilesGotmpl,
+		"templates/client/files.gotmpl",
+	)
+}
+
+func templatesClientFilesGotmpl() (*asset, error) {
+	bytes, err := templatesClientFilesGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/client/files.gotmpl", size: 4140, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesClientInterceptorsGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x5f\x73\xd3\x48\x12\x7f\xf7\xa7\x68\x52\x95\x94\x14\x84\x02\x5b\xc7\x3d\x98\xf5\x55\x2d\x04\x58\xae\x80\xe5\x08\x7b\x3c\x64\x53\x5b\x63\xab\x65\x0f\x91\x66\xb4\x33\xa3\x64\x7d\x5e\x7f\xf7\xab\x9e\x3f\xd2\x48\xb6\x13\xb8\xe2\x1e\x00\x6b\xa6\xff\xfd\xba\x7b\xba\x7b\x86\xcd\x06\x0a\x2c\xb9\x40\x38\x5a\x54\x1c\x85\xe1\xc2\xa0\x5a\x60\x63\xa4\xd2\x47\xb0\xdd\x4e\xce\xce\xe0\xa2\x9d\xd7\xdc\x18\x54\xa0\xed\x2f\x0d\x4c\x80\x6c\x50\x31\xc3\xa5\xc8\x00\xf3\x65\x0e\xb7\xdc\xac\xc0\xac\xd0\x53\x43\x8d\x66\x25\x0b\x90\x25\x30\x30\x8a\x09\xdd\x48\x65\x26\x66\xdd\x04\x0a\x92\x37\x83\xb2\x15\x8b\x44\x36\x70\xaa\x5a\x61\x78\x8d\xf9\x0b\x6b\xc6\x2f\x41\x7a\x0a\x89\x35\xa9\x64\x0b\xdc\x6c\x33\x40\xa5\xa4\x4a\x27\x64\xd6\x9b\xde\x54\xe0\x1a\x16\xac\xaa\xb0\x00\xa6\x64\x2b\x0a\x6b\x89\xb5\x56\x6b\x2e\x05\x99\x41\x2b\x9d\xd1\x3a\xac\x6c\x36\xb0\x6a\x6b\x26\xf8\x7f\x10\xf2\xf7\xac\x46\xd8\x6e\xc1\xb9\x22\x23\x25\x16\x9b\x91\xc0\x8a\x02\x56\xc8\x0a\x54\x3a\x03\x23\xa1\x92\x4b\x90\x8a\x7e\xd5\xc8\x74\xab\x70\x24\x3f\x9f\x9c\x9d\x11\xff\x4f\x02\x22\x97\x5a\x23\x35\x08\xfc\xd3\x10\xab\x35\xd0\x0c\x39\x33\x12\xab\xd0\xb4\x4a\x68\xeb\x54\xd9\x1a\x4f\x68\xb8\x58\x02\x37\xf9\x08\xbc\x86\x9a\xad\x61\x8e\xa0\xd1\x40\x29\x15\xb0\xaa\x1a\x59\x63\x05\xc1\xa7\x10\x86\x17\x52\x94\x7c\x99\x7f\xe6\x66\x15\xcb\xb1\x80\xa5\x72\x32\x40\x73\xb1\xac\xd0\x5a\xdc\x07\x77\xcc\x12\x85\x99\xb6\x1b\xa6\x58\xad\x73\x17\xe6\x88\xee\x2b\x02\x9d\x39\xaf\x74\xb9\x71\x47\xe0\x77\x8c\xb8\x55\xac\xd1\x71\x9e\x65\xa0\xe5\xd8\x05\x1a\x85\x71\x40\xb8\x81\x25\x6d\x2b\xd9\x2e\x57\x71\x78\x74\x06\x5c\x80\x54\x05\xaa\x7c\x42\x99\xb9\x83\x37\xe9\x54\xc0\x10\x47\xe7\xdb\x6c\x20\x10\xf2\x3c\x8f\xf8\xd3\x43\x5c\xb0\x99\x80\x8f\x3a\x9c\x74\x02\xb0\xe8\x08\x36\x23\x86\x29\x98\xfd\x1a\xa7\x83\xaf\xed\x64\x3b\x71\xd1\xd8\x27\x14\xb4\x51\xed\xc2\x2b\xdf\x6f\xd8\x04\x06\xf2\xe0\xf2\x2a\x82\x43\xc2\xa9\x3e\x5c\xf3\x46\xff\x9b\x55\xbc\xb0\x91\x04\x83\x55\xa5\x81\xbb\x03\x46\xb1\x69\xb0\xe8\xcd\x85\x82\x6b\x36\xaf\x50\xdb\xed\x9b\x9e\x4d\x96\x3e\x7f\x9c\xeb\x13\x03\xa7\xfb\x8c\x4e\xc7\xfa\x92\x14\xe6\x52\x56\x16\x85\xbe\xe6\x4d\x83\x2a\x03\x79\x0d\xd3\x19\x98\x31\x9c\x3c\x4a\xaa\x43\x72\xb6\x69\x1f\x0b\x79\x0d\x27\x27\x41\x6a\xbe\xc3\x10\x1c\x60\x93\x16\x34\x8a\x62\x58\x1b\xbb\x24\x23\xa8\xb1\x1f\xef\x85\x68\x25\x7e\x7b\x61\xb4\x4e\xb0\x07\x69\x2f\x7a\x77\xba\x26\x60\x8f\x38\x27\x0f\x55\x28\x12\x93\xc7\xa6\xa5\xf0\x08\x9e\x3c\x03\x0e\xff\x98\xc1\xe3\x67\xc0\x1f\x3d\xb2\x42\x07\x79\x90\x85\xd2\x65\xb5\xc4\xdc\x97\xfc\xca\x9d\x64\xcb\x42\x3f\xfe\xe7\x22\xef\xd5\x76\xb1\x88\xd4\x24\xb2\x09\x26\x50\xb0\x00\xb6\x13\xf7\xc7\x93\x92\xde\x44\x36\x21\x3e\xe7\x6d\xdd\x44\x69\x4b\xc5\x5b\x8f\xcb\x03\x17\x50\xe0\xbc\x5d\x42\x2d\x0b\xcc\xe0\x76\x85\xc2\x92\x9c\xbf\x7c\xfe\xeb\x6b\xaa\xc9\x17\x9f\x7f\x7a\xfd\xfa\xe5\xc7\xdf\xdd\x02\x8a\x1b\xae\xa4\xa8\xa9\xa8\xdc\x30\xc5\x29\xa3\xa9\x0b\x69\x34\x53\x2a\xa3\x7d\x35\x84\x5b\x45\x05\x4d\x50\xc1\xa7\x55\x85\x7f\xb4\xa8\x4d\x06\x2c\xb4\x29\xc3\x4c\xab\x61\x21\x0b\xec\xd6\x2a\x66\x50\x2c\xd6\xa1\xae\x2a\xd4\x8d\x14\x1a\x43\x57\xf9\xe4\xd8\x44\xc1\x54\x41\x68\x96\xa8\x3a\x52\x97\x2e\x64\x4b\xab\xb1\x70\x40\xa8\x5b\x71\x0d\x82\x57\xbe\xae\x8d\x3c\x92\x10\x81\x93\x93\xbf\xb5\xff\xa4\x83\xf2\x4d\x91\xe0\x25\x69\x82\xd9\x8c\xc4\xf8\xd8\xd8\x85\xc0\x78\xe1\x0d\x72\x02\x36\xa3\x90\x7c\x9f\xfa\xef\xf5\xf2\x12\x1e\x78\xad\xe7\x14\xb4\x97\x82\xfc\x5f\x24\x29\xfc\xf5\x17\xc8\x26\xff\x60\xeb\xc8\xd0\xd6\xdd\xe4\xa0\xb5\xed\xc4\x0a\x2c\xda\xba\xa1\xe3\x20\xf0\x36\x99\xaf\x0d\xea\xfc\x79\x5b\x96\xa8\x1c\x91\x0d\xcd\x74\x06\x8f\x3b\x5a\x2c\x88\xfa\x54\x36\xd1\x4a\xa7\x75\x54\x4b\x3f\xba\x80\x7f\x56\xdc\xa0\x7a\x45\x6e\xb0\xbe\x50\xfb\xc9\x32\x3a\x9b\x35\x33\x9a\x8a\x73\x59\x9b\xfc\x23\x2e\xb9\x36\x6a\x9d\xba\x63\x31\x46\xd3\xa1\xcd\xad\x82\x4f\xd2\xcb\x49\x4e\x08\x93\xff\xf0\xdd\xc3\x7f\x4d\x41\x65\xd6\xe6\xa9\xfd\x7b\xdb\xa9\xf4\x2e\x49\x83\x8f\x65\x93\x7f\xb4\x33\x0f\x3c\x18\x7a\xd2\x03\xf6\x9b\xb3\x1d\x24\x2e\x5b\xdd\x76\x84\xd8\x67\xf1\x01\xf2\x0c\x16\x52\xe8\xb6\xc6\xc8\x33\x7e\xe1\x9e\x02\xe1\x23\x34\xeb\xcf\xc9\x0b\x59\x60\x92\x76\xdb\xbd\xaf\x9c\x4d\x16\x56\xd0\x9b\xa8\x1d\x03\x02\xe7\x76\x90\x24\xda\x30\xe5\x4a\x1e\xd9\xf6\x5e\xde\x7a\x0d\x0a\x75\x5b\x19\x6b\x15\xed\x52\x1e\x3b\xef\x63\xe1\x08\xc2\x69\x0e\xac\x17\x5c\x2c\x30\xb1\xe2\x3a\x5f\xa3\xea\xbc\x7c\x72\xe2\xf1\xcc\xe0\x71\x87\xb1\x92\x4b\x97\xeb\x65\x72\x74\xac\xe1\x58\x43\x72\xac\xd3\xdf\xc4\xb1\x2e\x19\xb7\x83\x6f\x49\x13\xf5\xf1\xcd\x14\x8e\x6f\x7e\x13\x47\x19\xa1\x7d\x67\xa7\x33\xfb\xf3\x03\x33\xab\x0f\x8c\x4e\x96\xb0\xdf\x6f\xce\x5d\x0e\x64\xc1\x3a\x6b\xbf\xc7\x0b\x58\x69\xfc\x0a\xd5\xbe\x74\x1d\xf7\xda\xbf\x49\x33\xa1\xec\xf4\x07\x57\x47\xf1\x8a\x1c\x6b\x8b\x89\xab\xe6\x51\x5e\xdb\xda\x8a\x3a\xae\xb6\xf6\xc2\xe1\x6b\x2c\x95\x5c\x66\x75\xb9\x19\x28\xe6\x3c\x38\xfa\x78\x82\x89\x2f\x0a\xa7\x71\x39\xa0\x7e\x42\x87\x17\x12\x05\xa7\x91\xb4\x14\x2e\xd0\xfc\x6c\x53\xcb\x16\x81\x44\xd0\x4d\x42\x1b\xc5\xc5\x32\x83\x1b\x56\xb5\x68\x47\x41\xb7\x12\x9f\x64\x3a\xe1\xaf\x1a\xc5\x85\x29\x13\x95\x93\xc8\x0c\x8e\xc0\x5f\x35\xe0\x58\x4f\xe1\x58\x5b\x9f\x92\xc4\xcc\x8b\xd4\xf9\x3f\x25\x17\x89\x93\x9b\xc1\x51\x06\x47\x69\x34\xb4\xa8\x21\x92\x7c\x8f\x6d\xc1\xa8\x3c\xcf\xd3\x3b\x41\xfd\xab\x45\xb5\xfe\x3e\x98\xfe\x20\x51\xdf\x0f\xd2\xc8\xb2\xaf\x46\xf4\x4a\xaa\xfa\xfb\x00\xa2\xaa\xf9\xfd\xf0\x0c\xed\xfa\x6a\x38\xee\x5c\xef\x87\x03\x5f\x0d\xa5\x61\x66\xb5\x0b\xc5\x9a\x70\xb7\xd5\x43\xf5\x1d\xcb\x9d\x01\xe0\x15\xee\xb1\xb8\xe4\x74\x27\xc8\xf3\x3c\x1c\x47\xba\x8d\x17\x54\xa8\x5f\x54\x52\xa3\x1a\xa0\x90\x0a\x7e\x77\x2c\x54\x71\x15\x13\x4b\xb4\x5f\xda\x17\xad\x43\x11\x23\x86\x1d\x98\xc4\x68\xef\xfe\x49\x9a\x0e\x87\x96\x7d\x61\x1a\x58\xef\xcd\xbe\x37\x4a\xcf\x65\xe1\x73\xb5\x61\xeb\x4a\xb2\x02\xa2\x7e\x16\x23\xe3\x25\x01\xe3\xda\xb7\xd6\xe9\x0c\x3c\x43\x9e\x70\xe9\xbb\x57\xfa\xac\x27\xb8\x13\xee\x5c\x16\xeb\x29\x1c\x7f\xb2\x58\xbd\x1c\x8b\xd0\xd5\x77\x5e\x5a\x8a\xae\x71\x7d\xd1\x52\xe4\xef\x98\xd2\x2b\x56\x25\x81\xfc\x99\xdd\x1d\x4c\x52\x77\x6b\x73\x09\x44\x82\xef\x77\xe6\x8e\x5b\xc2\xc4\xfe\xd6\xf5\xa4\x78\x06\xf5\xef\x2c\x7a\xdf\x7c\xdc\xcf\xf1\x74\x19\x5e\x54\x6d\x41\x4f\x25\x66\x85\xdc\xbe\xa5\x28\x8e\xee\x71\x83\xc6\x6b\x85\x74\x15\xd2\xc0\x0d\xcc\xd7\xd1\x55\x8d\x1a\x62\x78\xed\xf1\xea\x7f\xe6\xda\xc8\x25\x8d\x92\xf9\x2f\x73\x8d\xea\x06\x5d\x56\xef\x5a\x97\x48\xb7\x1f\xae\x3a\x5e\xe8\x9b\xf3\x2e\xbd\x83\xc5\xb6\xd3\x9c\xb7\xfe\xe2\xb3\x3b\x66\xff\x3f\xc6\xe5\x43\x83\x4b\x81\x25\x2a\xa7\xaa\x1f\xa6\x3c\x92\xc4\x77\xea\x9d\x81\xc5\xf7\xe9\x6e\xf6\x19\xcf\xd4\xa1\x4d\x9f\x63\xc9\xda\xca\x78\x5f\x3d\x6f\x17\xd7\x68\x34\x30\xff\x52\xd6\xd2\x35\x1d\xe6\xf4\x56\xd7\xbd\xc5\xcd\x3d\x8d\x2c\x77\x03\xe0\x6f\x64\x42\x0a\xb4\x32\x96\xfc\x06\xc5\xe4\x86\xa9\x03\x7a\x66\x70\x79\x35\x70\x35\xc1\x7b\x0a\xa7\xce\x05\xef\x78\x55\x71\x8d\x0b\x29\x8a\x6c\x02\xf0\xe4\xf1\x81\x8d\x1f\x0e\x71\x3c\x3d\xc4\xf1\xe4\xf1\x61\x59\x87\x76\x9e\x1e\xe4\xb1\xd4\x17\xdd\xe7\x0f\x87\x29\x3b\x43\x2f\xf6\xa0\x0a\x6b\x83\xb3\xd5\xfb\x16\x14\x2e\xa4\x2a\xee\x39\x59\xf4\x34\xc6\x60\x15\xb8\x80\xe2\xd7\xed\xba\xf9\x6a\x57\x72\x34\x65\x85\xe8\x8e\xe2\x42\xa3\x75\xdd\x86\x41\x1d\xf4\x5a\x2c\xf2\x77\xad\xc1\x3f\x27\xd0\x2b\xa3\x27\xce\xe6\xd2\x1d\xa5\xab\xd3\xb1\x9a\x03\xb8\xe8\x0a\x4c\x88\x7a\x93\x65\x39\x86\xc8\xc4\x7d\x18\x62\x08\x67\x67\xf0\x0d\x79\x4c\xa5\x88\xaa\x91\x42\x46\x6f\xa9\xee\x5d\x71\x02\xf0\xfc\x80\x23\xac\xfc\x17\xb2\x15\x91\x78\xd1\xd6\x73\xf2\x73\xe9\x5f\x8e\xe7\x6b\x2f\x3d\xf3\x48\xb4\x81\x05\xb1\x10\x58\x7a\xd4\xa1\x55\x47\xaa\x2b\x79\x8b\xb4\xc0\x44\x4f\xeb\x98\x27\x10\xf4\x5c\x5e\xb5\x5c\x98\xbf\xff\x2d\x52\x1e\xdc\x36\x52\x1d\x78\x20\x66\xb8\x68\xeb\x40\x6e\xa4\x61\xd5\x38\x7b\x02\x27\xd1\x0d\xc1\xba\x90\xbd\xc7\xdb\xb1\xbf\x35\x2c\x14\x32\x83\x3a\x8e\x7e\xf7\xfe\xbc\xc7\xdd\x5c\x75\x0e\xbf\xbb\xec\xf4\xcf\x21\xa3\x2a\x42\x05\x70\xaf\x29\x89\x17\x4c\xa3\xfb\xc0\xfc\x14\x76\x92\x50\x87\xf6\x4d\x2f\x6a\x9e\x2f\x8d\xaf\x70\x41\xd6\x6c\xbf\x89\xbe\x57\x6a\xa9\x8c\x7b\x5c\xa0\x67\x53\x51\x24\xa3\x2c\x49\x04\xaf\xd2\x2c\x08\xb3\x73\x87\x63\xca\x2f\x2a\x4e\x35\xda\xf2\x67\xae\xa8\xf3\x0c\xbe\xd0\xa0\x11\x1e\x48\x43\xb5\x26\x7a\x2c\x2e\xf9\x15\xfc\x18\x7e\x7f\xb9\x1a\xbc\x7b\x9e\xec\xc0\xdb\x78\x95\x53\xcf\x91\x45\xf1\x99\x42\xcd\xae\x31\xb9\xeb\x8c\xa6\xa1\x2d\xf8\x46\x7a\xa8\xe6\x30\x9b\xe8\xd4\x83\x07\x47\x93\xe0\x40\xb2\xda\xe3\xf6\x34\x48\xfc\x86\xae\x6b\x43\xb5\xca\xeb\x36\x7f\x2b\x17\xd7\xb6\x93\xb9\x56\x68\xd7\x7e\x15\x55\x58\xed\x20\x86\x07\xe5\x55\xde\xa3\xbe\x8c\x14\x5e\xb9\x07\xb2\x07\xf2\xda\x47\xbb\x23\x83\xd9\xae\x33\x37\x3e\xe6\x53\x58\xe5\xde\xad\x99\x3f\x91\xde\x95\xe1\x5c\x66\x36\x9d\x3a\xaa\xf4\xe1\x93\x94\x26\x2a\x38\x6c\x08\xcc\x7a\xab\x7d\x4e\x39\x66\x32\x9f\x42\x97\x5f\x20\x53\x8b\x55\x32\x14\x1c\x32\x66\x5f\xba\x04\x27\xfe\x38\xeb\xed\xa5\xe4\xd9\x0e\x5c\x94\x3b\x00\x97\x8e\xe0\xea\xe1\xc3\xdd\xcd\xd1\x1a\x55\x85\x87\xb3\x20\xde\xe7\x47\x1f\x59\xaf\x9e\xfe\x63\x67\x21\x9b\xae\xa6\x74\xfc\x3a\x1b\x0f\x70\x77\xa6\x49\xff\x3b\x49\xe3\x76\x32\x26\xfd\xf6\xe4\xd0\xe4\xda\xf1\x09\x18\x8b\x0d\x91\xec\xb9\xec\x65\x83\x6a\x76\x14\xbe\xe8\x54\xf5\x17\x9b\x98\xc9\xa7\xd7\x42\x36\xdc\xbf\x41\x76\x7b\xd1\x86\x8f\x05\x44\x55\xc4\xe5\x93\x2f\x1f\xe3\xa0\xf9\x3a\x02\x70\x38\xa9\x9c\x46\x9f\x51\x3e\x31\x7a\xea\xc9\x76\xb2\xd9\x00\x8a\x02\xb6\xdb\xc9\x7f\x07\x00\xd1\x80\x96\x0c\x55\x1e\x00\x00")
+
+func templatesClientInterceptorsGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesClientInterceptorsGotmpl,
+		"templates/client/interceptors.gotmpl",
+	)
+}
+
+func templatesClientInterceptorsGotmpl() (*asset, error) {
+	bytes, err := templatesClientInterceptorsGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/client/interceptors.gotmpl", size: 7765, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesClientParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5f\x73\xdb\xb8\x11\x7f\xe7\xa7\xd8\xaa\xee\x55\xf4\x38\xd4\x3d\xfb\x46\x9d\xb9\xb3\x7d\x8d\x3b\xd3\x9c\x9b\x78\xae\x0f\x99\x4c\x07\x26\x57\x12\x2e\x24\x40\x03\xa0\x64\x55\xc3\xef\xde\xc1\x1f\x92\x20\x45\x4a\x54\x12\xc7\x77\xd3\x3c\x49\x02\x81\xc5\xee\x6f\x7f\xfb\x07\xa0\x66\x33\xb8\xe2\x09\xc2\x12\x19\x0a\xa2\x30\x81\x87\x2d\x2c\xf9\x2b\xb9\x21\xcb\x25\x8a\x1f\xe0\xfa\x17\x78\xf3\xcb\x3d\xdc\x5c\xdf\xde\x47\x41\x10\xec\x76\x40\x17\x10\x5d\xf1\x7c\x2b\xe8\x72\xa5\xe0\x55\x59\xce\x66\xb0\xdb\x41\xcc\xb3\x0c\x99\xea\x3c\xdb\xed\x00\x59\x02\x65\x19\x04\x41\x4e\xe2\x8f\x64\x89\x7a\x72\x74\xe7\xbe\xeb\x07\xb3\x19\xdc\xaf\xa8\x84\x05\x4d\x11\x36\x44\xb6\x95\x51\x2b\x04\xa7\x0d\x28\xce\xd3\x48\xcf\xbf\x49\xa8\xa2\x6c\x09\xaa\x5e\x97\x99\x1d\x73\xc1\xd7\x08\x8b\x42\x19\x51\x2b\x64\xb0\xe5\x05\x08\x7c\x25\x0a\xd6\x92\x54\x6d\x61\xd4\x26\x2c\x09\x02\x9a\xe5\x5c\x28\x98\x06\x00\x13\x86\x6a\xb6\x52\x2a\x9f\x04\xfa\xd7\x92\xa7\x84\x2d\x23\x2e\x96\xb3\xa7\x99\x7e\x14\x73\xa6\xf0\x49\xb9\xa7\x54\xad\x8a\x87\x28\xe6\xd9\x6c\xc9\x5f\xf1\x1c\x19\xc9\xe9\x4c\x14\x4c\xd1\x0c\x27\xc3\x33\xb4\x26\x07\x1e\xa3\x10\x5c\xc8\x03\x13\xd6\x24\xa5\x09\x51\x66\x8b\x58\x1c\xd1\x63\x16\xa7\x14\x99\xd5\x58\x2a\xb1\xc8\xd4\xa0\x5a\xe6\xa9\x99\xb8\xdb\x81\x20\x6c\x89\x10\x5d\xe3\x82\x14\xa9\xba\x35\x10\x49\x30\x6e\xcd\x05\x65\x6a\x01\x93\xbf\x3c\x4e\x20\x2a\x4b\x3b\xdf\xf9\xda\x5b\x7b\xf6\x11\xb7\x17\x70\xb6\x26\x69\x81\x70\x39\x87\xa8\x25\x44\x3f\x85\xb2\x84\x8e\x3c\x37\xbd\x23\x35\x34\x54\x79\x83\x1b\x3d\x9b\xc8\x98\xa4\xf4\xbf\x08\xd1\x1b\x92\xe9\xa9\x77\x44\x90\x4c\x42\x2c\x90\x28\x94\x40\x80\xe1\x06\x0e\xcd\xe4\x0f\xbf\x61\xac\xb4\xc8\x0d\x55\x2b\xc3\x8e\xc4\xda\x09\x66\x7b\x09\x94\x51\x45\xcd\xda\x24\x0a\x16\x05\x8b\x8f\x6c\x3e\x0d\xe1\xfc\xd0\x8e\x3b\x6b\x8e\x0e\x20\x37\x52\x96\x6b\x22\x0c\xe7\x1a\xb0\xeb\x47\x6e\xea\x6b\x22\x1d\xfe\xf5\x18\xe3\x0a\xa2\x5b\xf9\x33\x4d\xd1\xcc\xb6\x0f\xd6\x44\x30\xbd\x5f\x74\x7b\x5d\x96\xd5\x92\x79\xb5\xe3\xad\xbc\x13\x34\xa3\x8a\xae\x51\xcf\x8e\xfe\xce\xef\xb7\x39\x96\xe5\xd4\x02\xdc\xf6\xe9\x9f\xd7\x93\xda\xeb\x8d\x26\x9e\x08\x28\xcb\xb0\xe3\x6f\xfb\xdd\xfb\x62\xa4\x06\x00\xad\x89\x02\x55\x21\x18\x7c\xb7\x8f\x53\x05\xd3\xee\x24\x34\xf6\x84\x5c\x3a\x83\x09\x4b\x60\xea\x80\xfa\x51\x08\xb2\x0d\xeb\x9f\xff\x24\x79\xf5\x43\x8b\xa3\x32\xd6\x66\x31\xa2\xb8\x08\x61\xca\x85\x9e\xf3\xa6\x48\x53\xf2\x90\x22\x40\x08\x65\xf9\x9d\x6f\x9f\x87\x33\xd4\x40\x5f\xf4\x82\x10\x00\x98\xe1\x98\x64\x68\x95\xbc\xa7\x19\xf2\x42\x39\x62\x5c\x42\x2c\x2a\x9c\xdd\x13\x2d\xa8\x0c\xca\x11\x5c\xff\x37\x55\x2b\xb7\xe8\xb9\x68\x7f\x61\x60\xd4\x73\xc8\x03\x4d\xa9\xda\x82\xe2\x20\x51\x01\x01\xe5\x76\xe6\x0c\x08\x08\x7c\x2c\x50\xaa\x31\x41\xe2\x69\x3d\xad\x64\xe8\xcf\xe8\xba\x10\x44\x51\xce\xbe\x05\xd1\x4b\x06\x91\x36\xfb\x0f\x16\x42\xea\x53\x02\xe7\xca\xd6\xf0\x17\x08\x1c\xd7\x3d\xc0\x82\x8b\xd3\x23\xc7\xa9\x3d\x8d\xd5\x53\x25\x28\x72\x63\x2f\x1b\x37\x8d\x7b\x2c\x0d\xff\x0f\x43\xe7\x6b\xd5\x9f\x36\xd4\xa3\xe2\xc7\x51\xe4\x12\x62\xf5\x74\x5a\x9c\xbc\xbe\xbf\xbf\xbb\x32\xcd\xe3\x4b\x84\x4a\x21\x15\xcf\xc0\xd3\xe1\x93\x82\xa6\x59\x3f\xb5\x7d\x30\x9c\xeb\xee\x3e\xb2\x63\xdf\xe2\xe6\x5b\xdc\xf4\xe0\xd0\x90\xe6\x12\x2c\x6b\x9a\xc0\x39\x48\x18\x9d\x96\x09\x65\x12\x48\x9a\x1a\x5a\xe7\x7a\x1c\x15\x0a\x69\x99\xad\xd9\xce\xcd\x93\x1f\xef\x6e\xf5\x6e\x39\xa7\x4c\x05\x9a\xda\x7a\x70\xb7\x83\x55\x91\x11\xe6\x8b\x06\x9e\xa3\xed\x8e\x40\x6d\x73\x1a\x93\x34\x35\x27\x63\x89\x40\x04\xc2\x46\x50\xa5\x90\x69\xb1\x04\x0c\xb5\xdf\xba\x08\x39\x9f\x05\x6a\x9b\xe3\xc1\x68\x95\x4a\x14\xb1\x82\x5d\xd0\xef\xc0\x01\x6b\x77\x3b\xed\xd6\x6b\xd4\x4e\xc8\x8d\x66\x15\xa1\x1e\x52\x1e\x7f\xac\xaf\x03\x3a\x33\x7c\xac\xcf\x67\xf6\x57\xab\xfd\x70\xc7\xc1\xcf\x61\x82\x9b\x74\xcb\x14\x8a\x05\x89\xb1\x19\x7a\xa7\x04\x92\x6c\x80\x2c\xe7\x3e\x09\x06\x03\xd6\x05\xa0\xa3\x4a\x2a\xf5\x37\x77\xca\x36\xd0\x24\x6f\x91\x24\x57\x29\x97\x28\x9a\x50\xf2\xae\x41\x0e\x36\x33\xed\x4e\x38\xa8\x13\x77\xb7\xd6\x07\xe0\x27\x45\x3f\x9b\xb9\xc4\xae\xd3\x5e\x1b\xd9\xce\x46\x24\x49\xa4\xa1\x5b\xdd\x83\xf3\x61\xf6\x19\x06\x4b\x9b\x6e\x75\xde\x89\xde\x62\x8c\x74\x8d\xa2\x9a\x70\x28\x20\xc2\xa3\xca\x7c\xce\x39\xa0\xab\x4a\xf4\x0e\xd5\x98\xbd\xc2\x26\xa7\xf5\x48\x71\x28\x1e\x91\xf5\x55\x41\x1c\x69\x57\x17\xc3\x21\x98\x0e\x91\x70\x5e\xd9\xe3\x91\xa9\x22\x62\x6d\x72\xd5\xc6\x3e\x33\x6f\x3e\xbb\xe1\xed\x23\x88\x27\x74\x2c\x0f\x5e\xc2\xfe\xb6\xa6\xfb\xe6\x0f\x59\x58\xe9\x3a\xd7\xed\x9e\xe7\x43\x2f\x65\xd4\x66\x78\x63\xcf\xec\xc9\x2f\xd1\x85\xf5\x39\x73\x4f\xee\x58\x97\xbe\x1c\x1c\x7d\x5a\x77\xd0\x18\x32\xd8\x53\x70\xee\xfa\x12\x6d\x51\x4f\xdd\xee\x2f\x03\xb6\xc0\xd6\xf6\xfa\x67\x71\xb3\x85\x29\x42\xfb\x96\x9f\x0d\x9a\x7e\x76\xc4\xf6\xb3\xe3\xd5\xc0\xe8\x34\xed\x55\xe5\xcb\x74\x02\x5f\xbb\xec\x3b\x79\x5d\x4e\x9f\xf5\x93\x7a\x0f\xc1\xfd\x1a\x36\x8c\xd0\xa7\xd5\xb1\x7d\x16\x74\x9a\xe3\xe7\xa7\xc1\x09\x36\xfe\xd1\x59\x30\xe8\xe7\x3e\xa7\xcc\x7b\x63\xd2\xc5\xb8\x6b\x22\x75\x64\x0b\xaa\xf0\x9e\xbb\x3e\xdf\x9c\x00\x50\xba\x23\x81\xf5\x8d\x3d\x0d\x54\x2f\xbc\x5a\x47\xe6\x4f\xc9\xe0\xad\xfd\xa6\x02\x2a\xb3\x6d\x32\x72\xe3\x17\x20\x70\xe9\xde\x30\x45\x6f\x71\x49\xa5\x12\xdb\x10\xcc\xcb\x2c\x7b\xc0\xa0\x0b\xfd\x0b\x2e\xe7\x20\x34\xcd\xab\x9b\xe0\x13\x5b\x94\xf0\x07\x23\xe5\x4f\x73\x60\x34\x35\xf8\xd6\x51\x80\x42\x98\x73\x1a\x68\x10\x41\xa0\x84\xf7\x1f\xcc\xfe\xc6\x09\xad\x24\x59\xb5\xe3\xce\xdd\x8e\x17\x86\x5a\x8e\x54\xfa\xe3\x27\x9e\x6c\xcd\xfc\xb0\x3e\xe1\x38\x32\xfa\x24\xb2\x24\xfb\x31\x4d\xf9\xe6\x26\xcb\xd5\xf6\x57\x92\x16\xa8\x57\xd0\x85\x09\x4c\xf3\xfb\xe6\x29\x17\x28\xa5\x3d\x0a\xd5\xda\x43\x75\x92\x6f\x6e\x1a\x6e\xe5\xbf\x0a\x14\xdb\x8a\x79\x01\xc0\x6c\x06\x8f\x7a\xc8\x3a\xd7\x88\xac\x62\xdc\x5b\x55\xab\x63\xef\x28\x1e\x45\xaf\x4f\xa1\xc5\x64\xeb\x94\x23\x3a\x1a\x84\x87\xc4\xcd\x0d\x77\x7a\x96\x6b\x47\x34\x81\x32\xb4\xfc\x72\x3e\xb0\xbb\x87\xcb\x63\xdf\x95\x81\x5b\xa9\x4d\xff\x99\x8b\x8c\x28\x85\xc2\xc5\xa9\xff\x7b\x3a\xb0\x71\x78\x54\xb5\x1a\xd7\x2b\x73\x11\xe5\x0b\x8d\xde\x29\x41\xd9\x72\x1a\xba\x43\x5e\xfd\x51\x27\x8f\x0e\x17\x6a\xa4\x7b\x4c\x71\x48\x4f\x26\x35\x19\xea\xd9\x7e\xb0\x34\x9c\x98\x76\x5f\xc0\x3a\x29\x17\x03\xd2\x47\xc5\xcb\x41\xdd\xcb\xee\xad\x91\x06\xce\x5d\x2e\x11\xb5\x6a\x33\x35\x27\x6a\xd5\x4b\xd4\x8e\x41\xf5\xca\x61\x7b\xc6\xf8\xb7\x8f\xfe\xe7\x8d\x43\x7a\x98\xe5\xb9\x7e\xbf\xb6\x74\x9c\x1d\x9e\x24\xf9\x74\xca\x8c\xf5\x8d\x87\xf8\x6b\x24\x09\x8a\x36\xe6\x2b\x33\x36\x06\x75\x6f\xf5\x37\xdc\x4f\xc2\x5d\x4b\xf5\x50\xaf\xf7\xf4\xbb\x84\xbe\x74\x3c\x3e\xc9\x36\x57\x46\xc6\xa9\x0b\x2e\x32\xfb\xff\x96\x3e\xbf\xee\x79\xb6\xd6\xe3\xa0\x5f\xfb\xfc\xd2\x83\x45\x07\x0d\x3f\x47\x74\x4d\xeb\xf9\xf7\x87\xf3\x72\xd0\x98\x71\x4a\xe1\x5a\x7c\xd9\xc2\x35\x24\x6e\x64\xe1\x1a\x5a\x3e\xa6\x70\x2d\x3e\xa7\x70\x0d\x6c\x1c\x1e\x55\xed\x59\x0a\x57\x8f\x29\x23\x0b\x57\x1d\x37\xc3\xbc\xec\x17\xfe\x0c\x75\x6b\xe0\xfb\x29\x2d\x5d\xe9\x5f\xec\x7a\xe9\xc1\x76\x8e\x65\x47\x27\xaf\x83\x6c\x3c\x73\xb5\xa2\x69\x73\xd8\xd0\x8d\xa7\x19\xf1\xdc\xef\x06\xfa\x5c\xa8\x23\xc4\xbe\x47\xeb\xf7\xc8\xfb\x0f\xd2\xf8\x58\xd3\x8f\x0b\xf8\xcf\x05\xac\x8d\x2b\x4c\xef\x7b\xca\x59\xca\x3b\x33\x79\xc0\x84\x47\x93\xb1\xf3\xd4\x21\x1d\xe7\x40\xf2\x1c\x59\x32\x3d\x30\xc9\x66\xab\x2e\x30\x6d\x0c\xf7\x2a\x92\x75\xea\xba\x35\xe7\x48\x1c\xb4\x0e\x7e\x3d\x62\x9b\x29\x61\xa7\x2c\x68\x5f\x0c\xdb\x58\x47\xf9\x01\xb4\x6b\x80\x5b\xe8\x9f\x84\x76\x7f\xe6\xfd\x9d\x29\xf6\x1b\xa7\x0c\x93\xa1\x64\xa8\x4f\xa9\xd1\x3f\x38\x65\x3f\x6d\x2d\xf0\x87\x69\x31\xd9\xed\xa2\x2b\x9e\xa6\x18\x2b\xca\x99\x5d\x51\x96\x93\x70\xf0\x00\x55\x9f\x9e\x88\x09\xd1\x11\x4d\xd2\x98\x5e\x7b\xc8\x26\xcd\xae\x28\x3a\xb5\xbf\x70\xe9\xc7\xef\x31\xaa\xd2\x39\x5a\xeb\x11\x89\xf6\x59\x94\xf6\x8f\x00\x55\xff\x3f\xac\xb4\xbd\x91\x6a\xd6\x24\x1c\xa5\xc9\x95\xb2\xc8\xcd\x9f\x70\xd7\x44\x50\x92\x08\x1a\x03\x11\xcb\x22\x43\xa6\xe4\x05\x48\xca\x62\x84\x0d\x42\x21\x31\x01\x9f\x2c\x56\xe4\x06\x21\x26\xcc\xbd\x5f\x5d\x21\x2c\xa8\x90\x0a\xa8\xc2\x0c\xa8\xfd\xf3\xaf\xd5\x88\x48\xa0\xea\xaf\xcd\xeb\x59\x3d\x43\x02\x5f\xd8\x77\xb5\x02\xd7\x94\x17\xd2\x8a\xb4\x0b\x2c\x62\xa0\xf8\x12\xd5\x0a\x85\x45\x3d\x45\x36\x3d\x00\x65\x08\x7f\x83\xef\xab\x46\xea\xf4\x53\xcf\x01\xc9\xef\xbf\xff\x30\xba\x5b\xeb\x7f\xc3\x1f\xec\xbf\x90\xb4\x21\x53\xd7\x29\xaf\x84\xe9\xd2\xf4\x2e\x5e\x61\x46\xfc\x37\xaa\xde\x98\xcd\x13\x30\x35\x4c\xa8\x47\xdd\x25\x8a\xc9\xa5\x61\xf7\xa1\xb9\x58\xe9\x7f\xd4\x29\xbe\x7b\x97\x79\x3a\xe9\x8c\xe8\xfa\x5a\x7d\x74\x07\xfe\xda\xca\xe9\x17\xe9\x85\x7f\x8f\x00\x95\xd0\xea\x50\xcc\xb7\xd6\xe1\xc2\x11\x58\xa0\xf4\x89\xda\xd8\xc8\x85\x8c\xae\x78\x96\x73\x49\x15\xfe\x6a\xff\x69\x4e\x39\xbb\xd1\x4f\xf4\x2a\x9d\x29\x1c\xbf\xdc\x22\x46\xd3\xa0\x0c\xfe\x17\x00\x00\xff\xff\xc9\x09\xb6\xd2\x4f\x30\x00\x00")
+var _templatesClientMockGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\xcd\x6e\xdc\x38\x12\xbe\xf3\x29\x6a\x1b\x59\x40\x1d\xc8\xd4\x9c\xbd\xf0\x21\xeb\x64\xb0\x01\x76\x92\x60\xec\xc5\x1c\x16\x8b\x80\x91\x4a\x12\x61\x89\xd4\x90\x94\xdb\x1e\x41\xef\xbe\x28\xfe\xa8\x5b\xed\xee\x76\x66\xe6\x32\x17\xbb\x49\x16\x8b\x55\x5f\xfd\x7d\x2a\x0a\xb8\xd5\x15\x42\x83\x0a\x8d\x70\x58\xc1\xb7\x67\x68\xf4\x95\xdd\x89\xa6\x41\xf3\x0f\x78\xff\x19\x3e\x7d\xbe\x87\x0f\xef\x3f\xde\x73\xc6\xd8\x34\x81\xac\x81\xdf\xea\xe1\xd9\xc8\xa6\x75\x70\x35\xcf\x45\x01\xd3\x04\xa5\xee\x7b\x54\xee\xe8\x6c\x9a\x00\x55\x05\xf3\xcc\x18\x1b\x44\xf9\x20\x1a\x24\x61\xfe\x49\xf4\xe8\x77\x8b\x02\xee\x5b\x69\xa1\x96\x1d\xc2\x4e\xd8\xb5\x25\xae\x45\x88\xa6\x80\xd3\xba\xe3\xac\x28\xe0\x43\x25\x9d\x54\x0d\xb8\xe5\x5e\xef\x4d\x19\x8c\x7e\x44\xa8\x47\xe7\x55\xb5\xa8\xe0\x59\x8f\x60\xf0\xca\x8c\x6a\xa5\x29\x3d\xe1\x6d\x16\xaa\x62\x4c\xf6\x83\x36\x0e\x32\x06\xb0\xa9\x7b\xb7\xa1\xff\xf6\x59\x95\x1b\x46\xbf\x1a\xe9\xda\xf1\x1b\x2f\x75\x5f\x34\xfa\x4a\x0f\xa8\xc4\x20\x0b\x33\x2a\x27\x7b\xf4\x22\xd3\x04\x46\xa8\x06\x81\xbf\xc7\x5a\x8c\x9d\xfb\xe8\x15\x5a\x98\xe7\x69\x82\xc1\x48\xe5\x6a\xd8\xfc\xfd\xd7\x0d\xf0\x79\x0e\xf2\x11\x96\x83\xbb\x6f\x1e\xf0\x39\x87\x37\x8f\xa2\x1b\x11\xae\x6f\x80\xaf\x94\xd0\x29\xcc\x33\x1c\xe9\x8b\xe2\x47\x5a\xb7\x8c\x3d\x0a\x03\x5f\xe1\xb6\x93\xa8\xdc\x1d\x9a\x47\x59\x22\xdc\x80\xc2\x5d\xb6\xda\xfb\x49\x97\x0f\x5b\xc6\x8a\xb7\xec\xc5\x36\x48\x0b\x02\x6a\xf1\xe0\x43\xd6\x8e\xbd\x50\xf2\x37\x5c\x62\x07\xef\xbe\x7c\x84\xd2\x5f\xca\xc1\x69\x18\x2d\x82\x0c\x40\x3b\xb4\xce\x82\xae\xfd\xa2\xa4\xfc\x2a\x45\xd7\x85\x98\x9d\x57\xc6\x19\xfb\x20\xca\x16\xf4\x40\x09\x20\xb5\xf2\xb7\xac\x57\x52\x8f\xaa\xf4\x5b\x16\x1d\xd4\xda\x80\x74\x39\x20\x6f\x38\x69\xdb\x49\xd7\x82\x54\x15\x3e\x01\xff\x9c\x2e\x5b\xf8\x21\xa2\x2f\x6c\x29\xba\xc3\xc7\x7e\x1c\x55\xe9\x95\x9c\x3c\x5d\x50\xcc\x41\xa8\x0a\x0c\x96\xda\x54\x16\xa4\xb3\xde\x1e\xce\xee\x5b\xdc\xdb\x68\xfd\xeb\x7a\x74\x7b\x13\x0d\xba\xd1\x28\x10\x0a\xd0\x18\x6d\x38\x7b\x5b\x30\xf7\x3c\xe0\x3a\x18\x04\x3c\x58\x67\xc6\xd2\xc1\xe4\x83\x77\x95\x72\xe8\xc0\x07\x1f\xd7\xa2\x38\x6d\xa9\xf7\x43\x06\xb3\x42\xbd\x9c\x14\x63\x70\x7a\xdf\x5f\x27\xab\xb3\x69\x02\x87\xfd\xd0\x09\x87\xb0\x09\x21\x5d\x6c\x78\x67\x1a\xbb\x01\x0e\xf3\xbc\x85\x4b\x72\x3f\xa3\x1d\x3b\x17\x45\xa3\x3f\x31\x1b\x19\x40\x3f\x02\x00\x50\x49\xf1\x9f\x46\x87\x4f\x0c\x62\x70\xff\xfb\xbf\x17\xa8\xdc\x8a\xae\x63\xa1\x35\x9c\x3c\x0b\x79\x49\xd7\x63\x70\x82\xef\x2f\x64\xcf\x80\x4e\xea\x0f\x81\x2f\x0a\x58\x9c\x20\xd5\x94\x6e\x8a\x10\x8a\xf9\xdb\xa3\x6b\x75\x05\xbb\x56\x96\xad\xef\x50\xf4\x32\x56\x0c\x0e\xae\x59\x67\xa4\x6a\x42\xa8\xbe\x08\x23\x7a\x0b\xc2\xa0\xbf\x3e\x84\x65\x2a\x06\xf2\x0d\x92\x8c\x54\x0e\x4d\x2d\x4a\x9c\x62\x98\xdf\x8d\xae\xfd\xa8\x6a\x9d\xec\x10\xa3\x6b\x51\x39\x59\x06\xeb\x0e\x94\xe4\xa0\x64\xe7\x93\xd8\x9d\x4e\xc7\xf5\x55\x06\x7b\xdd\xb1\x71\xf1\x80\x4c\xda\xfe\xc5\x48\x87\x26\x06\x8e\x7a\xfc\x2f\xd2\xb5\xb7\x5a\x39\x7c\x72\x4b\x1a\xa6\x75\x34\xaf\x8c\xcb\xb5\x73\x49\x28\x9e\xf2\xb8\x5e\xe7\xc4\xcc\xd8\xbe\x69\x2e\x40\x52\xaf\x63\xe7\xd2\x7d\x29\xc5\xf4\x96\xaf\x4f\xfa\x61\xcf\x27\x38\xa3\x04\x87\xac\x87\xb7\x2f\x12\xe1\x72\x3e\xdf\xc9\x46\x09\x37\x1a\x0c\x19\xbd\xd4\xe8\x9b\x0e\x55\xe3\x5a\x6a\xd0\x1d\x2a\xe0\x77\x63\x59\xa2\xb5\x3f\xa3\x1d\xb4\xb2\x18\x4b\xf6\x2c\x88\x3d\x0f\x5e\x64\xa5\x7b\xca\x8f\x9b\x79\x76\xe4\xc3\xd6\x77\xa1\x90\x41\x39\xe8\xc1\xd9\x6d\xd4\x8d\x9d\xc5\x23\x85\xbf\x4b\x57\x9c\xe3\x14\x7c\x6d\xe4\x6f\x48\x75\x2a\x62\x26\x50\x03\x0c\xea\x95\xec\x96\x6e\xb8\xbc\x9c\xe6\x96\xac\xa1\xe7\x67\x71\x87\x9b\x1b\x9f\xa1\x04\x1b\xa4\x96\x18\x5f\x3d\x82\x2c\xb5\xe9\xea\x6e\x34\x46\x8f\xaa\x82\x8d\x92\xdd\x26\xfe\xfd\x61\x41\x9c\xb0\x58\xcc\xa9\x7b\xc7\x3f\x50\x7b\xad\xb3\xcd\x8b\xc8\x5e\x9f\xcf\x07\xaa\x2c\xa5\x1d\x58\x74\x1b\x72\x69\x66\x8b\x75\x17\xbc\xb9\xd8\x21\xa9\xa1\x1c\x74\x49\x36\x27\x96\xf4\x2f\x61\xef\x9c\x41\xd1\x4b\xd5\x1c\x38\x7b\x36\xc1\x83\x70\x6c\x8c\x27\x25\xd2\x48\x22\x7b\x2d\xec\x5a\xe1\x2e\x78\xba\x33\xda\x21\x4d\x66\x1a\x5d\xbb\x50\xde\x7f\xb8\x1a\xbc\x69\xa7\x6a\x22\x28\xa6\x72\x20\x6e\xf1\xed\xd9\xa1\xe5\xff\x1c\xeb\x1a\xcd\x76\x45\x8c\x4e\xd4\xc9\xd7\x83\x78\xa2\xf1\x3a\xce\xc4\xe0\x77\xe1\xef\x53\x93\xf4\xfd\xed\x44\x0a\x2a\xd9\xe5\x34\x97\xd7\xa1\x97\x9a\x58\x23\xff\xa4\x87\xdb\x4e\x5b\x34\x59\xf0\x6a\xeb\xbb\x6c\x08\x68\x98\x65\x31\xb2\x5f\x44\x23\x95\x7f\xfc\x52\x3c\xdf\x75\x1d\x90\x1a\xe1\xd0\x82\x7e\x44\x13\x07\x42\x83\x36\x3e\x7c\x61\x6a\xff\xa9\xe6\xf5\x45\x34\x68\x4e\x45\x2b\xa1\x80\xbb\x93\x8f\xfa\x7b\xa1\x35\xa5\x4e\x41\x19\x93\xc5\x21\xf6\xf6\xcc\x25\x3a\xdc\x42\x76\x74\x7c\x14\xf1\x24\xee\xe1\xd7\x66\xbb\x8e\xcb\x1f\x09\xbc\xb7\xf6\x47\x74\x65\xbb\x0e\x7f\xaa\xc1\x38\x6a\xf6\xbf\xa8\xf2\xee\xd0\xdd\x1b\xa1\x2c\x51\x6b\xa8\x34\xfa\x76\xd0\x4a\xd5\xe4\x3e\x3a\x3d\xd1\xb2\xb4\x0d\x96\x54\x18\xfc\x75\x24\x42\x7b\x31\x1a\x87\x6a\x33\xb7\x3c\xb0\x9e\xb5\x8b\x04\x39\x1f\x19\x8e\x2f\xf6\x54\xd1\x69\xb2\xd9\x15\xb3\x49\x76\xe5\xc4\xaf\x89\xef\x5c\xae\x63\xea\x47\x36\xdb\x9e\xa3\x56\x1e\xf7\x9e\xf7\x23\xff\xb7\x2e\x1f\x32\x02\xac\xc2\x1a\x4d\xd8\xfb\x8f\xea\xd2\x6e\x8c\x8c\x18\x06\x54\x55\x76\x46\x5b\xa6\x64\xb7\xcd\xa1\xe7\xde\x6c\xce\xf9\xf6\xd0\xb1\x7b\x7d\xc2\x35\x5d\x13\x37\x5e\x28\xcb\x2b\xae\x46\x9a\x1f\xd5\x65\xaf\xd3\xfd\x57\x66\xe0\x92\x0d\xdb\xd7\x41\xbc\xd7\xd9\xde\xcc\xc0\xf1\xfe\x3c\xac\xf4\x61\x76\x99\xfb\x82\xe7\x75\x5f\x73\x8f\x17\x75\xc5\xf0\x5d\x10\x31\x8e\x75\x23\x6b\x7f\xbc\x47\x80\x26\xee\xde\xda\x20\x94\x58\xf6\x4d\x8a\xa2\x5f\x06\xc5\x64\x4b\x68\x82\x07\x8d\x90\x0e\xec\x7e\x82\x1d\xd1\x97\x4b\x80\xed\x69\xcd\x31\xf1\xcb\x0f\xcc\x0a\x20\xa6\xee\x72\x48\x80\x49\xca\xed\x31\xf9\x3c\x90\x4f\xdb\xc4\xd0\x2f\x93\xe1\xc4\x47\xb5\x42\x1a\xec\x54\x33\x34\xf3\xb4\xd7\x61\x19\x80\x1e\xd2\x80\x5a\x17\xe4\x02\xde\x76\x0f\xba\x1e\xdc\x1e\x73\x6f\x13\xd9\x40\x3a\x5c\xa6\x87\xc4\x19\xbe\x27\xd2\x3d\x3f\x42\x3f\x6e\xe4\xa7\x3f\x4a\xa6\xc5\x9a\xeb\x3d\x62\x79\xfc\x56\xb8\x5e\x1a\x72\x22\xec\x24\xc4\xd3\x22\x4f\xac\xfb\x1a\x4a\xf7\xb4\x34\xc0\xc8\x12\xbf\x23\x6e\xdf\x17\xa2\x44\x11\x61\x0d\x63\xb2\x22\x7c\x43\x6c\x61\xfa\x8b\x00\x94\xcc\x8d\x78\x5c\x01\xaa\x0a\xe6\x99\xfd\x7f\x00\xdb\xaa\xe4\x79\xf6\x12\x00\x00")
+
+func templatesClientMockGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesClientMockGotmpl,
+		"templates/client/mock.gotmpl",
+	)
+}
+
+func templatesClientMockGotmpl() (*asset, error) {
+	bytes, err := templatesClientMockGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/client/mock.gotmpl", size: 4854, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesClientOptionsGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x55\x4d\x8f\xdb\x36\x10\xbd\xeb\x57\xbc\xe6\x50\x48\x81\xc1\xcd\xd9\x81\x0b\x14\xed\x21\xbd\xb4\x41\x6a\x20\x67\xae\x34\xb2\x88\xca\xa4\x3a\x1c\xc5\xdd\x0a\xfa\xef\x05\x3f\x24\x5b\xc6\xba\x39\x34\xe8\xc9\xd6\x70\x3e\xde\x7b\x33\x1c\x4e\x13\x1a\x6a\x8d\x25\xbc\xa9\x7b\x43\x56\xdc\x20\xc6\x59\xff\x06\xf3\x5c\x3c\x3d\xe1\xa7\x68\xfc\x2d\x1a\x51\x77\xda\x9e\xc8\x43\x5b\xb8\x81\x58\x47\xa3\x6b\x21\x1d\x61\x9a\xd0\x8d\x67\x6d\xcd\xdf\x04\xf5\xab\x3e\x13\xe6\x19\x29\x25\x9e\xa9\x75\x4c\x30\x02\xe3\xe1\xc7\xe7\xb3\x11\xa1\x66\x87\xd6\x31\x34\xbc\xb1\xa7\x9e\x50\xeb\xbe\xdf\x85\x92\xa4\x4e\x0a\x9f\x8d\x74\x3f\x8e\xd2\xfd\x62\x5b\xb7\x8b\x5f\x1f\x48\x37\xc4\xe9\xff\xd1\x9c\xc9\x8d\x02\xc7\xf1\xf3\xc3\xf1\xf8\x31\x01\x55\xc5\xd3\x53\xc8\x71\xec\x08\x99\x09\x34\x13\x4e\xe6\x0b\x59\x88\x8b\x50\x57\xec\x1e\xba\x15\xe2\x60\x34\x8c\x41\xb3\x3e\xfb\x1d\xb4\x6d\x62\x8c\x1e\x86\xde\x50\x03\x63\xe1\xb8\x21\x56\x85\xbc\x0c\xb4\x55\xe4\x80\x76\xb4\x75\xe9\x06\xbc\xe5\xd1\x8a\x39\x93\x5a\xce\x73\x8d\xaa\x08\x78\x6e\xe9\xc0\x93\xf8\x50\x13\x7a\x94\x8e\xac\x98\x7a\x55\x52\x27\x19\x60\xac\x17\xd2\xcd\x22\x6e\x43\xad\x1e\x7b\x41\xcd\xd4\x84\x00\xdd\xfb\xe5\x28\x49\x5c\x04\x18\x9b\x2a\xa5\x5e\xca\x6d\x81\x2d\xe7\x9f\xd9\x08\x71\xb5\xa5\x33\x15\x00\x93\x8c\x6c\xbf\xce\x2b\x3a\x03\x6e\x50\x4b\x4a\x1c\xb0\x14\x2d\x80\xb9\x98\x57\xea\xa9\x77\x89\xb8\x46\x97\xbe\x36\x74\xa1\x9b\xc6\x44\x15\xc4\xc1\x88\xcf\xdd\xb8\xf2\x4a\x29\x4a\x1b\x26\xcb\x0b\x1b\x7b\xda\xe1\x8b\xee\x47\xf2\x50\x4a\x25\xcb\x37\x61\xf3\x31\x16\xc6\x01\xdf\x33\xfd\x39\x92\x97\xa4\xd4\x94\x00\xed\xaf\x2e\x3b\x5c\xc2\xc9\x3e\x49\xc5\xd8\xa6\xfe\x94\x82\x2b\x10\xb3\xe3\x9c\x7e\xc5\xc3\xea\x77\x92\x44\x29\x26\x8b\xbc\x16\x42\x4a\xa9\x2a\xba\xcf\xf3\x9d\x8e\xcb\xdc\xaf\x13\x24\xcb\x45\x78\x38\x3a\x37\x1e\xaf\xc9\x9a\x33\x96\x8b\x5b\xf8\x55\x3f\x8f\x8b\x2a\xff\xaf\x9e\x19\xc4\x7e\xf9\xf3\x9f\x15\xbe\x63\xf7\x40\xd5\xeb\xfa\xb8\x0a\x1b\x6c\xcb\xfa\x7a\x7c\x2f\xaf\x0e\xe1\x4b\x58\x5b\x3f\x38\xbe\xb9\x8d\xd7\xd4\x65\xf6\x7d\xdb\x89\x0c\x99\xc1\x37\xd1\x37\x23\x3f\x64\x30\x37\xdc\x36\x7a\xaf\xcb\x3b\x20\xcd\x27\x01\xf8\x66\x97\xa7\x75\x78\x9d\x93\xb8\x07\x43\x0f\x84\x6c\xda\x7e\xdb\x9c\x5e\x78\xac\x25\x02\xcf\x01\xaf\xf7\x28\xb5\xbc\x40\xea\x27\x90\x1b\xfa\x2f\xed\x2c\x80\x57\x47\x32\x50\x0b\xc1\x28\x2f\x78\xbb\x01\x53\x21\x56\x39\xba\x9c\xe8\xd1\xbc\xc4\x67\xe7\xac\xc5\xc3\x0b\xb7\x67\x51\x9f\xe8\x64\xbc\xf0\xcb\xed\x20\x99\x16\x17\x95\x29\x7d\x77\x80\x35\x7d\x16\xdc\xb4\xc1\x0b\xfb\xc3\x7a\xae\xee\xcb\xae\x05\xaa\xf7\xd1\x77\x13\xbf\x76\x98\x98\xa3\x21\x5d\xf1\xd5\x7c\x51\x51\xa2\x92\xab\xdc\xc4\x9c\x36\x4f\x32\x4e\xf7\x37\xdf\x93\xe0\xf9\x25\xbf\x6b\x41\x21\xbf\x83\x09\x6d\x7d\xd9\xdf\x3f\x76\xc1\x35\x98\x1a\xd2\x4d\x1f\x9e\xfc\xb4\x22\x0c\xa3\x76\x56\xe8\x2f\xc1\xc5\x48\x07\x23\x0f\x15\xde\x82\x29\xab\x6d\x6f\xae\xca\x2d\xe0\x7e\xc0\xbb\xcc\x7b\xa5\x97\x8f\x32\x6b\xd3\xc6\x14\xcd\x0e\xee\x8f\x8d\xa8\xa5\xb1\x42\xdc\xea\x9a\xa6\xaf\x55\x9d\xab\xf7\x21\x7a\x53\x27\x78\x34\xea\x3e\x70\x2b\xf5\xbb\x62\x2e\xa6\x09\x64\x1b\xcc\x73\xf1\xcf\x00\x94\xa5\xab\xbb\x09\x09\x00\x00")
+
+func templatesClientOptionsGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesClientOptionsGotmpl,
+		"templates/client/options.gotmpl",
+	)
+}
+
+func templatesClientOptionsGotmpl() (*asset, error) {
+	bytes, err := templatesClientOptionsGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/client/options.gotmpl", size: 2313, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesClientParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x5b\x73\xe3\x36\xb2\x7e\xd7\xaf\xe8\xa3\x33\x27\x47\x72\x69\xa8\x3c\x9c\x3a\x0f\x4e\x79\xab\x12\x7b\xb2\xe3\xad\xcd\x64\x76\xec\xcd\x3e\x4c\x4d\x6d\xc1\x64\x4b\x42\x86\x22\x68\x00\xb4\xad\x55\xf1\xbf\x6f\x35\x08\x82\xe0\x55\x94\x2f\x99\xa4\x76\xfc\x62\x11\x97\x46\xf7\xd7\x17\x34\x9a\xe0\x7e\x0f\x11\xae\x78\x82\x30\x0d\x63\x8e\x89\xe6\x1a\xb7\xea\x8e\xc5\x3c\x62\x5a\xc8\x29\xe4\xf9\x04\x60\xbf\x7f\x0d\x7c\x05\xc1\xa5\xfa\x5e\x4a\xb6\x2b\x1a\xab\xe6\x9f\x78\x72\x49\xd3\xa8\x7d\xb9\x84\xfd\x1e\x02\xf3\x7c\x81\xa9\xde\x40\x9e\x6f\x6d\xff\xa9\xe9\xf2\x47\xf3\x15\xa0\x94\x70\x7a\x06\x76\x49\x74\xc4\x66\x34\xf6\x3d\x33\x04\x16\x34\x31\x95\x3c\xd1\x2b\x98\xfe\xcf\xed\x14\x82\xbf\x8a\x90\x69\x2e\x12\xd3\xc9\x13\xfd\xff\xff\x37\x8b\x31\x31\x73\x7e\x61\x71\x86\x6f\x1e\x52\x89\x4a\x15\x23\xe6\xf3\x45\x73\xe5\xf9\x77\x66\xe1\xff\x3a\x83\x84\xc7\xb0\x9f\x00\x48\xd4\x99\x4c\xa8\x75\x52\x49\x87\x49\xd4\x12\x96\x3d\x0c\x0b\xcb\x1e\x7c\x61\xd9\xc3\xa0\xb0\xec\xe1\xc5\x84\x65\x0f\x4f\x17\xf6\xef\x09\xbf\xcd\x70\x50\xde\xac\x1a\x72\x0a\x5a\x66\xd8\x25\xa7\x47\xe7\x08\x51\x7b\x04\x7c\xac\x34\x2c\x89\x20\x38\xdf\xf0\xb8\xfc\x17\xbc\x65\xea\x97\x82\x45\x2e\x12\x23\xe1\x4a\x48\x82\x3f\xb8\x4c\x22\x7c\xf8\x85\x49\xc7\x87\x99\xd7\xc1\x0d\xd9\xae\x64\xc9\x1a\x7b\xb8\x35\x0c\xee\xf7\xa0\x71\x9b\xc6\x4c\xf7\xfa\x99\x65\x2c\xcf\xbb\x44\x30\xf2\xc4\x0a\xad\x13\x9e\x8b\x6d\x1a\xe3\xc3\xcf\x37\xbf\x62\xa8\x9b\x2a\xbb\x54\xef\xb2\x38\x66\x37\x31\x5a\x9b\xeb\xe1\xeb\xac\xc2\x2f\x14\x89\xe6\x49\x86\xed\xa5\x2b\x55\x76\x53\x09\x2c\x7c\x38\x5b\x09\xb9\x65\x5a\xb5\x4d\x8d\xaf\xe0\x0e\x17\x20\x3e\x13\x15\x94\x32\x98\x9d\xa0\x94\x42\xaa\x72\x2e\x17\xc9\xfc\x3b\xea\xa7\xd1\x4e\x99\x77\xe8\x68\xbf\x63\x5b\xf4\xad\x66\x3e\x01\xc8\xdb\x6a\x77\x20\xe5\xf9\xa4\x06\x78\x2a\x45\x8a\x52\xef\x52\x26\xd9\xd6\x87\xdc\x03\xb7\x10\xd7\xfb\x39\x69\x45\xc6\xe6\x74\x37\xf9\x55\x2a\x78\xa2\xd1\x58\x3c\xd9\xd8\x2c\x11\xda\xc5\xca\xb9\x7b\xfc\x89\xa5\xe5\xc3\x5b\xa6\x2e\xb8\x0a\x25\xdf\xf2\x84\x88\x55\x83\x2e\x89\xd0\x8a\x85\x58\x35\x5d\x69\x89\x6c\x3b\x6f\x6a\xd6\xd7\xf8\x0f\x22\xda\xbd\x27\xf6\x1a\xc6\x20\x64\xc5\x5c\x6b\x51\x08\xae\xc2\x0d\x6e\x99\xbf\xaa\xd7\x56\x2c\x5b\x10\x1c\x65\x45\x15\x43\x1f\xf0\x36\xe3\x12\x9d\xff\x55\x8a\x22\xb5\x97\xbd\xa3\x03\xc1\xdc\x11\x2f\xd5\xeb\x11\x4d\x78\xdc\xf6\x17\xcf\x8e\xad\xd7\x90\x5e\x2a\xbe\x66\x42\x56\x9b\x59\xa9\x9a\x63\x24\x7d\x06\x89\xf2\x36\xdb\x15\x82\x6f\x99\xfa\x49\x44\x18\x3b\xc5\x2a\xc7\xde\x53\xfd\xd1\x67\xbe\x1f\xaa\xb7\x4c\x5d\xf1\x6d\x1a\xa3\xc7\x81\x43\xcc\x32\x5b\x63\xf7\x2a\xe6\x21\x36\x82\x29\xd8\xbf\x9a\x37\x2a\x1a\xd8\xe3\x8a\x1d\x88\x1c\x15\xbd\x01\x5e\x26\x7e\x1f\x19\xc1\x01\x7a\x84\x19\x07\xb3\x75\xc1\x2e\xf1\x5a\xac\x1c\x88\x6d\x0d\x0e\xfc\xf5\x83\x4b\xf5\x23\x8f\xb1\x2b\x6c\x54\x8e\xf2\xe5\x7d\xa2\xc1\xb2\x67\x80\x7c\x05\x03\x59\x10\xf1\xf6\x2d\xec\x5b\xfb\xbf\x13\xad\x08\xaf\xdf\xc7\xb1\xb8\x7f\xb3\x4d\xf5\xce\xd0\xb0\x51\xe0\x59\x84\xf1\x78\x3f\x2a\x64\x1d\x74\xa8\x23\xdc\xa9\xb6\xc4\x1f\xd9\x95\xba\x6d\xa2\x2e\x96\xdb\xea\x1e\x61\xb5\x85\x4e\xbc\xb5\xba\xf7\x8d\x6e\x9b\x99\xe1\x2d\x04\x7f\x16\xd7\xbb\x94\x14\xa2\x25\x4f\xd6\xd3\x79\x33\x60\x5b\xf1\xd0\x51\xbb\x32\x03\x5f\x20\x1d\xee\x8f\xf0\x2d\x4b\xe8\x54\xfc\x73\x04\x99\x56\x3e\xb5\x5c\xc2\xb9\x88\x10\xd6\x98\xa0\x64\x1a\x23\xb8\xd9\xc1\x5a\xbc\x56\xf7\x6c\xbd\x46\xf9\x1d\x5c\xfc\x0c\xef\x7e\xbe\x86\x37\x17\x97\xd7\xc1\xc4\xa4\x5f\xc4\xdf\xb9\x48\x77\x92\xaf\x37\x1a\x5e\xe7\x79\x71\xb6\x0c\xc5\x76\x8b\x89\x6e\xf4\xed\xf7\xe5\x4a\x93\x49\xca\xc2\xcf\xcc\x9a\xe1\x7b\xfb\x3b\xcf\x27\x74\x7c\xb9\xde\x70\x05\x2b\x1e\x23\xdc\x33\x55\x67\x46\x6f\x10\x2c\x37\xa0\x85\x88\x03\x1a\xff\x26\xe2\x9a\x27\x6b\xd0\x6e\xde\xd6\x70\x93\x4a\x71\x87\xb0\xca\xb4\x21\xb5\xc1\x04\x76\x22\x03\x89\xaf\x65\x96\xd4\x28\x95\x4b\x18\xb6\x59\x12\x4d\x26\x7c\x9b\x0a\xa9\x61\x36\x01\x98\x26\xa8\x97\x1b\xad\xd3\xe9\x84\x9e\xd6\x22\x66\xc9\x3a\x10\x72\xbd\x7c\x58\x52\x17\x25\xe6\xf8\xa0\x6d\x2f\xd7\x9b\xec\x26\x08\xc5\x76\xb9\x16\xaf\x45\x8a\x09\x4b\xf9\x52\x66\x89\xe6\x5b\x9c\xf6\x8f\x20\x99\x06\xba\x8b\x64\x7c\x60\x40\x69\xb8\x34\x24\x94\x07\xf8\x58\x16\x0e\x6d\x38\x56\x5a\xae\xb6\xba\x6f\x42\xd1\x6b\x06\xee\xf7\x36\x6e\x04\x17\xb8\x62\x59\xac\x2f\x0d\x44\x64\x8e\x4d\x87\xb0\x16\x56\xea\xda\x9b\xfb\xea\x33\xee\x16\xf0\xea\x8e\x1c\x84\x22\x51\x50\x23\x42\xbd\x66\x37\xaf\xd3\xb3\xc3\x1b\x54\xe7\xc6\x54\xde\xe1\x3d\xad\xce\x54\xc8\x62\xfe\x2f\x84\x80\xce\x21\x90\xe7\x76\x9b\x0e\x25\x32\x8d\x0a\x18\x24\x78\x0f\x43\x23\x85\x39\xa7\x11\xc9\x7b\xae\x37\xc6\x3a\xa2\x42\x4e\x3a\x20\x67\xa8\x80\x27\x5c\x73\x33\x37\x0a\x26\xab\x2c\x09\x0f\x2c\x3e\x9b\xc3\xc9\xd0\x8a\xf6\xcc\x49\x0e\x64\x5b\xf2\xfc\x8e\x49\x98\xf9\x80\x55\x5d\x76\x28\x1d\x10\x2c\x5f\x65\x9b\x3d\x82\xf8\x89\xc2\x7e\x0f\x77\x4c\x26\xc4\x4e\x70\x79\x91\xe7\xe5\x94\xb3\x72\xc5\x4b\xf5\x9e\xce\x18\x9a\xdf\x21\x8d\xb6\x81\x31\xcf\x29\xd2\x61\x12\xd5\x75\xfa\xdf\x77\x53\xa7\xf5\x8a\x13\x8f\x04\x45\xb8\x86\xbe\x0b\x2d\x79\x3f\x0c\xd5\x09\x40\x6d\xa0\x8d\x81\xdf\xb4\x71\x2a\x61\xda\x1f\x85\x46\x8b\xc8\xa9\x15\xf8\xd1\x47\x3e\x21\x6b\x67\x3a\xa0\x2d\xe3\x1b\x4f\x2c\x1f\x67\x70\x40\x2f\x3a\x41\xb0\x61\x18\x42\xb6\xc5\x42\xd2\x6b\xbe\x45\x91\x69\x6b\x18\xa7\x10\xca\x12\x67\xdb\x43\x84\xa8\xda\x70\xd8\xd6\xff\xc1\xf5\xc6\x4e\x7a\x29\xb3\x5f\x98\x9d\x96\x5c\x83\xdd\xf0\x98\xeb\x1d\x68\x01\x0a\x35\x30\xd0\x76\x65\x91\x00\x03\x89\xb7\x19\x2a\x3d\xc6\x49\x3c\xae\x67\x25\x0d\xfa\x1f\x5c\x64\xd2\xe4\xd4\x5f\x9d\xe8\x4b\x3a\xd1\xe5\xc5\x1f\xce\x85\xf4\x63\x1c\xe7\xbc\xd8\xc3\xbf\x80\xe3\xd8\xec\xc1\x24\xf2\x47\x7b\x8e\x65\x7b\x16\xea\x87\x92\x50\x60\xdb\xbe\xac\xdf\x54\xea\x21\x9e\xbf\xee\x3f\x2f\xb8\xff\xd4\xa1\x1e\xe5\x3f\xd6\x44\x4e\x21\xd4\x0f\xc7\xf9\xc9\xdb\xeb\xeb\xf7\xe7\x26\x79\xfc\x12\xae\x92\x29\x2d\xb6\xe0\xf1\xf0\x28\xa7\xa9\xe6\xcf\x8a\x3c\x18\x4e\x28\xbb\x0f\x8a\xb6\xaf\x7e\xf3\xd5\x6f\x3a\xfc\xa6\x32\x9a\x53\x28\xac\xa6\x72\x9c\x41\x83\xa1\xb0\xcc\x78\xa2\x80\xc5\xb1\x49\xaf\x4c\x19\x08\x35\x4a\x55\x64\x4f\x94\x51\x09\xd3\xf3\xfd\xfb\x4b\x5a\xcd\x14\x48\x26\x64\xda\xd4\xb8\xdf\xc3\x26\xdb\xb2\xc4\x27\x0d\x54\x4e\x34\xd9\x11\xe8\x5d\xca\x43\x16\xc7\xe6\x64\xac\x10\x98\x44\xb8\x97\x5c\x6b\x4c\x88\x2c\x03\x63\xda\x1f\xac\x87\x9c\x2c\x27\x9a\xea\x1f\x43\x0c\x2b\x2d\xb3\x50\xc3\xbe\x7e\xe6\xb3\x9d\x79\xde\x23\xed\x7e\x4f\x6a\xbd\x40\x52\x42\x6a\x8b\x21\x05\x81\x9b\x58\x84\x9f\x5d\x39\xa0\x31\xc2\xc7\xfa\x64\x39\x81\x06\x67\x26\xa5\x7e\xaa\x25\x1c\x7e\x69\xd3\x69\x2c\x27\xbe\xb1\xb4\x4b\xb1\xf6\x28\x6d\x54\x12\x7d\x40\x16\x9d\xc7\x42\xa1\xdc\xef\x5d\x55\xca\x4e\x30\xa9\x52\x39\xeb\xe3\xa7\x03\xf3\xcc\x6a\xd6\xab\x7d\xfb\xf3\x81\xb2\x9a\xe9\x4b\x81\xea\xf9\xb3\xad\xf5\x10\x3f\x7e\xa2\x63\x28\x9a\x72\x4f\xf1\xcc\x15\x19\x50\x99\x44\x80\x58\x15\x8f\x64\xb4\xa6\x70\x42\x4f\x95\xd5\x71\x65\xba\x30\x2a\x22\x3a\x33\x55\x2d\x3b\xb7\x5e\x5c\x72\x9b\x4d\x33\x3f\x99\x80\x1f\xc8\xfd\x08\x5c\x30\x66\xde\xb1\x85\x98\x6a\x21\x95\xb1\x6a\xbb\x20\x93\x22\x23\x97\xd9\x58\x1e\x4a\x56\x1d\x73\x0b\xb8\xc1\x95\x90\x68\x04\xe0\x3e\x95\x52\xa8\x72\x95\xda\x12\x1f\x3f\x79\x8f\x76\x3f\x24\xc0\xea\x06\xd9\x40\x9a\x45\x51\x81\x9b\x3b\xba\x88\x7e\xa7\x35\x8e\xaf\x8a\x43\x11\x9d\x10\x82\x0f\x18\x22\xbf\x43\x59\x0e\x18\x8a\x23\xf3\x83\xcc\x3c\xe5\xf8\xd4\x64\x25\xb8\x42\x3d\x66\xad\x79\xb5\x15\x74\x50\xb1\x28\x1e\xa0\xf5\x9b\x82\x38\x52\xae\x26\x86\x7d\x30\x0d\x79\xe1\x59\x29\x8f\x67\x4c\xa5\x2f\x38\x91\xad\x53\xbc\xb4\xdd\x3c\xf9\x9c\xd0\x92\xfc\x0a\xb5\x47\x74\xac\x1d\x7c\x09\xf9\xeb\x9c\xb6\xc5\xef\x93\xd0\x0e\x80\x33\xca\x92\x3d\x1d\x7a\x51\xcb\x89\xe1\xb5\xbd\xb0\x26\x9f\x23\x79\x6d\x89\x7a\x85\xba\x45\x77\xac\x4a\xab\x89\x95\x56\x7f\x1b\x38\xba\xb8\x6e\xa0\xd1\x27\xb0\xc7\xe0\x59\xb9\x1f\x54\x1a\xae\xed\x0b\x46\xa8\xda\x36\x72\x58\xa4\x45\xff\x66\x95\xc4\xbb\x27\xe9\xdf\xe7\x6d\x56\x63\x2b\x08\x02\xaf\xf3\xf1\x96\xd0\xbb\x42\x10\x04\x63\x8d\xc2\xa7\xf1\x7b\x42\x70\x48\xbc\x16\x80\x7d\x18\xf9\x14\xe0\x0c\x58\x9a\x62\x12\xcd\x0e\x8d\x5c\xd4\x20\x30\x58\xe6\x93\x49\x47\x82\x5d\x5a\x61\x5d\x90\x22\x13\x76\x1e\xe6\x17\xcd\x0c\x5f\xd4\xdb\x01\xeb\xab\x06\xae\x15\x72\xaf\x0e\x40\xf7\xaa\x89\x5d\x0f\x4f\xb3\x4e\x56\x9e\x27\x65\xff\x03\xe5\xe7\xf3\x61\xf8\x4a\x63\x6a\xa1\x1e\xb4\x32\x92\x7e\x54\xc7\x7a\xdf\x21\xcb\xa9\x52\x96\xdf\xc8\x74\x8e\x90\xf1\x3f\xd1\x72\x7a\x6d\xa3\x03\xb4\xe2\xe5\x43\x0b\x36\x52\xbe\xa3\x48\x11\xc4\xde\x17\x40\x77\xbb\x57\xd9\x02\x41\xa1\x4c\x60\x6b\x2a\x4a\x68\x6a\x2c\x87\x98\x0b\x4c\x11\x86\x31\x93\x18\xc1\xb8\xfa\x03\xbd\x7e\xa7\xe5\xde\x98\xf7\xd3\xe6\x9c\x26\x91\xde\xe8\x96\x47\x43\xa2\xaf\x88\x49\xb5\x61\x29\x55\x0b\x55\x63\x49\x7b\x7b\xc7\x1a\x76\xf1\x92\x9f\x81\x42\x79\x87\x32\x78\x74\xa0\x6f\xde\xb0\x83\xe2\x45\x76\xf0\x01\xd7\x5c\x69\xb9\x9b\x17\xcb\x1a\xb7\xa4\x77\xae\x12\x15\x7c\xfc\x64\xda\x86\xea\x56\x42\x7a\xf7\x4c\x9a\xd7\x32\xc6\xdd\x07\x6c\x6b\xd9\x62\x81\x1d\xda\x1e\xbe\x20\xe8\xed\x3e\x12\xd5\x82\x86\x94\xf7\xb3\x9c\x2d\x54\x46\xe1\xee\x62\x49\x54\x73\xf8\x93\xbb\x77\x55\xbf\x44\x45\xb7\x96\x85\xe2\xda\xbb\xd4\x64\x74\x3b\x93\x58\x26\x00\x5e\xfd\x8f\x2e\xe4\xe4\x93\x67\x85\xeb\xf1\x51\x66\x04\x8e\x43\x66\xd0\x71\xe3\xa9\xe7\x2a\x4d\x53\xf8\x26\xd2\xb4\x81\x4b\xae\xf1\x5a\xd8\xba\x9b\xa9\xc8\x35\x3d\xd0\x54\xe7\xca\x0b\x28\xb5\x12\xf6\x23\xec\xbd\xbe\xde\x4c\x42\x19\x8c\x8a\x2c\xd7\xb6\x2f\x40\xe2\xba\x1f\x83\x9a\xa9\x4a\xda\x99\xec\x91\x76\x76\xe4\xd9\x77\xd4\x6d\xa7\x2e\xb7\xab\x99\x51\x59\xe8\xb2\xc5\xf2\x8e\xeb\xc0\xb5\x5b\xd5\xf6\x2e\x57\xb5\x7f\xf8\x71\xbf\xf7\x1e\x61\xff\x05\xb4\x92\x7b\xeb\x4b\x1e\xf1\xe0\x52\xfd\x2d\x43\xe9\x42\x7f\x51\xb0\xba\xa5\xa6\x22\xcd\xa2\x71\xa5\x86\xfc\x59\x8e\x9d\xe2\xa2\xc7\xad\xec\xd4\x29\xd4\xf6\x89\xc1\x4b\x72\x35\x84\xfb\xc8\x9d\xc1\x49\xf7\x74\x52\x44\xb5\x3b\xf5\x4d\xef\xbd\xc6\xec\xe1\x72\xdb\x9e\xea\x66\x92\xe8\x3f\x9a\x30\x46\xb7\xef\x8d\x9f\xd4\x9e\x67\x3d\x0b\xcf\x0f\xb2\xe6\x70\x3d\x37\x2f\x86\x7c\xa2\x81\xbd\xb7\x37\xb7\xef\x2f\xdc\x3f\xf7\xea\xa5\x61\x0b\x0e\xe9\x0e\x51\x2c\xd2\xd3\xa9\x33\x86\x66\x5c\x37\xce\x52\xd9\xc4\xcc\x7f\x09\x73\x3b\x75\x54\x16\x3d\xd4\x47\xf9\xcb\x20\xef\x5e\xf4\x01\xa8\x67\x2a\xf4\xc1\x52\xdd\x52\x53\xba\xc8\xd8\x65\xa8\x0d\x81\xdc\xcc\x7e\x79\xc6\xe8\xb7\xcb\xfc\x4f\x2a\x85\x74\x58\x96\xa7\xfa\x76\x3a\xd8\x50\xf6\xfc\x28\xca\xc7\x9b\xcc\x58\xdd\x78\x88\xbf\x45\x16\xa1\xac\x63\xbe\x31\x6d\x63\x50\xf7\x66\x7f\xc5\xfd\x28\xdc\xc9\x26\x3c\xd4\x3b\x93\x7b\xbf\xbd\xe4\xbe\xd4\x42\x37\xeb\x3e\x0b\x96\x37\x13\x6e\x97\x4b\xca\x91\xb7\xc5\x3d\xd5\x2e\xbd\xb6\x34\xeb\xf8\x18\xd4\x6b\x07\x0b\x5d\x58\x34\xd0\x00\xe8\x17\xcd\xf6\xb4\xe2\x43\x69\x9b\x46\x8c\x2e\x09\x5a\xe4\xec\xcb\xee\xd5\xf3\x6e\x5c\xab\xa7\x6d\x5c\xab\x27\x6c\x5c\xab\xa7\x6c\x5c\x3d\x0b\xcf\x0f\xb2\x76\xbc\x37\x8c\xd8\xb8\x3a\x44\x19\xb9\x71\x39\xbf\xe9\xb7\xcb\x6e\xe2\x2f\xb0\x6f\xf5\xfc\xb6\xb1\x68\x54\x4a\x57\x62\x66\x28\x0e\x1d\xd9\x3d\xeb\x37\x4e\xcc\xa8\xb3\x2f\x44\x0f\x7d\xc5\x52\x1d\xa6\x9e\xcb\xe3\xe9\xa8\x35\xd6\xe9\x5b\x82\x7a\x9f\xdf\x78\xe0\xd7\x3f\x40\xb4\x26\x58\x7e\xc2\x51\x9d\xd7\x4c\x8b\x67\xe7\xb6\xa1\xcb\x56\x29\x87\x2d\x2e\xf0\x74\x9b\xde\xc7\x4f\xca\x64\x61\xf6\x33\x95\x7f\x2e\xe0\xae\xf6\xf5\xc9\xe8\x3a\x8f\x57\xcf\xf1\x2c\xc0\x96\x72\x4a\xff\xe8\x40\xd1\xa2\x36\xc4\xa3\x3b\x41\x0f\x0c\xf2\x3f\xa8\xf1\xe5\xf7\x31\xac\x75\xd8\xcb\x38\x14\x2d\x6b\x63\x0e\x38\xbc\x9d\xd3\x4b\xb6\x1a\xe2\x1f\xf3\xc9\xc0\xf3\x7c\x80\xfd\x2a\x9c\x0d\xa0\xed\x00\xb6\xcf\x05\xfa\x47\xa1\xdd\x74\xdf\xdf\x25\x63\xbf\x0a\x9e\x60\xd4\x66\xa7\x88\xfa\x74\x1c\x0f\xfe\x22\x78\xf2\xc3\xae\xd0\xd1\x6c\x80\xfd\x05\x4c\xf7\xfb\xe0\x5c\xc4\x31\x86\x54\xd2\x28\x66\xe4\xf9\x74\xde\x7b\x52\x74\xc7\xc4\xc1\x50\x73\xec\xa1\xa2\x4f\xa6\xfe\x38\xd2\x15\xa4\xcb\x00\x62\xe3\xac\x9f\x4c\x95\x51\x72\x34\xd7\x23\x76\x94\x17\x61\xda\x3f\xeb\x94\x07\x9d\x7e\xa6\x8b\x6a\x79\x35\x27\x12\xa8\x80\xac\x50\x65\x29\xd5\x30\xa9\x02\xcf\x59\x24\x79\x08\x4c\xae\x33\xfa\x9e\x49\x2d\x40\xf1\x24\x44\xb8\x47\xc8\x14\x46\xe0\x1b\x4b\xb1\x9f\xdc\x23\x84\x2c\xb1\x17\xbb\x36\x08\x2b\x2e\x95\x06\xfa\x78\x0e\x78\x71\x8f\xa6\xe0\x88\x29\xe0\xfa\x7f\xab\x7b\x61\x34\xc2\xdd\x51\x49\x25\xde\x71\x91\xa9\x82\x64\x31\xa1\x40\x0c\xb4\x58\xa3\xde\xa0\xac\xb6\xa5\x01\x28\xfd\xbd\xa9\xa9\x24\x27\xf8\xa3\x94\xf4\xf1\xdb\x4f\x5d\x4a\x1a\xd8\xa1\xac\x13\x56\xde\xe8\xb7\xba\x52\x8f\x5f\xd2\xf1\xb6\x30\x21\xdd\x27\xb7\x83\x5f\xc2\xcf\x8c\x25\xb8\x56\x5b\x2d\x32\xb1\x7b\xde\xec\x34\x15\xa4\xee\xae\x32\xb8\xf4\xbd\x68\xa0\xbd\x67\x44\x7a\x5b\x3b\x30\x34\xe0\x77\x52\xf6\xa5\x15\x63\xd1\xfd\xfd\x02\x94\x7b\xf2\x97\xbf\x6a\xa7\xa8\x97\xaa\x48\xff\x7b\x00\xe5\x21\x3e\x40\x31\x47\x00\x00")
 
 func templatesClientParameterGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -171,12 +388,12 @@ func templatesClientParameterGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/client/parameter.gotmpl", size: 12367, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	info := bindataFileInfo{name: "templates/client/parameter.gotmpl", size: 18225, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesClientResponseGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\x4f\x73\xe3\xb6\x15\x3f\x17\x9f\xe2\x95\xdd\xdd\x11\x5d\x99\x4a\x7a\x74\xc6\x87\xc4\xeb\x24\x3e\x64\xd7\x63\x6f\xa7\x87\x4c\xa6\x83\x25\x9f\x24\x74\x49\x80\x01\x40\x29\x2a\x87\xdf\xbd\x83\x3f\x24\x41\x11\x94\x9d\xf4\xd4\xee\x65\x65\xe2\xe1\xfd\xfd\xe1\xbd\x1f\xd0\xb6\x50\xe0\x96\x71\x84\x24\x2f\x19\x72\x2d\x51\xd5\x82\x2b\x4c\xa0\xeb\x36\x1b\xf8\x80\xc7\xb6\x85\x9a\xaa\x9c\x96\xec\xdf\x08\xd9\x07\x5a\x21\x74\x1d\xe4\x12\xa9\x46\x05\x14\xe2\xeb\x47\xa6\xf7\x46\x35\x6d\x4a\x0d\x7b\xa4\x05\x4a\x05\x07\x5a\x36\xa8\xc8\xb6\xe1\xf9\xa2\xe6\x55\xdb\x02\xdb\x02\xfe\x0a\xd9\x9d\x28\x10\xae\xbf\x86\xae\xcb\xcd\x2f\xc6\x75\xdb\x02\xf2\x02\xba\xce\x09\x65\xcf\xf9\x1e\x2b\x3a\xfc\x4d\x79\x01\xab\x60\x67\xda\x4b\x64\x0f\xea\x59\x4b\xa4\x15\x74\xdd\x1a\xda\x16\x79\x71\xa6\x23\x94\x38\x4a\xa6\x51\x02\x13\xd9\x3f\xec\xaf\xd0\xaa\xfb\x91\xc2\x55\x3c\xec\x96\x00\x48\xd4\x8d\xe4\xf0\x2e\x2a\x61\x04\x00\x62\x31\xfe\x53\x69\xaa\x1b\x65\x3e\xdc\x80\x09\x78\xdd\x8b\x0e\xc6\x25\xe5\x3b\x84\xec\x47\x9f\xce\x21\x84\x1f\xa9\x7a\xef\x53\x6d\xbf\xcd\xcd\xde\xd8\x32\x49\xc6\xf5\x16\x92\xb7\x7f\x39\x24\x90\x8d\x3b\xe6\x86\x2e\x25\x39\x92\xb0\x47\x7a\x2a\x05\x2d\x6e\xc0\x65\x6e\x49\x5f\x47\x3a\x42\x36\x91\xcc\x75\x1d\xec\x29\x2f\x4a\x54\xa0\xf7\x4c\x41\x4e\x15\xc6\x10\xe4\x01\x94\x11\xe2\x5d\x79\x8f\x2a\x97\xac\xd6\x4c\x70\x67\xe8\x73\x29\xf2\x2f\xb9\xa8\x2a\xe4\x7a\xbe\x8c\xa5\xc2\x85\x04\x19\x77\xf7\x4d\x45\xf9\xa4\x58\x0e\x28\xe4\x6a\x43\xf4\xa9\xc6\x05\xa8\x2b\x2d\x9b\x5c\xdb\xd2\xc7\xea\x4a\x00\x82\xd2\x1a\x14\x13\xf2\xba\xb2\x4e\xdd\xb7\x89\xbb\x14\x1f\x01\xb8\xda\x0c\x7a\x9d\x8d\x78\xa0\xd9\x0f\xe2\x93\x89\xa7\x97\x0a\x77\x4c\x2a\x4e\x00\x7c\x6d\x21\x38\x61\x5c\xe8\x00\x05\xdf\x51\x85\x46\x5b\x7a\xbe\xf0\xc0\x35\xca\x2d\xcd\x31\x3c\x86\x77\xa2\xaa\x4b\xfc\xed\xe3\xe7\x7f\x61\xae\xcf\x77\x38\x40\xa5\xd0\x75\x57\x67\x20\x5c\x14\x34\xd1\xf8\xcf\x43\x50\x66\x6f\xa9\xcc\xaf\xe0\x08\xbb\x4a\x86\xe1\x76\xd1\x6a\x91\xcd\x06\xec\x9f\x3b\xd4\x06\x8e\x08\xae\x78\xf6\x48\xc2\x56\x48\xfb\x2d\x86\x16\xe8\x7b\xa7\x6b\x70\xa6\x91\x65\x4f\x98\x23\x3b\xa0\xec\x45\xe2\x6d\x23\xb5\x16\x57\xa9\x01\x47\xd8\x42\x22\x1a\xb2\x00\x4b\xa4\x23\x63\x34\xe4\x0f\x58\xbd\x97\x52\xc8\x55\x6a\x10\xcc\xf8\x0e\x5a\xf2\x27\x6f\x78\x5b\xe9\xec\xd9\xb5\x8b\x55\xf2\x73\xdb\x42\x53\xd7\x28\x21\xfb\x09\xf5\x5e\x14\x3d\x8a\x1e\xa9\xde\x43\xd7\xfd\xf2\xf3\xdb\xe2\x97\x1e\x3a\xc3\xc9\x99\x00\xce\x97\xa3\xe1\x5f\xb8\x38\x72\x40\x63\x17\x16\xfb\x0c\xbc\xfd\xeb\x61\x58\x4c\xd6\xd1\x53\xf5\x42\x6a\x46\x9b\x46\xd0\x6e\xbb\xd0\xd8\xd6\x20\x32\x8f\xf3\xb1\xc5\x93\x3f\x96\x53\x89\xb4\x78\xf2\x40\x58\xf5\x88\x00\xd9\x70\xcd\x2a\xcc\xee\xec\x90\xed\xd7\xd7\x90\x0b\xae\x9a\x0a\xe5\x28\xe0\x3f\xac\x0d\xd4\x2a\xaa\x95\x29\x8e\x29\xc7\x13\xee\x98\xd2\xf2\x94\xf6\xd9\x73\x58\x9e\xf5\x0e\x02\xb0\xd9\x0c\x50\xec\x1b\x67\xdb\xfa\x46\x6b\x77\x99\xe0\xef\x04\x3f\xa0\x34\x73\xce\xe6\x23\xa7\x15\x4e\x22\x59\x1b\x3b\x70\x73\x0b\x2e\x81\xa3\xf0\x10\x54\xf6\x03\x6a\x67\x77\x95\x04\xf5\x4e\xd2\x94\x80\x2d\x98\x94\xf0\xe7\x5b\xe0\xac\x04\x37\xf5\x3c\xb8\xac\xff\x2a\x7b\xe0\x07\x5a\xb2\xc2\x9c\xd9\x55\x80\xa6\x35\x24\xce\xe7\x64\x0d\xc9\xa4\x57\x25\x6b\x78\x95\x69\x7f\xca\x67\xf0\x88\xb7\x43\x1b\xe0\x2c\x7a\xdf\x28\x0c\x84\x4c\xb2\x1e\xd4\x5d\xa3\xb4\xa8\xbe\xb7\x35\x71\x79\x70\x22\xcb\x79\xf3\xf5\xcb\x1e\xa9\x54\x36\xc2\x61\xfc\xfe\x9a\x40\xf6\x7c\xa4\xbb\x1d\x4a\xa7\xd0\x6e\xfb\x7f\x4b\xeb\xd5\x2a\x96\x9e\x6c\x75\x35\xb1\x6e\x55\xfb\x54\xc7\x4f\xf5\x92\xfe\x17\x9d\xb6\x8a\xe7\x6d\x3f\x3a\xe5\xce\xa9\x4d\x3f\xd4\xe6\x07\xaa\xf6\xf3\x90\x2a\xd3\xad\xdd\x80\x03\xc3\x0e\x08\xf4\x6b\x93\xa3\xf3\x93\x28\xb0\x54\x8f\x34\xff\x42\x77\x36\x9e\xbf\xf3\x8a\x4a\xb5\xa7\x65\xdb\xda\xc6\x5b\xf7\x6b\x67\x73\x6c\xb6\xf3\xdc\xc7\x6f\xa5\xa4\xa7\xae\x7b\x2e\x59\x8e\x43\x78\xe3\xe9\xfc\x4e\x14\xa7\x55\x3a\xb6\x98\x97\xe1\x73\xa1\xc8\x3d\x0b\xb8\xed\x63\x3c\x3b\x20\x0b\xf3\xbd\x7b\x59\x1f\xc7\xe3\x2a\x36\xc4\xd3\x33\x92\xc4\xb6\x10\xe7\x1d\x8b\x25\x1a\xe3\xbd\xb9\x1d\xb2\xd0\x37\xd8\x79\x9e\x46\x1b\x2b\x21\x17\x23\x8a\x71\x90\x77\xce\xcf\x38\x7c\x7d\xa4\xe9\x37\x61\xe6\xdf\xbd\xeb\xff\x62\x22\xbb\xff\xf8\xfd\x85\x52\x9c\x71\xe8\x91\x1c\x70\x56\x86\x04\x60\xa4\x2d\x1c\x25\xd5\x58\xc0\xe7\x13\xec\xc4\xb5\x72\x8d\xe6\x1b\x78\xff\x11\x3e\x7c\xfc\x04\xf7\xef\x1f\x3e\x65\x64\x60\xd0\x77\xa2\x3e\x49\xb6\xdb\x6b\xb8\xb6\x3a\xcc\x99\xed\xe9\xe5\x64\x2d\x64\x1a\xb5\xc7\xa4\xeb\x2f\x3d\x3e\x2d\x75\xfa\x64\xf8\xfb\x96\x95\x08\x47\xaa\xa6\xce\x58\x2e\xe5\xbc\x01\x2d\x44\x99\x19\xf9\xfb\x82\x69\xc3\x3d\xf4\xb0\xaf\xb2\x16\x6b\x29\x0e\x08\xdb\x46\x5b\x55\x7b\xe4\x70\x12\x0d\x48\xbc\x96\x0d\x9f\x68\xea\x4d\x58\xb7\x29\x2f\x08\x21\xac\xaa\x85\xd4\xb0\x22\x00\x09\x13\x89\xf9\x8f\xa3\xde\xec\xb5\xae\x13\xc3\xbd\x93\x1d\xd3\xfb\xe6\x73\x96\x8b\x6a\xb3\x13\xd7\xa2\x46\x4e\x6b\xb6\xf1\x23\x38\x59\x96\x30\x36\x2f\x2c\xbb\x0e\x7c\x41\xc0\x76\x66\xaa\x2f\x99\x18\x9c\x20\xe0\x27\xff\xa2\x33\x76\x35\x21\x13\x1e\xe0\x2f\x75\x0f\x36\x03\xfe\x2a\x31\x99\x3b\xb1\x66\xe8\xf6\xbe\xf9\x82\xa7\x35\xbc\xb1\x57\x2c\x73\x62\xb2\x89\x12\xb3\xea\xc9\x5c\xa8\xcf\x8b\x9f\x69\x4d\x2d\x14\xa2\x8d\xfb\xc9\xf1\x11\xa6\x80\x82\xff\x1d\x70\xea\xc5\xdb\x55\x23\x31\xbb\x70\x07\xf3\x9a\x82\x9b\xd8\x02\x7b\x1a\x2f\xcc\xee\xf8\x32\xbe\xeb\xc9\x98\x0b\xc2\xdf\xfd\x23\x97\x7f\xe2\x00\xfe\x14\xf0\x3b\x4b\xf6\x4c\x24\x0a\xe5\xc1\x90\xb8\xfe\x3b\xe3\x5a\xd8\x98\xa4\xeb\x06\x45\xb4\x09\xfe\x6e\x76\xe9\xc2\x4c\x27\x3e\xfc\x17\x1c\x33\x85\xd5\x30\xc2\x5a\x47\x5c\x84\x4c\x83\x8b\x6c\xd6\x2b\x51\x5d\xa7\x8e\x4c\xe7\xfb\x71\xea\xfa\x1b\x4b\x1b\xbf\xcb\x0e\x1b\x5d\x56\xc1\x5d\xe9\x03\x2e\x7e\x63\xbf\x9a\x66\xa6\xcc\xfd\xfe\xe6\xf6\xa5\x07\xa1\x57\x3c\x43\x18\x98\xce\x92\x7c\x5c\x7a\xc2\xf1\x0e\x8c\x23\xc2\xb9\x92\x45\x09\xfc\x98\x45\x3b\x28\xe6\x66\x3c\xde\xa6\x6d\xbe\xf5\x36\xc2\x9e\xbd\xf6\xed\xdd\xfc\xeb\xc8\x64\xd5\x07\xf6\xa0\x9e\x9b\x3c\x47\x65\x72\xe7\x7c\x5a\x9b\x8d\xfd\xf3\x85\xd5\xe1\xbe\x87\xf4\x26\x7c\xd2\xf2\x5d\x20\x40\x76\xdb\xba\xf7\x94\xc8\x52\xf0\x20\xf5\x26\x2c\xb8\x7f\x7a\xe9\x0b\x35\x24\xee\x15\x15\x3b\x03\xc9\xab\x0b\xb8\x90\xdb\xff\x85\x12\xb2\xed\xec\x68\x6c\xe0\xeb\xaf\xbe\x82\xdb\x5b\xf8\xdb\x5c\x4b\x50\xd7\x38\x16\x26\x55\x1e\x02\x77\x08\xf8\xfd\x15\x0b\x54\xfa\x1e\xf0\x01\x8f\xdf\x3e\x3e\xb8\x07\x80\x64\x72\x2f\x0f\xee\x03\xeb\xf3\x98\xd2\x08\x8d\x0e\x1c\xe8\x26\xcd\x72\xa1\x1b\xb4\x2d\x68\xac\xea\xd2\xcc\xec\xd9\xa3\x73\xe6\x25\xbc\x96\x45\x3c\xbf\xa0\x25\xbe\xa1\xe7\x2f\xa3\x63\xf7\xbf\x69\x49\x1d\x0e\xad\x6f\xb1\xc7\x49\x3f\xf5\x46\x6b\x85\xc8\xdd\x83\x89\x77\xd7\x33\x91\x9b\xca\xf0\x75\x08\xae\x20\xe4\x6a\x33\xf5\x53\x59\x4b\xb3\x28\xff\x13\x00\x00\xff\xff\xf3\x4b\x6a\x23\x85\x17\x00\x00")
+var _templatesClientResponseGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x59\x4f\x73\xdc\xb6\x15\x3f\x97\x9f\xe2\x85\xb5\x3d\xa4\x4a\x73\x93\x1e\xe5\xd9\x43\x22\x29\xf1\x1e\x62\x69\x24\x77\x7a\xf0\x78\x3c\x10\xf9\x76\x17\x15\x09\x30\x00\x56\x9b\x0d\x87\xdf\xbd\x03\x10\x20\xc1\x5d\x50\x92\xdd\xf6\x90\x9e\xbc\x26\x80\xf7\xef\xf7\xc3\xc3\x7b\x4f\x6d\x0b\x25\xae\x29\x43\x88\x8b\x8a\x22\x53\x02\x65\xc3\x99\xc4\x18\xba\x6e\xb1\x80\x0f\xb8\x6f\x5b\x68\x88\x2c\x48\x45\xff\x40\xc8\x3f\x90\x1a\xa1\xeb\xa0\x10\x48\x14\x4a\x20\x10\x5e\xdf\x53\xb5\xd5\xa2\xc9\xae\x52\xb0\x45\x52\xa2\x90\xf0\x48\xaa\x1d\xca\x68\xbd\x63\xc5\xac\xe4\xa4\x6d\x81\xae\x01\x7f\x83\xfc\x82\x97\x08\x6f\x7f\x80\xae\x2b\xf4\x2f\xca\x54\xdb\x02\xb2\x12\xba\xae\xdf\x94\xdf\x15\x5b\xac\xc9\xf0\x7f\xc2\x4a\x48\xbc\x93\xa9\xdb\x91\xaf\xe4\x9d\x12\x48\x6a\xe8\xba\x0c\xda\x16\x59\x79\x24\xc3\xdf\xb1\x17\x54\xa1\x00\xca\xf3\x7f\x9a\x5f\xbe\xd6\x5e\x7d\x0a\x67\x61\xb7\xdb\x08\x40\xa0\xda\x09\x06\x6f\x82\x3b\xf4\x06\x80\x90\x8f\x5f\xa4\x22\x6a\x27\xb5\xe9\xe7\xa0\x1d\xce\xdc\xd6\x41\xb9\x20\x6c\x83\x90\xbf\xb7\xe1\x1c\x5c\x78\x4f\xe4\xa5\x0d\x75\xd7\x05\xd5\x9e\x6b\x39\x8d\xa0\x4c\xad\x21\x7e\xfd\xd7\xc7\x18\xf2\xf1\xc4\xa9\xa2\xa7\x82\x1c\x08\xd8\x0d\x39\x54\x9c\x94\xe7\xd0\x47\x6e\x4e\x5e\x17\x75\x51\xb4\x08\x44\xae\xeb\x60\x4b\x58\x59\xa1\x04\xb5\xa5\x12\x0a\x22\x31\xc4\x20\x4b\xa0\x3c\x8a\xac\x29\x97\x28\x0b\x41\x1b\x45\x39\xeb\x15\xdd\x57\xbc\x78\x28\x78\x5d\x23\x53\xa7\xcb\x58\x49\x9c\x09\x90\x8e\xcf\x76\x57\x13\xe6\x7f\xb4\x44\x89\xce\x16\x91\x3a\x34\x38\x43\x75\xa9\xc4\xae\x50\xd0\x46\x61\x5c\x23\x00\x0f\x5a\xa0\x4c\x45\xd1\xcb\x60\x9d\x9a\xbf\x38\x7b\xc6\xbf\x08\xe0\x6c\x31\xc8\x8d\x60\xc6\xdc\xb6\x85\xfc\x17\xfe\x51\xfb\xe3\x76\xf9\x27\x26\x88\x47\x00\x16\x5b\xbb\x64\x6e\x18\xe3\xca\x63\xc1\x4f\x44\xa2\x96\x96\x1e\x2f\xac\x98\x42\xb1\x26\x05\xfa\xd7\xf0\x82\xd7\x4d\x85\xbf\x5f\xdf\xff\x0b\x0b\x75\x7c\xa2\x27\x54\x0a\x5d\x77\x36\x58\xd5\xeb\x9d\xdd\xd8\xb6\xc3\xe7\xc1\x29\x7d\xb6\x92\xda\x3d\xef\x0a\xf7\x48\x4e\xdd\xfd\xe2\x12\xd3\x56\xa9\xc6\xc6\xdf\x70\x74\x01\x06\xbf\x0d\x2a\xcd\x48\x84\x1e\x3f\x73\x2b\x61\xcd\x85\xf9\x16\x22\x0c\xb8\xf4\xd9\xe7\x38\x9d\xcb\xf2\x5b\x2c\x90\x3e\xa2\x70\x5b\xc2\x99\x23\x35\x1a\x93\x54\xf3\xc3\xcf\x22\x21\x46\x05\xa4\xe6\x1e\xc5\x46\xf7\xf5\x46\x73\xcc\xbb\x84\xd6\xbf\xeb\x06\x05\xd1\xc4\x59\x5d\x8e\x6e\xae\x2e\x81\xaf\xcd\x2f\xee\x96\x61\xbf\xa5\xc5\xd6\x5a\x83\xe5\xff\xc4\x73\xcf\x96\x24\x05\xa9\x04\x65\x9b\xa3\x18\x0c\xa9\xeb\xb7\x18\x72\xdf\xf6\xc1\xa1\x2b\x21\xb8\x70\x64\x1d\x3c\x6a\xec\x07\xbe\x7e\xde\xf2\x4c\x47\x9a\xb0\xc3\x37\x78\xe0\x2b\xef\x31\xec\x99\xdf\x76\xa7\x58\x5a\xba\xce\xe0\x68\x85\x8c\x18\x32\x5a\x1d\x63\x77\x4b\xf6\x2e\x57\x0c\x8e\xf6\x99\xd1\xc1\xe7\x78\x5d\x62\x51\x11\x81\xe5\xcb\x48\x9b\x01\x91\x20\x7a\x83\xca\x6f\x88\xc2\x68\x57\x92\xfa\x57\xea\x28\x06\x27\x3e\xbb\x6b\xa8\x91\xfc\xd6\xe0\xfb\xc4\xf9\x8b\xd5\xb5\xae\x55\x7e\xd7\x33\x27\x89\x3f\xb5\x2d\xec\x9a\x06\x05\xe4\xbf\xa2\xda\xf2\xd2\xe5\xc2\x1b\xa2\xb6\xd0\x75\x9f\x3f\xbd\x2e\x3f\x3b\x88\xac\xec\xb6\x1d\x7e\xc2\x88\xc8\x8e\x3d\x30\xbe\x67\x80\x5a\xef\x98\x4f\x8e\xd1\x85\xd7\x7f\x7b\x1c\x16\xe3\xec\xbf\x7f\x93\x8f\x15\x66\xc0\x7d\xfa\x98\x3d\xe9\x37\xc6\x54\x20\x29\x6f\x2d\x2d\x12\xc7\x0f\x10\x3b\xa6\x68\x8d\xf9\x85\x29\x15\xdd\x7a\x06\x05\x67\x72\x57\xa3\x18\x37\xd8\x0f\x99\x26\x5e\x4d\x94\xd4\xe0\x68\x38\x6e\x71\x43\xa5\x12\x87\xd4\x45\x6f\x7c\x74\x1c\xa5\xbb\xee\x29\x8e\xc0\x12\x6a\xf2\x80\x89\x47\x2f\x13\xda\x0a\x99\x2f\x22\x8d\x40\xab\x86\x2f\x19\x30\xed\xea\xf9\xd2\xbe\xb2\x9f\x3e\xf7\xf9\xa5\x85\x99\x87\x77\x92\x69\xac\xf6\x6c\x44\x19\xfa\x1b\x0d\x3a\xf4\xe6\xce\x19\xd1\x36\x12\xf9\x2f\xa8\x7a\x1b\x12\xad\x35\x7d\x67\xb7\x7c\xb7\x84\x38\xb6\xe7\xe0\xc9\x2b\x90\xdf\xa1\x32\x67\xb3\xfe\xa8\xf6\x03\x40\xbf\x55\xc7\x6f\x57\xc8\xfc\x08\x60\xb1\x18\xac\x71\x45\x53\xdb\xda\x22\xcb\x48\xd0\xa1\xbe\xe0\xec\x11\x85\xae\x71\xdd\x19\xc2\x80\xdc\x4b\x64\x43\xa5\x55\x21\x79\x34\xe5\x18\xc2\x1f\x28\x78\x6f\x4d\x64\xdc\xde\x96\x22\xc8\x9a\x99\x48\xc4\xde\x2d\x8a\xd3\x77\xf3\xc7\xfd\x28\xb5\x2d\x14\xa4\xc6\xc9\x86\x4c\x73\x46\x2b\x69\xdb\xa9\x0b\xc9\x9c\xc8\xd4\x21\xa5\x0f\x7e\xb7\x04\x46\xab\x01\x05\x9b\x23\x0c\x0d\x65\xbe\x62\x8f\xa4\xa2\xa5\x2e\x20\x12\x2f\x29\x64\x10\xf7\xf1\x88\x33\x88\x27\x85\x53\x9c\xcd\x3a\x32\x62\x16\xc6\x3a\x78\x08\x96\x41\x9f\x3d\xe0\xf5\x73\xa0\xc1\x5b\xc9\x8b\x9d\x54\xbc\xfe\xd9\xdc\xac\x3f\x2d\x88\x36\x31\xe4\x37\x44\x48\x4c\x8e\x2f\xde\xdd\x9e\x6c\x36\x28\x7a\x1f\x0d\xf6\xff\x1f\x18\x9f\x25\xa1\xa0\xe4\xc9\xd9\x44\x71\x9a\x4e\x71\xef\xba\xaf\xd1\xf1\x2c\x7c\x86\x50\xa1\x7a\xf8\xf8\x45\x39\xfe\xe4\x55\xfb\x03\xe9\x9c\xb6\xa1\xd4\x22\x72\xac\x7f\x40\xb7\x4d\x11\xb8\xb5\xc9\x0d\xfe\x95\x97\x58\xc9\x1b\x52\x3c\x90\x8d\x76\x3a\xff\x07\xab\x89\x90\x5b\xa2\xeb\x1d\x9d\xa4\x1b\xb7\xe6\xb4\xdb\xf8\x9c\x9c\x3c\xb6\xf1\x47\x21\xc8\xa1\xeb\xee\x2a\x5a\xe0\xe0\xde\xf0\x8a\xe5\x3f\xf1\xf2\x90\xa4\xe3\xab\x95\x46\x61\xfe\x8c\xec\x19\xd1\x98\xab\xd7\x60\xe9\x7c\x1c\x51\x9b\x1a\x35\x6d\x7c\xba\xe7\xe5\x31\xdc\x27\xa1\xee\x26\xf5\x41\xeb\x5d\x0f\x37\x64\xb3\x10\x8d\xfe\x9e\x2f\x87\x28\xb8\x37\xfb\x34\x4e\xa3\x8e\x84\x8b\x59\x8f\x42\xcd\x99\x1e\x81\xb8\x51\xcb\x9c\xa7\xe9\x3b\x3f\xf2\x6f\xde\xb8\xff\x51\x9e\x5f\x5d\xff\xfc\x04\x14\x43\x00\x06\xfa\xda\x5d\x8c\x56\x51\x17\x0d\x0b\x63\x33\xc7\x74\xdb\x80\x25\xdc\x1f\x60\xc3\xdf\xca\x3e\xc5\xbc\x83\xcb\x6b\xf8\x70\xfd\x11\xae\x2e\x57\x1f\xf3\x68\x18\x2d\x5c\xf0\xe6\x20\xe8\x66\xab\xe0\xad\x91\xa1\xef\xad\xeb\xbb\x27\x6b\xa3\x05\x51\xd4\x58\x4e\x6a\xdc\x46\x7e\x9a\xfe\xe4\xa3\x1e\x6c\xac\x69\x85\xb0\x27\x72\x6a\x8c\xce\xcf\xd6\x1a\x50\x9c\x57\xb9\xde\x7f\x55\x52\xa5\xcb\x59\x35\x9c\xab\x8d\x35\x8d\xe0\x8f\x08\xeb\x9d\xd2\x9f\xf6\x5b\x64\x70\xe0\x3b\x10\xf8\x56\xec\xd8\x44\x92\x53\x61\xcc\x26\xac\x8c\xa2\x88\xd6\x0d\x17\x0a\x92\x08\x20\xa6\x3c\xd6\xff\x30\x54\x0b\x5d\x4b\xc5\x7a\x28\x11\x6f\xa8\xda\xee\xee\xf3\x82\xd7\x8b\x0d\x7f\xcb\x1b\x64\xa4\xa1\x0b\x5b\xd5\xc5\xf3\x3b\xb4\xf5\x4f\x2c\xf7\x8f\xec\x13\x1b\x4c\x62\x26\x0a\xe3\x17\x18\x11\x81\x2d\x26\xe7\x76\xf6\xab\x71\x34\x29\x92\xec\xb4\x6b\x65\x22\x10\x2a\xf5\xdc\x8d\x3c\x2d\xb0\x5e\x3d\xe0\x21\x83\x57\x43\xb5\x97\x4f\x84\xe8\x55\xdb\x1f\xf8\xf2\xec\xf6\x23\xa9\xa9\xa1\x42\x30\x71\xdf\x9a\x3a\x03\xa8\x1e\xa9\xda\xdf\x5e\xd3\x16\x48\xf4\xfd\xd8\x69\x27\x30\x7f\x62\x38\x65\x25\x79\x23\xaa\x99\x82\xdc\xb2\xfe\x3d\xb1\xd7\x97\xb2\x8d\xab\xef\x35\xb5\xc1\x8e\xf6\x20\x30\x15\x75\x5d\xa9\xd7\x32\x98\xfe\x41\x7b\x22\x51\x3c\xea\xbe\xc0\x7d\xa7\x4c\x71\xc3\x52\xd7\x66\x06\x93\xe0\x57\x37\x2c\xbd\x9b\xe9\xc4\x86\xff\xa0\x6d\x49\x21\xf1\x5a\xf8\x4c\xa7\x24\x2e\x52\xbf\x59\x71\x42\x64\xd7\xc9\x3d\x55\x66\x42\x62\xd3\xa6\x9d\xe3\xb4\x47\x59\xca\xf2\x70\x38\xa8\x03\xa7\x5f\x19\x33\xeb\xf4\xda\xbb\xf3\xa1\x76\x91\x7a\xf0\x79\xbe\x7c\x6e\x52\x6e\x13\xef\x53\xf3\x59\x4d\xd3\x93\x20\xef\xa7\x28\x0e\x3f\x52\x6b\xc0\xf8\x44\xf4\xa6\xe4\xc1\x9e\x70\x8c\x62\x06\x41\x35\x96\x6f\xe9\xbb\x50\x81\x36\x3c\xb2\x8c\x56\x26\xcc\xf6\x7b\x17\x4d\x56\xad\x63\x2b\x79\xb7\x2b\x0a\x94\xfa\xe6\xf5\x36\x65\xba\xa4\x77\x73\x5d\x23\xa3\xff\xee\x97\x37\xfe\xac\xdf\x66\x01\xe7\x45\xef\xb6\x19\x34\x07\x96\x5c\x71\x47\xd7\xf0\x6a\xc4\xad\xeb\xec\x4c\xda\x01\x35\x04\xee\x05\x88\x1d\x91\xe4\xc5\x00\x66\xf0\xa7\x85\x90\xae\x4f\xae\xc6\x02\x7e\xf8\xfe\x7b\x58\x2e\xe1\xef\xa7\x52\x3c\x5c\x8f\x04\xf9\x6a\x1c\xca\x83\xe3\x3d\x03\xbe\x1e\x31\x4f\xa4\xcd\x01\x1f\x70\xff\xe3\xcd\xca\x0c\xf4\x92\x78\x32\xea\x89\xb3\xc1\x93\xec\xd8\xa7\x74\x90\x19\xcc\x11\x5e\x99\xd2\x45\xd1\x4c\x36\x68\x5b\x50\x58\x37\x15\x51\x81\xbf\xc6\xe5\x76\x87\x95\x32\xcb\xe7\x67\xa4\x84\x0f\x58\xa1\x9e\x61\x57\xbf\x2b\x41\xfa\x44\x62\x6c\x0b\xfd\xd5\xc6\xbe\x7a\xa3\xb6\x92\x17\xba\x6e\x67\x1b\x6b\xae\xad\x44\xce\x6b\x5d\xaf\x83\xd7\x82\xe8\x3f\xa8\x4c\x4e\x4a\xa3\xe9\xc4\xcb\x7f\x0f\x00\x8e\xf0\x55\x51\x9e\x1c\x00\x00")
 
 func templatesClientResponseGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -191,7 +408,47 @@ func templatesClientResponseGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/client/response.gotmpl", size: 6021, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	info := bindataFileInfo{name: "templates/client/response.gotmpl", size: 7326, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesClientRetryGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x59\x6b\x6f\xdc\x36\xb3\xfe\xbe\xbf\x62\x6a\xe0\x18\x92\xb3\x51\x9c\xb4\x3d\xc0\xd9\x64\x03\xe4\xd6\xb4\x07\x8d\x13\xd8\xce\xdb\x0f\x86\x11\x70\x25\x2a\x4b\x58\x22\x55\x92\xf2\x7a\xbb\xd9\xff\xfe\x62\xc8\x21\x45\xed\x25\x6d\x81\xa2\xce\x92\xc3\xb9\x3c\x73\x25\xb5\xd9\x40\xc5\x6b\x21\x39\x9c\x94\x8d\xe0\xd2\x6a\x6e\xf5\xfa\x04\xb6\xdb\xc9\x93\x27\x70\x89\x3f\x3e\xa9\x46\x94\x6b\xb0\xbc\x69\x0c\xd8\x25\x87\xcd\x06\x96\x7d\xcb\xa4\xf8\x8b\x43\x71\xc1\x5a\x0e\xdb\x2d\xf8\xd3\xb0\x54\x2b\xb0\x0a\x1c\x17\x47\xac\x3a\xae\x99\x15\x4a\x1a\x58\x2d\x45\xb9\x84\x9a\x89\x86\x57\xc5\xe4\xc9\x13\x94\xf0\x31\xd9\x16\x76\x09\x4c\x82\xa8\x78\xdb\x29\xcb\xa5\x85\x96\xdb\xa5\xaa\x20\x7b\xff\xee\x7a\x0a\xbf\xbe\x7b\xf5\x76\x0a\x1f\x3f\x5d\xff\xf6\xf1\xe2\x6a\x0a\x9f\x3e\x5f\x03\x93\x15\xbc\x7d\xf7\xfb\xbb\xeb\x77\x39\x30\xcd\x9d\x58\xc1\xab\x29\x72\xee\x65\xc3\x8d\x57\xd8\x74\xbc\x84\x96\xe9\x3b\xf7\xb3\xf5\x92\x70\xe3\xe1\x31\x9e\x58\xb3\x45\xc3\x67\x50\xb3\xc6\x70\xe0\x0f\x96\x4b\x23\x94\x44\x0d\xe1\xa3\x5d\x72\x9d\xda\x80\x52\x94\x6c\xd6\x41\x14\xac\x96\x5c\xfe\x0b\x21\x56\xf7\x3b\x32\x76\x50\x48\xcc\x48\x78\x73\x7d\xcf\x35\x94\xaa\x6f\x2a\x90\xca\xc2\x02\x89\x58\xb9\xe4\xd5\x14\x94\xf6\x84\xc2\x02\x93\x66\xc5\x35\x6a\x85\xc2\x19\x44\xc1\x60\x2c\xb3\xbd\x81\x52\x55\xbc\x98\xd8\x75\xc7\x47\xbe\x35\x56\xf7\xa5\x85\xcd\x04\xe0\xc9\x13\xf8\xc0\x1e\x5e\x59\xcb\xdb\xce\x1a\x10\xce\x1a\x68\xd9\x83\x68\xfb\x16\x64\xdf\x2e\x10\x90\x1a\x58\xa0\x50\x35\x3a\x2d\x42\x34\x05\x21\xcb\xa6\xaf\x84\xfc\xea\x4e\xd6\x42\x1b\x0b\x4a\xf2\x09\x8c\x19\x4b\xeb\xa5\xfd\x26\x85\x15\xac\x79\xcd\xca\x3b\x55\xd7\x41\x60\xc5\x1b\xb6\x86\x05\xaf\x95\xe6\x09\x1f\x67\xd0\x34\xa1\xa8\x54\xbf\x68\x38\x05\x0f\x67\xe5\xd2\xdb\x3c\x81\x5d\xbe\x56\xb4\xbc\x78\xdb\xfb\x60\x8b\x76\x86\xdd\x92\x75\x63\xb9\x76\xc5\xd1\xaf\x2b\x15\x0d\x9d\x40\x7a\xe0\x00\xbb\xff\x17\xd6\x72\x1d\x0c\xa8\x35\x2b\x51\x14\x62\x35\x30\xf6\x29\x20\x0c\x68\x26\x2b\xd5\x8a\xbf\xd0\x81\x41\xda\xb9\x8b\xe7\xa7\x13\x08\xac\xea\x46\x31\xfb\xbf\x3f\x79\x6d\x2f\x83\x2f\xaf\x9c\x2b\xdf\xa8\x8a\x1b\x17\xf4\x76\x39\x72\xaf\x09\x12\x35\x37\x9d\x92\x86\x87\xc4\x4b\x22\xab\xf0\x2c\xff\x08\xf1\x15\x48\x61\xc9\x0c\x30\x1f\x1a\x8f\x5f\xd5\x68\xce\x92\xb3\x8a\x6b\x0f\xb9\xe4\x0f\x36\xe0\x01\x2b\x26\xac\x01\x66\xa1\xe1\xcc\x58\xa8\x95\x4e\xec\x14\x16\x0c\xb7\x06\xe5\x1c\xd4\xfb\xe6\x56\x48\x3b\xd9\x4e\x30\xf8\xdf\xf2\x9a\xf5\x8d\x4d\xe3\xb1\xd4\x9c\x59\x1e\x55\xa1\xd5\x96\xdd\x61\x54\xf5\x1d\x56\x98\x1f\xa3\x63\x5c\xb6\xa3\x61\xeb\x10\x73\x89\xe5\x3e\x0f\x7e\x7a\xf6\x7f\x53\xf8\xf9\xfc\x19\xfe\xef\x47\x4c\x98\x9f\xcf\x7f\x1a\xa7\x44\xdd\xcb\xf2\x80\x26\x59\x0e\x67\xa9\x0a\x98\x21\x9a\xdb\x5e\x4b\x38\x4d\xd6\x71\x79\x14\xde\x33\xfc\xfd\xe3\xd4\x2d\x8f\x03\x71\x06\x4f\xcf\xcf\xe1\xcc\x07\xd0\x07\xd1\x34\xc2\xf0\x52\xc9\x6a\x1a\x58\x44\x3a\xfc\xfd\x73\xa0\xbc\x4a\x88\x7c\x74\x38\x11\xee\xbf\xf3\xe2\x99\x3f\x7d\x08\xea\x99\xc7\xda\x6b\x08\xb0\xb4\xb6\x2b\x7c\x04\x5d\x2b\xf5\x81\xc9\xf5\x25\xff\xb3\xe7\xc6\x9a\xe9\x3e\xc5\x6b\x56\xbd\x67\x96\xaf\xd8\xfa\xc0\xe6\x15\xd7\xf7\xa2\xe4\x9f\x25\xbb\x67\xa2\xc1\x22\x73\x80\x88\x8e\x5f\x8b\x96\xab\xde\x7a\x82\x2d\xfe\xd9\x92\xf3\x63\x85\xda\x29\x81\xbb\xad\xc3\xfb\x31\x29\xa5\x43\x11\x05\x31\x14\xe0\xc9\x3d\xd3\x07\x79\xce\xa1\x65\xdd\x8d\xb1\x5a\xc8\xaf\xb7\x0b\xa5\x1a\x04\x64\xb3\x79\x8c\x89\xf8\x95\x43\x11\x49\xdf\x6b\xd5\x77\x06\xb6\xdb\xcd\x66\x6f\x8f\x96\x45\x0d\xc5\xaf\xcc\x44\xb4\xb1\x59\x22\x33\xe8\xb4\x90\xb6\x86\x93\xff\xf9\xf3\x24\x36\xc6\x19\x6e\x14\x29\xed\x94\x24\x73\x59\x79\x7e\x3b\xff\x40\x64\xd0\x8c\xa1\x0f\x7e\x70\x6d\xf0\x88\x11\x0e\x6d\x4f\xf1\x9e\x5b\x1f\x15\xd8\x65\xa6\xe3\xbd\x5f\x39\xab\x66\x47\xf6\x3e\x76\x58\xa9\xcc\xec\xd0\xde\xa7\xfe\x38\xcf\xb7\xbc\xe1\x96\xcf\xc2\x9e\xf7\xe8\x1f\xc2\x2e\x9d\xb9\xb0\xd2\x58\x55\x19\x58\xcd\xa4\xe9\x94\xb6\x53\x30\x6a\x77\x28\x30\xd8\xe7\x5d\xf5\x16\x36\x2d\x51\xc0\xca\x52\x69\xdf\x46\x14\x30\xe8\x5c\xa2\x85\x86\x79\xbd\xe4\xb0\x50\x95\xc0\x1a\x21\x2b\x6a\x10\xd8\x07\x1c\xbb\xc5\x91\xd1\xa3\x75\x2d\x65\x90\xe0\xa4\x75\x0d\x5b\x87\x8e\xe9\x1a\x08\xd5\x95\x19\xca\xb1\x4b\xbe\x26\xba\x95\xea\xe5\xd0\xee\xd7\x20\xda\xae\xe1\x2d\x8a\x13\xaa\xb8\xe2\xfc\x0e\xab\xa4\xc2\x79\x61\x25\x0c\x4f\x4f\xb2\x0a\x43\xb4\xe5\xad\xd2\x07\x5a\x1a\x89\xa3\x12\x14\xd1\xcb\x22\x6a\xa0\x7b\xe9\xaa\xc5\x1b\x37\x62\x5d\x0f\x68\x7a\x4c\x46\x15\x2a\x3f\x46\x3d\x2a\x5d\x88\xc0\x3a\x6e\xe1\x0e\xc0\x0e\xfd\x2c\x71\x9b\xdb\xf7\xc2\x86\xaa\x43\xd2\x31\xcc\x80\xda\x59\xb2\x89\x0b\xc5\x05\x5f\x65\xe1\x1f\x57\xaa\xd7\x25\xcf\x9c\x6a\x17\x6a\x95\xe5\xc5\x67\x29\x1e\x2e\x98\x54\x59\x9e\xe7\xb1\x24\xb8\xd1\x64\xac\x5e\x3a\x9d\x1c\x31\x6e\x02\x87\xb0\x98\x4c\x00\xda\xde\xa9\x63\xd6\xb2\x2c\x3e\xf4\x96\x3f\x4c\x82\xb2\x70\xe6\x54\xbb\x64\xb2\xa2\xc8\xbd\xba\x13\x9d\xf9\x0f\x6b\x44\xe5\xb2\x9d\x26\x5e\xe1\xfb\x37\x06\x73\xc7\xab\x01\x15\xa8\x84\xc1\x22\xe3\xdb\xfd\xfd\x70\x4c\xd5\xd0\x31\xcd\x5a\xe3\x3d\x9a\x59\x38\x1b\x1b\x94\xef\x4a\xca\x72\xc0\x92\xe4\x2c\x34\x77\xa2\xeb\x5c\x28\xdd\xc1\x6c\x0e\x76\xd7\xd4\x22\x13\xd2\x72\x5d\xb3\x92\x6f\x8e\xf1\xd9\xe6\x83\xaf\xd5\x1d\x9c\x9e\x06\xae\xc5\xde\x81\x60\x7a\xbf\x68\x5d\xcf\x96\x95\xd9\x19\xe7\x62\x67\x15\x76\x9c\x93\x68\xb6\x47\xfd\x3b\x86\x3a\xbe\x99\xea\xe0\x6c\xec\xba\x58\x53\x73\x48\x0c\xda\x4e\x81\x6b\xad\x74\xee\xa0\x40\xe4\x0b\xf2\xeb\x7c\x0e\x52\x34\xf0\xed\x5b\x5c\x2a\xd2\x69\xf2\xc5\x1c\x9e\xe2\xe6\x0f\xb6\x40\x15\x04\x37\x99\xea\x3c\x97\x88\xc4\x3e\x94\x51\x3b\xc4\x6b\x8b\xe1\x42\x35\x65\x36\x07\x89\xb1\xeb\x8a\xc3\x6b\xb7\x86\x24\x15\xaf\xb9\x26\x9a\xe2\x4d\xa3\x0c\xcf\x72\x3c\x85\xb3\x0f\xa5\x31\xfa\xec\xe9\x73\x78\x1e\x7e\x3f\x7a\x44\x4a\x60\x45\xc7\x51\x83\x06\x5f\x80\xd8\xa9\xfc\x9c\xe5\xab\xba\xdb\x29\x7b\xad\xb1\xae\xcc\xe6\x70\xa6\xba\x74\xa9\xf8\xe4\x02\x0b\xe6\x3b\x59\x4e\x3d\xfc\x0f\x2d\x2c\xd7\xbf\xf4\xb2\xcc\xd0\x23\x99\x3e\x4c\x36\xc5\x61\xad\x65\xd6\x60\x66\xd5\xad\x2d\x2e\xf9\x57\x61\xac\x5e\xe7\x1e\x7d\xd2\x38\xa0\x51\x5c\xf2\x95\x90\x55\x96\xd3\x6a\x08\xac\x8e\xb4\x29\x9c\xd8\x6b\x45\xdc\xb3\x53\x0f\x1b\xfd\xdc\x8c\x44\xcf\x40\x4f\x89\xed\x8c\xfe\x6e\xa3\x3a\x5e\xc0\x36\x1f\x59\x7c\xe9\xe6\xcf\x03\x16\xfb\x11\xcf\x6f\x27\x26\x87\x49\xf6\x30\xf9\x14\x4a\x25\x4d\xdf\xf2\x04\x1a\x5a\xf8\x4e\x20\xa2\x5a\xce\x77\xf3\x38\x5a\x16\x38\x7f\xa7\x90\x04\x3f\x26\x24\xef\xb9\xc5\xae\xcb\x75\x76\x92\x4c\xd4\x27\xfb\x38\x7a\x23\xdc\x9f\xa0\x68\xa6\xf7\x34\x8e\xf0\x50\x50\x9b\xbe\xb1\x4e\xcd\xc3\x75\x82\x82\xfb\x94\x80\xf4\xa7\x45\xed\x0e\x0c\xd9\x14\xc2\xf6\xe5\xfc\x70\x62\x7d\xfb\x46\x6e\x2a\xf0\xdc\x0f\xf1\x1c\x25\x9a\x9b\x69\x32\xc4\xc6\x69\x32\xc0\x45\xc6\x25\x5a\x3a\xf9\x2e\xc9\x80\x2e\x09\x4e\xed\x85\x1f\x77\x33\x52\x24\xaa\x89\xd7\x8b\x50\x04\x3b\xa6\xd1\xd3\x01\xe3\x6c\x80\x3b\x7f\x4e\x05\x0e\xc9\xe1\x25\x31\x0e\x4a\xf8\x5f\x73\x77\x55\x21\xf1\xc4\x5d\x75\xc5\x1b\x25\x2d\xde\x67\x08\x8b\x70\xc6\xc5\xd8\x55\xc3\x79\x97\xb9\xe3\xc1\x5b\xa5\x92\x56\xc8\x9e\x27\x7c\x90\x54\xa3\x7e\xf8\x0f\xec\x71\x38\xe6\xea\xf4\x98\xe1\x0d\xa7\xce\x05\x50\x32\xc3\xe1\xc5\xe3\x41\x74\xf1\x56\x49\x9e\xe5\xb3\x44\xb2\x2e\xae\xac\xea\xb2\xfc\xfb\x28\x12\x27\x14\xab\x8b\x37\xb3\xa8\x52\x3a\x54\xe3\x68\x14\x1b\x58\x5a\xd1\x77\xa6\xa0\xe3\xb5\x7b\x28\xa4\xdf\x29\xde\xb1\x6f\x89\x7a\x98\xbb\x83\xdf\xe2\x42\x3c\x60\x6e\x54\x57\xfc\xf6\xf6\xd6\xb9\x6d\x54\x9d\x23\xad\x2b\xc5\x71\x79\x6f\x08\xa6\xf1\xd7\x14\xd7\xea\x33\x36\xca\x4c\x85\x69\x34\xbf\x4d\xcc\x77\xac\x46\x00\x84\x48\xf7\xef\x4f\xe1\x46\x31\xd0\xba\x7c\x9f\x01\x17\x38\xc2\x1d\x7b\x3b\x71\x77\x4d\xac\xf6\x92\x4a\xa5\x7b\x85\x09\xf7\x4e\xff\x4c\xf3\x7d\x44\x87\x8c\xc1\x4e\x30\x45\x36\xa1\xd4\xa4\x58\x3a\x82\x1f\xe6\x70\x4e\xc1\x83\x2d\xe6\x0b\x35\x63\xe4\xe0\xe0\x75\xd7\x93\x98\xb6\x87\x6e\x7f\x74\x7a\x60\x39\x4f\xbc\x12\x37\x23\xda\x38\xc7\xd3\xda\x36\x09\x74\xda\x75\x0f\x64\xe4\x9e\x2f\x53\x10\xe6\x82\xdb\x77\xa8\x3a\x2a\xc3\xb5\x2e\x32\xc9\x6d\xe1\x56\x92\x01\x64\x20\x23\xf7\x50\xc6\x43\xa9\xda\xae\xb7\x7c\xfc\xee\x12\x87\xe3\xf4\xad\xe1\x38\xa2\x3b\xd5\x03\x21\xcd\xc7\x6f\x33\xce\xc8\xa4\xde\x10\x58\xe3\x4b\x39\xf5\x70\x41\xdd\x5b\xc0\x8b\x58\x18\x4f\x4f\x21\x8b\xa7\x92\x07\xa0\x17\xe8\x9b\x6f\xdf\x48\xf1\x17\x70\x80\x26\x7f\x0e\x22\xf6\x7f\x4f\x77\x36\x87\x67\x84\xa0\xa8\x0f\x9d\x81\x97\x70\x8e\x03\x9b\x27\x7f\x79\x90\x24\x65\x38\x3f\x44\x71\x40\x02\xbd\x28\xbd\x8c\x01\x65\x8b\xb6\x2f\x7e\x57\xe5\x1d\x15\x1b\xcf\xee\xf1\x7c\x8c\x5e\xb6\xcb\xe0\x0c\x6c\x81\x73\xb3\x6a\x8b\x5f\xfc\xeb\x14\x3e\x91\x84\x97\x2a\xaa\x7d\xf9\x20\xe1\xb3\x6c\x82\x8c\x24\xab\x1d\x19\x85\xc3\x4e\x71\xc7\xd9\xd9\x50\x50\xdc\xb3\xa6\xe7\xa0\xea\x23\x4f\x52\x31\x55\x87\x57\x49\xff\x96\x62\xf0\x89\x87\x41\xc5\x2c\xf7\xa1\xb3\xdb\x40\x3c\x63\x5f\x49\x72\xc8\x46\x26\xe3\x7c\xa2\x9a\x38\x81\x7a\xd2\xf9\x1c\x4e\x4e\x08\x39\xb2\xe1\x7c\x3a\x4a\x08\x11\x85\xc7\x9e\x6c\xac\x2e\x95\xbc\x2f\x5e\x59\x25\x32\xc7\x27\x7f\x9e\xb6\xdf\xd3\xd3\xa8\xef\xcb\x21\xd3\x89\xfd\xd8\x0d\x44\x97\xef\x3c\x07\x85\x84\x25\x05\xd0\xe0\x28\xdd\xdd\xfd\x3f\xa1\xe1\xd8\x96\x0e\xc9\xdf\x97\xf7\x59\x5a\xd1\x64\xc8\x26\x1f\xf1\xde\x35\x39\xd4\xd9\x64\xce\xa3\x5f\xde\x71\x07\xaf\xe6\xce\x8f\x9a\xa8\xf7\xae\xdb\xe1\xe6\x97\xb2\x3c\x7a\xf1\x23\x82\x61\x60\x3f\x4b\x47\x75\x0c\x2c\xe7\xf6\x4c\xc3\xd9\x88\x63\x0e\x57\xdc\xbe\x56\xd5\xda\x4d\xae\x59\xc7\xd6\x8d\x72\xb7\xf3\x38\xf7\xa5\x23\x30\xb6\xb4\x2a\xde\xc4\x88\xb8\xc8\x84\xa2\x81\x2d\x4f\xba\xd8\x42\x55\xeb\x08\xbd\x2e\x68\x66\xba\xe0\x0f\x36\xd3\x95\x1e\x0d\x5f\x34\x44\x85\xe2\x4b\xd8\x0e\x13\x12\xfe\x3f\x68\x36\x47\x03\xd7\x63\x2f\xe8\x31\x08\xc5\x21\x93\xf2\xbf\xc1\xe0\x17\xd1\x70\x8f\x81\xc4\xef\x37\x3e\x13\xa6\xe4\xa8\xa2\x28\x02\xdc\xf8\x88\x55\xa1\xb5\xee\xb6\xa3\x53\x74\xe2\xcb\xc9\x0c\x9f\xa5\xee\x78\x76\x73\x7b\xe4\xd4\x14\x03\xa7\xe1\x32\x73\xec\xf3\x9c\xaa\xed\x17\x2f\x6f\xe8\x64\xf8\xcb\xfc\x3d\x9e\x48\xf6\xaf\x01\x8d\xda\xce\x01\xef\xf1\xb2\xa2\xcb\x1d\xbe\xbf\xef\xa9\xcd\xb5\x53\xd5\x3d\xe1\x65\x39\x16\x84\x6a\x9d\xe7\x7f\xeb\x85\x31\xa8\xd3\x88\x50\x51\x14\xf9\x28\x65\x7c\x98\xc2\x52\x35\x95\xa1\x07\x6b\xbc\x00\xa4\x8f\x61\xb8\x7a\xec\x13\x4b\x78\x04\xbb\xa4\x53\xf8\x3e\xd5\x32\x8b\x5f\x83\x86\xc3\x4a\x0e\x5f\x02\x3a\xcd\xef\x85\xea\xcd\xc0\x31\xbc\x90\x69\xbc\x5b\xc5\xa7\x2a\xc3\xf1\x4b\x0d\x69\xe4\xcd\x4a\xd3\x92\xd4\x4e\xb2\x92\xb2\xef\xe6\x96\x42\x0c\x53\x6b\x02\xbe\x77\x03\xdd\x74\xd1\x87\x00\xe8\x0e\x3f\x01\x8c\x19\x8e\xbe\x3d\x19\xf7\x46\x04\x31\xc1\x26\x00\xaa\xae\x51\x2b\x21\xfd\x77\x90\x8a\x59\x06\x00\x37\xb7\x8b\xb5\x0d\x65\xc8\x5f\x4f\xa1\xd3\xbc\x63\x9a\x3a\x87\x57\x67\xf8\x12\xe2\x81\xc2\xa8\x63\x78\xb3\x0f\x40\x50\x8a\x2c\xe0\x6c\x74\xd5\x27\x96\x99\x6f\x02\x8b\xc2\xd9\x33\x87\x73\x12\x88\x51\x48\x61\x60\x5c\x49\x43\xee\x64\x12\x8e\x82\x71\x69\x1f\xcf\x63\x02\x91\x65\xb6\x6b\x3e\xde\x48\x55\x91\xe6\x51\x72\x27\xf5\x6f\x11\xc8\x0e\xd5\x24\x25\x1f\x3d\x82\xad\xeb\xe7\xa2\x0e\x6a\xbf\x70\x99\xb7\xa0\x0c\x0a\x17\x34\x8c\x68\x4c\xbc\xb0\x7e\xe3\xa9\x6f\x43\x5a\xe1\x7e\xe1\xc0\x3e\x9c\x5c\x42\xf5\x56\x34\xc5\x85\xea\xbc\x6a\x19\xfa\x03\xf3\x73\x45\xf9\x13\x19\xe4\xf9\x14\x19\x24\xa9\x28\x6a\xf8\x12\x53\xdb\xd1\x79\xc3\x5d\x65\xf5\x0f\xa9\xb9\x7b\x50\xcd\xdc\xa6\x8f\x80\x69\x78\x65\xbd\xb2\x4c\xdb\xfc\xf9\xa1\xc4\x5f\xb8\x3b\xea\x3c\x66\x7e\xd4\x56\x8a\x26\xbd\x82\x7e\xd7\x8e\x41\x9f\xa8\x78\x78\x19\x72\x13\x24\xbd\x6e\x60\xa4\x6f\x3c\xdd\x0c\xfc\xdf\x38\x05\xd0\x53\xb0\xeb\x1a\xfb\xa6\x25\x4d\x23\x98\x46\x58\x18\x47\xe0\xe8\xb2\xf3\x68\xf0\x9b\xe3\xf7\xf7\x68\xf8\x80\x13\xcc\x29\x65\xc2\x16\x39\x78\xa8\x7b\x61\x85\xca\xda\x18\xa9\x3d\x3c\x76\xa0\x08\xf7\x4c\x9f\x89\x51\x73\x3a\x86\xbe\x7f\xd5\x34\x14\xc8\x14\x87\x25\xc5\xee\x1e\x1e\xd4\x52\x12\x3c\x3c\x69\x7c\x59\x8b\x73\xcd\x9e\xaf\x77\x3d\xbd\xef\xe7\x2d\xb9\xcc\xc5\x20\xcc\x9d\xba\x93\x7f\x06\xc7\x3f\x0d\xf1\x34\xba\x7d\x61\x70\x8a\x7b\x2b\xc6\x75\xdd\x7f\x7e\xc0\x4f\xf3\x54\x26\xa8\x52\xab\x1e\x3f\xe5\x63\xcd\x18\x7f\x25\x38\x56\x26\x08\x99\xa4\x0b\x53\x23\x0d\xd1\xe9\x1b\x69\xb4\x72\x13\x82\x66\xec\x84\xdd\xa4\x4b\x3d\x71\x7a\x9a\xe2\x36\x8e\xb3\x3d\xff\x0c\xe1\x30\x78\x60\xb2\x9d\x6c\x36\xc0\x65\x05\xdb\xed\xe4\xbf\x03\x00\x62\x88\x01\xc9\x5f\x22\x00\x00")
+
+func templatesClientRetryGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesClientRetryGotmpl,
+		"templates/client/retry.gotmpl",
+	)
+}
+
+func templatesClientRetryGotmpl() (*asset, error) {
+	bytes, err := templatesClientRetryGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/client/retry.gotmpl", size: 8799, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesClientSignatureGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x94\x41\xce\x9b\x30\x10\x85\xf7\xff\x29\x46\x59\x91\x0a\xf9\x0e\x08\xa9\x6a\x37\x6d\x94\x2c\xb2\xb6\x60\x02\x96\x8c\x8d\xc6\x83\x1a\xc5\xf2\xdd\x2b\x30\x89\xd2\xd0\x00\x49\xbb\xf8\x77\x86\xb1\xfd\x3e\xbf\xf1\xb3\xf7\x50\xe2\x49\x19\x84\x4d\xa1\x15\x1a\xfe\xd9\x22\x49\x56\xd6\x64\x54\xb9\x0d\x84\xe0\x3d\xa8\x13\x88\xa3\xe2\x3a\xb7\x86\xf1\xcc\x10\x42\xc1\x67\x28\xe2\x97\x18\xff\xa6\xd0\x4a\x92\x8d\x83\x2f\xde\x43\x2b\x5d\x21\xb5\xba\x20\x88\x1f\xb2\x41\x08\x61\x37\x14\xc7\xcd\xbe\x49\x77\x60\x42\xd9\x28\x53\xed\xd1\xb5\xd6\xb8\x7e\x4e\x0a\xbf\x48\x31\x12\x28\x2b\x8e\xc3\xc8\x7b\x40\x53\x0e\x25\xdb\xb2\x03\x21\x44\x3e\x62\xf6\x8c\x7d\x59\x0f\x4b\xd7\x6b\x67\x1d\xd7\x96\xd4\x05\xe3\xb6\xb2\xe3\xfa\xbb\x39\x59\xa0\xce\xb0\x6a\x70\xdc\x3f\x1b\x7f\x5f\x31\xd0\x94\x21\xbc\x4d\x3f\x1d\x7c\x3c\x37\x3e\x97\x5a\x2f\x99\x7f\x35\x7b\x1d\xd1\x4d\x35\xba\x28\x84\x78\x34\x6e\xde\x9b\x97\x8e\x7f\x13\x7b\xe9\xd0\x7b\x74\x9d\xe6\xfb\x33\x1f\xba\xa2\x40\xe7\xee\x14\x12\xef\x81\xa4\xa9\x70\x52\x74\x10\xc2\xdf\x5b\x9f\xc2\x14\x03\x89\x2c\x3d\x55\xd9\xbe\xc4\x7d\x50\x95\x91\xdc\x11\x8e\xe4\x53\x82\x9e\x9a\xb1\x69\xb5\xe4\xe9\xf2\xd8\x67\xd1\xcb\xc2\xdc\xbc\x9b\x3d\x62\x25\xd7\x4e\x56\x48\xcb\x70\x99\xd6\xc9\x3f\x66\xf9\xcf\xbb\xb9\x94\xd3\xf9\x8b\xb6\x32\x84\xb1\x2b\xdb\xa7\x74\x15\xd2\x6a\x8f\xbe\x22\x17\xf5\x6c\xdc\x62\x42\x1e\xad\x79\x3b\x4a\x23\xd7\x74\x30\x43\x1a\xc3\xbd\xdc\xce\x38\x2f\xf9\xaf\x4f\xf6\x67\x79\x79\xaf\x4d\x4f\x94\x15\x7b\x94\x65\xae\xad\x43\x4a\x61\x48\xf3\xd6\x7b\x40\x53\x42\x08\x1f\xbf\x07\x00\x42\xe4\x3b\x39\xd2\x06\x00\x00")
+
+func templatesClientSignatureGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesClientSignatureGotmpl,
+		"templates/client/signature.gotmpl",
+	)
+}
+
+func templatesClientSignatureGotmpl() (*asset, error) {
+	bytes, err := templatesClientSignatureGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/client/signature.gotmpl", size: 1746, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -236,6 +493,26 @@ func templatesHeaderGotmpl() (*asset, error) {
 	return a, nil
 }
 
+var _templatesMarkdownDocsGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x5f\x6f\xdb\x38\x12\x7f\xd7\xa7\x18\xc4\x5d\xc0\x16\xd6\x5a\xe0\x1e\x83\x6e\x00\x37\x69\x6f\x8d\x6b\xcf\x46\x9c\xee\xcb\xa2\x40\x58\x89\x8e\xd9\x4a\xa2\x4a\xca\xdb\x7a\x65\x7d\x8a\x7b\xbd\x4f\x77\x9f\xe4\x30\xfc\x23\x91\x32\xdd\xda\x69\x16\x68\x1e\x62\x71\x38\x33\xfc\xcd\x1f\xce\x90\x6c\x9a\x29\x64\x74\xcd\x4a\x0a\x17\x05\x11\x1f\x33\xfe\xb9\x5c\xb1\xa2\xca\xe9\xdd\xae\xa2\x17\xd0\xb6\x11\x00\x32\xb1\x35\x24\x73\x39\x2f\x6b\x2a\xd6\x24\xa5\xd0\xb6\xcc\x7e\x37\x96\x87\xe6\x92\x2a\xc6\xd5\x67\xf2\xf0\x40\xc5\x2b\x2e\x0a\x52\x43\xdb\x36\x4d\x47\x43\xb5\xd0\xb6\x30\x6e\x9a\x43\xbe\x89\xab\x28\x24\x66\xe7\xcb\x0c\x91\x39\x9f\x51\xc8\x90\x79\x4d\x0b\x19\xb4\x63\x26\x04\xd9\x41\xdb\xfe\xf1\xae\x69\xe0\x33\xab\x37\x90\x5c\x6f\x58\x8e\xaa\x9a\x06\x6a\x5a\x54\x39\xa9\xc3\x9a\x12\xcd\x63\xd6\x1d\xe2\x3d\x14\x75\xbd\x99\x9c\x6d\xc2\x2a\xdd\xd0\x82\x9c\x6a\x83\x32\xf8\x28\x10\x47\x95\x35\x22\x97\x83\x48\x06\x2d\xc3\x90\xce\xe5\x1b\x52\x41\xdb\x16\xa4\x02\xbe\x86\x6e\xc9\x59\x96\xb1\x9a\xf1\x92\xe4\x4b\xc1\x2b\x2a\x6a\x46\xff\x1e\x04\xa4\xcc\x10\xc5\x35\x47\x77\x7e\x59\xbc\xff\x40\xd3\x1a\x09\xb3\x92\x97\xbb\x82\x6f\x71\x55\xae\xa8\x03\xc1\x92\xd7\x30\xe6\x02\x79\x97\x82\x15\xac\x66\x7f\x52\x1c\x5c\x6f\x65\xcd\x0b\x9d\xa3\x35\x15\x7e\x7a\x27\x73\xb9\xaa\x05\x25\x85\xb7\xc4\x04\xdd\xdd\x34\x90\x09\x5e\x2d\x49\xfa\x91\x3c\x50\x48\xfe\xc9\x4d\x72\xbe\x1b\x8f\x70\x8e\xc8\x0d\x15\xec\x2f\x0a\xe3\x00\xdb\x24\x98\xe5\x4f\x9d\x35\x4b\x22\x48\x11\x4c\x9a\x17\x3c\xdb\xa9\x59\xbd\xb0\x4e\x1b\x1d\x97\x73\xa2\x76\x24\x45\x9e\x6a\x5b\x35\x8d\x52\x78\xcd\xf3\x9c\xa6\x98\x5d\x5d\x85\xd0\x75\x23\x30\x31\x09\xc2\xfa\x7b\xbc\xdb\x25\xfa\x05\x4c\xdb\x36\xda\xc3\xbf\x49\x41\x61\x0f\xe8\x23\xd8\xc3\x2d\xfd\xb4\x65\x82\x66\xb0\x87\x1b\xba\x26\xdb\xbc\x56\x5f\x32\x15\xac\x42\xcc\xb0\x87\xdf\x49\xce\x32\x82\x03\x09\x7b\x78\xf9\x85\x60\xb4\x61\x1f\xed\xa7\xea\xcf\xfe\x5c\x4e\xcd\xdf\xa5\xa1\xf4\x73\xdf\x18\xed\x15\x72\x41\x4a\xcc\x50\x6f\x67\x46\x7b\xdc\xbc\xc9\x42\xb0\x07\x56\x92\x5c\x41\x6f\x5b\xd8\x43\xd8\x53\x83\xe0\x6b\x3e\x0c\x4e\x67\x65\xdb\xfe\xef\xbf\xff\xe9\xbc\xdf\x33\x58\xdb\xdb\xf6\xbe\x69\xe0\x83\xe4\xe5\x90\xe6\x4a\xd8\x05\xef\xc8\xfb\x9c\x5e\xd3\x3c\x87\xc4\x75\x59\x97\x13\xb7\x94\x64\x8b\x32\xdf\xf5\x14\x9f\x0d\x3a\xbd\x63\x41\x49\x06\xbc\xcc\x77\x93\x6f\xae\x35\xb6\x24\x37\x30\xc9\xa4\x93\x40\x7b\x6c\x94\x34\xf6\x43\x1d\x03\x06\xbb\x60\x74\x46\x66\xbd\xe1\x19\xcd\x75\x52\x75\x1b\xe8\x8e\xd5\x4a\xe7\x15\xe2\x48\x8c\xa8\x52\xe8\xb1\x1d\x38\x2b\xc4\x8a\x66\xcc\xf2\x7c\xb1\x56\x84\x38\xc6\x62\xca\x25\xcd\x80\xaf\xe3\x18\x19\x4c\xc2\x68\x1e\x03\x98\xad\xbd\x1a\x88\x6a\xe3\x78\x5e\xe6\xac\xa4\x19\x54\x5d\x6a\xc5\x71\x14\x05\x53\xc8\xdd\x2e\x0a\x54\x5f\xf6\xa3\xf8\xb4\xac\xb3\x66\xf8\x2e\xf4\x8a\x8f\x93\xe3\xda\xb6\xe5\x77\x20\xd3\x1a\x70\xfd\x38\xbe\xb4\xf1\x1f\xf6\x1e\xdb\x6e\x7a\x73\x4e\xb2\xc5\x35\x45\xc7\x2e\x98\x7b\xc8\x10\xc7\x0e\xc9\x20\xf9\xba\x3b\x6c\x9f\x0c\x76\x65\xd5\x04\x9d\xb1\x0e\x33\x26\x79\x14\xc7\xbd\x84\x17\xd3\xcb\x13\x02\x14\x5c\xec\x00\x25\xba\xf0\x86\x61\x92\x16\xac\x24\x35\x17\xaf\x18\x55\x9d\x21\x8a\x63\x8f\x6e\x0d\x0d\x33\x37\xcd\xa9\x9b\xe9\x96\xca\x8a\x97\x92\x5e\x78\x08\xbc\x6d\x82\x10\x03\x24\x67\x81\x41\x83\x8c\xe2\x58\x7f\x9f\xe6\x99\x43\x7d\x88\xe1\x37\x4a\x32\x2a\xf0\xbc\x12\xc5\xb1\x45\x09\x1b\x4d\xc5\x64\x1d\x36\x94\x93\xba\xc8\xb0\x77\xd8\x4e\xe0\x7c\x05\x46\x6e\x9b\x70\x70\xa9\x22\x9e\x78\xbd\xe1\xc9\x3b\xfc\x39\x0d\xba\x93\xeb\xb1\xfc\x46\xe4\x13\x77\x98\xc7\x34\x07\x7f\x03\x86\x52\x73\xd4\x39\xaf\x5c\xf3\xae\x9a\xa3\x7b\x87\x84\xde\x21\x9b\x6d\x41\x4a\x3c\x42\xda\x10\xb8\xaa\x7b\x65\x3e\xfc\xa8\x69\x8e\xd2\x8d\xf0\x68\x04\xb8\x28\x1e\x99\xd0\x88\xbe\x7b\x20\xd5\x4d\xd1\xdf\xa9\x90\x46\x7a\x34\x1a\x81\x19\xe2\x3e\xf3\xe6\x5c\x58\x5a\xf0\x35\x4b\x69\xa9\xcc\x50\x82\x66\x18\x59\xd8\x66\x9c\xbc\xbd\x7d\x6d\x8e\xd2\x2e\xb5\x37\x36\x40\xea\xbd\xd3\x4d\x6a\x2d\x1d\x88\x77\xe3\xc3\xc9\x49\x58\x32\xe4\xd6\x81\x25\xd7\xbc\xac\x49\x5a\x5b\x4b\xcc\x30\xea\x7d\x66\x28\x2e\x6a\xef\xfc\x71\xc0\xf8\xb2\x20\x2c\x87\xb6\x7d\x6e\x58\xaf\x8e\xb3\x6a\xcb\x6c\xbd\xff\x1a\xcc\x3b\x2a\x0a\xb9\x58\xaf\xa8\xf8\x93\xa5\x9d\xdf\x15\x15\x16\x6b\x30\x74\x05\x3b\xc0\xeb\xeb\x73\x3e\x35\x9c\x97\x5f\x6a\x2a\x4a\x92\xdf\xf0\x54\x5a\xd5\x96\x06\x19\x4f\xb7\x05\x2d\x6b\x95\x4b\x51\x64\x83\xe9\x27\xdf\x61\x81\xf5\x03\x12\x0c\xa1\x09\x9d\x63\xad\x09\x00\x2d\x6b\x28\xe9\x03\xaf\x99\x59\x14\x6d\x7d\x7b\x3b\x07\x55\x94\xa9\x74\x8e\x30\x86\x82\xa8\x01\x62\xa7\x73\x3a\x3b\x53\x6b\x95\x5b\x5f\xd2\x92\x5c\xd1\x37\x34\x63\xc4\xbe\x41\x0c\x75\x2c\x05\xcf\xb6\xa9\xa7\xc3\x92\x4e\xd1\x61\xfc\xb6\xa2\xe9\x56\xb0\x7a\x77\x83\xb7\x0d\x75\xa5\x36\x2e\x87\x59\x9a\x52\x29\x21\xe5\x65\x2d\x78\xae\xd7\xb4\xdc\x21\xcb\x8f\x29\x1a\xa9\x52\x94\xcc\x6f\xfa\xb3\xf3\x5c\xce\x96\xf3\x7f\xd1\xdd\x6c\x5b\x6f\xfa\x57\x19\xbe\x15\x2a\x95\x2e\xdd\x2e\xe0\x5c\xb0\x82\x91\xfe\x76\x2f\x35\x37\x50\x22\x59\x6a\xd6\x8b\xae\xa0\x3f\x66\xbd\xc7\x09\xff\x50\x37\x80\xe7\xb1\x93\x8a\x7d\xa4\xbb\x21\xff\x02\x59\xff\x31\xe4\xe5\x04\xa9\x4a\xfc\x55\xce\x3f\xdb\x13\x06\x7e\xbb\xe0\x50\x96\x0b\xf6\x97\x4a\x2e\x9d\x84\x4a\xc6\xa3\xc3\xdb\xdb\xd7\x56\x41\x48\xc0\x8b\xac\xd9\xa3\xfc\x23\xb5\xd3\xa8\x4f\x8d\x5d\x3d\x2e\x43\x40\x7e\x95\xf2\x4a\xe7\x12\x4a\xeb\x91\x11\xd5\x31\x7f\xc6\x7e\x86\x67\x12\xe9\x70\xf9\xab\xc3\xaf\x03\xf5\x8c\x41\xdb\xfe\xec\x15\x26\xc3\xec\x97\x16\x6f\xdd\xee\x33\x40\x1c\x8d\x60\x96\xe7\x38\x51\x71\x56\xd6\x6e\xf2\x2d\x2a\x2a\x94\x37\xe4\x8b\xdd\x1d\x79\xb0\x45\xc3\xc9\xa3\xc7\x64\xcf\x1e\xde\xd0\x7a\xc3\xf1\x46\x8d\xbb\xbd\x3b\x1f\xad\xb6\x45\x41\xc4\xae\x3f\xfc\xd8\xf3\xcd\x7e\xea\xfe\x0c\x0f\x3a\x3d\xc8\xee\xac\xb3\xad\x2a\x7c\x02\x32\xcb\xd8\xb3\x40\x9a\x53\x52\x2e\x49\xbd\x81\x71\x25\x58\x59\xaf\xe1\xe2\x27\xf9\xcb\x4f\x78\x5d\x78\x41\x24\x55\x33\x09\xfe\x37\x37\xc5\x3f\xbc\xf7\x1f\x6b\xf1\xf0\x5d\xc8\xd2\x27\x47\xcf\x25\xd6\xae\xe3\x27\x8c\xd1\x08\x70\xdd\xb0\xeb\xad\xd7\x9f\xcb\x8a\x94\xc0\xb2\x5f\x2f\x82\xeb\x5f\x5c\x3d\xff\x05\x39\xae\xec\x09\xa5\x5f\xb6\x69\xdc\x91\x7e\x74\xb1\xa8\xfd\x02\x6e\xa8\x3d\xb2\xe8\xfe\xfe\x3e\x0a\x79\xf4\x5c\x7f\x5a\x45\x8f\x2d\x35\xb6\x8e\x77\x75\xb7\x2f\x83\x76\xca\xf1\x5e\x90\xbb\xeb\xcb\x6e\x1d\xf7\x37\x83\xbb\x6e\xff\x35\xb5\x77\xd2\x6c\x9b\x0e\x74\x6a\x04\x76\xca\x41\x10\xe4\xfe\x4e\x04\x5d\x9b\xb0\xeb\x76\x04\xf3\x7c\x83\x0d\xdc\x05\xe1\x0a\x98\xe5\x9c\x12\x23\xe8\x27\x55\x60\xbe\x5a\x5a\x04\xfd\xe4\x64\x05\x32\x21\xa5\xab\x49\x6e\xd9\xfa\xe0\x96\x2d\x9f\xcd\x88\x7e\x38\xa1\x74\x1d\x7e\x38\xde\x09\x85\x05\xdf\x3f\x9d\x50\xe0\x90\xd6\x54\x48\xe7\xe6\x65\x7a\xe0\xa3\xdf\xf4\x06\xb7\xb1\xef\x78\xd2\x73\x0b\x57\x8f\x7c\x78\x41\xc3\xcb\x4e\xf2\x9a\xa7\xc4\xec\x87\xfb\x63\x0f\x7a\xce\xd3\xf0\x99\xef\x79\x3f\xf2\x85\x4b\xed\x29\xec\x49\xc2\x5c\xa7\x55\x2c\xaf\x79\x46\x0f\xc2\x64\xee\xf2\xc3\xb7\xd6\xe9\x60\xe4\x35\x0c\x7b\x49\x37\xae\xc7\x32\x9f\x28\xe5\x8f\x29\xee\x21\x1f\x7c\xd7\x3b\x3c\xec\xc3\xa7\x77\x13\x99\xee\x85\x41\x63\xcf\x34\xf5\x07\xc4\x1d\xf4\xf6\x68\x74\x6e\x27\xb3\x91\x31\x69\x3b\x97\xab\xad\x3e\x40\xb7\x2d\x4c\x41\xea\x41\x07\x22\xfc\x32\x68\x21\x74\x6f\x38\xa7\xb9\xf7\x4c\xb0\x46\x43\x97\xb4\x8f\xc1\xe2\x7c\xe2\x2e\x56\x2f\xc8\xc6\x71\xa0\x07\x8e\x67\xdd\xd9\x33\x9d\x6a\x88\x47\x5e\x52\xcd\xc3\xf5\x57\x00\xfe\x7f\x00\xb0\xca\x19\x49\x69\x1e\x00\x00")
+
+func templatesMarkdownDocsGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesMarkdownDocsGotmpl,
+		"templates/markdown/docs.gotmpl",
+	)
+}
+
+func templatesMarkdownDocsGotmpl() (*asset, error) {
+	bytes, err := templatesMarkdownDocsGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/markdown/docs.gotmpl", size: 7785, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _templatesModelGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x51\xcd\x4e\xf3\x40\x0c\xbc\xf7\x29\x46\xb9\x37\xb9\x7f\xb7\x7e\xa2\x48\x3d\x80\x10\xf0\x02\x56\xd6\xa4\x2b\x6d\x76\xc3\x7a\x11\x05\x2b\xef\x8e\x92\x6e\xaa\x2d\x3f\x12\xaa\xb8\xd9\x1e\x7b\xec\x19\xab\x22\x71\x3f\x38\x4a\x8c\x6a\xcf\x64\x38\x56\xa8\x31\x8e\xab\x95\x2a\xec\x13\xea\x9d\x6f\xdd\x8b\xe1\x9b\x60\xd8\x4d\x75\x40\x75\x3d\x21\xfc\x8c\xfa\x96\x7a\x46\xb5\x19\xec\x3d\xcb\x10\xbc\x70\x85\x71\x6c\x1a\x6c\xee\x76\x4b\x05\x56\x90\xf6\x8c\xb8\xe4\x29\x80\xfc\xd4\x81\x96\x9c\xab\x33\x21\x3b\xe1\x23\xfd\x69\x41\xbd\x93\xed\x61\x08\x31\xb1\xc1\x3a\x43\x40\xd3\x40\x15\x03\x49\x4b\xce\xbe\x73\xbe\x61\x1c\x71\x26\xc5\x84\x56\x52\xb4\xbe\xcb\x6a\x8e\xb3\x99\xd8\x87\x34\x91\xff\x27\xe1\xc7\xb7\x61\x5e\xdb\x34\x90\x57\xea\x3a\x8e\xff\xfa\x59\xa9\xea\x89\xb9\x18\x5e\xae\x2c\xda\x8d\x95\x36\xda\xde\x7a\x4a\x21\x96\x63\x73\x7c\x55\xa2\xd7\x96\x9d\xf9\x44\xe8\x4d\xa9\x3a\xa7\x3f\x85\x85\x40\x69\xf7\xdc\x53\xf1\xab\x48\xbe\x63\xd4\xdb\x43\x8a\xf4\x30\x83\x72\xf6\xae\xd2\xcd\x23\xd9\x37\xdf\xbd\xd4\xdc\x8b\x8d\xfd\x53\x53\xbf\xda\xf6\x5b\x03\x55\x97\x9e\x8f\x00\x00\x00\xff\xff\xea\xef\x8c\xad\x11\x03\x00\x00")
 
 func templatesModelGotmplBytes() ([]byte, error) {
@@ -356,7 +633,7 @@ func templatesSchemavalidatorGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesServerBuilderGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5b\x5f\x6f\x23\x39\x72\x7f\x8e\x3e\x45\x9d\xb0\x97\xa8\x07\xda\xd6\xe0\x9e\x02\x2f\x26\x80\xd7\xde\xcd\x39\xd9\xdb\x19\x8c\xe7\x72\x0f\x86\xb1\xa0\xbb\x4b\x12\x33\xdd\x64\x2f\xc9\xb6\xd7\x27\xe8\xbb\x07\xfc\xcf\xfe\x27\xc9\x1a\xef\xdd\x64\x5e\xc6\x12\x8b\x55\xc5\x1f\xab\x8a\x55\x45\x6a\xb5\x82\x2b\x5e\x22\x6c\x90\xa1\x20\x0a\x4b\x78\x78\x86\x0d\xff\x56\x3e\x91\xcd\x06\xc5\x77\x70\xfd\x1e\x7e\x7e\xff\x09\x7e\xb8\xbe\xf9\x94\xcf\x66\xb3\xdd\x0e\xe8\x1a\xf2\x2b\xde\x3c\x0b\xba\xd9\x2a\xf8\x76\xbf\x5f\xad\x60\xb7\x83\x82\xd7\x35\x32\xd5\x1b\xdb\xed\x00\x59\x09\xfb\xfd\x6c\x36\x6b\x48\xf1\x99\x6c\x10\x76\xbb\xfc\x83\xfd\x73\xbf\xd7\x0c\xbf\xf1\x03\x17\xef\xc0\x8f\x98\x19\xab\x15\x7c\xda\x52\x09\x6b\x5a\x21\x3c\x11\xd9\xd5\x52\x6d\x11\x9c\x9a\xa0\x38\xaf\x72\x4d\xff\x43\x49\x15\x65\x1b\x50\x61\x5e\x6d\x54\x69\x04\x7f\x44\x58\xb7\xca\xb0\xda\x22\x83\x67\xde\x82\xc0\x6f\x45\xcb\x3a\x9c\xbc\x08\xb3\x1e\xc2\xca\xd9\x8c\xd6\x0d\x17\x0a\x16\x33\x80\xb9\x54\x82\xb2\x8d\x9c\xeb\xbf\x19\xaa\xd5\x56\xa9\x66\x3e\xd3\x9f\x36\x54\x6d\xdb\x87\xbc\xe0\xf5\x6a\xc3\xbf\xe5\x0d\x32\xd2\xd0\x95\xe6\xaa\x89\x65\x83\xc5\x24\x4d\x83\x85\xa6\x29\x38\x53\xf8\x9b\x82\xf9\x86\x57\x84\x6d\x72\x2e\x36\xab\xdf\x56\x5a\x8a\x1b\xd1\x44\x15\x27\xa5\x9c\xe2\x64\x06\x35\x15\x0a\xc1\xc5\x24\x99\x1d\x35\x6a\x29\xb1\xae\xd5\xa4\x62\x66\x54\xd3\x89\x96\x29\x5a\xe3\x14\xa1\x1b\xd6\x94\x35\x2d\xcb\x0a\x9f\x88\x38\x46\xbc\x8a\x94\x46\x13\x2c\x5a\x41\xd5\xf3\xb1\x59\x9e\xce\x80\xbe\xdb\x81\x20\x6c\x83\x90\x5f\xe3\x9a\xb4\x95\xba\x31\x5b\x25\xc1\xd8\x5d\x23\x28\x53\x6b\x98\xff\xf1\xd7\x39\xe4\xda\x9e\xcc\x04\x67\x8d\xc9\xe4\x6f\x3e\xe3\xf3\x12\xbe\x79\x24\x55\x6b\x4d\xb0\xc3\x45\x8f\xc2\x7e\x0f\x3d\x86\x8e\xbc\xc7\x35\x33\x36\xfb\x33\x3e\x69\x6a\x22\x0b\x52\xd1\xbf\x23\xe4\x3f\x93\x5a\x93\x5e\x7e\xb8\x81\x42\x20\x51\x28\x81\x00\xc3\x27\x18\x25\x03\xca\xa4\x22\xac\xc0\xd9\xba\x65\xc5\x21\x6e\x0b\x63\x56\x6f\xcc\xb6\xe7\xd7\xbc\x68\xb5\x03\x66\xf0\x66\x52\xfa\x4e\xef\x25\xaa\x56\x30\xf8\xd7\x29\x22\x4d\x03\xb0\x25\xac\xac\x50\xc8\x0b\xe8\xfe\xab\xc9\x67\x5c\xd4\xa4\xb9\xb3\x9e\x70\x9f\xfc\xa9\x7d\x21\xff\xb3\x9d\x97\x2d\x0d\x97\x35\x17\x35\x51\x03\x26\xce\xee\xfc\xae\x59\xda\xd2\x7e\xb8\xe2\x4c\xb6\x35\xc6\x39\xf3\xdd\x2e\xec\xaf\x1f\x84\xfd\x7e\xde\x99\xf5\x41\xf0\xb2\x2d\x26\x66\xf9\xc1\x38\xab\x68\xa5\xe2\xb5\xe3\x96\x2c\xb2\xbf\x3a\x67\x75\xb9\xa7\xcc\xd2\xe9\x8e\xed\x09\xd3\x3d\xa5\x9b\x7e\x8b\xe2\x11\xc5\xed\xb6\x55\x25\x7f\x62\x11\x1c\xbd\xdd\x8b\x0c\x76\x00\x7b\x4b\xa8\xb7\x77\x80\x9d\xfb\x3e\x61\xf5\x83\xf6\xe7\x2e\x9d\x75\xf1\x3c\x0e\x5b\xf2\xef\x89\xa4\xc5\x65\xab\xb6\xc8\x14\x2d\x88\xf2\xd3\xbc\x57\xe5\x81\xc0\xd2\x5f\x7e\xb8\xf9\x6f\x7c\x1e\x4e\x08\xf4\x91\xc0\x09\x40\x22\x50\x1c\x98\x10\x09\xec\x84\xe8\xc2\xc9\xde\xba\x43\xe6\xa6\x6e\x2a\xd4\x26\x4d\x14\xe5\xcc\x39\xf5\xc0\x64\xfd\xde\x5c\x68\x5e\xc3\x39\xcb\xdd\x0e\x2b\x89\x47\x27\xf7\xb7\xfa\x47\xbd\x19\x66\x47\x04\x50\x9e\x7f\x44\x52\xa2\x58\x82\x22\x62\x83\x0a\x28\x53\x28\xd6\xa4\xc0\xdd\x3e\xb3\x60\x83\xf5\x9b\xe0\x5f\x6e\x07\x7e\xe6\x2a\xa8\x84\xe5\x62\xbe\xdb\x19\xd1\xfb\xbd\x0e\xf7\x46\x10\x6c\x89\x04\xc6\x15\x3c\xa3\x82\x07\x44\x06\x34\x4e\x98\x67\x86\xeb\x3e\xd3\xcb\x60\xa5\x09\x37\x1a\x34\xf3\x77\xc4\x2e\xb1\xf0\x17\x61\xe7\x0d\xf3\x2c\xec\xe2\xe4\xbe\x9d\x47\xec\x9e\x34\x76\x7f\x13\x54\x69\xec\x4a\xa2\xc8\x6b\x20\xd7\x38\x31\x5f\x82\x9c\x03\xee\x7d\xa3\x0f\x7b\xca\x99\xd4\x5f\xd2\x35\x30\x8c\x29\x88\xcf\x4b\xfa\xeb\x8f\x29\x4a\xdc\x88\x21\x3c\x2e\x12\x6a\x68\x0f\xf1\x4d\xb8\xe5\x27\xb0\x8b\xd0\xba\x8d\xfe\x1b\x55\xdb\x2b\x97\x39\xec\xf7\x85\xfa\xcd\xe7\x11\xb9\xfb\x76\x19\xcf\xa7\x86\x08\x52\xcb\x57\x52\xe8\x83\x61\x66\x78\xe5\xda\x9f\xb9\xa0\x7f\xc7\x72\xbf\x5f\x9a\x73\xb2\xa0\x0d\xa9\x9c\x24\xae\x60\x01\xf8\xab\x36\x53\x3f\x30\x4f\xcc\x60\x0e\xd9\x7e\xff\x26\x28\xa9\xb3\x43\x4f\x17\x10\xce\x92\xc4\x22\xff\x88\xb2\xe1\xac\xc4\x81\xe5\x24\x34\x7d\xeb\xe1\x7e\xa3\x8f\xac\xfe\xf0\x36\xf7\x50\xd8\xef\x4f\x34\xc1\xd4\xf6\xdc\xdf\xce\x00\x6f\x5d\x60\xbc\xc6\x35\x65\x34\xb5\xc4\xfc\x46\x86\x68\x6c\x72\xec\xcb\xa6\xa9\x28\x4a\x9b\xbd\xea\x94\xd5\xa3\x6e\xd7\xb5\x35\x11\x0a\xa8\x04\x89\x0a\x9e\xa8\xda\x1a\x22\xc3\x03\x64\xb1\xc5\x1a\x7d\xc4\x4d\x96\x71\x73\xad\x4f\xfd\x56\x6d\x2f\xec\xf1\xd3\x4a\x14\x60\x8f\xaf\xa5\xa6\x93\xee\x43\x06\x8b\x2f\xdf\xcc\xa5\xf5\xed\xac\xbf\x6f\x8c\x56\xcb\x29\xb7\x7f\x30\xfa\x93\x56\x6d\x41\xab\xe0\x34\xce\x4e\x02\x7e\xd9\xf5\x79\x0b\x6a\x3c\xb2\xc6\x51\x35\x99\x83\xb3\xf1\xb9\x89\x8b\xb7\xbc\x15\x85\xcd\xcc\x0c\xb8\x27\xc0\xa8\xf8\x67\x64\xff\x6c\xe8\x48\x43\x41\x67\xaf\x06\xbc\x14\xbb\x18\x4a\xd7\x82\xd7\xfa\xa3\x5d\xa2\x8e\xad\xda\xab\xe1\x2e\xc1\xe0\xfe\x34\xa8\x7b\x28\xbf\xd7\x60\xfc\xa9\xef\x52\x87\x60\x5a\x82\x2c\x78\x83\x12\xee\xee\xff\xc9\xb8\x71\x0d\xd8\x9f\xe0\xc1\xa4\x2a\x43\xf4\x5e\x6c\x79\x23\x7f\x6b\x90\x46\x5d\xdf\x8c\xaf\x56\x3e\xaf\x35\xd2\x4d\x64\x35\x9e\x1d\x3e\x95\x50\x23\x61\xba\xd0\x65\x1c\x04\xfe\xda\xa2\x54\x12\x74\xd5\xf5\x50\xf1\xe2\x33\x96\x3e\x7d\x0b\x91\xb9\x9f\xb8\x05\x4e\x8b\x41\x78\xda\xcf\x74\xed\x7d\xa0\x8a\x70\x29\x06\x5b\xf3\x24\xe1\x60\x6b\x9e\x5f\xa3\x2c\x04\x6d\x42\xca\x31\xf8\xd6\x36\x04\x2a\xa9\x59\x69\x67\xdb\xed\x60\xdb\xd6\x84\x75\xea\x1f\x5d\x84\xc4\xdd\x74\x7f\xc0\x9b\xd5\x4c\x3d\x37\x38\x5e\x33\x69\xb5\xa4\x12\x6d\xa1\xcc\x46\x9b\xba\x28\xf9\xd7\x2b\x91\x92\x62\x3b\x50\x24\x67\x87\x3b\x38\x67\xb1\x0a\x8a\x09\xfe\xb1\xc2\x67\x16\x8a\x9e\x98\xad\xdb\x62\xe7\x23\x6e\xa8\x54\xe2\x79\x36\x28\x3f\xe0\x40\xc5\x31\x1b\x54\x1b\x63\xd4\x7e\x70\x36\x28\xa3\x9c\x73\xcd\x06\x95\x52\x1c\xf8\x4b\x2c\xd9\x63\x19\x92\xc0\xf1\x7d\x4b\xab\x12\x45\x06\x9d\x75\xce\x8c\x99\x0e\x0b\x8a\xd0\x40\xd1\x35\xae\xd7\xaf\x4b\x61\x82\x8e\xe9\xb9\xb4\x26\xf8\x96\x90\x04\x79\x2d\x5d\x5b\x4a\x6e\x05\xdc\x28\xe3\x6f\x24\x38\x44\x70\x36\x6d\x63\xd4\xf5\x6e\x7c\xf3\xc0\x9d\xe4\x4b\xd8\xf2\x27\x7c\x44\x61\x9a\x3c\x05\x61\x20\xb0\xa9\x48\x81\x40\x95\xde\x1e\xfd\xb5\xd0\xa1\x4e\xd1\xa2\xad\x88\x80\x56\x92\x0d\x6a\x89\x23\xeb\x31\x70\x04\xbf\xf9\xab\x44\xf1\x81\x48\x99\xd0\x50\xce\xb2\xf1\x95\xda\x25\x8c\x14\x51\x67\x81\x64\x83\xe5\x57\x00\xd2\xd8\x82\x2c\x4a\x3e\x90\xfb\xff\x3d\x6a\x9f\xb4\xea\x2f\x80\x6c\xa4\x8c\x3c\xcf\xae\x6c\x08\xff\x6a\x90\x1b\x5b\x57\x17\x39\x8f\xd8\xad\x3e\x0b\xcb\x17\xe0\x36\x4b\x92\x4a\xef\xfc\xbe\x35\x3b\x5d\xf7\x82\x30\x51\x49\x87\x15\x12\x2b\x52\xbd\x06\x62\x13\xa1\xbf\x60\x49\xc9\x27\x1d\x77\x75\x36\x54\xd3\x1a\x41\x47\xe1\x59\x3f\x01\x1a\xf0\x1d\xc6\xb1\x78\xc0\x04\x45\x7d\x30\x9a\x56\xd4\x47\xb6\x8e\xa2\xa1\x00\x3c\x5f\xd1\xc8\x77\x18\x42\x47\x14\x9d\x3a\xab\xc7\x33\xf5\x89\xa4\xa7\xb3\x06\x6f\x88\xa0\xb6\x44\x81\x22\x9f\x51\x82\x4e\xbe\x99\xd6\x8f\xb0\xd2\x24\xdf\x4f\x5c\x94\xe6\x83\xcd\x5a\xec\xda\x5d\x6e\x63\x0d\x98\x2a\x68\x50\xe8\x23\xc7\x66\x07\xd1\x50\x6c\x09\x10\x23\xeb\x00\x89\xa8\xd7\x88\xf3\x9a\xe4\x0b\x4e\xcb\xbe\xa0\x9b\x7e\xa5\x94\x31\x01\x9b\x1d\x4b\xc4\xcf\x05\x8d\x78\xf7\x3e\x13\xa6\x07\x22\xb1\x04\xae\x19\x80\xcf\x98\x93\xf4\xd7\xdc\x1c\xd0\x12\x4b\x1f\x0d\x92\x6c\xf9\x34\x48\x7f\x57\x28\x21\x4d\xb7\xe1\xcb\x80\x64\x40\x8a\x02\xa5\x4c\x00\xd5\x41\xa1\xaa\xd0\xd2\xf2\xb5\x49\x35\xa9\xc0\xd2\xe7\xea\xaf\x01\x7a\x37\xdd\xb6\xb2\xfb\xa0\xbb\x14\xf7\x54\x1b\xee\x94\x10\xaf\x0e\x7d\x7a\x87\x10\x23\xc5\xa1\x94\xde\x66\x01\x31\x17\xf7\xeb\x93\x1e\x71\x9d\x8e\x0a\x5e\xc1\xe2\xf2\xea\xa7\xd5\xc7\xef\x2f\xaf\x56\x97\xdf\x5f\x5e\x65\xf0\xf0\xec\x48\x75\xaa\x1f\x76\x27\x85\xc4\x6e\x53\x44\x17\xcb\xce\x36\x74\xc5\xa6\x47\x87\xfd\x6a\x3c\xdc\xa5\x6d\x31\x63\x51\xbf\x47\x67\x4c\x97\xd2\xd2\x2c\x25\x36\x67\x5c\xc2\x6d\x02\xfb\x64\x7d\x10\xc8\x3d\xee\xaf\xaf\xda\x41\xb6\xf1\xf6\xf2\x94\x8e\x5d\x07\xe1\xd5\x2a\x69\xd9\xeb\x8a\xae\x20\x55\x85\xa5\xed\x3e\x10\xd7\xfb\xd4\xdf\x0b\x2c\x90\x3e\x62\xb9\xd4\x30\x08\x34\xc5\x5f\x48\x52\xb6\x81\xf3\x6a\x05\x0f\xad\x0a\x79\x88\x44\x65\x93\x0f\xfe\xc4\x7c\x0b\x88\xca\x59\x7a\x4f\x10\x53\x7c\x93\xce\xdb\x5e\x9a\x44\xdf\xa3\x7d\xe3\xbe\x35\xb6\x15\xac\x3e\xd1\x3c\xdc\x5b\xf4\xb5\xd7\xdb\xf5\xe7\x4f\x9f\x3e\x2c\x6e\x33\x90\x86\xd2\xb4\x4b\xb6\xad\x02\x43\xae\xed\xb4\xe4\x0c\x2d\x2f\xb3\x04\x63\xd4\x55\x05\xa4\x50\xf4\x11\xb5\x13\x30\x1b\x6a\xa4\xa3\x46\x5b\x69\x6b\xc3\x6f\x54\x6f\xfc\x19\x6a\x2e\x70\xd6\xbf\x4e\x71\xb7\x28\x4e\xe5\x2b\x53\x3b\xf9\xbb\x5c\xa8\x28\x43\x20\x62\x63\xaa\x40\xd8\x08\xde\x36\x32\xb4\xca\xa8\x80\x32\x56\xaa\x1a\xb5\x2b\x3b\xed\x27\xca\xf0\xbd\xfd\xf2\x3f\xed\x94\xbb\x7b\xf9\x44\x36\xf9\xc4\xb8\x93\xad\x4b\x05\xbd\x65\x94\x61\x09\x15\x37\xb7\xcb\x69\xfa\xf9\x93\xfd\xaa\x7b\xfd\xe3\x23\x58\x9e\xe7\x69\xa7\x7c\x66\x6f\xc3\x6f\x51\xf5\xef\xb4\x82\x13\x79\xe3\x68\xfc\x48\xad\x33\x22\x9b\x04\x99\x8b\xc4\xc5\x6e\x97\x7f\xb4\x66\x25\x5c\xfb\x67\xb2\xc6\xcf\x46\x44\x2d\xea\x90\x62\xf9\xe8\xba\x9b\xfd\xcb\x80\x69\xde\x2f\x34\xdf\x41\x98\x38\x58\x46\x28\x53\xfd\x21\x92\xae\xa4\xf0\x83\xaf\xb5\x12\x2f\xed\x85\x2b\x09\x4a\x8e\xae\xe4\xb6\xc1\xc2\xee\x02\xb1\x7d\x07\x73\xa4\x3e\xd1\xaa\x82\x07\xb4\x9e\x50\x86\x78\x56\x54\x14\x99\x92\xf9\x99\xeb\xd0\xb2\x26\x2e\x7d\x47\x17\x60\x48\xdf\x19\xb5\x9c\xc2\x7d\xf3\x19\xc3\xfd\x95\x2c\xa8\x6f\x3e\x99\x03\x5b\xab\xea\xba\x70\x47\x8d\xa7\xab\xf5\x3f\xc2\x5a\xfa\xa6\xf2\x12\xad\xfd\x24\xa7\xf5\x8f\xae\x11\x94\x6a\xeb\x93\x30\x9d\x42\x59\xbe\xae\x5d\x74\x8e\xae\x4e\x80\xd5\x31\xed\x31\x1d\x54\xd6\x0b\xb4\x4a\x7e\x74\x0a\x59\x5e\xdd\x7a\xd0\x06\x4f\x4b\x0f\x8f\xa4\xa2\xa5\x29\x35\xcf\xd0\xb4\x2b\x65\x61\x8a\x1c\x1f\xea\x1c\x7f\xb7\x04\x4b\xb1\x8c\xe2\xfc\xc0\xff\xf8\x2f\x6c\x2f\x77\x72\x5d\xf9\x65\x59\x1a\x01\x9e\x73\xc2\xcb\xc7\x51\xc7\x0b\xfd\x08\xa6\x9b\xe3\x32\x9f\x98\xef\x8f\x2f\xea\x1c\x18\xbc\xdc\x45\x7a\xf5\xf9\x48\x04\xb4\x2c\x31\x0c\x9f\xbd\x8e\x97\xf4\x33\xd0\x09\xed\x70\xf9\x87\xeb\xf1\x77\xef\x80\xd1\xca\x75\xc1\x3b\xd2\xde\x01\x69\x1a\x64\xe5\x22\xfd\x76\x69\x8a\xea\x69\x7e\xa6\xcf\xdd\xcf\x80\x07\x45\xfd\x8b\x54\x0d\x15\xf9\x2b\xa9\xea\xf9\x1d\x52\x75\x2a\x5f\x3f\x41\xeb\x58\x79\x9c\xa3\x6f\xbf\x02\x9e\xba\xa0\x8f\xdd\xf2\x11\xe9\xa1\x12\xd1\x1c\x0e\x2d\x33\x4d\xe7\xa7\x57\xf7\xbb\x24\xd2\x67\x82\xf3\x3a\xa9\xf7\x00\x13\xbb\xf8\x0a\x59\x47\x68\x06\xff\x01\x6f\x9d\x8a\x2e\x6a\xea\x80\x63\xd2\xe5\xf5\x62\x5e\x53\x29\x75\xa0\x4e\xa3\xc3\x05\xfc\x51\xce\x7d\xaf\x44\xe6\xff\xc5\x29\xeb\xaf\x63\x09\xf3\xcc\xca\x9f\xa5\xb7\x4e\xb3\xfd\xac\x53\x04\xfc\x68\x5a\x9b\x26\x7b\xb0\x21\x21\x2d\x81\x08\x6c\xe8\x23\xb2\xa4\x40\xa2\xe5\x79\xa9\x43\x22\x6e\x11\xb8\xdd\x5c\x87\xfc\xe7\x85\x15\x41\xfa\x92\x6c\x68\x4b\x51\x9c\x5d\x6d\xa7\x53\x29\xc3\x8a\x75\x74\x25\x9d\xa1\x90\x27\xe9\x8c\x85\xae\xa9\x3e\x25\x7d\xf3\xd5\x5e\x61\x9f\x75\x4e\x0e\xe4\x2f\x1c\xb3\xf4\x2e\x45\x8b\x0c\x01\xe1\xd6\x8c\x67\x63\x77\x2d\xdd\xee\xed\xee\x68\xed\x2f\x50\xea\xf4\xe4\xe2\xdd\xe4\xf3\xb0\x0e\xc7\xcc\xde\x20\x81\x3d\xc1\xac\x9e\x7a\xb2\x75\x65\xaf\xb7\x35\x56\xf9\x44\x55\xb1\x35\xa4\xe1\x7e\xf3\x68\x6c\xd3\xff\x0a\x22\xcd\x45\x77\x7e\x73\xbd\xdf\xcf\x2f\x66\xbe\x04\x19\x69\x67\xfe\xa2\xf3\xc7\xf8\x7a\xc0\xfa\x88\x5e\xd1\x9d\x16\x7b\x0f\xef\x46\xf6\x7f\x78\x8b\xf2\xa2\x36\x4c\x78\x88\x60\x31\x08\x8d\xd0\xd0\xd3\x49\x66\x0c\xee\x77\x13\x27\x4e\xaf\x84\x4f\x8b\xe5\x2f\xd1\x72\x44\xc3\x2c\xe8\x10\x63\x73\x96\x5e\xfc\xa6\x18\xa7\xed\xcf\xa3\x88\x8e\x5c\xb9\x38\x1b\x36\x86\xee\x0d\x25\xbf\x61\x4b\x78\x31\xd4\xbd\xc7\x0a\x5f\x07\xba\x46\xa9\x17\x01\xea\x9f\x1c\x1c\x37\xcf\xe1\x2d\x4c\x17\xcc\x2f\x42\x70\xec\x1d\xc3\x57\x04\xa9\x57\xef\x04\x68\xd3\x4f\x7b\x77\x92\x3a\x4d\x2d\xc6\xf6\x64\x35\x2f\x04\xbb\x67\x5c\x9c\x6b\xf3\xed\xb4\x05\x39\x5e\x0c\xc5\x77\x0e\xe7\x06\x78\x3b\x7b\x91\x8d\x34\x39\x4f\x8a\xd2\x13\x67\x59\xa7\x81\x7a\xf2\x82\xc3\xdd\x7e\xe7\xb0\x2b\xc2\x8d\xff\xf0\x9c\x8b\x55\xeb\x59\x47\x5c\x2a\x30\xb6\x37\x52\x23\x3c\xf0\xc8\x20\xc1\xa7\x53\x67\x1c\x3f\xba\xc2\xc3\x68\x77\x6a\xfd\xb2\x84\x5a\xc5\xe3\x2a\x51\xa4\x73\x62\xd5\x6a\x78\x5e\x75\x24\x77\x46\x2e\xab\xea\x16\x05\x35\xab\x16\xc3\x43\x2c\x4a\x31\x80\x74\x6f\x02\x2f\xfa\xa7\xd6\xb1\x09\xe3\x21\xe3\x60\x31\x34\xe2\x2e\x7d\xe7\x31\xff\xd1\x35\x14\x4b\xe0\x9f\x35\x3e\x43\x11\xbd\x47\x21\x77\xb5\xba\xff\x4e\x13\xc7\x87\x4b\x46\xff\x5a\x69\x0d\x8b\x57\x70\xca\xf0\xa2\xa4\x63\xa3\x4d\x78\x67\xf2\xea\x36\x9a\x0a\x3c\xd9\x46\x43\x59\x18\x6d\xb4\x53\x60\x1e\xb7\xd1\xf0\xfa\xfe\xcb\x6d\xb4\x23\xf9\xff\x85\x8d\x26\xd7\xda\xa7\xda\x68\x73\xcc\x46\xc3\x3e\x1e\xb6\xd1\xe6\x15\x6c\xd4\xbf\x7a\x8e\x45\x52\xfa\x08\x29\x98\x68\xb8\x2e\x8c\x85\x52\x8d\x6a\xcb\x4b\x77\x93\xae\xb6\xe7\xd8\x6b\x14\xbe\xb0\xdc\x96\x86\x55\xcc\x94\x52\x5d\x96\xf0\xc0\x79\x65\x8f\xf5\xd1\x02\x3b\xbc\x25\xeb\x94\xc4\xe9\x73\xc4\x35\xa9\x24\x3a\xb8\xda\x5a\xef\x80\xaf\x2f\x3f\xf1\xbf\x36\x0d\x7a\x35\x32\x2b\xe2\x97\xe9\x7d\xf2\xb2\xee\xda\xfa\xfe\x3b\xf8\x43\xd8\xa1\x29\x69\x7a\xd7\x89\x6d\x65\xcc\x57\x73\x47\x6c\xbf\x81\xf9\xdc\x11\x6d\x4f\x93\x77\xa7\xe7\xdd\xc7\x6d\x35\xd3\xe2\xb1\x68\x5e\xdd\xa5\x49\x40\xf2\x8b\x31\xff\x28\xef\xe0\xed\xdf\x99\xad\x37\x27\x7a\x91\x8d\x3d\xf5\x9b\xde\x35\xaf\x52\x67\xd3\x0e\x90\x75\xde\xa0\xe3\xd3\x47\xde\x2a\xf2\x50\xa1\x97\x3e\xde\xa2\x5f\x0e\x39\x2e\xb5\xb8\x7e\xfb\x60\xb7\x83\x0e\x19\x44\xc9\x1a\xe0\x33\x50\xd1\x39\x90\x33\xe0\x2b\x52\x6c\x71\x31\xd5\x5c\x8d\xf0\xad\x56\x50\x72\xf6\x6f\x0a\x0a\xbd\x65\xe4\x81\xb7\xca\x25\x73\xda\xb3\x97\xf0\xbf\xad\x54\xee\x59\xc1\x16\x8d\x00\x13\x15\xfd\xfd\x6e\xd3\x20\x33\xcf\x53\x6d\x2c\x1f\x6b\x4d\x0d\x17\x39\xee\x3b\x87\x7c\xec\xe4\x1f\xaa\x85\x0e\xd1\x81\x5e\xd9\xb4\x42\x77\xbd\x9f\x05\x2e\x5a\xed\xa7\x3a\x5c\x1b\x47\x35\xcf\xa7\x7b\x3a\x7f\x21\xb3\xc1\xc2\xc6\x57\x33\xe9\xa1\xc7\x65\xdc\xd9\xa2\xc2\x94\x13\x3a\x04\xe8\x88\xb0\xdf\xcf\xe7\xdd\x5e\x64\xca\xa3\xa8\x90\x30\x43\x6b\x66\x64\x69\x6f\xd2\x9e\x55\x2f\x6a\xe9\x4d\xfd\xe2\x71\xc4\x7b\x8a\xf8\x1b\x97\x7f\x4c\x43\x33\xeb\x75\x58\xd3\xc3\xca\xb4\xbd\x92\x5f\x78\xea\x9d\x09\xed\x3c\xc5\xed\x5d\xa0\xfd\xe5\xc6\x87\x1b\xe0\x8f\x28\xcc\x85\xb9\x9e\x5a\x10\x06\x0f\x08\xad\xc4\x12\x4a\x2a\xb0\x50\xd5\x33\x50\x66\x4f\xbb\x9f\x74\x91\xc4\x2e\x59\x69\x04\x2c\xe6\x17\xff\xfe\xf6\xed\xdb\xf9\x12\x48\x43\x6d\xab\x6d\xa1\x63\x45\x76\x76\x63\x70\xf1\x60\x5f\xf8\xc2\xb1\x47\xbf\x2e\x36\x0c\x2d\xf8\x86\x51\x65\xef\xdc\x47\xfc\x65\xbf\xcf\x93\x27\xc6\x7f\x18\x39\xfd\xc6\x58\xc6\x29\x5e\xbd\xac\x9b\x49\x4c\x1a\x83\x2e\xd9\x9c\xc2\x71\xaa\xdd\x21\xad\x27\x90\xaa\xe2\x4f\xd2\xbc\x99\x50\xdc\x86\xab\x10\xa5\xec\xf6\xf8\x3d\x2b\x74\x48\x5c\x86\xd7\x15\x6a\x8b\x3a\x81\x29\x78\xdd\x70\x89\xfd\xc3\x8b\x58\x96\x12\x11\xd6\x54\x9d\xb3\x19\x16\x45\x7f\x16\x55\xc8\x46\x0c\xde\xfb\x71\xa6\xc3\xca\xdb\xc9\x40\x38\x8c\xeb\xe1\x5d\x7f\xbc\xf2\x0b\xe5\x5f\x17\x11\x52\x96\xb0\xe0\xc2\x18\xa8\xa0\x25\x66\xc3\xa7\xa1\xb1\x10\x38\xeb\x26\xbb\xaf\xc0\xe0\x3e\x7e\x19\x05\x0e\x2a\xcd\x89\x03\x6a\x50\x3e\x79\x96\xa6\x5c\xf2\x95\x5a\x17\x80\x50\x5b\x1c\x07\xa0\xf7\xe4\xf4\x95\x00\xf0\x0a\x8c\x00\xd0\x4c\x3d\x50\x3d\x0c\x40\x92\x9b\xa7\x00\x78\x6e\xb3\xfd\xff\x05\x00\x00\xff\xff\x20\xb2\x90\xaf\xf1\x41\x00\x00")
+var _templatesServerBuilderGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5c\xdd\x6f\xeb\xb6\x92\x7f\x5e\xfd\x15\x73\x8d\xde\x5d\xfb\xc0\x95\x8b\xee\xcb\x22\x45\x16\x48\x93\x76\x9b\xdd\xf6\x9c\x20\xc9\xbd\x7d\x08\x82\x82\x91\x68\x9b\x1b\x89\x54\x49\x2a\x69\xae\xa1\xff\x7d\x31\xfc\x10\xa9\xaf\xc4\x71\x72\x6e\xcf\xe6\x3c\x1c\x5b\x1a\xce\xc7\x8f\xc3\xe1\xcc\x90\xc9\x6a\x05\xa7\x22\xa7\xb0\xa1\x9c\x4a\xa2\x69\x0e\x77\x4f\xb0\x11\x5f\xab\x47\xb2\xd9\x50\xf9\x1d\x9c\x7d\x82\x8f\x9f\xae\xe1\x87\xb3\xf3\xeb\x34\x49\x92\xdd\x0e\xd8\x1a\xd2\x53\x51\x3d\x49\xb6\xd9\x6a\xf8\xba\x69\x56\x2b\xd8\xed\x20\x13\x65\x49\xb9\xee\xbd\xdb\xed\x80\xf2\x1c\x9a\x26\x49\x92\x8a\x64\xf7\x64\x43\x61\xb7\x4b\x2f\xec\xc7\xa6\x41\x86\x5f\xf9\x17\x47\xc7\xe0\xdf\x98\x11\xab\x15\x5c\x6f\x99\x82\x35\x2b\x28\x3c\x12\xd5\xd5\x52\x6f\x29\x38\x35\x41\x0b\x51\xa4\xc9\x6a\x05\x3f\xe4\x4c\x33\xbe\x01\xdd\x8e\x2b\x8d\x9a\x95\x14\x0f\x14\xd6\xb5\x36\xac\xb6\x94\xc3\x93\xa8\x41\xd2\xaf\x65\xcd\x3b\x9c\xbc\x08\x63\x0f\xe1\x79\x92\xb0\xb2\x12\x52\xc3\x3c\x01\x98\x29\x2d\x19\xdf\xa8\x19\x7e\xe6\x54\xaf\xb6\x5a\x57\xb3\x04\xbf\x6d\x98\xde\xd6\x77\x69\x26\xca\xd5\x46\x7c\x2d\x2a\xca\x49\xc5\x56\xa8\xdf\x6c\xfa\x35\xe1\xa4\x78\x52\x4c\x3d\x43\xf2\x40\x0a\x96\x13\x4d\x91\x44\x55\x34\x9b\xa2\xc3\x77\x48\x93\x09\xae\xe9\x1f\x1a\x66\x1b\x51\x10\xbe\x49\x85\xdc\xac\xfe\x58\xa1\xae\xee\x0d\x12\x15\x82\xe4\x6a\x8a\x93\x79\x89\x54\x54\x4a\x21\x27\xc9\xec\x5b\xa4\x53\x5a\xae\x4b\x3d\x45\x67\xdf\x22\x9d\xac\xb9\x66\x25\x9d\x22\x74\xaf\x91\xb2\x64\x79\x5e\xd0\x47\x22\x5f\x22\x5e\x05\x4a\x1c\xa7\x68\x56\x4b\xa6\x9f\x5e\x1a\xe5\xe9\xcc\xd4\xed\x76\x20\x09\xdf\x50\x48\xcf\xe8\x9a\xd4\x85\x3e\x37\x13\xae\xa0\x69\x76\x3b\xa8\x24\xe3\x7a\x0d\xb3\xbf\xfe\x3e\x83\x14\xbd\x12\x20\xf8\x74\x34\xf8\xab\x7b\xfa\xb4\x84\xaf\x1e\x48\x51\x5b\x47\xee\x70\xc1\xb7\xd0\x34\xd0\x63\xe8\xc8\x7b\x5c\x17\x09\x7a\xf2\x47\xfa\x88\xd4\x44\x65\xa4\x60\xff\xa0\x90\x7e\x24\x25\x85\xa6\x39\xb9\x38\x87\x4c\x52\xa2\xa9\x02\x02\x9c\x3e\xc2\x28\x19\x30\xae\x34\xe1\x19\x4d\xd6\x35\xcf\x9e\xe3\x36\x47\xd7\x81\x0f\x66\xda\xd3\x33\x91\xd5\xb8\x8c\x17\xf0\x61\x8a\x1e\x76\x38\x97\x54\xd7\x92\xc3\xbf\x4e\x11\x21\x0d\xc0\x96\xf0\xbc\xa0\x52\x1d\x41\xf7\xa7\x24\xf7\x74\x5e\x92\xea\xc6\xae\xa7\xdb\xe8\x23\xae\xa8\xf4\x27\x3b\x6e\xb1\x34\x5c\xd6\x42\x96\x44\x0f\x98\x38\xbf\xf3\xb3\x66\x69\x73\xfb\xe5\x54\x70\x55\x97\x34\x8c\x99\xed\x76\xed\xfc\xfa\x97\xd0\x34\xb3\xce\xa8\x0b\x29\xf2\x3a\x9b\x18\xe5\x5f\x86\x51\x59\xad\xb4\x28\x1d\xb7\xc8\xc8\xbe\x75\xce\xeb\x52\x4f\xe9\xcc\xb2\xc3\x1d\xdb\x3d\x86\x7b\x4a\x37\xfc\x8a\xca\x07\x2a\xaf\xb6\xb5\xce\xc5\x23\x6f\x47\x03\x4e\xf7\x7c\x01\x3b\x80\xc6\x12\xe2\xf4\x86\xd7\xe1\x07\x9f\x47\xac\x7e\xc0\xf5\xdc\xa5\xb3\x4b\x3c\x0d\xaf\x2d\xf9\xf7\x44\xb1\xec\xa4\xd6\x5b\xca\x35\xcb\x88\xf6\xc3\xfc\xaa\x4a\x5b\x02\x4b\x7f\x72\x71\xfe\x3f\xf4\x69\x38\xa0\xa5\x0f\x04\x4e\x00\x25\x92\xca\x67\x06\x04\x02\x3b\x20\x2c\x61\x87\xb1\x5b\x76\xb8\x55\x9d\x97\x55\x41\xd1\xa5\x89\x66\x82\xbb\x45\x3d\x70\x59\x3f\x37\x47\xb8\x9a\x86\x63\x96\xbb\x1d\x2d\x14\x7d\x71\x70\x7f\xaa\x7f\xc4\xc9\x30\x33\x22\x81\x89\xf4\x92\x92\x9c\xca\x25\x68\x22\x37\x54\x03\xe3\x9a\xca\x35\xc9\xe8\xae\x59\x58\xb0\xcd\xda\x02\x68\xd7\x97\x9b\x81\x8f\x42\xb7\x2a\xd1\x7c\x3e\xdb\xed\xcc\x32\x6f\x1a\xc8\x9c\x20\xd8\x12\x05\x5c\x68\x78\xa2\x1a\xee\x28\xe5\xc0\xc2\x80\xd9\xc2\x70\x6d\x16\x68\x06\xcf\x4d\xb8\x41\xd0\xcc\xe7\x80\x9d\x73\xb0\x57\x63\xe7\xc6\x1d\x86\x5d\x18\xdc\xf7\xf3\x80\xdd\x23\x62\xf7\xab\x64\x1a\xb1\xcb\x89\x26\xef\x81\x5c\xe5\xc4\xbc\x05\x39\x07\xdc\xa7\x0a\xb3\x12\x26\xb8\xc2\x87\x6c\x0d\x9c\x86\x44\xc6\x67\x37\x7d\xfb\x43\xa2\xd3\xb2\x1b\x81\xc7\x45\xc2\x23\x78\x9e\x6f\xc4\x2d\xdd\x83\x5d\x80\xd6\x4d\xf4\xaf\x4c\x6f\x4f\x5d\xe6\xd0\x34\x99\xfe\xc3\xe7\x11\xa9\x7b\xba\x0c\xfb\x53\x45\x24\x29\xd5\x3b\x29\x74\x61\x98\x19\x5e\x29\x2e\x78\x21\xd9\x3f\x68\xde\x34\x4b\xb3\xf1\x66\xac\x22\x85\x93\x24\x34\xcc\x81\xfe\x8e\x6e\xea\x5f\xcc\x22\x37\x98\xc1\xa2\x69\x3e\xb4\x4a\xee\x76\x81\xae\x45\x78\x11\x25\x16\xe9\x25\x55\x95\xe0\x39\x1d\x78\x4e\x44\xd3\xf7\x1e\xe1\x27\xfa\x05\xeb\x23\x3b\x03\x0e\x2d\x0c\x3d\x14\x9a\x66\x4f\x17\x8c\x7d\xcf\x7d\x76\x0e\x78\xe5\x02\xe3\x19\x5d\x33\xce\x62\x4f\x4c\xcf\x55\x1b\x8d\x4d\xa6\x7e\x52\x55\x05\xa3\xca\xe6\xc0\x98\xf8\x7a\xd4\x8d\x03\xc3\xd6\x44\x28\x60\x0a\x14\xd5\xf0\xc8\xf4\xd6\x64\xc7\x86\x07\xa8\x6c\x4b\x4b\xea\x44\xc7\x93\x79\x7e\x86\xbb\x7e\xad\xb7\x47\x76\xfb\xa9\x15\x95\xb8\x3d\x33\xbe\x59\x22\x9d\x72\x5f\x16\x30\x7f\xfb\x64\x2e\xed\xda\x5e\xf4\xe7\x8d\xb3\x62\x39\xb5\xec\xef\x8c\xfe\xa4\xd6\x5b\x40\x15\x9c\xc6\x8b\xbd\x80\xf7\x5b\x8c\x9b\x3d\xf4\xd4\x73\x15\xb6\xac\x71\x54\x4d\xbe\xe1\x7c\x7c\x86\x68\xa5\x57\xa2\x96\x19\xfa\x81\x03\x77\x0f\x18\xb5\xb8\xa7\xfc\xcf\x86\x8e\x54\x0c\x30\x7b\x35\xe0\xc5\xd8\x85\x50\xba\x96\xa2\xc4\xaa\xce\x9a\xd8\x34\x60\x42\x04\xdc\x44\x18\xdc\xee\x07\x75\x0f\xe5\x4f\x08\xc6\xb7\x4d\xb3\x3f\x4c\x4b\x50\x99\xa8\xa8\x82\x9b\xdb\x3f\x19\x37\x81\x80\x7d\x0b\x77\x26\x55\x19\xa2\xf7\x6a\xcf\x1b\xf9\xcc\xd6\x13\x4b\xdf\xbc\x5f\xad\x7c\x5e\x6b\xa4\xe3\x1a\xa7\x12\x9d\xaf\xfd\x96\x43\x49\x09\xc7\x72\x99\x0b\x90\xf4\xf7\x9a\x2a\xad\x00\xab\xae\xbb\x42\x64\xf7\x34\xf7\xe9\x5b\x1b\x99\xfb\x89\x5b\xcb\x69\x3e\x08\x4f\x4d\x82\x15\xfc\x33\x55\x84\x4b\x31\xf8\x5a\x44\x09\x07\x5f\x8b\xf4\x8c\xaa\x4c\xb2\xaa\x4d\x39\x06\x4f\x0d\x39\xe6\x63\xd0\x34\xb8\xd8\x76\x3b\xd8\xd6\x25\xe1\xb1\x08\x54\x3b\x9a\x4d\xf7\x01\x3e\xac\x12\xfd\x54\x51\x98\x54\x4b\x69\x59\x67\xda\x2c\x10\x4c\x90\x7d\x2a\x8c\xff\x7a\x25\x52\x54\x6c\xb7\x14\xd1\xde\xe1\x36\xce\x24\x54\x41\x9e\x2a\xca\xed\x27\x0a\x9f\xa4\x2d\x7a\xfa\xc5\xce\x25\xdd\x30\xa5\xe5\x53\x32\x28\x3f\x62\xb6\xfd\x34\xb4\xa5\xf6\xb9\xd5\x28\xb5\x7f\x99\x0c\xca\x28\xb7\xb8\xc2\x0b\x47\xea\xe3\x7a\x02\xf0\x4b\x6b\x79\x54\x86\x44\x70\x7c\x5f\xb3\x22\xa7\x72\x01\x1d\x3b\x13\x80\xd5\x6a\xa4\xa0\x68\x3b\x3d\x58\xe3\x7a\xfd\xba\x14\x26\xe8\xe0\xec\xab\xda\x04\xdf\x1c\xa2\x20\x8f\xd2\xd1\x7f\x52\x2b\xe0\x5c\x9b\xf0\x43\xbc\xfa\x61\xb1\xa1\x8f\x31\xd7\x01\x72\x5e\x0d\x6e\x27\x5f\xc2\x56\x3c\xd2\x07\x2a\x4d\xab\x28\x23\x1c\x24\xad\x0a\x92\x51\x60\x1a\xa7\x07\x1f\x4b\x0c\x75\x9a\x65\x75\x41\x24\xd4\x8a\x6c\x28\x4a\x1c\xb1\x07\x15\x9a\xb7\xeb\xe6\x6f\x8a\xca\x0b\xa2\x54\x44\xc3\x04\x5f\x8c\x5b\x6a\x4d\x08\x1b\xce\xdb\x40\xb2\xc1\xf2\x0b\x00\x69\xcc\x20\x8b\x92\x0f\xe4\xfe\x7f\x8f\xda\x35\xaa\xfe\x0a\xc8\x42\x95\xf8\x36\xc8\x5c\x08\xff\x62\x90\x1b\xb3\xab\x8b\x9c\x47\xec\x2a\x13\x15\xcd\x5f\x81\x5b\x12\x25\x95\x7e\xf1\xfb\x06\xef\x30\x5e\x3a\x0a\x09\xd2\x44\x25\x0c\x2b\x24\x54\xa4\x68\x03\xb1\x89\xd0\x2f\x34\x67\xe4\x1a\xe3\x6e\xd3\xcc\xa0\xc4\x26\x20\x46\xe1\x04\x5e\xe2\xeb\x94\xf4\x0f\x92\x78\x83\x69\x15\xf5\xc1\x68\x5a\x51\x47\xd1\x55\xb4\x2d\x00\x0f\x57\x34\xf0\x75\x8a\xfa\x07\xe3\x8a\x4e\xed\xd5\x3e\xdd\x69\xe3\xc6\x88\x25\x6d\xd2\xd3\xb1\xc1\x3b\x22\xe8\x2d\xd1\xa0\xc9\x3d\x55\x80\xc9\x37\x47\xfd\x08\xcf\x71\x93\x53\x8f\x42\xe6\xe6\x8b\xcd\x5a\xac\xed\x2e\xb7\xb1\x0e\xcc\x34\x54\x54\xe2\x96\x63\xb3\x83\xe0\x28\xb6\x04\x08\x91\x35\x81\x49\xbd\x46\x16\xaf\x49\xbe\x60\xbf\xec\x0b\xba\x69\x6b\x4c\x19\x12\xb0\x80\xab\xc7\x2c\x84\x91\x37\x81\x46\x7c\x60\x3c\x10\xa6\x3b\xa2\x68\x0e\x82\x03\xe1\xe0\x33\xe6\x28\xfd\x35\xe7\x0f\x2c\xa7\xb9\x8f\x06\x51\xb6\xbc\x1f\xa4\x9f\x15\x4a\x88\xd3\x6d\x78\x1b\x90\x1c\x48\x96\x51\xa5\x22\x40\x31\x28\x14\x05\xb5\xb4\x62\x6d\x52\x4d\x26\x69\xee\x73\xf5\xf7\x00\xbd\x9b\x6e\x5b\xd9\x7d\xd0\x5d\x8a\xbb\xaf\x0f\xdf\xdc\x7e\x4e\xe8\x1d\x4d\x98\x86\xe4\xa5\x94\x7e\xb5\xea\xe6\xe2\xde\x3e\xe5\x11\xc7\x9e\x8d\x14\x05\xcc\x4f\x4e\x7f\x5e\x5d\x7e\x7f\x72\xba\x3a\xf9\xfe\xe4\x74\x81\x87\x65\x96\x14\x53\xfd\x76\x76\x62\x48\xec\x34\x05\x74\x69\xde\x99\x86\xae\x58\x1f\xec\xc2\xa3\xf1\x70\x17\xb7\xc5\x56\xab\x37\xb5\x4c\x46\x62\xaf\x4b\x21\xb1\x4f\xa1\x8c\x29\xa1\x39\xe3\x12\x6e\x93\xa4\x4d\xd6\x07\x2d\x79\x02\x9f\x4b\xb5\x67\xd9\xfa\x87\xfb\x75\xec\x3a\x08\xaf\x56\x51\xcb\x1e\x2b\xba\x8c\x14\x05\xcd\x6d\xf7\x81\xb8\xde\x27\x3e\x97\x34\xa3\xec\x81\xe6\x4b\x04\x48\x52\x60\x71\x92\xe2\x50\xb2\xfc\xee\x6a\xdd\xe6\x21\xd8\xf9\x31\xc9\x87\x78\x74\xf1\x1f\x4f\x53\x93\xf8\x9c\x20\xa4\xf8\x26\x9d\xb7\xbd\x34\x45\x7d\x8f\xf6\x83\x7b\x6a\x96\x5b\xeb\xf5\x91\xe6\xed\xb9\x45\x5f\x7b\x9c\xae\x9f\xae\xaf\x2f\xe6\x57\x0b\x50\x68\xa3\xa9\x58\xd5\xb6\xd6\x80\xc7\x1c\xc6\x4f\x73\xc1\xb1\x09\xb5\x5a\xd9\xca\xca\x38\x75\x51\x00\xc9\x34\x7b\xa0\x98\x7f\x70\x1b\x6a\x94\xa3\xa6\xb6\xd2\x46\xc7\xaf\x74\xef\xfd\x13\x94\x42\xd2\x04\xfa\x6a\x99\xcd\xcc\xab\x7c\x6a\x6a\x27\x7f\x22\x0c\x05\xe3\x14\x88\xdc\x98\x2a\x10\x36\x52\xd4\x95\x6a\x5b\x65\x4c\x42\x1e\x2a\x55\x95\x00\x9c\xda\x61\x3f\x33\x4e\x3f\x99\xf2\x55\xfd\x97\x1d\x72\x73\x8b\xc7\xc3\xe9\xc4\x7b\x27\x1b\x4b\x05\xcc\x2b\x19\xa7\x39\x14\xc2\x9c\x51\xfb\xb8\x8b\xb5\xc6\xcf\xf6\x51\xfb\xd3\x89\x60\x69\x9a\x46\xe1\xc9\x9b\x83\x18\x63\x64\xcb\x34\xc7\xa8\x21\xd6\xe8\x1d\xe0\x0e\x9a\x5d\x88\xc6\x27\xd2\xcd\xaa\x5a\x86\x66\xe0\x15\xd5\x7f\x77\x27\xd2\x7e\xd2\xd1\x46\x4f\x7a\x15\xd8\x12\x2d\x4a\x96\xa5\x7f\xc7\x13\x4e\x14\xbc\xa5\xa4\xd0\xdb\x5f\x6a\xa3\xa5\x7a\xe2\x59\x7a\xf9\xeb\x2f\xb5\xa6\x7f\xb4\xef\x4e\xb7\x34\xbb\x47\x58\xa2\xaf\x09\xe0\xdc\xe1\xb9\xc9\x13\x8e\xc3\xc6\xff\xbf\x7f\x9b\xc4\x12\x2b\x9a\x7d\xe2\x19\xb5\x2c\xf1\x53\xef\x25\x8e\x82\x0f\xfe\xa4\x3d\xbd\xaa\x68\x66\x3a\x13\xe8\x89\xba\x7f\xb6\xd7\x06\x13\xbf\x48\x5c\x92\xa8\xa0\xc4\xcc\xd0\x26\x83\x08\x31\xee\xc6\xe9\xa5\x5d\x5e\xd2\xb5\xc1\x26\x7b\x1d\x8b\x11\x51\xf3\xb2\x4d\x35\xfd\x2e\xb3\x4b\xfe\x65\xc0\x34\xcd\xbb\xc3\xe0\x18\xda\x81\x03\x33\x5c\x9a\xac\xda\xcd\x34\xb6\xc4\xe5\xe5\xef\x67\x89\x97\xf6\x4a\x4b\x5a\x25\x47\x2d\xc1\xe9\x41\x6f\xc3\x40\x65\xfa\x2f\x26\xb5\x78\x64\x45\x01\x77\x58\xa2\xcb\x07\x9a\xb7\x71\x3d\x2b\x18\xe5\x5a\xa5\x07\xda\x81\xb2\x26\x0e\xbf\x47\x0d\x30\xa4\xc7\xa0\x82\x07\x9d\xf5\x26\x67\x0c\xf7\x77\xf2\xa0\x9e\xa8\xf9\xc2\x81\x8d\x58\xbb\x6e\xe4\x24\xe4\x7e\x50\x57\xeb\x7f\x86\xb7\xf4\x44\xbd\x4a\x6b\x3f\xc8\x69\xfd\xa3\x6b\x88\xc5\xda\xfa\x64\x14\x53\x49\xcb\xd7\xb5\xcd\x0e\xd1\xd5\x09\x98\x2f\xfa\xbd\xb6\x67\x95\xf5\x02\xad\x92\x97\x4e\x21\xcb\xab\x93\x2c\xdb\xe3\x7e\xa7\xa0\x8f\xb6\x42\x1e\xa2\x69\x57\xca\xdc\x14\x7b\x3e\xe4\x3b\xfe\xce\x04\x4b\xb1\x0c\xe2\xbc\x6d\x2e\x88\xfb\x9e\xf6\xa4\x5d\xe9\x49\x9e\x1b\x01\x9e\x73\xc4\x6b\xe1\x8c\xf6\x1b\x82\x7f\x43\xe3\xc9\x71\x19\x60\xa8\x7b\xc6\x8d\x3a\x04\x06\x2f\x77\x1e\x1f\x01\x3f\x60\x23\x8e\x47\x8e\xe1\xb3\xf8\x38\x33\xf5\xae\x65\xb2\x29\xb6\x1e\x31\x7f\x54\xaa\x1b\x26\xe1\xf8\x18\x5b\xff\xee\x34\xa0\x23\xed\x18\x48\x55\x51\x9e\xcf\xe3\xa7\x4b\x98\x3d\xcb\xcf\xf4\xfb\x9b\xf1\x24\xda\xaf\xdd\x57\xaa\xea\x86\xbd\x9b\xaa\x9e\xdf\x73\xaa\x4e\xd5\x2d\x7b\x68\x1d\x2a\xb0\x43\xf4\xed\x77\x02\xa6\x2e\x2a\x84\x53\x83\x11\xe9\x6d\x45\x86\x1c\x9e\x33\x33\x2e\x6b\xa6\xad\xfb\x2c\x05\xc5\x81\xe0\xbc\x4f\x09\x32\xc0\xc4\x1a\x5f\x50\xde\x11\xba\x80\xff\x84\x6f\x9c\x8a\x2e\x6a\x62\xc0\x31\xb5\xca\x7a\x3e\x2b\x99\x52\x18\xa8\xe3\xe8\x70\x04\x7f\x55\x33\xdf\x33\x52\xe9\x7f\x0b\xd6\x65\xb9\x84\xd9\x12\x66\x0b\x2b\x3f\x5c\x3e\xe3\xac\x48\x9a\xa4\x53\x0c\xfd\x68\x5a\xbc\x78\x88\xe5\x42\x82\x2b\x72\x5c\x33\x72\xc3\x1e\x28\x8f\x0a\x45\x96\x1f\x12\x77\x3a\xe2\xe6\x2d\xb7\xf3\x33\x67\xc1\xe2\xb5\x95\x51\x7c\xa3\x6e\xe8\x4b\x41\x9c\xb5\xb6\xd3\xb1\x55\xad\xc5\x18\x5d\xa3\x0a\x1e\x6f\x6e\xfa\x3c\x09\x33\x16\xb6\xc6\x56\x76\xdb\x84\xb6\x47\xf9\xea\x10\xf3\x07\xf2\xe7\x8e\x59\x7c\xa6\x84\x22\xdb\x80\x70\x65\xde\x2f\xc6\xce\x9c\x3a\xcc\x60\xf7\x62\x0f\x44\x52\x85\x49\xd5\xd1\xf1\xe4\x35\xb9\x0e\x47\x74\x19\x44\xc1\xee\x60\x56\x4f\xbc\x9d\x69\x83\xab\xd7\x1b\xc5\x02\xa8\x47\xa6\xb3\xad\x21\x75\x4f\xf6\x88\x6d\x48\x95\x11\x65\x0e\xfc\xd3\xf3\xb3\xa6\x99\x1d\xb9\xa7\xde\x92\x4e\x5b\xf7\x37\x38\x76\x52\x5b\x2a\x6b\xd1\x0d\x8a\xbd\x85\xe3\x91\xf9\x6f\x87\xb7\x56\xbd\xaa\x1d\xd5\x5e\xc8\x40\x09\xcb\xd0\x10\xf6\xbe\x3a\x8f\x46\x74\x1c\xd2\xff\x6b\x1d\xd3\xc5\xc7\xa1\x86\x13\xb1\xfc\x35\x5a\x8e\x68\xb8\x68\x75\x08\xe7\xb7\x0b\x1f\x7b\xfa\x18\xc7\x6d\xe0\x17\x11\x0d\xc4\x01\x52\x3b\x2b\xe9\xc7\xc8\x51\xd2\x73\xbe\x84\xd7\x18\x31\x76\x69\xe3\xcb\x40\xd7\xf4\x43\x5f\x05\xa8\xbf\x7a\xf1\xb2\x7b\x0e\x4f\xa3\xba\x60\xbe\x09\xc1\xb1\xfb\x1c\x5f\x10\xa4\x5e\xbd\x3d\xa0\x8d\xbf\x35\x6e\x27\x75\x9a\x5a\x8c\x4d\xec\xc3\x5b\x0d\x4d\xd3\xdd\xe3\xc2\x58\x9b\x6f\xc7\xad\xd8\xf1\x62\x28\xdc\xf7\x38\x34\xc0\xdb\xd1\xf3\xee\x39\xa1\x13\xba\x4f\x94\x76\x33\xd0\x07\xbe\xd3\x48\xde\xdb\x60\x9f\x27\x77\x37\x3b\x57\xa2\x8e\xee\x73\xa1\x6a\x3d\x68\x8b\x8b\x05\x86\xf6\x46\xec\x84\x23\x1b\x8f\x1f\x14\xed\x62\xee\xd1\xbe\x5b\x97\xe7\xe0\x77\xad\xdf\x96\x50\xea\xb0\x5d\x45\x8a\x74\x76\xac\x52\x0f\xf7\xab\x8e\xe4\xce\x9b\x93\xa2\xb8\xa2\x92\x19\xab\xe5\x70\x13\x0b\x4d\x19\xe3\x12\xdd\x13\xd1\xb0\xb7\xb9\xb0\xf0\xd2\x80\xf1\x90\x31\x0a\xbc\x37\xde\x89\xf0\x1e\x30\xfc\x66\x52\x40\x93\x7a\x66\x4b\x10\xf7\x70\x34\x26\xa2\x77\x39\xe6\xa6\xd4\xb7\xdf\x21\xf1\x2e\xe9\xe8\x5f\x6a\xd4\x30\x7b\x87\x45\xe9\x0b\xa4\xae\x8f\xba\xe6\xcf\xe7\xf0\xd1\x58\xe0\xde\x3e\xea\x07\x45\x3e\xea\x1e\xed\xeb\xa3\x9e\xc3\x3b\xf8\x68\x47\xf2\xff\x0b\x1f\xf5\xc6\x8f\x78\xe5\x94\x8f\x56\x2f\xf9\xa8\xe7\xf9\x82\x8f\x56\xef\xe0\xa3\xae\x90\x6b\x3d\x94\x74\x2e\x63\xb5\x2e\xda\x1e\x9b\x86\x42\xa9\xa4\x7a\x2b\x72\x77\xa3\x40\x6f\x0f\xf1\xd7\x20\x7c\x6e\xb9\x61\x2e\xaa\xb7\x21\x53\x8a\x75\x59\xc2\x9d\x10\xc5\x02\x76\x53\x05\xb6\xab\xeb\x54\xb7\x5f\x10\x6c\x5f\xc2\x9a\x14\x8a\x3a\xb8\xea\x12\x67\xc0\xd7\x97\xd7\xe2\x6f\x55\x45\xbd\x1a\xe8\xc8\x6c\x0d\xbf\x4d\xcf\x93\x97\x75\x53\x97\xb7\xdf\xc1\x5f\xc4\xfd\x0b\xd2\xd8\xda\x5a\x76\x7c\x0c\xb3\xd5\xcc\x11\xdb\x27\x30\x9b\x39\xa2\xed\x7e\xf2\x6e\x70\xdc\x6d\x98\x56\x33\xcc\x4d\xa7\xbb\x61\xe8\x5e\xd9\x80\x13\x6e\xdc\xb5\x97\x13\x9f\x3d\x05\x3d\xb0\xf5\xe6\x44\xcf\x17\x63\x57\x1e\xa7\x67\xcd\xab\xd4\x99\xb4\x67\xc8\xe2\xfb\xfa\x1f\xe9\xe3\xa5\xa8\x35\xb9\x2b\xa8\x97\x3e\x1c\x89\x65\xe7\x72\x28\x78\x89\xe2\xfa\xed\x03\xbc\x9e\x11\x93\x41\x90\x8c\x00\x1f\x80\x0a\x56\x86\xce\x81\x4f\x49\xb6\xa5\x73\xeb\xc0\x03\x1e\x1e\xa8\xf9\x02\x8f\xe3\x72\xc1\xff\x4d\x43\x86\x37\x27\xc9\x9d\xa8\xb5\x4b\xe6\x70\x65\x2f\xe1\x7f\x6b\xa5\xdd\xf5\x8a\x2d\x35\x02\xcc\xce\xed\xcf\xb9\xb1\xd7\x68\xae\xe9\xda\x7c\x6c\xac\x35\x35\x34\x72\x7c\xed\x4c\xfb\x21\x0c\x77\x83\xe8\x63\xbc\x6c\x43\x87\xe8\x99\x5e\xd9\xb4\x42\x37\xbd\x5f\x8f\x9c\xd7\xb8\x4e\x31\xbe\x9b\x85\x6a\xae\x91\xf7\x74\x7e\x23\xb3\x81\x61\xe3\xd6\x74\x84\xbc\x4e\x06\xaa\xc1\xd6\xb6\x9c\xc0\x10\x80\x11\xa1\x69\x66\xb3\x6e\x2f\x32\xe6\x91\x15\x94\x70\x43\x6b\x46\x2c\xe2\xde\x24\xaa\xfc\xca\x96\xde\xd4\x6f\x7e\xce\x27\xd7\xdd\xf2\x9f\xd6\xd0\x8c\x2e\x21\x0d\x36\x2b\xd3\xf6\x8a\x7e\xd3\x15\x67\xa6\xbd\xd9\xa1\x85\x3d\x0b\x34\xab\x02\x6f\x93\x0b\xbc\x29\xf0\xd3\xf5\xf5\x05\x16\x48\x78\x57\xf7\x8e\xe2\x45\xb8\x1c\x72\x26\x69\xa6\x8b\x27\x3c\x80\x40\x16\xe9\xcf\x58\x24\xf1\x13\x9e\x1b\x01\xf3\xd9\xd1\x7f\x7c\xf3\xcd\x37\xb3\x25\xde\xda\x4a\xed\x23\x8c\x15\x8b\x43\xd6\xbf\x1d\x7e\x67\x6f\x3a\xc3\x4b\x97\x9f\x5d\x6c\x18\x7a\xf0\x39\x67\x7a\x8e\xd0\x78\x4e\xc7\xa3\x64\x0f\xfd\x73\x78\x2f\x79\x91\x8c\x2f\xb5\xa6\x49\xa3\x5b\xda\x7f\x89\x17\xd2\x33\x21\x31\x0c\x09\xfc\x3b\x49\xc8\xa4\x1f\xa5\x27\x17\xe7\xce\xd6\x30\xd4\x6e\x5d\x68\x22\x90\xa2\x10\x8f\xca\x5c\x3b\xd1\xc2\x46\xba\x36\xc0\xd9\x99\xf5\xd3\x9d\x61\x34\x5d\xb6\x17\x54\xb0\x6f\x03\x92\x66\xa2\xac\x84\xa2\xfd\x7d\x8f\x58\x96\x8a\x52\x58\x33\x7d\xc8\x3c\xa2\x76\x2e\x76\xbb\x06\xf7\xd0\x46\xa7\x9a\x5a\x60\x14\xf5\xfd\xee\x21\xd9\x70\x4b\x68\x7f\x35\x22\x9c\x16\xfa\x32\xa5\x87\x08\xc9\x73\x98\x0b\x69\x7c\x5b\xb2\x9c\x2e\x86\xb7\x6b\x43\x0d\x91\xbe\xe5\x20\xd1\x2b\x10\xea\x08\x97\x2c\x2d\x83\x40\x5f\x00\x78\xda\xa9\xbd\x6d\x50\x79\x79\x96\x18\xbb\x3c\xb7\x1e\x00\x3e\x07\xde\x03\x00\x5f\x51\xbd\x2f\x00\x5e\x81\x11\x00\x5a\x81\x83\x0a\xe8\x59\x00\x3c\x55\x0f\x00\xcf\x2d\x31\x7f\xf9\x42\xd3\xb2\x2a\x88\xa6\x30\x33\xd1\x4c\xda\x8b\x2f\xee\x0f\x0d\x8c\xbc\xf7\xf7\x5a\xdc\xd2\x67\x82\xcf\x20\x85\xa6\x49\xfe\x6f\x00\xa5\xf2\x0c\xdd\xc7\x43\x00\x00")
 
 func templatesServerBuilderGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -371,12 +648,12 @@ func templatesServerBuilderGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/builder.gotmpl", size: 16881, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	info := bindataFileInfo{name: "templates/server/builder.gotmpl", size: 17351, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesServerConfigureapiGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x4b\x8f\xdb\x38\x12\x3e\xaf\x7f\x45\x41\xc8\x02\x76\x60\xcb\xc0\x1e\x03\xf4\xa1\xb7\x93\xc9\x18\x9b\x4c\x1b\xe3\xc6\xce\x61\x30\x07\x9a\x2a\xcb\xdc\xa6\x48\x0e\x49\xa5\xdb\x23\xe8\xbf\x2f\x8a\xa4\x64\xc9\x8f\x4e\x27\x39\xcc\xc9\x26\x59\xcf\x8f\xf5\xa2\x96\x4b\x78\xd8\x0b\x07\x3b\x21\x11\x84\x03\xc7\x76\x08\x5e\x03\x16\xc2\xe7\x70\xaf\x38\x82\xf0\x80\xcf\xc2\x79\x47\xff\x9e\x84\x94\xa0\xb4\x87\x2d\x82\xfe\x82\xf6\xc9\x0a\xef\x51\x4d\x26\x93\xa6\x01\xb1\x83\xfc\x4e\x9b\x83\x15\xe5\xde\xc3\xa2\x6d\x97\x4b\x68\x1a\xe0\xba\xaa\x50\xf9\x93\xb3\xa6\x01\x54\x05\xb4\xed\x64\x32\x31\x8c\x3f\xb2\x12\x89\x38\xbf\x5d\xaf\xd6\x69\x49\x67\xa2\x32\xda\x7a\x98\x4e\x00\x32\x6e\x0f\xc6\xeb\xa5\x97\x2e\xa3\xa5\x42\xbf\xdc\x7b\x6f\xc2\x42\xea\x32\x9b\x4c\x00\xd0\x5a\x6d\x1d\x64\xa5\xf0\xfb\x7a\x9b\x73\x5d\x2d\x4b\xbd\xd0\x06\x15\x33\x62\x19\x4f\x89\xc1\xd6\xca\x8b\x0a\xaf\x11\xa6\x63\xa2\xac\x44\x51\x48\x7c\x62\xf6\x6b\xc4\xcb\x23\x25\xf1\x39\xe4\xb5\x15\xfe\xf0\x35\xae\x8e\x8e\x78\x4a\xcb\x38\xee\x6a\x39\xe2\xf1\x07\x89\x76\xbb\xec\xce\x82\xbb\xa5\x96\x4c\x95\xb9\xb6\xe5\xf2\x79\x49\x40\x70\xad\x3c\x3e\xfb\x80\x41\xd3\x58\xa6\x4a\x84\xfc\x3d\xee\x58\x2d\xfd\x2a\x60\xe8\x08\x73\x63\x85\xf2\x3b\xc8\xfe\xf9\x67\x06\x79\xdb\x06\x62\x54\x45\xfa\x17\xd9\xde\x3c\xe2\x61\x0e\x6f\xbe\x30\x59\x23\xbc\xbb\x81\x7c\xc0\x4f\x67\x6d\x4b\x17\x35\x94\x14\x69\x47\xe2\x66\x14\x10\x6f\xba\x8b\x25\x29\xc3\x5b\x6d\x1a\x78\x12\x7e\x0f\xf9\x47\x54\xf7\xc6\x3b\xda\x5c\x2e\x4b\xfd\xae\x44\x85\x96\x79\x04\xf7\xc4\xca\x12\x2d\x1c\x37\xd0\x7e\x41\x0b\x8b\x85\x67\xb6\x44\x1f\x62\xe5\x21\xfc\x5d\x33\xbf\x87\xb6\x85\xc5\x42\xb1\x2a\x06\xd1\x2f\xf4\x27\x6c\x39\x83\x3c\x6c\x6d\x0c\xf2\x44\x39\x69\x9a\x45\x08\xd6\x51\xac\xc5\x00\x56\x38\xda\xce\xb4\x21\xf5\x42\x2b\x97\x45\x81\xcc\x88\xc5\xd5\x78\xed\x83\xfa\x18\xdd\x9d\xae\xcf\xba\x40\x79\x49\xdb\xe8\x20\xab\x68\xd5\xe9\x0a\x8b\x91\xb6\x73\x29\xd7\xf4\x6d\x02\x5e\x97\x14\x8e\x4f\x32\x8b\xce\x33\x23\xb2\x08\x57\x38\x1b\xa9\xbc\x20\xe8\x9a\xce\x3b\x29\x50\xf9\x4b\x3a\xc7\x27\x19\x0f\xcb\xe4\x65\x5c\x8c\x74\x5e\x10\x74\x4d\xe7\x03\x56\x46\x32\x8f\xef\x85\x8d\xe2\x7c\xda\x58\x14\xc2\xc6\x28\x19\x51\x8c\x25\xa4\x44\xb9\xef\x6f\x39\xca\xe8\x6f\x3d\x08\xb8\xc6\xf5\xc0\xca\x44\xef\xe9\xdf\x45\x52\x32\x71\x6d\x85\xe2\xc2\x30\x19\x89\x4d\xbf\x24\x8e\xe1\xe1\x39\x6b\xca\xe0\x0d\xdf\x63\x35\x46\x74\x7c\x92\x85\x42\x18\xe5\x17\xf1\x64\xe1\xe2\x11\x29\xb9\x20\xe6\x12\x9e\xc9\xaf\x10\x64\x6e\x10\x82\x57\x5d\xd3\x16\xa6\xd4\x0d\xf2\x95\xe2\xb2\x2e\x30\x70\xce\xc6\x7b\xff\x65\x52\x14\xcc\x6b\x3b\x4b\x19\xf9\x28\x4c\x14\xeb\xbe\x2a\xef\x67\xa6\x0a\x89\xf6\x44\xe2\x9a\x59\x56\xa1\x47\xeb\xe0\xe4\xe4\x57\x74\x46\x2b\x87\x6e\xa8\xeb\x98\xc2\x67\xfa\x86\xbc\x9b\xda\x84\x56\x73\x64\x74\x71\xe7\x45\xae\xcf\x4c\xa8\xc8\x82\xcf\x61\x63\x51\x31\xa1\xce\x2f\xf2\x43\x3c\xa5\x2a\x34\x26\xa7\x02\x75\xe1\xde\xeb\xca\xbc\x67\x9e\xa5\x1b\xad\x2b\xb3\x28\x98\x67\xe7\x84\xbf\x09\xbf\xbf\x8b\xb5\x3f\xd2\x52\x5d\x5d\xa4\x6e\x30\x24\xef\xfe\xed\x6a\xc5\x81\x6b\xb5\x13\x65\x6d\xf1\x27\xc9\x4a\x37\x65\x46\xc0\xdb\xa6\xe9\x4a\x74\xdb\xe6\x54\xe0\x99\xe3\x4c\x8a\xbf\xb0\x2f\xa7\xb7\xeb\xd5\x0c\x9a\x09\xc0\x72\x09\xcc\x88\xfc\x4e\x57\x15\x53\xc5\x27\xa1\xf0\xde\x04\x80\x3f\x5a\x5d\x1b\x07\x37\xf0\xfb\x1f\x54\xc0\xaf\x51\x34\x90\xe7\x39\xb4\x93\x76\x72\x62\xce\xed\x7a\xf5\x4d\xc6\x50\xd4\xe7\x29\x48\x3a\xcb\x7a\x61\xe0\xf7\x48\x76\xc2\x1e\x2d\x4e\x20\x98\x1c\x8a\xd9\x07\x9a\x02\xe0\x26\xcd\x0a\x83\xbd\x49\x94\xb0\x41\x0f\x07\x5d\x5b\xe0\xb5\xf3\xba\x02\xa9\x43\x2b\x0a\x89\x87\x05\x16\x39\xa4\x7c\x02\xad\xc2\xd4\x24\x75\x19\xf2\xd8\xef\xa2\x80\x0f\xcf\x06\xb9\xc7\x02\x84\xf2\x68\x77\x8c\x23\x90\x9f\x53\xe7\xad\x50\xe5\x9c\xbc\xef\x4f\x9a\x76\x16\x98\x3a\x4e\x56\x19\x89\xef\x8e\x20\x7f\x8a\xca\x6f\x86\x4a\x42\x9f\xed\xb2\xf5\x4e\x2b\x57\x57\xe8\xfa\xea\x40\xfd\x5a\x22\x8d\x5c\xb1\x84\xb5\x2d\xc9\xb9\x08\x62\xe2\x25\xf1\x94\xe2\x67\x8c\xb1\xa1\x4b\x87\xaf\x93\x91\x46\x9a\xce\x24\xfb\x13\x39\x1d\x3c\xb7\x20\x74\xfe\x2b\xb2\x02\xed\x1c\x52\x07\x1f\x42\x10\xef\x22\x5c\x21\x80\x45\x5f\x5b\xd5\x5d\xcf\x2f\xda\xf7\x76\x61\x31\xcd\x9a\x26\x68\x6e\x5b\xba\xe9\xa8\x79\xcf\x5c\x48\xca\x03\xd2\x60\x8a\x0a\xc4\x91\x21\x23\x78\xdb\xd9\x70\xcc\x39\xfe\xeb\x30\x5c\x5b\x5d\xd4\xfc\xfb\x30\x4c\xbc\x3f\x84\xe1\x40\x46\x87\x61\xb7\x75\xc4\xf0\x89\x30\xfc\xcd\x0a\x4f\x18\x52\x35\xf8\x71\x04\x4d\xa7\xf7\x87\x11\xdc\xa4\x29\xf6\x3d\xee\x84\x12\x5d\x2b\x0d\xc6\x74\xa5\x6a\xe5\xfe\xcd\x9c\xe0\xb7\x75\x1c\xc2\x42\x84\xdf\x1a\x23\x05\x3a\x78\xda\xa3\x0a\xf9\x4a\xa7\xda\x8a\xbf\x22\x7a\xfb\x10\x31\xe1\x61\x82\x3e\x8e\x8c\x44\x14\xe4\x40\xec\x6f\x29\xb1\xc7\xb0\xae\xde\x53\x85\x20\x45\x37\x31\xf3\x6a\x87\x16\xba\xf4\x33\xcc\xb9\xb4\x98\xc1\xb4\x69\x52\x49\x9f\x02\xfe\x39\xec\xc7\xd9\x00\xde\x0c\x66\x6d\xfb\x76\xd0\x36\x8f\x74\x6d\x3b\x8f\x40\xcf\xc6\xe0\x2b\x21\xe7\xd7\x6e\x60\x1b\x1c\x60\x64\x20\x19\x90\x0c\x9e\xbd\xe2\x1a\x7a\x44\x29\xa2\x12\xac\xb7\xeb\xd5\x7f\xf0\xf0\x32\xae\xd9\x60\x2c\xce\xe2\x5c\xa7\x6b\xcb\xc3\x94\x1c\xe1\x7d\x1d\x90\x5e\x3f\xa2\xfa\x7b\xc1\xa3\x92\xfe\x88\x87\x08\xdf\x10\xbd\x63\x5c\xef\xac\xae\x68\x19\x7d\xa4\x40\xa7\x91\x01\x7e\x1f\x80\xf0\xc7\x77\x82\x7d\x4f\x68\xfc\x2b\x02\xfd\x8d\x78\xcd\xc1\x71\x6d\xd0\x51\x77\xfc\x3b\x01\xd4\x2c\xb8\xb0\x45\x66\xd1\x9e\xc3\xf8\x2d\xb8\xc4\x99\xe2\xc2\x22\x3e\x41\x2e\xd6\x84\xcb\x4d\x96\xa5\xc4\x7f\xb1\xd1\x76\x6f\xe5\xbc\x2b\x13\x58\x4c\x67\x57\x7b\x6e\x57\x4a\x7b\x62\xfb\x62\xa7\xbd\x5d\xaf\x8e\x94\x70\x73\x55\xd9\x89\xaf\x67\x4f\x88\xae\xce\xa7\x41\xbd\x7b\xc5\x74\x8f\xe1\xf0\x0c\x3f\x46\xcc\xfa\xb8\x1b\xaa\xea\xc5\xfe\xd0\x8d\x38\xd4\x62\xbe\x36\x18\x25\xda\x63\xdf\x48\x1d\x6d\x3c\x29\x72\xff\x0c\x69\x4a\xcc\xd3\xee\x1c\xfa\x08\x0b\xe9\xe2\x5e\xa1\x2c\x8c\xe2\x2e\xf8\x3a\x80\x89\x82\x72\xf8\xca\xf9\xd1\x10\x4f\xd0\xcc\x06\xdf\x62\xf2\x38\xea\x17\x78\xd2\xf2\x06\x14\x67\x61\x3f\x78\xd4\xbd\x70\x37\xe7\x57\x92\x8f\x2e\x2c\x95\x98\x57\x74\xcc\x41\xab\x1c\x8e\x9f\x76\xb3\xaf\x7d\xa1\x9f\x54\x57\x23\x66\xd0\x50\x52\x4d\x7a\x27\x1c\xfa\xda\x7c\x94\x7a\xcb\xe4\xe7\xde\x9f\x69\x2f\x60\x1a\xce\x8f\x27\x6e\x36\xa3\x51\x3a\x7c\xcb\x43\x78\xf8\xb4\xe9\x67\xe0\xe8\xee\x16\x77\xda\x22\xfc\xfc\xf0\xb0\xde\x74\xdf\x51\x9c\x67\xd6\xbb\xfc\x64\xfe\x7e\xf8\xb4\x99\x7a\xe9\xee\xc2\x1a\xde\x7a\xe9\xf2\xf8\xbf\x9f\xfb\x3f\xb3\x47\x04\x26\x25\x28\xe4\xe8\x1c\xb3\x07\xe0\x7b\xca\x00\x07\x5e\x87\x4e\x73\xae\x9f\xe6\xef\x3c\x59\x78\xeb\xc0\x69\xad\x80\xb9\xce\x12\xe1\x20\x54\x87\x00\x6f\x01\xdb\xda\x07\x5c\x6d\xad\x08\xdb\x39\xf8\xf0\x7d\xb2\x56\x3c\xc8\x0a\x1f\x20\xb7\x08\x9c\x49\x89\x45\x4e\x22\x57\x3b\x2a\x24\xa1\x64\x90\x0d\x95\x2e\xc4\xee\x00\x2c\x19\x31\x07\xe7\xc9\xfb\x4e\x9b\x72\x9e\x29\x1e\x3e\x72\x3a\xaf\x0d\x08\x1a\x44\x0b\xf1\x45\x14\x35\x93\xf2\x00\x92\x85\xf9\x2a\x68\x15\x2e\x78\x64\x24\xe3\x98\x4f\xfa\x6f\xa5\x9d\x2d\x9c\xa9\xa3\x29\x50\xd5\xd2\x0b\x23\x11\xa8\xe6\xb8\x39\x14\x68\x50\x15\x42\x95\xa0\x63\x0b\x56\x75\xb5\x45\x0b\x7a\x17\x6c\xa1\x83\x38\xc1\xb8\x20\x3a\xbd\xd6\xe3\x57\xb7\xce\x4b\x9a\x7a\x18\xe7\xda\x92\x1c\x79\x78\x97\xde\xf9\xf3\xf8\xeb\x32\x7a\x30\x67\xb5\x12\xcf\xd9\xc9\x45\xc6\x40\x9b\x3a\x78\xdb\x7d\x36\x4c\xb1\x37\x4f\x8a\xe6\xc0\x8a\xc2\xf6\x8d\xbc\x19\x04\xd0\xe0\x83\xe7\xf8\x1e\xc9\x77\x6d\x83\x2f\xfb\x54\x90\xf0\x19\x79\xed\xa9\xc9\x10\xab\x43\x28\x74\xb8\x3d\x66\x8c\x3c\x74\x11\x91\xbe\xe5\xe5\xff\x73\x5a\x41\xa1\x79\x4d\x59\x92\x5f\x50\x17\xa5\xa1\x03\xb6\xf3\x68\xc1\xea\xda\x13\x4c\x14\x12\x29\x86\xa9\x4b\xa0\xf2\x82\x07\x8b\xe6\xb0\x15\x11\x62\xa6\x0a\x82\x4e\x14\x61\x3f\x82\x71\x9a\x25\xd3\xce\xe8\xe1\xab\xf1\xec\x0d\xf9\x8f\x94\x83\x89\xf8\x35\xb8\xec\x99\x31\xa8\x5c\x6f\xa3\x3a\xf8\x7d\x68\xf7\x21\x88\x06\x6c\x4c\x3a\x1d\xa0\x11\x31\x5d\xba\x38\x78\x19\xa4\x8d\xee\xa3\x91\x41\xa9\x75\x11\x03\x92\x04\x18\x59\x97\x20\x14\x30\x30\x4c\x09\x1e\x8d\x26\x89\x47\xa5\xf3\xf0\x7c\xed\x30\xaa\xd0\x5b\xc1\xdd\x00\xa0\xb3\x32\xf3\x9d\x28\xfd\x3f\x00\x00\xff\xff\xe4\xf9\x38\x6b\x4a\x18\x00\x00")
+var _templatesServerConfigureapiGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\xcd\x6f\xdb\x38\x16\x3f\xaf\xff\x8a\x07\xa1\x0b\xd8\x85\x2d\x03\x73\x2c\x90\x43\x36\xe9\x74\x8c\x6d\xa7\x46\x9d\xdd\x39\x0c\xe6\xc0\x48\xcf\x32\x37\x14\xc9\x21\xa9\x26\x1e\x42\xff\xfb\xe2\x91\x94\x2c\xf9\x23\x69\x9b\xc3\x9c\x6c\x91\xef\xf3\xf7\x3e\xf8\xc8\xe5\x12\xee\x76\xdc\xc2\x96\x0b\x04\x6e\xc1\xb2\x2d\x82\x53\x80\x25\x77\x39\x7c\x96\x05\x02\x77\x80\x4f\xdc\x3a\x4b\xff\x1e\xb9\x10\x20\x95\x83\x7b\x04\xf5\x15\xcd\xa3\xe1\xce\xa1\x9c\x4c\x26\xde\x03\xdf\x42\x7e\xa3\xf4\xde\xf0\x6a\xe7\x60\xd1\xb6\xcb\x25\x78\x0f\x85\xaa\x6b\x94\xee\x68\xcf\x7b\x40\x59\x42\xdb\x4e\x26\x13\xcd\x8a\x07\x56\x21\x11\xe7\xd7\xeb\xd5\x3a\x7d\xd2\x1e\xaf\xb5\x32\x0e\xa6\x13\x80\xac\x30\x7b\xed\xd4\xd2\x09\x9b\xd1\xa7\x44\xb7\xdc\x39\xa7\xc3\x87\x50\x55\x36\x99\x00\xa0\x31\xca\x58\xc8\x2a\xee\x76\xcd\x7d\x5e\xa8\x7a\x59\xa9\x85\xd2\x28\x99\xe6\xcb\xb8\x4b\x0c\xa6\x91\x8e\xd7\x78\x89\x30\x6d\x13\x65\xcd\xcb\x52\xe0\x23\x33\x2f\x11\x2f\x0f\x94\xc4\x67\xb1\x68\x0c\x77\xfb\x97\xb8\x3a\x3a\xe2\xc9\x2a\x25\x98\xac\x72\x65\xaa\xe5\xd3\x92\x1c\x2c\x94\x74\xf8\xe4\x82\x6f\xde\x1b\x26\x2b\x84\xfc\x16\xb7\xac\x11\x6e\x15\xb0\xb1\x6d\xeb\xbd\x36\x5c\xba\x2d\x64\xff\xfc\x33\x83\xbc\x6d\x03\x31\xca\x32\xfd\x8b\x6c\x6f\x1e\x70\x3f\x87\x37\x5f\x99\x68\x10\xde\x5d\x41\x3e\xe0\xa7\xbd\xb6\xa5\x00\x0c\x25\x45\xda\x91\xb8\x19\x05\xfa\x4d\x17\x30\x92\x32\x8c\x96\xf7\xf0\xc8\xdd\x0e\xf2\x0f\x28\x3f\x6b\x67\x29\xbc\xcb\x65\xa5\xde\x55\x28\xd1\x30\x87\x60\x1f\x59\x55\xa1\x81\xc3\x02\x9a\xaf\x68\x60\xb1\x70\xcc\x54\xe8\xc8\x84\xfc\x2e\xfc\x5d\x33\xb7\x83\xb6\x85\xc5\x42\xb2\x3a\x26\xc7\xaf\xf4\x27\x2c\x59\x8d\x45\x58\xda\x68\x2c\x12\xe5\xc4\xfb\x45\x48\xc2\x51\x0e\xc5\xc4\x94\x38\x5a\xce\x94\x26\x7b\xb8\x92\x36\x8b\x3a\x98\xe6\x8b\x8b\x79\xd8\x27\xeb\x21\x6b\x3b\x5d\x9f\x54\x89\xe2\x9c\xb6\xd1\x46\x56\xd3\x57\xa7\x2b\x7c\x8c\xb4\x9d\x4a\xb9\xa4\x6f\x13\xf0\x3a\xa7\x70\xbc\x93\x19\xb4\x8e\x69\x9e\x05\xef\x6c\xd8\x1b\xa9\x3c\x23\xe8\x92\xce\x1b\xc1\x51\xba\x73\x3a\xc7\x3b\x59\x11\x3e\x93\x97\xf1\x63\xa4\xf3\x8c\xa0\x4b\x3a\xef\xb0\xd6\x82\x39\xbc\xe5\x26\x8a\x73\x69\x61\x51\x72\x13\x84\x8d\x29\xc6\x12\x52\xa1\x7c\xee\xa3\x1c\x65\xf4\x51\x0f\x02\x2e\x71\xdd\xb1\xca\x26\x9d\xf4\xef\x2c\x29\x99\xb8\x36\x5c\x16\x5c\x33\x11\x89\x75\xff\xe9\xfd\x78\xf3\x94\x35\x55\xf0\xa6\xd8\x61\x3d\x46\x74\xbc\x93\x85\x06\x17\xe5\x97\x71\x67\x61\xe3\x96\xf7\xc7\xc4\x03\x45\x67\xfd\x0a\x49\x96\x3c\x0b\x29\x78\xd1\x35\x65\x60\x4a\x5d\x3e\x5f\xc9\x42\x34\x25\x06\xce\xd9\x78\xed\xbf\x4c\xf0\x92\x39\x65\x66\xa9\x22\x1f\xb8\x8e\x62\xed\x8b\xf2\x7e\x61\xb2\x14\x68\x8e\x24\xae\x99\x61\x35\x3a\x34\x16\x8e\x76\xbe\xa0\xd5\x4a\x5a\xb4\x43\x5d\x87\x12\x3e\xd1\x37\xe4\xdd\x34\x9a\xda\xdc\x80\xd1\xc6\x95\x67\xb9\x3e\x31\x2e\x23\x0b\x3e\x85\x85\x45\xcd\xb8\x3c\x61\xc9\xdf\xc7\x5d\xea\x42\x63\x72\x6a\x50\xa7\xe4\xb7\x4d\xad\x6f\x99\x63\x29\xa2\x4d\xad\x17\x25\x73\xec\x94\xf0\x37\xee\x76\x37\xb1\xf7\x47\x5a\xea\xab\x8b\x74\x1a\x9c\x92\x27\x40\x57\xd2\xa1\xd9\xb2\x22\x35\xc9\x5d\x5c\x5d\xf0\x7e\xd9\xfb\xb3\xb4\x43\x81\xdd\xbf\x6d\x23\x0b\x28\x94\xdc\xf2\xaa\x31\xf8\xb3\x60\x95\x9d\x32\xcd\xe1\xad\xf7\x5d\xcf\x6f\xdb\x9c\x4e\x0c\x66\x0b\x26\xf8\x5f\xd8\xf7\xe7\xeb\xf5\x6a\x06\x7e\x02\xb0\x5c\x02\xd3\x3c\xbf\x51\x75\xcd\x64\xf9\x91\x4b\xfc\xac\x43\x39\x7e\x30\xaa\xd1\x16\xae\xe0\xf7\x3f\xe8\x44\xb8\x44\xe1\x21\xcf\x73\x68\x27\xed\xe4\xc8\x9c\xeb\xf5\xea\xbb\x8c\xa1\x32\xea\x1c\xef\x2c\xeb\x85\x81\xdb\x21\xd9\x09\x3b\x34\x38\x01\xfa\x1b\xbb\xe3\x7b\x1a\x17\xe0\x2a\x0d\x15\x83\x35\x3a\x8d\x97\x4b\xd8\xa0\x83\xbd\x6a\x0c\x14\x8d\x75\xaa\x06\xa1\xc2\xd9\x46\xa9\x84\x58\x62\x99\x43\x2a\x50\x50\x32\x8c\x57\x42\x55\xa1\x31\xb8\x6d\x14\xf0\xfe\x49\x63\xe1\xb0\x84\x43\x84\xc8\xcf\xa9\x75\x86\xcb\x6a\x4e\xde\xf7\x3b\xbe\x9d\x05\xa6\x8e\x93\xd5\x5a\xe0\xbb\x03\xc8\x1f\xa3\xf2\xab\xa1\x92\x70\x70\x77\xe5\x7f\xa3\xa4\x6d\x6a\xb4\x7d\xbb\xa1\x01\x40\x20\xcd\x66\xa1\x8c\xa0\x6d\x49\xce\x59\x10\x13\x2f\x89\xf7\xfe\x0c\x63\x50\x84\xc2\xe2\xb7\xc9\x48\xb3\x4f\x67\x92\xf9\x99\x9c\x0e\x9e\x1b\xe0\x2a\xff\x82\xac\x44\x33\x87\x34\x12\x0c\x21\x88\xb1\x08\x21\x04\x30\xe8\x1a\x23\xbb\xf0\xfc\xaa\x5c\x6f\x17\x96\xd3\xcc\xfb\x90\x02\x6d\x4b\x59\x1c\xd4\xc0\x8e\xd9\x50\xe5\x7b\xa4\x09\x16\x25\xf0\x03\x43\x46\xf0\xb6\xb3\xe1\xdc\x74\xf8\xd7\x61\xb8\x36\xaa\x6c\x8a\x1f\xc3\x30\xf1\xbe\x0a\xc3\x81\x8c\x0e\xc3\x6e\xe9\x80\xe1\x23\x61\xf8\x9b\xe1\x8e\x30\xa4\xf6\xf2\x7a\x04\x75\xa7\xf7\xd5\x08\x6e\xd2\xb8\x7b\x8b\x5b\x2e\x79\x77\x36\x87\x70\x76\xcd\x6c\x65\xff\xc5\x2c\x2f\xae\x9b\x38\xd5\x85\x0c\xbf\xd6\x5a\x70\xb4\xf0\xb8\x43\x19\xea\x95\x76\x95\xe1\x7f\xc5\xd4\xdd\x85\x8c\xa1\x12\xb3\x48\xb7\x14\xb7\x0b\x44\x41\x0e\xc4\x03\x33\x15\xf6\x18\xd6\xd5\x2d\xb5\x2b\x52\x74\x05\x21\xff\x1a\x8b\x06\xba\xf2\xd3\xcc\xda\xf4\x31\x83\xa9\xf7\xe9\x8c\x98\x02\xfe\x39\x3c\xe0\xb3\x01\xbc\x19\xcc\xda\xf6\x6d\xdf\x45\xbd\x3f\xd0\xb5\xed\x3c\x02\x3d\x1b\x83\x2f\xb9\x98\x5f\x8a\xc0\x7d\x70\x80\x91\x81\x64\x40\x32\x78\xf6\x0d\x61\xe8\x11\xa5\x8c\x4a\xb0\x5e\xaf\x57\xff\xc6\xfd\xf3\xb8\x66\x83\x39\x3b\xa3\xb8\xe5\x1b\xd5\x98\x70\x4a\x24\x78\xbf\x0d\x48\xa7\x1e\x50\xfe\xbd\xe0\x51\x4b\x7f\xc0\x7d\x84\x6f\x88\xde\x21\xaf\xb7\x46\xd5\xe0\x7d\xf2\xb1\x6d\x41\xd3\x0c\x02\xbf\x0f\x40\xf8\xe3\x07\xc1\xfe\x4c\x68\xfc\x14\x81\xfe\x4e\xbc\xe6\x60\x0b\xa5\xd1\xd2\xe9\xf8\x77\x02\xa8\x08\xb9\x9f\xe0\x1e\x99\x41\x73\x0a\xe3\xf7\xe0\x12\xaa\x61\x72\xe6\x83\x6f\x2f\xf6\x84\xf3\x87\x2c\x4b\x85\xff\xec\x41\xdb\x5d\xaa\xf3\xae\x4d\x60\x39\x9d\x5d\x3c\x73\xbb\x56\xda\x13\x9b\x67\x4f\xda\xeb\xf5\xea\x40\x09\x57\x17\x95\x9d\xf5\xf5\x78\xfa\x5a\xa3\xb9\x63\x15\x1c\x75\xc9\xfe\xe2\x92\x06\xa5\xae\x5c\xbf\x60\xc5\xad\x43\x13\xc7\x8e\x1e\xef\x40\x0a\x6a\x0b\xc3\xcb\x79\x1c\x88\xde\x9c\xcc\x7b\x21\xaf\xbb\xec\x1e\xce\x3c\x9d\xf0\x97\xb9\xa6\x47\x7a\xfe\x23\x07\xa1\x7f\x99\xdd\xb7\x67\xe0\x39\xd4\xce\x31\xef\x1a\xcd\xf5\x7a\xf5\x0a\x0c\xce\x5b\x93\x65\x97\xfd\x7f\x86\xe3\x25\xdf\x9f\x61\xed\xfc\x8e\xae\x26\xbf\x8f\x23\x6e\xbb\xe3\x3f\x5d\x08\xd3\x78\xdb\xeb\xa4\xb2\x1e\x34\x92\x7e\xfa\x4d\x87\xed\xb8\xcd\x24\xc0\x93\x49\x61\xf2\x78\x69\x5e\x4e\xb4\x87\x71\xc2\xfb\x33\x37\x92\xc2\x3d\x41\xba\x8d\xe4\x69\x75\x0e\x7d\xe3\x09\x5d\xd4\x7e\x83\xb2\x70\xe5\xb3\xc1\xd7\x41\xf5\x50\xaf\x1a\xde\xa6\x5f\xdb\xf9\x12\x34\xb3\xc1\x5b\x5e\x1e\xaf\x94\x25\x9a\x71\x3b\x1c\x50\x9c\x74\xc3\x2e\x42\xf0\x6c\x6c\x4e\x43\x92\x8f\x02\x96\x4e\x9e\x97\x9b\xe7\xec\x68\x82\xea\xab\x25\xa5\x6b\xb8\x8e\x98\xcd\xae\x71\xa5\x7a\x94\xdd\x39\x32\x03\x4f\x07\xd2\xa4\xf7\xc8\xa2\x6b\xf4\x07\xa1\xee\x99\xf8\xd4\x3b\x37\xed\x05\x4c\xc3\xfe\x61\xc7\xce\x66\x74\xdd\x0a\x0f\xc3\x08\x77\x1f\x37\xfd\x3d\x29\x16\xd8\x3d\x6e\x95\x41\xf8\xe5\xee\x6e\xbd\xe9\x1e\xef\xac\x63\xc6\xd9\xfc\xe8\x8e\x76\xf7\x71\x33\x75\xc2\xde\x04\x76\x78\xeb\x84\xa5\x4c\xd9\xf2\xaa\xbf\x1b\x7e\x62\x0f\x08\x8c\x5e\x94\xb1\x40\x6b\x99\xd9\x43\xb1\xa3\x72\xb0\xf4\x06\xed\xce\xea\xa7\x7e\x95\x27\x0b\xaf\x2d\x58\xa5\x24\x30\xdb\x59\xc2\x2d\x84\xa9\x32\x60\x5d\xc2\x7d\xe3\x42\xe6\x98\x46\xd2\xe9\x3d\x07\x17\x1e\xbb\x1b\x59\x04\x5f\xc2\x6b\xf6\x3d\x42\xc1\x84\xc0\x32\x9f\x2c\x97\xb0\xda\x52\x6b\x0d\xf7\x37\xb2\xa1\x56\x25\xdf\xee\x81\x25\x23\xe6\x60\x1d\x79\xdf\x69\x93\xd6\x31\x7a\x23\x77\x8a\x36\x34\xbd\x90\x73\x59\xf2\xaf\xbc\x6c\x98\x10\x7b\xa0\xe7\x29\x93\xb4\x72\x1b\x46\x52\x2d\x58\x81\x41\xd5\xdd\xc8\x96\x82\xc9\x83\x29\x50\x37\xc2\x71\x2d\x10\xe8\x61\xd9\xce\xa1\x44\x8d\xb2\xe4\xb2\x02\x15\xc7\x34\xd9\xd4\xf7\x68\xa8\xe5\x93\x2d\xb4\x11\xa7\x5c\x1b\x44\xa7\x27\xa2\xf0\x7c\xdb\x7b\x49\x93\x31\x2b\x0a\x65\x48\x8e\xd8\xbf\x4b\x8f\x4b\xf3\xf8\x6b\x33\x7a\xa5\xc9\x1a\xc9\x9f\xb2\xa3\x40\xc6\x44\x9b\x5a\x78\x4b\x84\xe9\x9d\x71\x9e\x14\xce\x81\x95\x65\x37\x32\x53\x64\x0f\xc9\x73\xa8\xa5\x5e\x56\x8c\x21\xf9\xad\x4c\xf0\x23\x3d\x51\x00\x3e\x61\xd1\x38\x1a\x42\x28\xef\x2c\x42\xa9\x42\xe4\x98\xd6\x62\xdf\x65\x43\x7a\x3c\xce\xff\x67\x95\x84\x52\x15\x0d\x95\x4b\x7e\x46\x5d\x94\x86\x16\xd8\x96\x0e\x0a\xa3\x1a\x47\x10\x51\x3a\xa4\xfc\xa5\x29\x02\xa5\xe3\x45\xb0\x68\x0e\xf7\x14\x37\x59\x01\x93\x25\x7c\x8d\x2f\x5b\x5c\xc9\x08\xc4\x71\x85\x4c\x3b\xa3\x87\xaf\x0a\x27\x6f\x0c\xff\x48\xf5\x97\x88\xbf\x05\x97\x1d\xd3\x1a\xa5\xed\x6d\x94\x7b\xb7\x0b\xe3\x60\x48\xdb\x01\x1b\x13\x56\x01\x4b\xa3\xbb\x53\x7d\x0e\x3c\x0f\xd2\x46\xf5\x99\xc8\xa0\x52\xaa\x8c\xc9\x48\xe8\x6a\xd1\x54\xc0\x25\x30\xd0\x4c\xf2\x22\x1a\x4d\x90\x1d\x94\xce\xe9\x61\xa1\xea\x30\xaa\xd1\x19\x5e\xd8\x01\x40\x27\x2d\xe6\x07\x51\xfa\xff\x00\x04\x00\x1c\x8c\x93\x1a\x00\x00")
 
 func templatesServerConfigureapiGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -391,7 +668,7 @@ func templatesServerConfigureapiGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/configureapi.gotmpl", size: 6218, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	info := bindataFileInfo{name: "templates/server/configureapi.gotmpl", size: 6803, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -416,7 +693,47 @@ func templatesServerDocGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesServerMainGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x57\x5f\x6f\xdb\x36\x10\x7f\x16\x3f\xc5\x55\xe8\x00\xa9\x73\xa8\x15\x7b\x4b\xe1\x87\x20\x7f\x3a\x0f\x69\x12\xc0\xe9\xc3\xb0\x0e\x05\x23\x9e\x64\x2e\x34\xa9\x91\x54\xdc\xcc\xd0\x77\x1f\x48\x31\xb6\x1c\xdb\x4d\xb6\x22\xc0\x80\xbe\x58\x16\xef\xee\xc7\xe3\xef\xfe\x51\x45\x01\xc7\x9a\x23\xd4\xa8\xd0\x30\x87\x1c\x6e\xee\xa1\xd6\x07\x76\xc1\xea\x1a\xcd\x3b\x38\xb9\x84\x8b\xcb\x6b\x38\x3d\x99\x5c\x53\x42\x08\x2c\x97\x20\x2a\xa0\xc7\xba\xb9\x37\xa2\x9e\x39\x38\xe8\xba\xa2\xf0\xcb\xa5\x9e\xcf\x51\xb9\x47\xb2\xe5\x12\x50\x71\xe8\x3a\x42\x48\xc3\xca\x5b\x56\x23\xcc\x99\x50\x84\x88\x79\xa3\x8d\x83\x8c\x00\xa4\x52\xd7\xa9\x7f\x6a\x1b\x1e\x0a\x5d\x31\x73\xae\x49\x09\x01\x90\x9a\x71\x0b\x69\x2d\xdc\xac\xbd\xa1\xa5\x9e\x17\xb5\x3e\xd0\x0d\x2a\xd6\x88\x22\x08\x53\x92\x44\xb7\x3e\x5a\x7c\xaf\xa7\xce\xb4\xa5\x3b\x93\xac\xb6\xd0\x75\x55\x78\x0e\xcd\xff\x44\x6b\xf1\x8e\xdf\x7a\x9c\x20\xf5\x7b\x46\x3f\x0f\xba\xae\x7f\x89\x68\x57\x43\x98\x0d\x14\xdb\x54\x6f\x7f\x2e\x1a\xbf\xfe\xc8\x3e\xa9\x0d\x2b\xb1\x6a\xe5\x86\xbe\xbb\x97\x68\x6e\x8a\x07\x59\x38\xda\x72\x69\x98\xaa\x11\xe8\x09\x56\xac\x95\x6e\x12\x28\xb1\x9e\xb5\xc6\x08\xe5\x2a\x48\x7f\xf8\x2b\x05\x1a\x9d\x42\xc5\xe3\xbf\xde\xec\xf5\x2d\xde\x8f\xe0\xf5\x1d\x93\x2d\xc2\xe1\x18\xe8\xc0\xde\xcb\xba\xce\xbb\x35\x44\xea\x75\x37\xe0\x72\x42\x8a\x02\xae\x67\xc2\x42\x25\x24\xc2\x82\xd9\xcd\x6c\x70\x33\x84\x98\x0e\xe0\xb4\x96\xd4\xeb\x7f\x60\xb7\x08\xb6\x35\x08\x4a\x3b\x70\x1a\xf4\x1d\x9a\x85\x11\x0e\xc1\xad\xa0\x58\xe5\xd0\xc0\xbd\x6e\x07\x80\xc2\xc1\x0d\x96\xac\xb5\x08\x4c\x4a\x2f\x34\x80\x5c\x38\x0b\x0b\xdd\x4a\x0e\x37\x08\x52\x5b\xf7\x8a\xc4\x18\x9c\x7e\x29\x65\xcb\x71\xda\x60\xe9\x93\xa8\x6a\x55\x09\x42\x09\x97\xe5\xb0\x7c\x48\x0e\x7a\xc4\xf9\xb9\x66\x1c\x4d\x56\xcd\x9d\xa5\xbf\x1d\x7d\x38\xff\xc0\x5c\x39\x43\x33\x82\xd5\xca\x89\x2e\x73\xd2\x91\x41\x42\x06\x30\x9f\x8c\x11\x6c\x47\xd8\xfb\x25\x7f\xc6\xc7\x9e\xc0\x03\x29\x7e\x61\x04\x68\x8c\x0f\x41\xef\xcf\xe9\xfc\x06\x39\x47\x9e\x2d\x97\x40\x8f\xae\x26\x57\x31\xf1\xbb\x8e\x4e\x7b\xa3\x5f\xa7\x97\x17\x23\xd8\x16\x9f\x49\xe6\x06\x2a\x39\x01\xbf\xbf\x07\x7f\x35\x06\x25\x64\xf0\xd3\x1f\xbb\xa6\x67\xcc\x31\x29\x55\x86\xc6\x78\xb5\x6e\x9d\x84\xc1\xbb\x3b\x66\xc0\xa2\xb9\x43\x03\x6f\x76\xb8\xd1\x4b\x8a\x02\xe6\xab\x48\x7a\x5a\x41\x58\x28\x99\x94\xc8\x09\x49\x7c\x6e\xd3\x8f\xd6\x9b\x8c\xc1\x93\x15\x79\x02\x4f\x2a\x3d\x0b\x89\x95\x69\x4b\xa7\x8e\xa3\x31\x23\x48\x83\xee\xe1\x27\x95\xe6\x24\x49\xf6\xe8\x04\x2f\x39\xb3\x33\x34\xe2\x6f\x04\x7a\xc1\xe6\xde\xa3\x83\xe8\xeb\xef\x97\x57\xd7\x93\xcb\x8b\xe9\x1f\x9f\x54\xc0\x09\xdb\x39\xe1\x64\xc8\xf0\x18\xa1\x89\xaa\xf4\x2a\x38\xe1\x8d\x5e\x07\x95\xb0\xb6\x51\x3a\x8f\x85\x28\x2d\xae\x4d\x37\x63\x9a\xa6\x6b\x85\x41\x70\xa9\xff\xc9\xf2\x01\xd4\x8a\xe7\x8d\x3f\x2f\x80\xec\xbb\xc9\x1e\x22\x03\x27\x3f\xa6\x91\xa6\x24\xe1\x68\xcb\xaf\x53\x74\x82\xb6\x34\xa2\x71\x42\xab\x7d\x44\x6d\xa9\x7c\xeb\xa1\x06\x80\x2f\x42\xda\x7e\xfc\x50\x04\xa1\x7a\x02\x33\xaf\xc6\x90\xa6\xb0\x24\xc9\x90\xcf\x6a\x48\xa8\x57\x1b\xf0\xb9\xc9\xbc\x54\x43\xd5\x50\x18\xc7\x7a\x3e\x67\x8a\x9f\x0b\x85\xbe\x6c\xeb\x90\xfc\x36\xcb\x73\xe2\x6d\x8b\x02\x1a\x66\x2c\x86\xf6\x79\x7c\x3e\x09\x36\x36\xd6\xd4\x95\x97\x64\x39\x59\xf7\x9c\xed\xe6\xd2\x97\xc3\x78\x47\x8f\xb8\xc0\x45\x5f\xbe\x99\x12\x32\xff\x6a\x23\x0a\x54\x59\x67\x84\xaa\xb3\x1e\x31\x2c\xe5\xff\xb2\xaf\xb0\x46\xf4\xa9\x45\xa3\x1b\xbd\x17\x3e\x85\x98\x2d\x99\x1c\x16\xf2\xd1\xd5\x24\x1b\x38\x94\xaf\xce\x42\xa7\xe8\xbc\x90\x35\x22\x8f\xbd\xaa\x8f\x2d\x81\xa4\xdf\xe0\xbf\xe1\x7b\xaa\x6b\x74\x0f\x8c\x2d\x84\x9b\x05\xb2\x21\xcc\xba\x30\x8a\x24\x72\xd0\xad\x23\xc9\xb3\x58\x1d\x38\xd8\x37\xd3\x84\x63\x85\x66\x75\x8c\x59\xeb\xb8\x5e\x28\x1f\xbf\x08\x48\x8f\xb5\xaa\x44\xdd\x1a\xf4\xde\xe5\x24\x89\xdc\x1e\x8e\xd7\x67\x37\x77\x98\xe5\xef\x36\x29\x4f\x92\x2d\xc2\x93\x6e\x83\x9b\x27\xd2\xe3\xf0\xe9\xfc\x18\xf2\xfc\xbf\x1b\x59\xdf\x9a\x5b\xc9\xf3\x78\x88\x11\xdd\x13\xc6\xc1\x8d\x00\xfa\xa2\x0d\x80\xa1\x60\x3d\x48\xa8\x56\x13\xeb\x67\x14\xd7\xe3\x8d\x2d\x5f\x99\xd0\xe9\x4c\x1b\x37\x6c\xa0\xdf\xe5\xb8\x5a\xd1\x71\xae\x55\xfd\x5c\x36\xbe\xbb\xc9\xb4\x6a\xfc\x7b\x6e\x96\x8f\xba\x4a\xb8\x8d\x66\x3e\xd7\x2a\x6d\xe0\xf3\x08\x74\xe3\xec\x7b\xa3\xdb\xc6\x27\x6a\xff\x31\xc0\x1a\x31\x1c\x49\x97\x61\xe7\x5e\xc9\xc6\x12\xfc\xbc\xaa\xf9\x18\xa3\x23\xce\x83\x42\xb6\xc2\xdb\xca\xe2\xc1\x5e\x8f\x43\x3a\x14\xc5\xed\xf2\x87\x99\xbb\x55\xfe\x3b\x1b\x40\x3f\x5d\x36\x6f\xae\xbe\x77\x6e\x39\x1a\x07\xe6\x56\xfb\x2c\xfd\xb7\xeb\xe1\x18\xde\x92\xc4\xdb\x55\x38\x02\x7d\xeb\x17\xd0\x18\x9a\xbd\xe9\x4b\xf5\xd4\x18\x6d\xf2\x77\x5e\x12\xe6\x7f\x50\xa4\xd7\xf7\x0d\xc2\xf8\xa1\xcc\x4f\x8d\xf9\x05\x65\xd3\x2b\xf4\xb0\x63\xf8\xc9\xbf\x74\xf1\x2e\xa0\x2d\x3d\xfd\x22\x5c\xe6\x65\xeb\x36\xbd\xbb\x39\xbf\xec\x3c\x7e\xa1\x81\xbc\x6f\xc8\x0d\x83\xb3\x23\x37\xfb\x89\xb7\xf6\xff\xa9\x99\xf7\xac\xcf\x97\x8e\xfc\x13\x00\x00\xff\xff\xd5\x24\x78\x71\x99\x10\x00\x00")
+var _templatesServerHandlersGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4d\x6f\xdb\x38\x10\xbd\xf3\x57\x0c\x04\x2f\x60\x17\xb1\x74\xef\xa2\x87\x20\xe9\xee\xfa\x92\x18\xd9\x2c\xf6\xcc\x48\x63\x89\xa8\x44\xb2\xd4\x28\x8e\x4b\xf0\xbf\x17\xd4\x27\xe5\xda\x75\xd3\xa2\x87\x02\xbd\x04\x91\x38\x9c\x79\xf3\xde\xcc\x33\x94\x24\x70\xa3\x32\x84\x1c\x25\x1a\x4e\x98\xc1\xd3\x01\x72\xb5\xae\xf7\x3c\xcf\xd1\xfc\x09\xb7\xf7\x70\x77\xff\x08\xef\x6f\x37\x8f\x31\x63\xcc\x5a\x10\x3b\x88\x6f\x94\x3e\x18\x91\x17\x04\x6b\xe7\x92\x04\xac\x85\x54\x55\x15\x4a\x3a\x3a\xb3\x16\x50\x66\xe0\x1c\x63\x4c\xf3\xf4\x03\xcf\x11\xac\x8d\xb7\xdd\xbf\xce\xf9\x84\x8b\xe1\xe0\xed\x3b\x18\x4e\xa0\x3f\xe2\x5a\xb7\xaf\xfd\x33\x4b\x12\x78\x2c\x44\x0d\x3b\x51\x22\xec\x79\x3d\x47\x4d\x05\x42\x0f\x1b\x48\xa9\x32\xf6\xf1\xef\x33\x41\x42\xe6\x40\xe3\xbd\xaa\x85\xad\x8d\x7a\x46\xd8\x35\xd4\xa6\x2a\x50\xc2\x41\x35\x60\x70\x6d\x1a\x39\xcb\x34\x94\x68\xfb\xe3\x32\x63\x4c\x54\x5a\x19\x82\x25\x03\x48\x95\x24\x7c\x21\x88\x72\x55\x72\x99\xc7\xca\xe4\xc9\x4b\x22\x91\x92\xfe\x24\x62\x00\x95\xc8\xb2\x12\xf7\xdc\x20\x44\xb9\xa0\xa2\x79\x8a\x53\x55\x25\xb9\x5a\x2b\x8d\x92\x6b\x91\x98\x46\x92\xa8\x30\x99\x22\x23\xc6\xc0\x93\x6a\xb8\xcc\x11\xe2\x5b\xdc\xf1\xa6\xa4\x4d\x5b\xb9\x06\xe7\xac\x05\x6d\x84\xa4\x1d\x44\x7f\x7c\x8c\x3a\x7a\x00\x26\xb2\x83\xcb\x8b\x0f\x78\xb8\x82\xc5\x33\x2f\x9b\x8e\xe1\x59\x16\x7f\x0a\xce\xc1\x51\xc2\x3e\xfc\x28\xeb\x6a\x90\xff\x1f\x2e\xb3\x12\xcd\x46\x12\x9a\x1d\x4f\x71\x8b\xe6\x91\xe7\x43\xe1\xf5\x00\xfb\x5e\x7b\x75\x84\x92\x7f\x1b\xd5\x68\x5f\x91\x01\x74\x11\x0b\xc9\xab\x16\xce\x82\x6b\xfd\x45\xbe\x3b\x7f\x18\xb7\x7f\x7b\xdd\x3d\xd4\xf6\x8a\x73\x20\x6a\x10\x95\x2e\xd1\x8f\xdb\x24\x7d\xd1\xe5\xa8\x41\xed\x5a\x01\xd5\x50\x7c\x7c\x63\x2d\x14\x4d\xc5\xa5\xf8\x34\x25\x87\xdc\x43\x63\x74\xd0\x38\xaf\x31\x40\x01\x3b\x62\x3e\xee\xaa\x6f\xa8\x43\xa7\x79\x9d\xf2\x72\x96\xba\x43\x54\x9f\xaf\x3d\x42\xec\x69\xfd\xb7\xa9\x2a\x6e\x0e\xe0\xdc\x5b\x7f\x21\x78\x3e\x52\x96\xb0\xd2\x25\x27\x84\xa8\xef\x7a\x84\x5b\x21\x15\x2a\x1b\x27\xc2\x47\xaf\x87\xab\x1d\x93\xff\xc9\x80\xbc\xb0\x65\x2e\xeb\xbd\xe7\x8f\x97\xe5\xab\x09\x04\x5e\x83\x54\x14\xea\xe2\xd7\xcf\xd7\xdb\x90\x17\x0c\xab\x27\xcc\x32\xcc\x40\x74\xeb\x35\x06\x4e\x15\x42\x2c\xfb\x42\xa4\x05\xf8\x95\x49\x95\x8f\xf4\xc6\xa4\x64\x80\x09\x38\x01\x07\xbf\x35\x9d\x76\x67\xbb\xaa\xc9\x34\x29\x59\xf7\x75\x15\xd9\x59\x11\x07\x56\x2e\x8b\x78\x82\x03\xb6\x6b\x64\x0a\xcb\x73\xe0\x56\xdf\xae\x65\x3b\x86\x06\xa9\x31\x32\x30\x94\xf8\x4e\xd1\x66\x4a\xbd\x8c\x26\x30\xdd\x48\x49\x9c\x1c\x75\xb4\xd9\x76\x9c\x02\xa3\x1d\x87\x2b\x3e\x37\xc7\x5d\x63\x07\x24\x78\x42\x94\x61\x87\xd1\x8a\x7d\x31\x67\x9e\xcc\x07\xcc\x45\x4d\x68\x66\x52\x20\xd5\x3f\xbe\xaa\x1d\xa5\xfd\x6f\x43\xfc\x80\x29\x8a\x67\x34\x43\xcc\x9b\x59\x0b\xad\xb9\xf4\x47\xd7\xdb\xcd\xea\x14\xac\xe5\x88\x26\x78\xb9\xba\xbc\xf6\x67\x10\xc4\x97\x88\x0f\x08\x3e\x21\xc1\x3c\xa0\xcf\xd9\xdb\x23\xbc\x7b\x8d\xaa\xf1\x37\xe5\xfc\xab\x91\xe9\xc8\xc0\x69\xfd\x57\xc7\xfa\x3a\x36\x7b\x6c\xff\x2d\xeb\xf1\xd7\x22\x30\xf7\xd3\xc6\x1e\x45\xc1\xca\xbd\xc2\xd5\x5f\x69\x4c\xd7\xdb\xcd\x25\x5f\xff\x45\x5d\xfd\xa7\x7b\xfa\xf5\x76\xf3\x4b\x38\xfa\x6f\x3f\xff\x49\x7e\xfe\xbd\x6e\x7e\x7e\xce\xfc\x36\x8e\xd6\x7d\xc1\xb6\x7f\xc0\xb2\x7f\x1b\x76\x67\xd8\x33\x8b\x08\xd5\xb4\x16\x32\xdc\x09\xf9\x95\xf9\x5c\xb7\xa6\x7e\x22\xf1\xb2\x77\xb4\xff\x05\x15\x37\xfd\xa7\x8f\x73\x29\xbd\x0c\x1f\x42\x71\xff\xf6\x6a\xfa\x68\xd0\xdc\xf0\xaa\x3e\xc1\x43\xfc\xa0\x14\x6d\xbf\x8f\x8b\x6d\x9b\xb4\x47\x73\xdd\x50\xa1\x8c\xf8\x84\x3e\xfc\xaa\xfd\x2e\x4a\x85\xe6\xe5\x50\x53\x11\x2c\x01\x3f\x42\xbc\x1d\x4f\xa2\xb1\x67\xeb\x22\x58\x81\x73\x6f\xc2\x82\x41\x64\xa0\xf4\x2a\x5c\xd4\x07\xac\xb5\x92\x19\x1a\x66\xed\x1a\x50\x66\xe0\x1c\xfb\x3c\x00\x99\x31\xe7\xc8\x4d\x0f\x00\x00")
+
+func templatesServerHandlersGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServerHandlersGotmpl,
+		"templates/server/handlers.gotmpl",
+	)
+}
+
+func templatesServerHandlersGotmpl() (*asset, error) {
+	bytes, err := templatesServerHandlersGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server/handlers.gotmpl", size: 3917, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServerHealthGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x55\x4d\x6f\x1b\x37\x10\xbd\xef\xaf\x78\xc9\xa1\xd8\x2d\x36\xb4\xd3\xde\x04\xab\x80\x91\xf4\xc3\x40\x5c\x04\x4e\x8a\x1c\x0c\xa3\xa0\xb9\x23\x2d\xe1\x15\xa9\x92\x5c\xcb\xae\xb0\xff\xbd\x18\x7e\x48\x72\xec\x38\x69\x4e\x6b\x8f\xde\x0c\xe7\xe3\xbd\x99\xed\x16\x1d\x2d\xb4\x21\xbc\xf4\xe4\x6e\xc9\xf5\x24\x87\xd0\xbf\xc4\x34\x55\x47\x47\x48\xff\xbd\xe9\x49\xdd\x40\x7b\x48\x18\xb9\xa2\x0e\x2a\x1a\xec\x02\xa1\x27\x38\x92\x9d\x36\xe4\x7d\x31\x9c\xbe\x3f\xab\xc2\xfd\x9a\x1e\x78\xfb\xe0\x46\x15\xb0\xad\x10\x63\x00\x80\x0f\x4e\x9b\x65\x05\x04\xbd\x22\x3b\x86\xf8\x15\x6f\x47\x27\x83\xb6\xa6\x42\x7e\x06\x58\x8c\x46\xd5\xca\x9a\x40\x77\x41\xbc\x49\xdf\x06\xe4\x9c\x75\xd5\x54\x71\x9e\x6e\x34\x70\xa3\xf1\xf1\xfd\xe8\xd6\x62\xd3\x6b\xd5\x63\x21\xf5\xe0\xb1\xe9\xc9\x40\x07\x74\x96\x3c\x8c\x0d\x50\x76\xb5\x1e\x28\x10\x36\x3a\xf4\x9a\x7f\xf3\x25\x8d\x8a\x9f\x43\xad\x0e\xd3\x6f\x38\x7a\xad\xc2\x1d\x9e\x4e\x23\xd6\xa5\x17\x50\xa2\xd4\xf2\x0b\x8e\xa3\x11\xb8\x95\x0e\x4a\x1a\x45\xc3\xde\x39\xfe\xfb\xdb\x68\x54\x44\xa8\x70\xd7\x16\xc8\x7c\x07\xfa\xa4\x43\xff\x31\x45\xe3\x97\xdb\x7d\xf0\x26\x7a\x75\xb4\xa0\x12\xb9\x66\xd3\x54\x01\x9d\x35\x84\xd9\x1c\x2b\x79\x43\xb5\xea\xa5\x49\xf9\xb5\x78\xcd\x88\xa5\x4d\xbd\x6c\x72\x6a\x11\x7d\xf2\x0a\x4a\xc4\x9e\xf1\x33\x0c\x9b\x62\x38\x4f\x03\xe5\x89\x29\xe9\x89\x03\x71\xe4\x93\x57\xec\x35\x8b\xfe\x8e\xc2\xe8\xe2\x13\x05\x74\xf2\x4a\x85\x3b\xf1\xd6\x1a\xaa\x9b\x07\x18\x36\xff\xea\x5c\x4e\x34\x4d\xed\xb4\xeb\xfe\x38\xa0\x88\xa3\xa5\xf6\x81\xdc\xff\xe0\x59\x0b\x12\x4b\x81\x60\xb1\xd6\x66\x09\x89\x4e\x06\x79\x2d\x3d\x89\xea\xe8\x88\x9f\xf8\x98\xf8\xc8\xe4\xe5\xb1\x33\x57\xef\x13\x1b\x64\x8e\x1e\x09\xd2\xc2\xba\x6f\x22\x47\x8b\xd1\x0c\xcc\x75\x4e\x20\xdb\x38\xf8\xb1\xc8\xb4\xd9\x6e\xc5\x05\x29\xd2\xb7\xe4\xfe\x94\x2b\x9a\x26\xfc\xb8\xdd\x62\x2d\xbd\x92\x83\xfe\x97\x20\xd8\x8a\x69\x3a\x7d\x7f\xd6\x7c\xd6\x80\x9a\x8b\xce\xaa\x68\x9f\x16\x45\x5b\x92\xe6\x29\x7e\x99\x8e\x69\xbe\x8f\x52\x11\x89\xd1\xe7\xa3\x78\x67\xd5\x4d\x1c\x45\x22\xd1\x33\xc8\xbf\xcc\x50\xb0\x5f\x42\xc5\xe4\x3d\xe6\x90\xeb\x35\x99\xae\x7e\x1e\xd7\x1e\xea\x6a\xcb\x25\xcf\xe2\x46\xd8\x55\x3c\x2b\x7f\xe4\x62\x67\xe9\x33\x35\x59\xeb\x1f\x28\x5c\xc4\x31\x7a\x0a\x51\xd9\xa1\x27\x57\x08\xc1\xb3\x48\x43\x0e\x16\x71\xa3\xc1\xd1\x3f\x23\xf9\xe0\x5b\x68\x03\xd9\x75\x9a\x1b\xc9\x94\xe1\xb1\xa6\x5c\xd2\x0b\xfe\x90\x34\xd1\xd7\xa5\x37\x4a\x6c\xf9\x88\x44\x3a\xc0\xf7\x63\xf0\xe8\xec\xc6\x7c\x17\x05\x4a\x35\x75\x8a\x7a\x6d\xed\x90\xa6\xc7\x6b\xc3\xd8\x5c\xaa\x36\xe1\xe7\x9f\xd2\x8a\x79\x91\x80\x0c\xc1\x1e\x30\xc7\xeb\xbc\x01\x64\xb0\x2b\xad\xc4\x87\x60\x1d\x9d\xb1\x5b\xfd\xc3\xe3\x81\x14\xbf\x76\x17\xa1\x74\x37\x0e\x29\x91\x72\xbf\x51\x1f\x74\xe9\x40\x7e\xbc\xab\xd4\xe8\x1c\x99\x30\xdc\xb7\x90\xa6\x43\xa0\x61\xf0\xd0\x7b\x48\x19\x48\xcb\xd1\x79\xdb\x66\x31\xfb\x71\x08\x1c\x8a\xa4\xca\x91\x71\x7d\x9f\x99\x60\xf4\xb0\xeb\xef\x5a\x7a\x4f\xdd\xf7\xb4\xf6\xa0\x94\xa7\xb5\x52\x73\xb7\x5b\xac\xe4\xfa\x32\xa9\xee\xea\xdb\xd4\x73\xb1\x93\x4f\xee\xc8\x6c\xc7\xfd\xcb\xab\x03\x76\xd7\x46\x0f\x4d\xfb\x15\xdd\x08\x21\x9e\x13\xd7\xf9\x28\x2e\x76\x1a\xac\x90\x1b\xe7\x77\x3b\xfe\xf3\xe4\x5b\x0c\x64\xea\x94\x57\xd3\x64\x1e\xad\x46\xf8\x7b\xa3\xc4\xf9\x18\xe8\x2e\xdb\x36\xcb\x64\xfb\x24\x75\xf8\xdd\xd9\x71\x5d\x01\x0b\xeb\xf0\x77\xd9\x31\xb3\x39\x9c\x34\xcb\x7c\x4f\x7d\x66\xdc\x66\x29\x4e\xbb\xae\x8e\xe7\x64\x7f\x50\x22\xe4\xe1\xc1\x4c\xf0\xb2\x61\x36\xcb\x7c\x14\xb2\x35\x1f\x93\xe8\x27\xf2\x6d\x2d\xbf\xad\x0e\xf6\x13\xb0\x2b\xf9\x32\x81\x99\x21\x57\x98\xe7\xa3\x93\xf1\x07\x5b\x8a\xcf\x57\x44\x96\x93\xb8\x59\xc6\x1a\x4b\xfb\x58\x2d\x3c\xaf\x24\x93\x77\x56\x76\x5f\x55\x49\x83\xf9\x1c\xc7\xfb\xfe\xe4\xe4\x53\x77\xca\x40\x52\xbd\x7a\xc1\x89\xe1\xc5\x3c\x72\xb8\xf4\xc0\x65\x8d\x2e\xe4\xe0\x29\xda\xa6\x9c\x5c\xbe\x8e\x11\xd0\x96\x58\xd5\x54\x6d\xb7\x20\xd3\x61\x9a\xaa\xff\x06\x00\xb0\x35\xae\x1b\xa2\x09\x00\x00")
+
+func templatesServerHealthGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServerHealthGotmpl,
+		"templates/server/health.gotmpl",
+	)
+}
+
+func templatesServerHealthGotmpl() (*asset, error) {
+	bytes, err := templatesServerHealthGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server/health.gotmpl", size: 2466, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServerMainGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x57\x5b\x6f\xe3\xb6\x12\x7e\x16\x7f\xc5\xac\xb1\x07\x90\xf6\x38\xf4\x59\x9c\xb7\x6c\xfd\x90\xe6\xb2\x75\x91\x1b\xea\x2c\x8a\xa2\x5b\x2c\x18\x69\x24\xb3\x91\x49\x95\xa4\xe2\xb8\x86\xfe\x7b\x31\x14\xad\xc8\x76\xbc\x9b\x6e\x11\xa0\xc0\x3e\x49\xe4\x0c\x3f\x0e\xbf\xb9\x91\xa3\x11\x1c\xeb\x0c\xa1\x40\x85\x46\x38\xcc\xe0\x76\x09\x85\x3e\xb0\x0b\x51\x14\x68\xde\xc1\xc9\x15\x5c\x5e\xdd\xc0\xe9\xc9\xe4\x86\x33\xc6\x60\xb5\x02\x99\x03\x3f\xd6\xd5\xd2\xc8\x62\xe6\xe0\xa0\x69\x46\x23\x9a\x4e\xf5\x7c\x8e\xca\x6d\xc9\x56\x2b\x40\x95\x41\xd3\x30\xc6\x2a\x91\xde\x89\x02\x61\x2e\xa4\x62\x4c\xce\x2b\x6d\x1c\xc4\x0c\x60\x50\xea\x62\x40\x5f\x6d\xfd\x47\xa1\x1b\xcd\x9c\xab\x06\x8c\x01\x94\x5a\x64\x16\x06\x85\x74\xb3\xfa\x96\xa7\x7a\x3e\x2a\xf4\x81\xae\x50\x89\x4a\x8e\xbc\x70\xc0\xa2\x60\xd6\x07\x8b\xef\xf5\xd4\x99\x3a\x75\x67\xa5\x28\x2c\x34\x4d\xee\xbf\xfd\xe5\xbf\xa3\xb5\x78\x9f\xdd\x11\x8e\x97\xd2\x9e\xc1\xce\x83\xa6\x69\x07\x01\xed\xba\x0f\xb3\x61\x84\xad\xf2\xb7\xff\x1f\x55\x34\xbf\xb5\xde\x8f\x8c\x50\x05\x02\x3f\xc1\x5c\xd4\xa5\x9b\xf8\xb3\xda\xa6\x59\xad\x2a\x23\x95\xcb\x61\xf0\x9f\x3f\x06\xc0\xc3\x6e\xa8\xb2\xf0\xd7\x2e\x7b\x7d\x87\xcb\x21\xbc\xbe\x17\x65\x8d\x70\x38\x06\xde\x5b\x4f\xb2\xa6\x21\x13\xfb\x48\xad\xee\x06\x5c\xc2\xd8\x68\x04\x37\x33\x69\x21\x97\x25\xc2\x42\xd8\x4d\x37\xbb\x19\x42\xf0\x33\x38\xad\x4b\x4e\xfa\x17\xe2\x0e\xc1\xd6\x06\x41\x69\x07\x4e\x83\xbe\x47\xb3\x30\xd2\x21\xb8\x0e\x4a\xe4\x0e\x0d\x2c\x75\xdd\x03\x94\x0e\x6e\x31\x15\xb5\x45\x10\x65\x49\x42\x03\x98\x49\x67\x61\xa1\xeb\x32\x83\x5b\x84\x52\x5b\xf7\x8a\x05\x72\x4f\x1f\xd2\xb2\xce\x70\x5a\x61\x4a\xd1\x91\xd7\x2a\x05\xa9\xa4\x8b\x13\x58\xad\xbd\xce\x8f\xb2\xec\x5c\x8b\x0c\x4d\x9c\xcf\x9d\xe5\xbf\x1c\x5d\x9c\x5f\x08\x97\xce\xd0\x0c\xa1\x9b\x39\xd1\x69\xc2\x1a\x16\x3c\x40\x0e\xf0\x60\x14\x65\x01\xec\x09\x7f\xb6\x53\x74\xc6\x6d\x4b\x60\x4d\x0a\x99\x36\x04\x34\x86\x5c\xe0\x03\x8d\x9f\xce\x6f\x31\xcb\x30\x8b\x57\x2b\xe0\x47\xd7\x93\xeb\x10\xd1\x4d\xc3\xa7\xed\xa2\x1f\xa7\x57\x97\x43\xd8\x15\x9f\x95\xc2\xf5\x54\x12\x06\xb4\x3f\x81\xbf\x1a\x83\x92\xa5\xb7\x93\x8e\x5d\xf0\x33\xe1\x44\x59\xaa\x18\x8d\x21\xb5\x10\x90\xe1\x6c\x00\xf7\xc2\x80\x45\x73\x8f\x06\xde\x3c\x61\x46\x2b\x19\x8d\x60\xde\x79\x92\x68\x05\x69\x21\x15\x65\x89\x19\x63\x11\x05\x2d\xff\x60\xc9\xb4\x31\x10\x59\x81\x27\x20\x52\xf9\x99\x0f\xac\x58\x5b\x3e\x75\x19\x1a\x33\x84\x81\xd7\x3d\xfc\xa8\x06\x09\x8b\xa2\x3d\x3a\xde\xca\x4c\xd8\x19\x1a\xf9\x27\x02\xbf\x14\x73\x3a\xf9\x41\xb0\xf5\xd7\xab\xeb\x9b\xc9\xd5\xe5\xf4\xb7\x8f\xca\xe3\xf8\xed\x9c\x74\xa5\x8f\xf0\xe0\xa1\x89\xca\x75\xe7\x1c\x3f\xe2\x37\x5e\xa5\x69\xb6\x02\x7e\x47\x88\xa5\x0d\x7f\xbb\xd1\x35\x18\x3c\x2a\xf4\x9c\xcb\xc9\xc3\x71\xd2\x83\xea\x78\xde\xf8\x79\x01\xe4\xa6\xd9\x4b\xa4\xe7\xe4\xbf\x83\x40\x53\x14\x65\x68\xd3\xcf\x53\x74\x82\x36\x35\xb2\x72\x52\xab\x7d\x44\xed\xa8\x04\x9b\xbf\xfa\x50\x3d\xc0\xad\xa3\xbd\x34\xbe\x4f\x02\x9f\x3d\x9e\x99\x57\x63\x18\x0c\x60\xc5\xa2\x3e\x9f\x79\x9f\x50\x52\xeb\xf1\xb9\xc9\x7c\xa9\xfa\xaa\x3e\x31\x8e\xf5\x7c\x2e\x54\x76\x2e\x15\x72\x2a\xff\x3e\xf8\x6d\x9c\x24\x2c\x6a\x58\x34\x1a\x41\x25\x8c\xa5\x72\x88\x70\x7c\x3e\xf1\x6b\x6c\xc8\xa9\x6b\x92\xc4\x09\x7b\xac\x39\x9b\x27\x67\xb0\x4e\xdd\xf1\x13\x35\xe2\x12\x17\x53\x2f\x8d\x95\x2c\x29\xf5\xf7\x17\x22\x4f\x95\x75\x46\xaa\x22\x6e\x11\xbd\x77\x92\xbf\x59\x57\x44\x25\x09\x73\xb5\xe2\xc1\x8c\xd6\x0a\xca\x35\x61\x53\x51\xf6\x13\xf9\xe8\x7a\x12\xf7\x0c\x4a\xba\xb3\xf0\x29\x3a\x12\x8a\x4a\x26\xa1\x56\xb5\xbe\x65\x10\xb5\x1b\x7c\x1d\x3e\x51\x5d\xa0\x5b\x33\xb6\x90\x6e\xe6\xc9\x06\xdf\xeb\x7c\x2b\x2a\x31\x03\x5d\x3b\x16\x3d\x8b\xd5\x9e\x81\x3e\x5e\x59\x94\x61\x8e\xeb\x6a\xca\xa7\xb3\xda\x65\x7a\xa1\xc8\x7f\x01\x90\x1f\x6b\x95\xcb\xa2\x36\x48\x07\xa4\xf9\xd4\x3d\x0c\x21\x15\x2a\xc5\x92\x98\x93\xca\xa1\x31\x75\xe5\x8e\xb5\x72\xf8\xe0\xe2\x64\x0d\xda\xea\xd0\x38\xf8\xe3\x70\xdc\x6d\x44\x9f\x38\x75\x0f\xc9\xbb\x4d\x4f\x45\xd1\x8e\x9f\xa2\x75\xf9\xff\x4c\x3e\x3d\x46\xd5\xe1\x97\xc3\xaa\xef\x9e\x7f\x5d\xa7\xfb\xa7\x21\x19\x3d\x8f\x87\x10\x08\x7b\xbc\xff\x18\x1f\x0c\xda\x5c\xf7\x80\x14\x7a\x96\x40\x7c\x92\x9b\x90\x76\x6d\xcd\xb0\xeb\x8b\x5e\xd2\x2d\xe1\xd3\x99\x36\xae\x57\xc6\xe0\x9b\xec\x72\x1d\x1d\xe7\x5a\x15\xcf\x65\xe3\x9b\x6b\x68\x5d\xbf\xd8\x73\x21\xdd\x2a\x46\xd4\x95\x6c\x4c\xb1\x96\x6b\x03\x9f\x86\xa0\x2b\x67\xdf\x1b\x5d\x57\x14\xa8\xed\x1b\x42\x54\xb2\xdf\xc9\xae\xfc\xce\xad\x92\x0d\x29\xf8\xa9\xcb\xf9\xe0\xa3\xa3\x2c\xf3\x0a\x71\x87\xb7\x13\xc5\xbd\xbd\xb6\x5d\xda\x17\x85\xed\x92\x75\xab\xde\x49\xff\x27\x0b\x40\x7b\xd9\xdd\xbc\xf0\x46\x32\xdf\x35\x34\xf4\xd9\x9d\xf2\x99\xd2\x5b\xf6\x70\x0c\x6f\x59\x44\xeb\x72\x1c\x82\xbe\x23\x4e\xd0\x18\x1e\xbf\x69\x53\xf5\xd4\x18\x6d\x92\x77\x24\xa1\x92\xdb\x2a\xf2\x9b\x65\x85\x30\x5e\xa7\xf9\xa9\x31\x3f\x60\x59\x79\xd0\x00\x3b\x86\xff\xd1\xa0\x09\x57\x08\x6d\xf9\xe9\x83\x74\x31\xc9\x1e\xcb\xf4\x6e\x6c\xbc\x7c\x1b\x7f\xa1\x3e\xbe\xaf\x37\xf6\x9d\xf3\x44\x6c\x86\x46\x09\xf0\xe5\x4e\x09\xb0\xd5\x2a\x01\x9e\xdf\x2b\xf7\xd2\xd1\xb7\xaf\xf1\x6f\xe0\xed\xad\xdb\xb7\x10\xed\x49\xf7\x87\xc5\x0c\x95\xbf\xcc\x55\x46\xa7\x68\x2d\x49\xbb\x15\x74\xbf\x30\xe0\xd0\xcc\xa5\xa2\x67\xee\x10\xac\x06\x37\x13\xce\xaf\x08\xdd\xc6\x3a\x5d\x59\x28\x8c\x48\x31\xaf\xcb\x72\xb9\x7e\xcc\x6e\xee\x1a\x27\x10\xa7\xed\x2f\x0f\x53\x43\xe8\x26\x3c\x4f\x67\xb5\x4a\xdb\x97\xd8\x16\x79\x6b\xb5\x9f\xa5\x9b\x1d\xfb\xd9\x0e\xea\x7b\x91\xde\x15\x46\xd7\x2a\xa3\x1b\x2a\x80\x95\x85\x12\xa5\x25\x0a\xe9\x05\x18\xa7\x33\xa1\x80\xae\xb8\x7e\x7e\x08\x6f\x1f\x95\xf8\xa5\x76\x32\x5f\xc6\x61\xc9\x90\xd4\x26\x6b\xab\x87\x60\x97\x74\x05\x2c\xf9\x74\xf2\xfe\xe6\xf4\xa7\x0b\x5a\x57\xe8\xcd\xe7\xa2\xc5\x12\x53\x17\x06\xa9\xb0\x08\xdf\x1d\x04\xb4\xc3\x90\xe6\x3d\xe7\x76\x2a\xa9\x7b\xe0\x27\x5a\x61\x9c\x1c\x76\x59\xdf\x19\x35\x75\xba\x5a\x9b\x44\x7b\x36\x7e\xad\x41\x57\x1b\xd5\xe7\x85\x35\xec\xaf\x01\x00\xe4\xde\xaf\xe4\xbe\x12\x00\x00")
 
 func templatesServerMainGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -431,7 +748,27 @@ func templatesServerMainGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/main.gotmpl", size: 4249, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	info := bindataFileInfo{name: "templates/server/main.gotmpl", size: 4798, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServerMetricsGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x6d\x53\xdc\x38\xf2\x7f\x6d\x7f\x8a\xce\xfc\x0b\xd6\x06\xa3\x90\xad\xcd\xd6\xbf\x48\xfc\x62\xf7\x12\x2a\xd4\x85\x2c\x15\xb8\xbb\xba\x02\x0a\x3c\xb6\xcc\x68\xc7\x96\xe6\x24\x99\x09\x3b\x35\xdf\xfd\xaa\xf5\xe0\x87\x19\x13\xe0\x6e\x8f\x17\x66\x2c\xb7\xba\x5b\xbf\x7e\xd6\x6a\x05\x05\x2d\x19\xa7\x30\x51\x54\xde\x53\x59\x53\x2d\x59\xae\x26\xb0\x5e\x87\xaf\x5f\xc3\x07\x5a\x66\x4d\xa5\x3f\x34\x32\xd3\x4c\xf0\x5f\x9b\x7c\x4e\xb5\x82\x4c\x52\xd0\x33\x0a\xcd\x62\x41\x25\x4c\x45\xc3\x0b\x05\xa2\x34\x6b\x53\x47\xe3\x5e\x25\xfd\x57\x43\x95\x86\xc2\xb1\x50\x09\x30\x0e\x8a\xe6\x82\x17\x2a\xbc\xcf\xe4\x63\x32\x52\xb8\xbc\x2e\x2b\x91\xe9\x9f\x7f\x5a\x1d\x92\xc3\xc3\xb7\x09\x1c\x92\xc3\x37\xe6\xf9\xa3\x7d\x31\x4f\xb3\x62\x17\xde\x26\xf0\x26\x81\x1f\xc9\xdb\x04\xf0\xe7\xe1\x3a\xec\x9d\xe1\x9c\xfd\x41\xff\x23\xfd\xd5\x42\x70\x45\x41\xb1\x3f\xa8\x55\x7e\xfa\xa0\xe9\x40\xf5\x3e\xeb\xbe\xda\x6f\x0e\x0f\x51\x0d\xff\xf4\xff\xda\xff\xdd\x0f\xa7\xea\xa9\x45\x1f\x24\xcd\x85\x2c\x54\x1f\x40\x05\xc6\x40\x05\x4c\x1f\xcc\xf2\x2f\x67\x27\x09\xfe\x16\x0b\x6a\x71\x83\x93\x0f\x09\xd4\x54\xcf\x44\x01\x19\x2f\x40\xe9\x4c\x37\x0a\x72\x51\xd0\x04\x61\xc0\x35\xfa\x6d\x21\x14\x35\x7c\x6b\x3c\x09\x32\x3a\x93\x02\x77\xd1\x46\x81\xa6\xdf\x34\x94\x42\xd6\x99\x26\xe1\xeb\xd7\xb8\xeb\xa2\xaf\xc1\x72\xc6\xf2\x19\xd4\x99\xce\x67\xc0\x45\x4f\x34\xe2\x69\x75\xa6\x05\x2c\x99\x9e\x41\xc6\x81\xd6\x0b\x3d\xd4\x8f\x84\xfa\x61\x41\xdb\x53\x2a\x2d\x9b\x5c\xc3\x2a\x0c\xd0\x4a\x2f\x70\xb1\x19\x53\x5a\xdc\xc9\xac\x7e\x91\xb3\x11\x23\xe7\x62\x46\x1f\xa0\x6e\x94\x86\x29\x05\x45\xf1\x5f\x29\x9c\xac\x92\x49\xa5\x5b\x26\xcc\xdb\x81\x16\x24\x0c\x36\xf5\x6b\xad\x6c\xb8\x3e\xd7\xb9\xbe\xa3\xf9\xb8\x9b\xfd\x77\x4a\xf7\xd5\xea\x14\x0e\x83\xba\x01\xfb\xa7\x1e\x78\x4e\x4e\x1b\x4d\xbf\x85\x81\x63\xa1\xa0\xce\x16\x97\x2e\x0f\x7c\xce\xa6\xb4\x52\xd7\x7b\xee\x9b\xb3\x5c\x18\x30\x7e\x5c\xb1\xbb\x99\x1e\xa1\x65\x1c\x65\x58\x7f\xfe\x42\x97\xde\xd8\xb9\xa4\x99\xb6\xae\x07\x6e\x43\x77\x76\xc3\x5c\x25\xd6\x75\x70\xa9\xb0\x39\xc1\xa3\x14\x96\x0d\xcf\x7b\xdc\xa2\x18\xf6\x3c\xe3\x15\x6a\xae\x1b\xc9\x61\xd7\x2d\xad\xc2\x60\xd3\x5c\x47\x3e\x54\x37\xd6\x93\x30\xe8\x83\x74\x84\x98\x8c\x44\x35\x92\x79\x2d\x8f\x1c\x74\x50\x67\x73\x1a\x3d\x89\x55\x8c\x7b\x3d\x5c\x4f\xed\x35\xd8\xe1\x8e\x75\xb8\x0e\x6d\xb0\x0c\x08\x7a\x21\xd3\xc5\x95\xd2\x92\xf1\xbb\x30\x70\xb1\x0f\xd0\xae\x60\xec\x3b\x3b\xdb\x15\xcf\x74\xa8\x62\x8f\x6b\x2e\x1a\xae\x71\x43\x63\x54\x09\x03\x1f\x49\x5e\x8f\x4f\xde\x81\xc3\x00\x13\x22\x92\x6e\x7f\xda\xd0\xbd\xfd\xb0\x29\x08\x9d\xd2\x0b\x52\x4d\x8d\xbc\xda\xa0\x72\x9a\xb8\xcf\xeb\xd0\xba\x40\x34\x83\xbd\x4d\xae\x31\x88\xa9\xc9\x8d\xd1\x74\xd3\xd5\x13\xb8\xcf\xaa\x86\x7a\xae\x31\xe6\x1a\x56\xc2\x8c\x38\xf1\x69\x0a\x9c\x55\xb8\x1a\x74\x6b\xd6\x38\x5e\xb3\x04\x2a\xca\x3d\xe7\x38\x46\xd3\x04\xa5\x90\xc0\x12\x9b\x94\xe0\x28\x05\x99\xf1\xbb\xae\x70\x20\x37\x56\x3a\xc9\xef\x53\x47\x86\xab\xad\x90\x4b\x76\xbd\xbf\x1f\x06\xc8\x6b\x1d\x06\x33\x82\x87\xdf\x4f\xed\x96\xd0\x53\xed\xef\xbb\x28\x3a\x65\x45\x51\xd1\x65\x97\x64\xbf\x5b\x18\x38\x26\xf1\x59\xc6\x8b\x8a\xca\x7e\x16\xef\x1c\x46\x94\x90\xf9\xdd\xc0\x14\xe8\x6c\x4e\x39\x94\x52\xd4\xc0\x4c\xf8\xeb\x7c\x46\x0b\x90\xa2\xd1\x34\x81\xe5\x8c\xda\x42\x51\x77\x6a\x30\xac\x46\x1a\xb2\x52\x53\x69\xbe\x21\x2d\xe3\x77\xa6\xd0\x08\x09\xb4\x52\x14\x2a\x21\xe6\xb4\x80\x66\xd1\x85\x35\x92\x51\xe9\xe3\xfe\x97\xb3\x13\xe2\xcc\x5a\xb7\xf1\x1c\xf7\x8e\x1b\x65\x0b\x06\x7b\xab\x15\x90\xb3\x2c\x9f\x67\x77\x14\xd6\x6b\xb2\x5a\xc1\x22\x53\x79\x56\xa1\xfb\x91\x2f\x59\x8d\xab\xa6\x1a\xda\x93\x6b\xbd\x20\x9f\xec\xf1\xe3\xc1\x5b\x2f\x53\xf4\x97\x8f\x1b\x9e\x47\xa8\x45\xb4\xb4\xe4\x5f\x5d\x2a\xfe\x87\x64\x9a\xca\x04\x24\xec\xb9\x75\x83\xb7\x71\xa2\xa0\xb2\xf1\x78\x94\x7a\x27\xb7\xd9\x6f\x65\x83\xf0\x08\x24\x39\x35\xbf\xd6\x98\x39\xf0\xd4\xe8\x27\x1d\x82\xe4\x14\x8b\x28\x2d\xbe\xe2\xa7\x63\x29\xea\x48\xc6\xd6\x6d\x0c\x44\xde\x31\x77\x77\x01\x21\x78\xd5\xb9\x69\xe0\xac\x72\x03\x29\x7e\x22\x7f\x11\x1c\xab\x76\x14\x93\xcf\x42\xcc\x9b\x85\x61\x68\x99\xad\xfb\x0c\x1d\x8b\xdd\x5d\xfb\x4e\x7e\x6b\xbd\xa1\xcf\xdc\x9e\x8a\x74\xae\x92\x6e\x92\x93\x93\x0f\xc6\x6f\xc3\x20\xa8\x49\xdd\x90\xcf\x22\x9f\x47\x28\xad\x26\x3e\xc9\x5d\x5a\x2e\xd6\xc3\x0d\xd1\xdf\x78\x65\xc9\xc2\x20\x50\x3a\x93\x1a\xc1\xd0\xac\xa6\xe4\x8b\x58\x9a\xdd\xae\x66\x49\xfc\xb0\xeb\x10\x1d\xda\x61\x35\x7c\x3d\x82\x65\x62\x7a\x9b\x23\x6b\xb4\x73\xd3\xed\xfc\xf6\x57\x3c\x74\x41\x4b\x2a\xc1\x98\xd4\x1a\x6b\x43\x55\x47\x30\x54\x2d\x18\x3b\xc2\xc1\x41\x0f\x15\x94\x06\x29\xe6\xb0\x5c\xf0\x7b\x72\xa2\x45\x16\x79\xbd\xcd\x47\xc3\xc4\x47\x65\x02\x62\x8e\xa7\xa9\x89\x5f\xf1\x4c\x91\x8a\x95\xf0\x4a\xcc\xad\x72\x5d\xe1\x4d\x81\xd3\x65\x34\x4c\xce\x86\x69\xb0\xcd\x05\xd2\x36\x01\x20\xc5\xba\x2f\xbb\xcd\x1f\xfd\x35\x9f\xc9\x89\xcf\x96\x35\xd9\x2c\x86\xd6\x26\xe7\x8c\xe7\x34\x32\x76\x8a\xc9\xb9\x6d\xd4\xa3\x78\x70\x38\x82\xb9\xbf\xc7\xa8\x5f\x2a\x7d\xb2\xed\xb0\x41\x62\xb3\x7f\x6d\xe0\xc7\x30\x25\xe7\xb8\xf3\xd3\xc5\xc5\x59\x4b\x96\x00\x7a\xed\x3a\x76\x49\xaf\x25\xe8\x37\xad\x3e\xd8\xbe\xdf\xbb\x8e\xe4\x94\x4e\xdc\x0b\x82\x7c\x49\x3e\xd1\xac\xa0\x32\x42\x18\x74\x34\x31\xb1\xc6\xf5\xc1\xc5\xc3\x82\x4e\x12\x98\x60\xe0\xbd\x5e\x54\x19\xe3\xef\xe0\x9e\x4a\xc5\x04\x4f\x0f\xc9\x21\xf9\xe9\x1d\xe4\xb3\x4c\x2a\xaa\xd3\x46\x97\x07\xff\x3f\x89\x47\x78\x65\xf9\x8c\x1e\x20\x47\x29\x2a\x64\xc6\xc5\x41\x8e\x6b\x48\x5c\x13\xe3\xe4\x17\x22\x5a\x7a\x38\xdc\x02\x2c\x25\xdb\xec\xa2\x5e\x8a\x45\xcb\x1b\x98\xb0\x82\x64\x0c\x91\x2b\x76\x54\x4a\x21\xcd\xe9\x07\x31\x33\x16\x31\x61\x80\x23\xd0\xb4\x29\x5d\xa7\xfa\x6b\x53\x96\x54\xf6\xfa\xc8\xa3\xb6\x94\x3a\x55\x6d\x8e\x4c\xe0\xd0\x16\xd5\xce\xa9\xd1\x3b\xb0\xa8\x76\x59\xd5\x96\xd4\x8e\x02\x35\xea\x38\x63\xee\x5b\x50\x5e\xf8\x58\x51\x89\xdb\x8a\x1e\x14\x06\x4a\x48\x1f\x3e\x56\x64\x4b\x17\xf7\x5a\xd7\xa7\xd5\xf3\xa4\x8f\xab\xd7\x32\x5b\xf5\xba\xbc\x4e\x3d\xbf\xf2\x94\x7a\x9e\x0e\x41\x9d\x36\xa5\xb5\xca\xb9\xe9\xd9\xa2\xc9\xff\xc1\xa7\x8f\x9f\xcf\x8c\xdb\xde\xf8\x73\xdc\x68\xa1\xb3\xca\xd4\x75\xde\xd4\x53\x5b\x53\x37\x1a\x82\xe1\x78\xf8\xd8\x6c\x48\xae\xf8\x24\x1e\x95\x7a\xf1\xcf\xb3\x8f\xa3\x52\x4d\x76\xa1\xd2\x6e\x44\x5c\x6e\x92\x2d\x68\x5a\x5d\x10\x98\xb2\xd6\xe4\x78\x21\x19\xd7\x65\xb4\x3b\x6d\xca\x04\x26\x23\x7c\x57\x3b\x6a\x0d\x3b\xc5\x15\x9f\x78\x76\xc9\x48\xf6\xb4\xb9\xcd\x00\xf9\x3c\xac\x6e\x7c\xe2\xbb\x71\x73\xa0\x41\xcd\x2f\xfa\x5e\xc4\x8b\xf9\xd3\x41\xdb\x16\xdf\xce\x80\xcf\x07\xd0\x44\xfd\xe9\x46\xdf\x3b\x06\xe5\x96\xb4\x01\x96\x5b\xf9\x7e\x04\x5e\xcf\xe0\x79\x08\xdb\x92\x7c\x83\x19\xfe\xc6\x64\x01\x03\x2e\xbe\x6e\x0d\xb6\x53\x51\x30\xfa\xa7\xe2\xbb\x2d\xfc\x7f\x04\xed\x96\xa0\x01\xaa\x83\xe2\x37\x82\x28\xa2\xf1\x02\x7f\x55\x37\x8c\xdf\x94\xa6\x0d\x79\x2c\xbe\xa7\x94\xf1\xbb\xd1\x28\x37\x40\x5a\x4c\x5f\xe0\xa3\x7d\x91\x77\x59\x73\x47\xbf\x0b\x9f\x4f\x56\xcf\x0b\xed\x96\xf3\x78\x78\x7b\x66\x1e\x2d\x07\x94\xeb\xd3\x5b\xe5\xdb\x52\x68\xca\xd9\xb8\xcd\xb0\x12\xed\xf5\x4b\x51\x02\x1c\xa7\x03\x3b\x0d\xb7\xe7\xd8\x48\xf6\x23\x33\x63\xeb\x45\x50\x6f\xc8\x30\x85\xf1\xe9\xe9\xef\x3e\x93\x60\xd2\x54\x3b\x46\x63\x67\xcf\xe0\xbd\x29\x7b\x2d\x7b\x37\x0c\xba\x1e\xd5\xbc\x40\x0a\x9b\x9f\x2f\xd9\xb5\x6b\xe6\xfb\x58\x5b\xa8\x77\xd4\x8d\x95\xbb\xda\x51\x49\x45\xd3\xab\xc9\x8e\xba\x9a\xb4\x30\xe3\xf1\x3b\xb0\x7d\xe3\x7a\x6c\x1a\x83\x63\xec\xd2\x22\x33\x9b\x26\xf0\xc3\xdd\x0f\x09\x1c\xbc\x49\xe0\xe7\x9f\xe2\x04\xba\x0c\xfb\x2c\x91\xfb\x27\xbc\x7c\x54\xe8\xc6\x69\xe2\x47\x58\xaa\xa6\xb6\xfe\xa1\x9e\xa9\x78\xc7\x57\x35\xf5\xf0\x00\x8f\xc9\x30\x0a\xf4\xbd\xf0\x09\x4d\xbd\xb7\x6d\x97\x6b\xe7\x49\x1b\x8d\x83\xb1\x23\x12\x93\xf3\x8a\xe5\xd4\x51\x25\x76\x10\x61\x09\xfc\x0e\x8c\xeb\x18\xa6\x42\x54\xfe\x8e\xc0\x92\x5c\xb2\xeb\xde\xc8\xf5\x2a\x75\x2a\x5d\xfe\xde\x5f\xc6\x1d\x7e\x7c\x1d\xdb\xf6\x7e\x6c\x57\x37\x05\x76\x5b\x5c\xc6\x1d\x88\x71\x6b\xe3\x32\xdc\xc7\xbe\x00\xbb\xe4\xb8\x6f\x6d\xc0\x0c\x3e\xd0\x07\x17\xfa\xad\xbd\x09\x48\x90\x94\x17\x54\xda\x5e\xd6\x21\x6a\x6e\x26\x2c\xa8\xf6\x32\x50\x34\xda\x7c\xef\xd5\x06\x7b\x21\xc1\xcc\x55\x27\x17\x1a\xe6\x5c\x2c\xb9\x6b\x73\x2b\xd8\xb0\x88\x4b\x7a\xb1\x4b\x02\x88\xbb\xc9\x64\xe8\xd7\xe7\xce\x41\x26\x2d\x5c\xe9\x8e\x4a\xec\xd1\xd2\x1d\x35\x49\x06\xcc\xfe\x8e\x77\x33\x51\xd5\x61\x1b\x8f\x7f\xb7\xfb\xb1\x63\x44\xfb\x9a\xb9\x10\x5e\xa5\x30\x99\xa0\xec\x40\xc1\x7e\x0a\x93\x04\x57\xd3\x09\xec\x8f\x72\x70\xa3\xe4\xba\xcd\x82\x0a\x71\xbb\xcf\xe4\x80\xfa\xa3\xca\x33\xbc\x5f\x4e\xdd\xd1\x14\xf9\x42\x97\x5f\xe9\xa2\xca\x72\x2a\xa3\xdb\xab\xdb\x04\x6e\xaf\xcc\x73\x82\x8f\x2b\x7c\x4e\x4c\x70\xdd\x5e\xf1\xdb\xd8\xf9\xf6\xb6\x7c\x73\x07\xe5\x78\xf6\x61\x73\xba\xdc\x4e\x6e\x61\x7f\x4c\x11\xe2\x64\x5b\x06\x31\xec\xc3\xed\xe4\xd6\x19\xdc\x91\x0f\x67\xae\xc1\x5d\x56\xdf\xbe\x58\xc2\x74\xaf\x8b\xc8\xda\x1e\x62\x70\xab\xb8\xc1\xad\xbb\x5a\x1c\x99\xef\xfa\x57\xa1\x80\x61\xd8\xbb\xbd\xb4\x0b\x78\x05\xb9\x94\x42\x53\x3b\xa6\x99\x28\x6d\xeb\x4d\xb4\x84\xbd\x51\xa9\x6e\x98\x72\xa3\x9d\x91\x61\x62\xdc\x5e\x33\xbe\x5a\x92\x3e\x4b\xb4\xff\xd2\xdf\x22\xe0\x3f\xf3\xde\xa7\x48\x41\x4b\xbc\x00\x5c\x87\xc1\x72\xe3\x04\x64\x53\x50\xfc\x6c\xed\xa2\x05\x5c\x5e\x63\x5d\xb4\x53\x5e\x7f\xc6\x1b\x17\xcf\x0d\x09\x86\xc9\xb8\x16\xd1\xc2\x0c\xb4\x06\xc1\xfd\xd4\xa2\x17\xf1\xb8\xf5\x11\xbb\xdf\xd9\xfe\xb8\x6a\xd4\x0c\x14\xe5\xce\xd2\x53\x33\x25\xd2\x02\x8a\x4c\x67\x68\x5e\xa5\x25\xcd\x6a\x0c\x4e\x6f\x66\xf5\xe4\xb9\x0c\xd3\xc8\xe3\x5c\xe2\x1b\x96\x7b\x31\x1f\x55\x3a\x32\x1e\x61\xf6\x50\x19\xbf\x03\x77\xf1\xe2\xb6\x11\xc7\x0c\x61\x5f\x87\xab\x15\x50\x5e\xc0\x7a\x1d\xfe\x7b\x00\xd8\x07\xba\xf0\x1b\x1d\x00\x00")
+
+func templatesServerMetricsGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServerMetricsGotmpl,
+		"templates/server/metrics.gotmpl",
+	)
+}
+
+func templatesServerMetricsGotmpl() (*asset, error) {
+	bytes, err := templatesServerMetricsGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server/metrics.gotmpl", size: 7451, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -456,7 +793,7 @@ func templatesServerOperationGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesServerParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3c\x59\x73\xdc\x36\x9a\xcf\xe9\x5f\xf1\x4d\x6f\xe2\x22\x55\x2d\x32\x3b\x3b\xb5\x0f\x4a\x94\xaa\xb1\xa4\x8c\x55\x89\x8f\xb5\x1c\x3f\xac\xc7\x95\xa0\x9b\xe8\x6e\x8c\x49\x82\x02\x40\x49\xbd\x5d\xfc\xef\x5b\xb8\x48\x80\x04\xfb\xb0\x15\x7b\x52\x33\x7a\x50\x35\x89\xeb\xbb\xf0\x5d\xf8\xc0\xed\x16\x32\xbc\x24\x25\x86\xe9\x9c\x66\x9b\x3b\x94\x93\x0c\x09\xca\xa6\x70\xda\x34\x30\x01\xd8\x6e\x4f\x81\x2c\x21\x79\x86\xf8\x73\x9a\xe1\xfc\x29\xcd\x36\xaf\x10\x43\x05\x87\xa6\x99\x00\xa4\x29\x98\x41\x18\xe4\x0c\x40\xe7\xff\xc0\x0b\xb1\xdd\xa6\x27\x90\xe1\x1c\xaf\x64\x83\xe9\x41\x68\x09\x82\x42\x21\xe7\x31\xfd\xe0\x24\x55\xd3\x90\x25\x60\xc6\xe0\xec\x5c\x4d\x92\xbc\x35\x53\x46\x8c\xd6\x02\x27\x3f\x52\x56\x20\xc1\xe3\xef\x54\xa7\x3f\x9d\x43\x49\x72\xd8\x4e\x00\x00\x18\xe6\x70\x0e\xa8\xaa\x70\x99\x45\x0c\xf3\x99\xec\x12\x4f\x00\x9a\x89\x9e\x36\xc7\xa5\x7c\x1f\xc3\xf9\x39\x7c\x6b\x06\x6d\xb7\x90\xbc\xc6\x0b\x4c\xee\x30\x7b\x81\x0a\x0c\x4d\x93\x6c\xb7\x50\x21\xbe\x40\x39\xf9\x3f\x0c\x89\x79\x0b\xe7\xb2\x2f\x59\x02\x2a\x33\x88\x4a\x2a\x20\xb9\x59\xac\x71\x81\x92\x6b\xfe\x14\x71\xfc\x66\x53\xe1\x18\x92\x6b\xfe\xa2\xce\x73\x34\xcf\xe5\x98\x27\xdb\x2d\xe0\x32\x83\xa6\x91\xa8\x28\x48\x34\x19\x71\xce\xb1\x9d\x4b\xd2\xf3\x86\x14\x55\x8e\x1d\x82\x7a\x44\xbe\x16\x38\x44\x63\xc4\x18\xda\x00\x5d\xba\xc4\xe6\x8a\xda\x0b\xc4\xd8\x06\x68\x09\x3c\x27\x0b\x97\xe6\x7c\x06\x62\x8d\x4b\x20\x02\x33\x24\xc8\x1d\xce\x37\x2d\x6b\xf8\x01\xbc\x91\xd4\x7a\x8b\xf2\x1a\x5f\x3d\x54\x0c\x73\x2e\xbb\x2a\xd2\x18\xfc\x3a\x21\x31\xb4\x91\xb8\x49\x18\xde\x76\x20\x68\x44\x0c\xed\x05\x2e\xaa\x5c\x22\x33\x55\xa0\x56\x12\x7b\x47\xf2\x12\xdb\x59\x11\x4d\x51\x72\x02\xb0\xa4\x0c\x7e\x9d\x29\x60\xae\xcb\x0c\x3f\xbc\x45\x0c\x9a\x26\xc0\x49\x29\x44\x0c\x95\x2b\x0c\xdb\x6d\x1f\xee\xa6\x31\x12\xd0\xc9\xdb\xfe\x09\x8f\x90\xc6\x1d\xf2\x28\xff\xe6\x0c\xa3\x0f\xea\x77\xf3\x4f\x2d\xa1\x41\xe9\x74\x98\xe2\x2d\x77\xcd\xff\x2a\x65\x32\x76\xd9\x3f\xe0\xbc\x2b\xc2\xa4\xcc\xa5\xc2\x91\x6b\x1b\xc1\xcd\x73\xf7\xa5\x2b\x92\x05\x16\x6b\x9a\xb9\x92\xf8\xf9\xa8\xe2\xcb\x48\x7f\x5d\x8b\x8e\xbf\xfe\xf5\x25\x34\x8d\x24\xda\x27\x69\xae\x56\xf8\x2d\x3f\x0c\x8d\x77\xd2\x52\xab\x86\xcf\x4e\x25\x47\x26\x28\x83\xe4\x62\x4d\xf2\xac\x2f\x02\xbb\x24\xe3\xcb\x52\xb9\x55\x32\x4d\x8f\xea\x2d\xa9\x4b\xea\xca\x23\xc3\xb7\x35\x61\x38\x93\x7a\xd6\xa1\xfd\x64\xc7\x4c\x4d\xe3\x53\x29\xb9\xe6\xd7\xa5\xc0\x6c\x89\x16\x78\x64\x15\x5a\xc2\x0a\x97\x98\x91\x05\x10\xdb\xf5\x4b\x30\xd6\x53\xc0\xce\x83\xf3\x73\xe2\x78\x10\x21\x6d\xae\x7a\x5b\xe1\x78\x4e\x4a\x6d\xd6\x92\xe7\xe8\xc1\x31\x70\x0a\xb5\x05\x2a\xb0\x87\xc5\x8d\x7c\x38\x3b\x97\x24\xf8\xef\xbf\x44\x52\x4b\xf6\x91\x6a\x55\x8f\x7e\x7c\x86\xf8\x25\xe1\x0b\x46\x0a\x52\xca\xc5\xe3\xb6\x5b\x4b\xf0\xee\xd5\x8d\x60\x18\x15\x7d\x2a\x9c\xb4\x54\xe8\xd6\xd2\x32\xad\x07\x46\x6b\xc4\x5f\x31\xbc\x24\x0f\x43\x9b\x38\xa5\xc9\x34\x8e\x8d\x0d\xd1\xfb\x60\x68\x36\x2f\xe4\x02\x5a\x2c\xc2\x76\xb5\x05\x20\x8e\x27\xed\x6f\x43\xc3\x8e\x80\x4d\x33\x49\x53\x6d\xbb\xe4\xf3\x25\xae\xc4\x1a\x9a\xa6\x30\xed\x67\xaa\xc9\xed\xdd\x6d\x33\xbb\xa5\xda\x66\x49\xd6\xe4\x15\x52\x13\x28\x03\x5b\x31\x52\x8a\x25\x4c\xbf\xb9\x9d\x42\xf2\x33\x5d\x68\xa1\x34\x8d\x41\x36\xcd\xfa\xeb\x0d\xb7\x22\xc3\xa2\x66\xa5\x7c\x3b\x69\x02\x88\x39\xf2\x10\x44\xcc\xb4\x1b\xc4\x9c\xde\x21\xc4\x4c\xf3\x23\x21\xd6\xce\x77\x3c\x5a\xbf\x94\xe4\xb6\xc6\x3b\x31\xab\xbb\x2e\x67\x20\x58\x8d\x43\x18\x39\xf3\x1c\x87\xd4\x97\xde\x2e\xb0\x67\xbf\xc0\x63\x6e\x98\x23\x99\x73\x55\xd6\xc5\x18\x57\x64\x9b\x96\x35\xdb\x2b\xc0\x15\xd9\x14\xd9\x20\xe9\x40\x9e\x4c\x76\x79\x50\x8f\xce\x97\x5e\x10\xa7\x39\x63\x5e\xb6\xaf\x0f\x64\x94\x3f\x6e\x17\xbf\x9c\xc5\x92\x6b\x7e\x51\x73\x41\x0b\x6d\xa2\x05\x96\x3e\x76\x72\x23\x18\x29\x57\x51\xdc\x41\xe9\x9b\xcc\x76\x25\xb3\x56\x7f\x95\x53\xc9\xf5\x4f\x5a\xc5\xa7\x8d\x79\x6c\xb9\xd3\xb2\xef\x3f\xee\xa6\x46\x02\xec\xe8\x9d\x42\x26\xbd\x0a\x4f\xcc\xba\x5f\xd6\x46\x56\x8c\x56\x98\x89\x4d\x3f\xe8\xe9\xc4\xf2\x9a\xbf\x92\xac\x97\x81\x9a\x96\xee\x2e\x5c\xea\x5c\x84\xb6\x8b\x0e\x97\x06\xb2\x1d\x22\x48\x48\x84\x75\xfb\xcb\x65\xb4\xdd\x2a\x01\x96\x44\x98\x6e\xb7\xad\xcc\x36\xcd\x54\xbf\xb8\xb9\x47\xab\x15\x66\xba\xbf\x7a\x1b\xd8\x8d\x0e\xd1\x67\x32\x60\x0b\x3b\x65\xee\xae\x0c\x00\x6e\x3d\xdd\xc3\x02\x45\x87\xb9\xee\x5c\x6d\x52\x83\x94\x59\x65\x69\xa5\xc6\x4f\x47\x59\xd3\xad\x21\x47\x61\xcd\x94\x3b\xc4\x24\xa6\x77\x88\x95\xd2\x2a\x8c\x8a\xfd\x6b\x45\x8f\xbf\x51\xe9\x5a\xc9\x71\x32\x5c\xed\x1c\x9f\xb0\x57\xac\xdf\x5e\xd0\xf2\x0e\x33\xc9\xa2\x1d\xc2\x3c\x8c\x52\x8d\x4e\xfc\xb5\x45\x65\x76\x10\x9c\x6f\xdd\xf0\x78\x7f\xf7\x0b\xc5\xb1\x3e\x22\x96\x49\x66\x1f\xa6\x27\xf0\x1a\x2f\x6a\xc6\xa5\xc8\x32\xcc\x69\x5e\x6b\x37\x76\xa9\x63\x12\x0e\xa4\x84\x4a\x47\x91\x27\xa9\xd9\x4a\x7a\x4e\xa5\xc5\xf4\xa4\x37\x1f\x48\xf5\x0a\x31\x85\x95\x51\xc9\x66\x35\x57\x31\x5f\xd0\x3c\xc7\x0b\x39\xbd\x26\xcf\x99\xd3\xb1\xdf\xe6\x6d\x0c\x70\x3b\x8e\x62\x7b\x66\x3d\xe7\x00\x50\x07\x91\xb7\xe3\x0c\xbf\x47\xab\xe4\xa6\xca\x89\x78\xba\xd1\xf0\x44\x07\xcd\x30\x34\x20\xa3\xd8\xc5\x2e\x7a\x26\x93\x30\x5c\x63\x14\xdb\x18\x7e\x30\x09\x87\x91\xbd\x66\xf7\x81\x31\x1c\x96\x8a\x07\x6c\x86\x36\xe4\x3a\xa8\xfb\x61\xa2\xeb\x31\xa6\xd3\x13\xd7\xaf\xb7\x5b\x15\x76\xc5\x6d\x26\xc3\xe9\x17\xca\x45\x9d\x1e\xa4\x5c\x2c\xce\x8e\x81\x98\xf8\x36\xca\xc8\xfe\x0b\x5a\x9e\xea\xb4\x9c\x90\x9b\x9f\xa8\x4c\xdb\x50\xd4\x07\xdb\xfd\xd4\xcd\x89\xc9\xbd\xd0\xe2\xee\x29\x5b\x98\x4e\x77\xee\x08\x67\x1f\x04\xa4\xc6\x9f\x6a\xb0\x21\xf6\x12\x7d\xe6\x46\xe7\x7d\x0c\x9a\xe6\x30\x91\x8e\xbb\x38\xbf\x17\x9c\x5b\x4b\x40\x19\x4f\xae\x4b\x45\x7e\xa9\x41\xa3\x6e\xb5\x51\xb7\x4a\x37\x7b\xce\xd5\xb4\x1b\xd6\x6a\xe2\xe9\x61\xc2\x15\x8f\x25\x6a\xc7\x74\x72\x14\x52\x5d\xb1\x61\xea\x63\x71\x6b\x02\xd2\x54\xd7\xb8\xe5\x82\x31\xaa\x89\x5a\x2d\x3a\x6c\x92\xd9\x1f\x81\x47\x0a\x4d\x67\x07\xef\x05\x58\x52\xe3\x24\x52\xc3\x92\xe8\x64\xbb\xf5\xe6\x54\xd1\xf3\x2e\x66\xd2\xa2\xca\xf1\xc3\x4b\x9d\xe7\x0e\x9b\xe7\x6e\x83\x67\x38\x23\x0b\x24\x70\x06\x82\x42\x89\xb9\xfc\xa5\x32\x6e\x01\x9b\x76\x10\xdc\x07\x31\x64\x90\x1b\x1b\xeb\xd9\xe5\xa7\xad\xcb\x05\x81\x44\x18\x59\xc2\x1d\x9e\x01\xfd\x20\xa7\xc3\x8c\x25\xd1\x89\xe1\x69\x87\x74\xfc\x9d\x6c\xef\x52\xd9\x8a\xf3\x77\xb8\x5d\x40\x06\xc6\x43\xb6\xc7\x6d\x46\x7b\xe0\x0c\xfb\xfa\xf2\x77\xa0\x50\xa7\xce\xfa\x56\x6c\xcc\xd1\xfe\xe3\xd8\x32\x27\xcb\xe6\x78\xac\x69\x0a\x17\x34\xc3\x3a\x41\xa8\x84\x72\xbe\x81\x15\x3d\xe5\x7a\xd7\x7f\x07\x97\x2f\xe1\xc5\xcb\x37\x70\x75\x79\xfd\x26\x99\x4c\xac\x5f\x7d\x41\xab\x0d\x23\xab\xb5\x90\x8c\xd0\xea\x69\x41\x8b\x02\x97\xa2\xd7\xe6\x50\x74\x52\xa1\xc5\x07\xa4\x3d\xc5\xe4\x95\xf9\x2d\x1b\xd2\x14\xde\xac\x09\x87\x25\xc9\x31\xdc\x23\xee\x03\x23\xd6\x18\x0c\x34\x20\x28\xcd\x13\xd9\xff\x2a\x23\x82\x94\x2b\x10\xed\xb8\x42\xad\x58\x31\x7a\x87\x61\x59\x0b\x35\xd5\x1a\x97\xb0\xa1\x35\x30\x7c\xca\xea\xd2\x9b\xc9\x2e\xa1\xc0\x46\x65\x36\x99\x90\xa2\xa2\x4c\x40\x34\x01\x98\x96\x58\xa4\x6b\x21\xaa\xa9\x94\x83\xe9\x8a\x88\x75\x3d\x4f\x16\xb4\x48\x57\xf4\x94\x56\xb8\x44\x15\x49\xb5\xb8\x4f\xc7\x3b\xd8\xa8\x68\x47\x17\x56\x97\x82\x14\x07\xf4\x48\xb9\xf4\x88\x89\xd8\x1c\xd0\xb5\x20\x59\x96\xe3\x7b\xc4\x76\xcd\x2b\xe9\xa0\xb0\xe3\x82\x2d\x0b\x31\xda\x4d\xb5\x4e\xcd\x76\xd0\x8e\x7e\x72\x89\x97\xa8\xce\xc5\xb5\x22\x18\xd7\xe2\xe7\xe9\x69\xbb\x1b\x5c\xd7\x40\x8f\xfd\xfa\x03\xde\xcc\xe0\x6b\xa5\x67\xe5\xc6\x4c\xbc\x49\x64\xab\xdc\xb1\xbd\xf9\x4c\xf7\xde\xac\xb1\x12\x9c\x17\xf8\x3e\x98\xb3\x36\xa7\x4c\x0b\x86\xd5\xb1\x24\x82\x12\xdf\xc3\xae\x9e\xfa\x90\xd2\xca\xb7\x7e\xa9\xdc\x3d\x5a\x60\x83\xb0\x52\xe2\x72\xd1\x7b\x22\xd6\x4a\x9a\x32\xdd\xa0\xcd\x8d\x8c\x4b\x88\x20\x6a\xf6\x2c\xb1\x9e\xbb\x19\x52\xd2\x7e\x67\x1d\x27\x66\xd2\xc1\xe3\x15\x5e\x24\x6e\x1a\x7c\x59\x97\x8b\x3d\xa8\x45\xf1\x4e\x74\xb6\x7b\x30\x31\xce\x00\x53\xf2\x9e\xa6\x0e\xe8\xda\x0c\x61\x81\x19\xd7\x88\xfa\x70\x7b\x92\xd0\x9e\xe5\x81\xe7\x7a\xaa\x9c\x93\x19\xd5\xb9\xa5\x8e\x6b\xaa\x93\x4d\x3f\x92\x1c\xab\x19\x7a\xd1\xd0\xf5\x65\xd3\xd8\xe1\xe7\xe0\x8c\xee\x1d\x18\x7a\xb9\x8d\x31\x9f\x2a\xe2\x2a\x85\x70\x41\x4b\x81\x88\x0c\x93\xff\x17\x33\x0a\xd3\xe8\xef\x53\x27\x5b\xa8\xde\xc9\x9f\xe9\x89\xdd\x10\xc6\xf9\xb6\x54\x61\xf6\xac\x86\xc3\x2f\x65\x81\x18\x5f\xa3\xfc\x0d\x7e\x10\x51\x3c\x03\x9c\xac\x12\xb8\x44\x02\xcf\xd4\x7f\xb9\x09\x67\x70\x59\x33\xed\xa5\x98\xc3\xc6\x3e\x0e\x9e\x23\xb1\x0f\x91\x5d\x38\x28\xb8\x5b\x47\x25\x1a\x26\x9c\x2c\x29\x55\x80\xb7\x03\x41\x81\x3e\x60\x0e\x7a\xa9\xa3\xa1\xb6\x09\xc4\x1e\xe8\xb1\xb6\x01\xc7\x80\xc7\xf0\xaa\xce\x11\x83\x15\x85\x36\xd5\x32\x04\x76\x0f\x7c\x81\x9c\xc2\x25\x55\x72\xe7\x08\xfa\x92\xd1\x02\x2a\xca\x39\x99\xe7\xed\x5e\x56\xd9\x05\xe3\x95\x99\x74\x43\xeb\x90\xf5\xff\x5c\x59\xb4\xb6\xd7\x61\xe4\xee\xfc\x8b\x2b\x73\x2d\x50\x5a\x64\xb8\x90\xa6\x69\xb5\xd1\xfb\xaf\x27\x6f\x21\xd4\x07\xe8\x07\x12\x2b\x47\xac\xf8\x0f\x4e\xcb\xa4\x5d\xf6\xb0\x25\x47\x88\xe0\xc6\x35\x7b\xa5\x43\xce\xb7\xa2\x2a\xc0\xbe\x76\x78\xed\x88\xc8\xe1\x12\x72\x06\x5c\x95\x1c\x98\xfa\x95\x3d\xb2\x03\xfe\x11\xab\x6b\x80\x7a\x42\xaa\x20\x40\x79\x0e\x54\xac\x31\x83\x05\xe2\x98\x43\xa4\x54\x00\x57\x87\xa2\x31\xbc\xe3\x6b\x5a\xe7\x99\x12\x37\xba\x58\xd4\xec\xfd\xce\x25\xad\x91\xfc\x48\x58\x24\x04\xf6\x40\x76\x74\x5b\xf4\xd7\x18\xbc\x1c\x3b\x9b\x05\x88\x95\xd3\xd7\x57\xf8\x41\x45\x6f\x76\x5a\x5b\x3a\xe4\x49\xee\xa8\xc8\x79\xdb\xcb\x39\xf8\xf9\x44\xed\x6e\x95\x7b\xc8\xac\x24\xfe\x9e\x7a\xf7\x7e\xbe\x11\xc3\xf8\xd7\xd1\x4c\x71\x07\x5e\x48\xc5\x0c\x2c\x1c\x65\x10\x1d\xad\x17\xe2\xc0\xbe\x75\x66\xee\xc2\xb7\xde\xfe\x34\xe0\xff\xb6\xdd\xb6\xe0\xf3\x29\x44\xb2\x57\x8b\x44\xdc\x34\xbf\xc5\x33\x78\xe2\x13\x04\x5a\x8a\x84\xcb\x8f\xf4\x5f\x9a\x42\x85\x4a\xb2\xe0\x12\x04\xe9\xab\x90\x25\x31\x21\x38\x91\xea\x52\x39\xba\xde\x88\x82\xaf\x54\x7a\xa1\x10\xc9\x8d\x86\x29\x9a\x9a\x7e\xbe\x3b\xa1\xea\xb0\x5a\x87\x03\x06\xd0\x9d\xc1\x37\x77\xd3\x99\x53\xf3\xa4\xff\x14\x38\x51\xc1\x57\xee\xeb\x1e\x17\xdc\x43\x9a\x81\x7c\x3b\x59\x7e\xfb\x1e\x26\xae\xc7\x6a\x22\xcf\x1d\x2e\xd6\x36\xe4\x07\x0d\xb7\x45\x57\x91\xe0\x55\x9c\x48\xd4\x7e\xff\x03\xd5\x27\xee\x81\x6a\x90\xef\x33\xd7\xa7\x6e\x7f\x80\x8c\x11\xcd\x49\xe6\xb8\x6f\x6d\x37\x9d\xd4\x86\xd2\x1b\x9e\xd3\xba\xcc\x6c\x16\x43\x32\x56\xbe\xdc\x6e\x61\x5d\x17\xa8\xf4\x0a\x4a\x64\x2c\xad\x04\x48\xae\x21\x36\x15\x59\xa0\x3c\x57\xa1\x1e\xc7\x80\x18\x06\x3a\x97\x53\xe3\x4c\xdb\x68\x04\x32\x18\x4b\x5e\xe3\xdb\x1a\x73\x31\x49\x53\x39\xcc\x44\x72\x67\x8e\xbb\x2a\x8d\x9c\x59\x62\xa2\xec\xc1\x2e\xf0\xb9\x60\xf5\x42\xc0\x76\xa2\x1d\xe0\x67\x6f\xde\xbc\x02\xb3\x02\xe8\x4c\xce\x04\xd4\x5b\xfb\xf2\xc4\x05\x02\x7e\x93\xdb\xeb\x6c\x7a\x3a\xfd\x6d\x12\x76\x89\xd3\x13\x23\x0c\x97\x58\x32\xb1\x12\x6d\xa8\x3e\xcf\xe9\xe2\x43\x1b\x2e\x0f\x9a\xdd\x13\x70\xb5\x9c\xaa\x10\x52\x72\x64\x9f\xf4\xd1\x7e\xbf\xef\x73\xf4\x40\x0a\x7d\xbc\x0c\x60\x1e\xac\x94\x25\x57\x0f\x8b\xbc\xe6\xe4\x0e\x77\xbd\xbe\xf7\x38\xef\x0c\x1f\x4c\x4c\x4a\x67\x62\xfd\x10\x98\xb8\xed\xf5\x43\x6f\xe2\xb6\x61\x30\x71\x9d\x0b\x52\xe5\xf8\xe5\xd2\xcc\x6d\x9e\xe1\xe5\xd2\x94\x66\xb8\x1d\x02\xf8\xfe\x8c\xcb\x95\xca\x1a\x69\x8c\x41\x3f\xb7\x65\x1d\x6d\x73\x00\x23\x6f\x28\x29\xfd\xa1\x4e\x73\x7f\xe8\x2b\xa5\xac\x4b\x3d\xd0\x3c\x9c\x99\x9c\x86\x6d\x09\x40\xea\xd4\x27\x49\x40\xc3\xe5\x27\x01\x30\xdd\x71\xa4\x84\x70\x3d\x4e\x7f\x5c\xaf\x50\x04\x40\xbf\x08\x8b\x8d\x93\x4f\x9d\x00\x5c\x1b\x64\x9c\xb7\xfd\x01\x81\xa3\xa3\x09\x40\xf7\x16\xbc\xb3\xb4\x1d\xa7\x68\x23\xda\xd2\x3c\xf8\x59\xed\x81\xeb\xd3\xe9\xea\x93\xd4\x3f\x00\x34\xbe\x90\x76\x9d\x7a\x65\x7e\x9f\xb9\x8a\xc5\x71\x6d\x5d\x7f\x6e\x58\x62\x77\x64\x81\x5d\xaf\x93\x05\x64\xc7\xe2\xbe\xed\x6b\xac\x82\x7f\x4a\xca\xcc\xaa\xb4\x39\x15\x6b\x98\x93\x32\xe3\x0a\x10\x9b\xbf\xe2\x80\x54\xf0\x8b\xb9\x98\x01\x11\x80\x38\xaf\x0b\xcc\x41\xac\x91\x80\x85\x4e\x7d\x83\x58\x93\x72\xc5\x41\xb9\xdc\x4a\xaf\x21\x30\x69\x5e\x09\x6f\xa4\x63\xce\xe4\x35\x5e\x11\x2e\xd8\x26\xd6\x07\x00\x4e\x39\x62\x9a\x2a\x8b\x61\x7c\x76\x9b\x4f\x11\x70\x4f\xf2\x1c\x6a\x8e\x95\xbb\xa8\x52\x7c\xa6\x86\x57\x5a\x0c\x9e\x18\x5b\xf0\x86\x02\x2e\x79\xcd\xfa\x19\x99\x99\xce\xf9\x69\x4d\x5f\xd4\x5c\xc0\x1a\xdd\x61\x98\x63\x5c\xba\xc9\x1a\x1d\xf5\xec\xcd\xb7\xcc\xf1\x92\x32\xbc\x46\x65\x96\xe8\x0c\x4d\x14\xaa\x17\x3f\xd9\x31\x49\xec\xd2\x3b\x62\xbe\x49\x99\x81\x2a\x3f\x85\x93\x2e\x81\x97\x3c\x47\x62\xb1\xc6\xd9\x6b\xd9\x60\x89\xb6\x35\x99\x1b\x86\x39\xbc\x7b\xaf\xde\x4d\x46\xca\x39\x5d\xf3\x75\x0e\xb6\x9b\xd9\x73\xff\x53\x63\xd6\x55\x63\xdf\x72\x75\x94\xaf\x73\x88\x3a\xc5\xcc\x23\x96\xfc\xf2\xfa\xe7\x44\x75\x8c\xe2\xb8\x9f\x1d\x37\xf3\xc8\x7d\xdd\x4e\xd3\x39\xa9\x4c\x9f\x2a\x69\x0d\x8e\x98\x90\xdd\xa2\xff\xfa\x33\x7c\xff\x3d\xfc\xf9\xdb\xbe\xbb\xf9\xd5\x57\xdd\x61\x91\xa2\xc9\x15\x63\x2f\xa8\x68\x07\xf7\x3c\x52\xff\x14\xe9\x05\xbe\x8f\xfe\xf2\xed\xb7\xb3\xe9\xc0\x57\x6c\x5a\x6f\xdd\x07\x4a\xc1\xb2\xcb\xe5\x3d\x78\x81\xc9\x57\x8d\x4f\x09\x45\x39\xb7\xc6\x7d\x99\x85\x29\x2b\x3b\xc7\x41\xef\x6b\xc4\xa1\x98\xf4\xd2\x67\x6e\x8c\xd0\x96\xbb\x74\x4c\x95\x3c\x0d\x8a\xe2\x0c\x6e\xd7\x1f\x46\x5a\x7e\x95\xa0\xde\xf2\xe4\x6f\x58\xbc\xfc\xc9\xad\x0d\x8c\xf7\xd6\x56\x4b\xc5\x11\x50\xb8\xd1\xf1\x40\x7c\x5a\x15\xb6\x1f\xa2\x49\xf0\x2d\x39\xd8\xd8\x7a\xbb\xc9\xa1\xc1\x31\x79\xd4\x47\x24\xcc\xf1\xe0\x3c\x26\x61\x9e\x61\x94\x61\x66\x49\xf3\x91\x18\x24\x7a\x96\x77\x6a\xcb\x5e\xa0\x92\x96\xd2\x93\xd7\x2f\x7f\xc2\x1b\x8f\x4e\xef\x67\xca\xfb\x78\x5c\x2c\x5a\xdd\xe3\xd4\xe3\x99\x16\x3f\xb9\x3c\x28\xc7\x0d\x17\xe9\x6a\xd0\x67\x9d\xba\x90\x0b\xc8\xa9\x46\x58\x6e\xe1\xb6\xdb\xcf\x8d\xbd\x9e\xf4\x15\xda\x73\xc2\x39\x29\x57\x72\xba\x6e\xd3\x8f\x63\xec\x6a\x1e\x98\x32\x8c\x32\x52\xae\xf4\x61\xd7\x37\xb7\xb0\x44\x24\x97\x81\x80\x54\x49\xfd\x03\x93\xc8\xc7\x2b\xb6\x85\x17\x71\xa7\xb8\x42\x10\xfb\xda\xf2\x3c\x08\xb8\x61\x91\x3a\xd1\x38\xa5\x95\x32\xda\x85\x6e\x86\x79\x2d\x80\xaa\x40\x06\xe5\x1a\xce\x36\x36\x9b\xf4\x02\xf3\xfe\x62\x47\x0a\xde\xb1\x0c\x0d\x49\xd9\x30\xc0\x4a\xd3\x61\x80\x65\xc9\xfa\xf7\x72\xaa\x04\x49\x03\x3f\x2e\xa1\x1a\xab\xe3\x6f\x5d\x3d\xb1\xf6\x41\x49\xc7\x25\x12\xe8\x2c\x88\xcf\x0c\x34\x46\xe1\x56\xdd\xd6\x38\xb7\x7e\xac\xd7\xb9\xcc\x46\x74\xca\x32\xdb\xad\xfd\x96\xd9\xa3\x2a\xbd\x8f\x81\xe3\xd3\xef\x3e\xb5\x16\x36\x3d\x51\x3f\x93\x4e\x3b\x9c\xa4\xb6\x70\xb7\xdf\x49\x99\x59\x6d\x64\xbb\x0b\x63\xa3\xd9\xbf\x7f\x5b\xe0\x7f\x5b\xe0\x7f\x39\x0b\x6c\xf2\xf4\x9d\x15\xfe\x57\x56\x34\x01\x47\xbe\x77\x9e\xd1\x5e\x7f\x6d\x73\x14\xa7\xb6\xd6\xd6\xaa\xff\x67\x88\xeb\x9b\x88\x71\x6b\x44\xbc\xcb\xd0\x36\xd6\x87\xee\xf6\xc4\xe1\x46\x86\x25\x4f\xbd\xdb\x85\xce\x7d\xc2\x0c\x2f\x31\x33\x1d\x92\x8b\x9c\x72\x1c\xc5\xfe\x45\x43\x85\xc3\x20\x2d\xe1\xbc\xba\x7a\xa8\x28\x13\xb8\x3d\x54\x9a\xd3\x6c\xe3\xd5\x7b\xaa\xcb\xe8\xbc\xab\xfc\xe9\x8e\x30\xb6\x5b\x75\xee\x5c\xd9\x36\x5b\xcb\x37\x18\x32\x28\xeb\x57\x87\x85\x2d\xc1\x23\x8d\x80\xe5\xe4\x05\x2d\x79\x5d\x60\x13\xaf\xf5\x5c\x36\x70\x7c\x92\xd3\x40\xae\xd5\x19\x72\x7e\x0e\x84\x26\x57\x2f\x7f\x74\xa2\x44\xf5\xde\xba\x69\x76\xe4\xc1\xb7\xa9\x2c\x6d\x1b\x0f\x08\x7b\x10\xb1\x53\xd4\x3c\xde\xe9\xbe\x77\x88\xe9\x0a\x42\xff\xd2\x00\x78\x87\x46\x3e\x49\xec\x8f\x96\x62\x4f\xe4\x04\x3d\x99\xff\x44\x12\x8d\x7a\xb5\x2e\xb9\xf6\xfa\xad\xbb\xa8\xd8\x92\xd1\xb8\x5d\x10\x20\xe7\xae\x9b\xf4\xc6\xc5\x56\x39\x81\x2b\xf9\xf8\xa9\x10\xcd\x60\x3a\x35\xae\xf6\x21\xc4\x1b\xb2\x3f\x74\x62\xa5\x67\xf2\x5c\xcb\x5e\x75\x62\xef\x63\x1b\xed\x27\x0f\x02\x1f\x3e\xf0\xae\xdd\xbb\x20\xb9\xd3\x7f\x1e\xce\x39\xf7\xbf\xc2\x47\xcf\xde\x83\xfb\x69\x83\x1f\xda\x2f\x1b\xf8\x89\x9a\x0b\x5a\x54\x94\x13\xe1\xd4\xe7\x6b\xb6\x32\xcc\x93\x24\xb1\x9a\xda\x0c\x2a\x49\x3e\xd1\x57\x8e\xbf\x5e\xe4\x88\x73\xa5\x28\xcf\xce\x21\xea\x29\xcf\xd8\xdc\xec\x19\x4d\xc8\xf4\xc2\xcd\x3d\xc9\x41\x67\xb1\x2e\x2f\x38\x6a\xc6\x54\x6d\xa3\x4d\x83\x29\xbf\x75\x06\x6b\x65\xde\xe1\xc4\x7f\x6f\x42\x1d\x27\x4b\x68\xa2\x61\x73\x57\xda\x9c\x68\x74\xe7\x22\x4d\xc3\xd5\x9d\x54\x6d\x7e\x49\x8e\x93\x1b\x8c\x3f\x44\xdf\xce\xe4\x56\x96\x3f\xaf\xca\x4c\x92\x2c\xd4\x74\x23\x10\x13\x56\x1d\xb9\xd7\xd4\xbd\xd3\x15\xe5\xd6\xc8\x45\xe0\x7b\x75\xf3\xb5\x7b\x1f\x64\xdf\xd5\xc3\x02\xe3\x8c\x9b\xf3\xa2\xe3\xae\xa6\x7a\x67\x36\x33\x58\xa2\x9c\x3b\x05\xe0\x16\x42\x03\x5f\x47\x01\x0b\xdf\x0f\xe6\x66\xee\x41\xf0\xe9\x83\xb2\x63\xe1\x73\x8e\xa3\x7a\xf0\xb9\x10\x7a\xb2\xe9\x39\x5b\x26\xed\xd7\x39\x11\x5e\xe4\x21\x99\xbc\xa7\x62\xe2\x71\x25\x93\xa1\x7b\x19\xa6\xc2\xbb\xf7\xba\x06\x63\x06\x6b\xc4\x7f\xc2\x1b\x98\x53\x9a\xb7\xf7\xf5\x60\x24\xf3\xbf\x1d\xd4\x7b\xd8\x43\x95\x36\x5e\x88\x3d\xf5\x44\x96\xf0\x27\xb3\x40\x88\x35\x1f\x65\x81\x9d\xa2\x13\xad\x66\x54\x5a\x1d\xdd\x9b\x22\x38\x47\xe9\x68\x5c\x3d\xc5\x83\xee\xa5\x47\xa5\x1b\xde\xb9\x9d\x4e\xff\xf3\xbd\xfd\x1c\x8b\x97\x51\xd0\x54\xef\x9b\x80\x70\xdc\x98\xa6\xf0\xd7\x3c\xa7\xf7\x57\x45\x25\x36\x2a\x65\xac\xc7\xf7\x5e\x06\x0e\xd3\xfc\x80\x2b\x4d\xe1\x55\x5b\x5d\x41\xb8\xaa\x8f\x26\x99\x2e\xac\x5e\xd0\x52\x9f\x8c\x48\x82\xa8\x73\x76\xb1\xc6\xda\x4d\x70\x3d\xd8\x23\xb8\xa4\x1b\x7b\x30\xc6\x9d\x93\xdb\xbf\x2e\x6a\x07\x9a\x5b\x9e\x87\xef\x27\x86\xee\xc3\xee\x79\xef\xba\x00\xc0\x20\x5c\x89\xa0\x8f\x01\xc4\x10\x51\x7b\x03\xc7\x82\x14\xef\xc2\x43\xf1\xfe\x1c\xa6\x53\xd8\x4a\x16\x63\xd9\xc7\x9e\x59\x55\x88\x73\xa7\x3e\xcc\xf9\x6e\xd2\x68\x6d\x48\x9a\xda\x23\x4f\x3b\x49\x77\x4a\x55\x31\x7c\x47\x68\xcd\xf3\x8d\x77\x60\x35\xdf\x98\xe3\xaa\xc0\x96\x8d\x62\x9f\x7d\x8e\x46\x09\x88\xbc\x2f\x86\xee\x65\x2c\xff\xaa\x90\x3e\xcb\x75\xae\x6a\x49\x1e\x7c\xd4\x25\x9f\x83\x55\xa6\xd7\xd8\xba\xb5\x9a\xfb\x5d\xcc\x15\xfe\x98\x54\x30\x23\xeb\xec\xf3\xbc\xc6\xc1\x92\xae\xa1\xce\x4c\x53\xef\x3c\xfb\x13\xef\x54\x0d\x6e\x53\xc1\x1f\x81\x8e\x1f\x71\x5c\xde\x3a\x44\xc3\xe3\x72\xfb\x6c\x59\xe3\x9f\x5b\x3b\x97\xb0\x1c\x68\x9d\x0a\x3c\xc9\xad\xb1\xaf\x37\xec\xe5\x3c\x43\xf7\xa3\x3b\xc0\xec\x4e\xff\x9b\x42\x1f\xfd\x41\xa1\x68\xf4\xc6\xfa\x0e\x45\xd5\x2b\x3f\xb3\x5e\x80\x45\xdb\x0d\x7e\xff\x39\xad\x79\x2f\xa8\xf8\x2c\x56\xdb\x53\x61\xf8\x36\x50\x73\x32\x55\x9e\xf2\xb4\xbb\x4e\x39\x72\xef\x3b\x58\xd8\x72\xd8\xc5\xf6\xb3\xd6\x19\x80\x9e\x62\x31\x5f\x86\x90\xbe\xc5\xed\x5d\x38\x35\x73\x80\xbf\x31\x36\x34\xec\x83\xc0\x29\x78\x5e\xc8\xef\x82\x6f\xe0\x4a\xfa\x08\x94\x81\xab\x9c\x7b\xae\x9f\xb7\xd2\xa4\xd2\x4e\xfb\x3c\x8c\xdd\x37\xd6\x83\x77\xd5\x9d\xaf\xe3\x3d\xb2\x4c\xea\xcc\xcc\xc7\x40\x13\xb8\x3e\xf3\x25\x5c\x84\x70\x7d\xf8\xee\x2b\xfd\xb0\xfb\xdb\x8e\x07\xdd\x75\x1c\xdc\x21\x0a\x7c\xf6\xf1\x4b\xa8\xe3\xb0\x3e\x76\xe9\xd3\x85\x60\xfd\x8f\x72\x8c\x7c\xbc\xf2\x23\x34\xf7\x01\xb8\xed\x52\xcf\x87\xdd\x66\x1d\xb5\x3e\xbd\xa4\x4c\x67\x8b\xba\x70\xb4\xbb\x00\x30\xfc\xe2\xe2\x52\x15\x7b\x75\x1f\x17\xec\xdf\x31\x73\xdc\x64\xf7\x3e\x80\x9b\x7a\x0e\x7d\x44\xb2\x75\x42\xfc\xef\x9c\xc6\xc3\xaf\xe4\x85\x3f\x19\x68\xbe\xd7\xb4\xe7\xb3\x80\x6e\x25\x5c\x19\x46\xe3\x91\x19\xaa\x8e\x00\x0e\xb4\xb9\xc7\xb1\xd6\xa7\x8a\xf7\x21\xc8\x7e\x63\xf0\x1a\x7b\xef\x7a\xc9\xc1\xb6\x22\xac\x19\xfa\x73\x1d\xa0\x62\xbc\xfe\x9f\xae\x6c\x20\x7c\x43\xc6\x7b\x35\xd8\x0e\x83\x21\xa1\xdb\x05\xde\x77\x81\xfe\x3f\x00\x00\xff\xff\x10\x68\xd5\x9c\x19\x59\x00\x00")
+var _templatesServerParameterGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3c\x6b\x73\xe3\x36\x92\x9f\x57\xbf\xa2\xa3\xdb\x4c\x91\x2e\x99\xca\xed\x6d\xdd\x07\x27\x4e\xd5\x8e\xed\x6c\x5c\xc9\x3c\xce\x9e\xcc\x87\x9b\x9d\x4a\x60\x11\x92\xb0\x43\x11\x32\x40\xd9\xd6\xb1\xf8\xdf\xaf\x1a\x2f\x02\x24\x28\xc9\x33\xce\x24\xa9\x5d\x4f\x2a\x25\x12\x40\xa3\x5f\xe8\x17\x00\xd6\x35\xe4\x74\xce\x4a\x0a\xe3\x1b\x9e\x6f\xef\x48\xc1\x72\x52\x71\x31\x86\xe3\xa6\x81\x11\x40\x5d\x1f\x03\x9b\x43\xf6\x3d\x91\x2f\x78\x4e\x8b\xe7\x3c\xdf\xbe\x26\x82\xac\x24\x34\xcd\x08\x60\x3a\x05\x33\x88\x02\x42\x00\x7e\xf3\x4f\x3a\xab\xea\x7a\x7a\x04\x39\x2d\xe8\x82\x54\xd4\xf6\x60\xbc\x84\x8a\xc3\x0a\xe1\x98\x7e\x70\x34\x55\x60\xd8\x1c\xa8\x10\x70\x72\xaa\x80\x64\x6f\x0d\xc8\x44\xf0\x4d\x45\xb3\xef\xb8\x58\x91\x4a\xa6\x5f\xab\x4e\x5f\x9c\x42\xc9\x0a\xa8\x47\x00\x00\x82\x4a\x38\x05\xb2\x5e\xd3\x32\x4f\x04\x95\x13\xec\x92\x8e\x00\x9a\x91\x06\x5b\xd0\x12\xdf\xa7\x70\x7a\x0a\x5f\x99\x41\x75\x0d\xd9\x15\x9d\x51\x76\x47\xc5\x4b\xb2\xa2\xd0\x34\x59\x5d\xc3\x9a\xc8\x19\x29\xd8\xff\x51\xc8\xcc\x5b\x38\x85\xba\x46\x28\xa4\xcc\x21\x29\x79\x05\xd9\xf5\x6c\x49\x57\x24\xbb\x94\xcf\x89\xa4\x6f\xb6\x6b\x9a\x42\x76\x29\x5f\x6e\x8a\x82\xdc\x14\x08\xe9\x59\x5d\x03\x2d\x73\x68\x1a\x24\x45\x61\xa2\xd9\x48\x0b\x49\x2d\x2c\xe4\xe7\x35\x5b\xad\x0b\xea\x31\x34\x60\xf2\x65\x45\x63\x3c\x26\x42\x90\x2d\xf0\xb9\xcf\x6c\xa9\xb8\x3d\x23\x42\x6c\x81\x97\x20\x0b\x36\xf3\x79\x2e\x27\x50\x2d\x69\x09\xac\xa2\x82\x54\xec\x8e\x16\x5b\x27\x1a\x79\x80\x6c\x90\x5b\x6f\x49\xb1\xa1\x17\x0f\x6b\x41\xa5\xc4\xae\x4d\x03\x5a\x54\x86\x9f\x5a\x49\x0c\x6f\x90\x36\xc4\xc1\x48\x91\xf1\xd2\x10\x62\x78\x5f\xd1\xd5\xba\x40\x62\xc6\x0a\xd5\x35\xaa\x93\x41\x03\x35\x2f\xb3\x9d\x15\xd3\x14\x27\x47\x00\x73\x2e\xe0\xe7\x09\x8a\x23\xbb\x2c\x73\xfa\xf0\x96\x08\x68\x9a\x88\x24\x51\x89\x04\x29\x17\x14\xea\xba\x8b\x77\xd3\x18\x0d\x68\xf5\x6d\x3f\xc0\x47\x68\xe3\x0e\x7d\xc4\xd6\x1b\x41\xc9\x07\xf5\xbb\xf9\x5d\x6b\x68\x54\x3b\x3d\xa1\x04\xd3\x5d\xca\xbf\xa1\x4e\xa6\xbe\xf8\x7b\x92\xf7\x55\x98\x95\x05\x1a\x9c\x1b\x9e\x6f\x8d\xe2\x16\x85\xff\xd2\x57\xc9\x15\xad\x96\x3c\xf7\x35\xf1\xf3\x71\x25\xd4\x91\xee\xbc\x96\x9c\x70\xfe\xcb\x73\x68\x1a\x64\xda\x27\x59\x2e\x80\x8e\x3c\x0c\x8f\x77\xf2\x52\x9b\x86\xcf\xce\x25\x4f\x27\xb8\x80\xec\x6c\xc9\x8a\xbc\xab\x02\xbb\x34\xe3\xb7\xe5\xb2\xe1\x73\x99\xfb\x26\x07\xed\xb4\x63\x75\xc9\x7d\x7d\x14\xf4\x76\xc3\x04\xcd\xd1\xce\x7a\xbc\x1f\xed\x80\xd4\x34\x21\x97\xb2\x4b\x79\x59\x56\x54\xcc\xc9\x8c\x0e\xcc\xc2\x4b\x58\xd0\x92\x0a\x36\x03\x66\xbb\xfe\x16\x82\x35\x14\x81\x59\x7c\xee\xc1\xfb\x39\xf2\x22\x88\x98\x35\x57\xbd\xad\x72\xbc\x60\xa5\x76\x6b\xd9\x0b\xf2\xe0\x39\x38\x04\x0e\x33\xb2\xa2\x01\x15\xd7\xf8\x70\x72\x8a\x2c\xf8\xef\xbf\x26\x68\x25\xeb\x7a\xc0\xf4\xe8\xc7\xef\x89\x3c\x67\x72\x26\xd8\x8a\x95\x18\xc4\xd8\xf7\x1e\xc3\xdb\x57\xd7\x95\xa0\x64\xd5\xe5\xc2\x91\xe3\x42\x3b\x97\xd6\x69\x3d\x30\x59\x12\xf9\x5a\xd0\x39\x7b\xe8\xfb\xc4\x31\xcf\xc6\x69\x6a\x7c\x88\x1a\xd3\xeb\xd2\x34\x67\x38\x81\x56\x8b\xb8\x5f\x75\x08\xa4\xe9\xc8\xfd\x36\x3c\x6c\x19\xd8\x34\xa3\xe9\x14\xb9\x96\xa9\xe7\x73\xba\xae\x96\xd0\x34\x2b\xd3\x7e\xa2\x9a\xfc\xde\xed\x32\x33\xb2\xa1\x0e\x58\x82\x7d\x5f\x13\x05\x40\x39\xd8\xb5\x60\x65\x35\x87\xf1\x97\xb7\x63\xc8\x7e\xe4\x33\xad\x94\xa6\x31\x2a\xa6\x49\x77\xbe\xfe\x52\x14\xb4\xda\x88\x12\xdf\x8e\x9a\x08\x61\xe4\x61\x37\x61\xe4\xc1\x27\x8c\x3c\xec\x24\x8c\x3c\x3c\x25\x61\x0e\xde\xe3\xc9\xfa\xa9\x64\xb7\x1b\xba\x93\xb2\x4d\xdb\xe5\x04\x2a\xb1\xa1\x31\x8a\x3c\x38\x8f\x23\xea\xb7\x5e\x2e\xb0\x67\xbd\xc0\x53\x2e\x98\x47\x0a\xe7\xa2\xdc\xac\x86\xa4\x82\x6d\x7a\x11\xd9\x5e\x11\xa9\x60\x53\x62\x93\xa4\x03\x65\x62\x7a\x7f\x26\xb9\x74\x92\x38\x2d\x19\xf3\xd2\xbd\x3e\x50\x50\xe1\xb8\x5d\xf2\xf2\x26\xcb\x2e\xe5\xd9\x46\x56\x7c\xa5\x5d\x74\x45\x31\x68\xcf\xae\x2b\xc1\xca\x45\x92\xb6\x58\x3a\xb8\x2a\xe4\x71\x33\x99\xb9\xba\xb3\x1c\x37\xcd\x27\xce\x12\xf2\xc6\x34\x3b\xe9\x38\xf1\xfd\xc7\xdd\xd8\x68\x80\x1d\xbd\x53\xc9\x30\xaa\x30\xb3\x35\x9d\x5f\xd6\x47\xae\x05\x5f\x53\x51\x6d\xbb\x49\x4f\xab\x96\x97\xf2\x35\x7a\x30\x4c\xd4\xf4\xd2\x68\xd3\x25\xd3\x9f\xf1\xd2\x75\xd1\xe9\x52\x30\x95\x06\xd2\x67\x48\x4c\x85\x75\xfb\xab\x79\x52\xd7\x4a\x81\x9b\x66\x02\xe3\xba\x76\x3a\xdb\x34\x63\xfd\xe2\xfa\x9e\x2c\x16\x54\xe8\xfe\xea\x6d\x64\x35\x7a\x4c\x9f\xc0\x7c\x28\x28\xf3\x57\x65\x04\x71\x1b\xe9\x1e\x96\x28\x1a\x00\xc7\x1d\x7e\x5b\x76\xdf\xb0\x32\x5f\x5b\x5e\xa9\xf1\x63\xe8\x74\x8d\x84\x2f\x38\x8a\x0a\xd5\xf3\x8e\x08\x34\x03\x77\x44\x94\xe8\x15\x8c\xbe\x75\x15\xb2\x69\xae\xb0\x57\xf6\x77\x8e\xa1\x15\x8e\xc3\x74\xb5\xae\x77\x47\xc5\xfa\xed\x19\x2f\xef\xa8\xa8\xa8\xeb\x16\x93\x5d\x3f\x4b\x35\x36\xf1\x67\x47\xca\xe4\x20\x3c\xdf\xfa\xe9\xf1\xfe\xee\x67\xca\x8e\x76\x09\xb1\x42\x32\xeb\x70\x7a\x04\x57\x74\xb6\x11\x12\x55\x56\x50\xc9\x8b\x8d\xb2\x77\x7c\xae\x73\x12\x09\xac\x04\xc5\x58\x09\x47\x53\xb3\x10\x35\x4c\xb4\x3d\x06\xe8\xf5\x07\xb6\x7e\x4d\x84\xa2\xca\x98\x64\x33\x9b\x6f\x98\xcf\x78\x51\xd0\x19\x82\xd7\xec\x39\xf1\x3a\x76\xdb\x5a\xf5\xb0\x33\xda\x8e\x83\xd4\x9e\xd8\xc8\x39\x82\xd4\x41\xec\x6d\x25\x23\xef\xc9\x22\xbb\x5e\x17\xac\x7a\xbe\xd5\xf8\x24\x07\x41\xe8\x3b\x90\x41\xea\xac\x69\xb3\x89\x94\x89\x91\x3b\x73\x0c\xcd\x74\x96\xc2\xb7\xa6\xe0\x30\xb0\xd6\xec\x3a\x30\x2e\xdd\x72\x71\x2f\x0d\x57\x6d\xca\x75\x50\xf7\xc3\x54\x37\x10\x4c\x6b\x27\x2e\xaf\xea\x5a\xa5\x5d\x36\xab\x0b\xfa\xc5\x6a\x51\xc7\x07\x19\x17\x4b\xb3\xe7\x20\x46\xa1\x8f\x32\xba\xff\x92\x97\xc7\x4a\xcf\xa1\xc2\xc5\xcf\x54\xa5\xad\xaf\xea\xbd\xe5\x6e\x5d\x8a\xed\x50\x3a\xda\x03\x63\x0b\xe3\xf1\xce\x15\xe1\xad\x83\x88\xd6\x84\xa0\x7a\x0b\x62\x2f\xd3\x27\xd6\x65\xd4\x75\x9f\x82\xa6\x39\x48\xbe\x6f\xd3\x36\xcf\xf7\xfc\x80\xef\x09\xb8\x90\xd9\x65\xa9\xd8\x8f\x16\x34\x69\x67\x1b\x0c\xab\x34\x32\x41\x70\x35\x6e\x87\x39\x4b\x3c\x3e\x4c\xb9\x5a\xe5\x31\xf2\x0d\xa2\xd9\x88\x3f\x4d\x62\xa6\x2b\x35\x12\x7f\x2a\x69\x8d\x00\x5d\xf5\x86\x3a\x29\x18\xa7\x9a\xa9\xd9\x92\xc3\x80\x4c\xfe\x08\x32\x52\x64\x7a\x2b\x78\x2f\xc2\xe8\xc7\x8e\x12\x35\x2c\x4b\x8e\xea\x3a\x80\xd9\x34\x69\xba\x53\x98\x7c\xb5\x2e\xe8\xc3\x2b\x5d\xe7\x8e\xbb\xe7\x76\x81\xe7\x34\x67\x33\x52\xd1\x1c\x77\x2f\x4a\x2a\xf1\x97\xaa\xb8\x45\x7c\xda\x41\x78\x1f\xd2\xef\x6d\xaf\x36\x36\xd4\xb3\xad\x4f\xdb\x90\x0b\x7a\x31\x97\x82\x75\x47\x27\xc0\x3f\x20\xe3\xa8\x10\x59\x72\x64\x64\xda\x12\x9d\x7e\x8d\xed\xb5\xb1\x4a\x46\xf2\x77\xd4\x4d\x80\x19\x7f\x5f\xec\xa9\xab\x68\xfb\xda\xd2\x5d\x4f\xbf\x0e\x87\x5a\x73\xd6\xf5\x62\x43\x81\xf6\x1f\xc7\x97\x59\xc7\xd3\x34\x7e\xf6\x3a\x9d\xc2\x19\xcf\xa9\x2e\x10\x2a\xa5\xbc\xd9\xc2\x82\x1f\x63\xb8\xb1\xa0\xe2\x6b\x38\x7f\x05\x2f\x5f\xbd\x81\x8b\xf3\xcb\x37\xd9\x68\x64\xe3\xea\x33\xbe\xde\x0a\xb6\x58\x56\x68\xa1\xb4\x79\x9a\xf1\xd5\x8a\x96\x55\xa7\xcd\xe3\xe8\x68\x4d\x66\x1f\x88\xda\x48\xc1\x3a\x8a\xfe\xdd\x34\x23\xac\x63\xbc\x59\x32\x09\x73\x56\x50\xb8\x27\x32\x44\xa6\x5a\x52\x30\xd8\x40\xc5\x79\x91\x61\xff\x8b\x9c\x55\xac\x5c\x40\xe5\xc6\xad\xd4\x8c\x6b\xc1\xef\x28\xcc\x37\x95\x02\x85\xdb\x54\x5b\xbe\x01\x41\x8f\xc5\xa6\x0c\x20\xd9\x29\x14\xda\xa4\xcc\x47\x23\xb6\x5a\x73\x51\x41\x32\x02\x18\x97\xb4\x9a\x2e\xab\x6a\x3d\xc6\x5d\xbf\xf1\x82\x55\xcb\xcd\x4d\x36\xe3\xab\xe9\x82\x1f\xf3\x35\x2d\xc9\x9a\x4d\xb5\xba\x8f\x87\x3b\x18\x2d\xa1\x3b\xba\x88\x4d\x59\xb1\xd5\x01\x3d\xa6\x12\x23\x62\x56\x6d\x0f\xe8\xba\x62\x79\x5e\xd0\x7b\x22\x76\xc1\x45\x8e\x2a\xea\x64\x25\xe6\xab\x6a\xb0\x9b\x6a\x1d\x9b\xe5\xa0\x03\xfd\xec\x9c\xce\xc9\xa6\xa8\x2e\x15\xc3\xb0\x12\xd5\xb5\xd3\x76\x35\x18\xc9\x7b\x63\xff\xfc\x81\x6e\x27\xf0\x67\x65\x67\x71\x61\x66\x01\x10\x6c\x45\x9b\xd6\x81\x67\xba\x77\xa0\xa6\x4a\x71\x5e\xd2\xfb\x68\xcd\xda\xec\x32\xcd\x04\x55\xdb\x92\x04\x4a\x7a\x0f\xbb\x7a\xea\xbd\x4f\xab\xdf\xfa\x25\xda\xf0\x6b\xbe\xa2\x86\x60\x65\xc4\x71\xd2\x7b\x56\x2d\x95\x36\xe5\xba\x41\x7b\x55\xcc\x4b\x58\xc5\x14\xf4\x3c\xb3\x91\xbb\x19\x52\xf2\x6e\x67\x9d\x27\xe6\x98\xcc\xc8\x35\x9d\x65\x23\x6f\x81\xce\x37\xe5\x6c\x0f\x69\x49\xba\x93\x9c\x7a\x0f\x25\x26\x18\x10\x4a\xdf\xa7\x53\x0f\x75\x9d\x5a\xd1\x8a\x0a\xa9\x09\x0d\xf1\x0e\x34\xc1\x4c\xe6\xd7\x57\xec\x86\x9f\x99\xc9\x18\x6a\x80\x20\x34\xc5\x58\xe7\x52\x7e\xc7\x0a\xaa\x20\x74\xb2\xa1\xcb\xf3\xa6\xb1\xc3\x4f\x2d\x64\xfb\x67\x2a\x35\xca\xff\xfa\xb5\x8d\xa1\x98\x2a\x91\xaa\x3a\x74\xc6\xcb\x8a\x30\x4c\x93\xff\x97\x0a\x0e\xe3\xe4\x1f\x63\xaf\x5a\xa8\xde\xe1\xcf\xe9\x91\x5d\x10\x26\xf8\xb6\x5c\x11\x76\xaf\x46\xc2\x4f\xe5\x8a\x08\xb9\x24\xc5\x1b\xfa\x50\x25\xe9\x04\x68\xb6\xc8\xe0\x9c\x54\x74\xa2\xfe\x8f\x8b\x70\x02\xe7\x1b\xdc\x1d\xe7\xa5\xdd\x6c\xf4\xff\x7a\x81\xc4\x3e\x42\x76\xd1\xa0\x98\xe7\x02\x95\xa4\x5f\x70\xb2\xac\x6c\x9a\x74\x27\x81\x15\xf9\x40\x25\xb6\xa2\x61\x7d\x2c\xd6\xb6\x80\xd8\x41\x5d\x45\xb0\x8f\x43\x4f\xd0\xc5\xa6\x20\x02\x16\x1c\x5c\xa9\xa5\x8f\xec\x1e\xfc\x22\x35\x85\x73\x0e\x88\x63\x0b\x04\xe6\x82\xaf\x60\xcd\xa5\x64\xb8\x5b\x63\xd4\x1c\x57\xb1\x8d\xca\x4c\xb9\xc1\x05\x64\x00\xc3\xba\x68\x7d\xaf\x27\x48\xfb\xaa\x2b\xce\xbe\xce\x39\xa4\xb4\xca\xc8\x0a\x5d\xd3\x62\xab\xd7\x5f\x47\xdf\x62\xa4\xf7\xc8\x8f\x14\x56\x1e\x31\xe3\x3f\x25\x2f\x33\x37\xed\x61\x53\x0e\x30\xc1\xcf\x6b\xf6\x6a\x07\xc2\x5b\x70\x95\x60\x5f\x5a\xfc\xa8\xf0\x55\xe4\x70\x0d\x39\x01\xa9\x0e\xc4\x98\xf3\x2b\x5e\xcb\x5e\x6a\x42\x87\xd6\x51\x52\x85\x01\x29\x0a\xe0\xd5\x92\x0a\x98\x11\x49\x25\x24\xca\x04\x48\xb5\x29\x9a\xc2\x3b\xb9\xe4\x9b\x22\x57\xea\xc6\x67\xb3\x8d\x78\xbf\x73\x4a\xeb\x24\x3f\x12\x17\xc4\xc0\x6e\xc8\xc6\xe6\x89\xce\xd1\x7b\x19\xbc\x08\x1e\xd2\xd1\x28\x62\xf0\xa3\x86\xde\xac\x34\x77\x74\x28\xd0\xdc\x41\x95\x0b\x96\x97\xb7\xf1\x13\xac\xa5\xc7\x5b\xf7\x34\x1d\x76\x2b\xad\x72\x2b\x1b\xfe\xee\xfd\xcd\xb6\xea\xe7\xbf\x96\x32\x93\xfe\x19\xf4\x62\x26\xc6\xb4\xb6\x04\x70\x01\xc9\xa3\xed\x42\x1a\x59\xb7\x1e\xe4\x36\x7d\xeb\xac\x4f\x83\xfe\x2f\x75\xed\xd0\x97\x63\x48\xb0\x97\x23\x22\x6d\x9a\x5f\xd2\x09\x3c\x0b\x19\x02\x8e\x23\xfd\xba\x7a\xfb\x37\x9d\xc2\x9a\x94\x6c\x26\x91\x32\x8c\x55\xd8\x9c\x99\x14\x9c\xa1\xb9\x54\x81\x6e\x30\x62\x25\x17\x88\xe7\x7c\x55\x65\xd7\x1a\xa7\x64\x6c\xfa\x85\x61\x10\x96\xf5\xdb\x80\x23\xc8\x75\xd4\xa9\x8c\x13\xf8\xf2\x6e\x3c\x31\x67\x2c\xda\x7f\x0a\x9d\x64\x25\x17\xfe\xeb\x8e\x14\xda\x22\x5b\x44\xbf\x5d\x6b\xfb\x1e\xbc\x32\xbe\xcb\x3c\x77\x84\x58\x35\x1c\xb4\x2c\x0c\x06\x21\x20\x8c\x70\x4e\x3e\xc3\x86\x6a\x7b\x0a\x63\x48\xee\x13\x3f\xa6\x76\x3f\x00\x73\x44\xb3\x93\x39\x44\x3f\xcc\xec\xa2\x43\x6b\x88\xd1\xf0\x0d\xdf\x94\xb9\xad\x62\xa0\x60\xf1\x65\x5d\xc3\x72\xb3\x22\xa5\x0f\x00\x30\x97\x56\x0a\x84\x73\x54\xdb\x35\x9b\x91\xa2\x50\xa9\x9e\xc4\x33\x89\x14\xf8\x0d\x82\xa6\xb9\xf6\xd1\x04\x30\x19\xcb\xae\xe8\xed\x86\xca\x6a\x34\x9d\xe2\x30\x93\xc9\x9d\x78\xe1\x6a\x5d\xbb\x29\x46\xca\x1f\xec\x42\x5f\x56\x62\x33\xab\xa0\xc6\xdc\x66\x3a\x85\xef\xdf\xbc\x79\x0d\x66\x06\xd0\x95\x9c\x11\xa8\xb7\xf6\xe5\x91\x8f\x04\xfc\x82\xcb\xeb\x64\x7c\x3c\xfe\x25\x4c\x8e\x0c\xf4\xa6\x99\x1e\x19\x65\x38\xa7\x28\xc4\xb5\x29\x59\xd5\x35\xdc\x14\x7c\xf6\xc1\xa5\xcb\xbd\x66\x27\x0b\x1c\x7c\x65\x4f\x08\x29\x3d\xb2\x4f\x7a\x6b\xbf\xdb\xf7\x05\x79\x60\x2b\xbd\xbd\x0c\x60\x1e\xac\x96\x65\x17\x0f\xb3\x62\x83\x5b\x2a\x6d\xaf\x6f\x02\xc9\x7b\xc3\x7b\x80\x59\x69\x5a\x46\x00\x2f\x58\x39\x00\xd8\xf5\xfa\xb6\x03\x98\x95\x43\x80\x37\x45\xc5\xd6\x05\x7d\x35\x37\xb0\xcd\x33\xbc\x9a\x9b\xa3\x19\x7e\x87\xde\x68\xf2\xf0\x23\x2d\x17\xaa\xa0\x8b\x88\x91\x07\xd0\xcf\xee\x58\x87\x6b\xee\x0d\x65\x65\x30\x94\x95\xe1\x50\x56\x0e\x0e\x7d\xad\xdc\x0f\xca\x6a\x04\x60\x1e\x4e\x4c\x4d\xc3\xb6\xf4\xa6\x33\x47\x3e\x5a\x44\xe3\xc7\x4f\x7a\xe3\xda\x23\x30\x06\x4b\x7f\x1c\x2b\x87\xc6\x75\x0e\x8a\x00\xe8\x17\x71\xb5\xf1\xea\xa9\x23\x80\x4b\x43\x8c\xf7\xb6\x3b\x20\xb2\x75\x34\x02\x68\xdf\x82\x57\x95\x8e\x75\xee\xc2\xeb\x5a\x4b\xf3\x70\x02\x3b\x43\x1f\x03\x63\x04\x70\x34\x75\xbb\x22\xde\xe1\x34\x13\x3a\xb5\xcb\x5f\x99\xbd\xcf\x60\x74\xfd\x53\x2c\x5e\x68\xeb\xc7\x73\x3d\x9b\xf4\xd8\x03\x76\x9d\x4e\x16\x91\x1d\x93\x5b\x66\x69\xe4\x1a\x6b\xe0\x9f\xb3\x32\xb7\x26\xed\x86\x57\x4b\xc0\x4d\x3a\xa9\x10\xb1\xf5\x2b\x09\x44\x25\xbf\x54\x56\x13\x60\x15\x10\x29\x37\x2b\x2a\xa1\x5a\x92\x0a\xcb\x67\x58\xfa\xc6\x42\x5c\xb9\x90\xa0\x42\x6e\x55\x06\x24\x60\xca\xbc\xc8\x15\x4c\xc2\x31\x18\xb8\xa2\x0b\x26\x2b\xb1\x4d\x31\xd8\xe0\xc2\x3b\x8e\x38\x9d\xaa\x50\xc0\xc4\xec\xb6\x9e\x52\xc1\x3d\x2b\x0a\xd8\x48\xaa\x32\x14\x55\xe2\x33\x67\x78\xd1\x63\xc8\xcc\xf8\x82\x37\x1c\x68\x29\x37\xa2\x5b\x91\x51\x47\xd5\xad\xa5\x5f\x6d\x64\x05\x4b\x72\x47\xe1\x86\xd2\xb2\x0d\x47\x69\xae\xf3\xac\xbd\xf5\x96\x1b\x3a\xe7\x82\x2e\x49\x99\x67\xba\x42\x93\x44\x8e\x50\xc2\xd1\x0e\x20\xa9\xcf\xef\x44\x84\x2e\x65\x02\xea\xf8\x29\x1c\xb5\x05\xbc\xec\x05\xa9\x66\x4b\x9a\x5f\x61\x83\x65\x5a\x6d\x2a\x37\x58\x8b\x78\xf7\x5e\xbd\x1b\x0d\x1c\xe7\xf4\xdd\xd7\x29\xd8\x6e\x66\xcd\xfd\xcf\x86\x8a\xf6\x34\xf6\xad\xc4\x88\xcd\xd4\x10\x75\x25\x5a\x26\x22\xfb\xe9\xea\xc7\x4c\x75\x4c\x52\x6f\x7b\x38\x80\x83\xab\xdd\x81\x69\x83\x54\x81\x51\x91\xa4\xda\x82\x13\x51\x61\xb7\xe4\xbf\xfe\x02\xdf\x7c\x03\x7f\xf9\xaa\x1b\x6e\xfe\xe9\x4f\x66\xe0\x17\xa7\xda\xd7\x5f\x08\xf1\x92\x57\x6e\x70\x27\x22\x6d\xf7\x05\x70\xa7\xef\x25\xbd\x4f\xfe\xfa\xd5\x57\x93\x71\x2f\x56\x6c\x5c\x7a\x1a\x22\xa5\x70\xd9\x15\xf2\x1e\x3c\xc1\xe8\x4f\x9e\x15\x43\xb0\x8a\x73\x8e\x1d\x78\xd3\x20\x8f\x73\x16\x3b\xa7\x6e\x41\x06\x26\x2d\x1e\x50\x38\xa6\x1b\xeb\xe3\xe7\x08\x06\x87\x4b\x4f\xa8\xd0\x34\xb7\x51\x55\x9c\xc0\xed\xf2\xc3\x40\xcb\xcf\x88\xea\xad\xcc\xfe\x4e\xab\x57\x3f\xf8\x67\x03\xd3\xde\xfe\x51\x57\xd7\xd0\x70\x84\x50\x95\xc1\x4d\x1e\x8f\xc4\xa7\x9d\xc2\x0e\x53\x34\xdc\xef\xb5\xec\x10\x43\xf3\xed\x66\x87\x46\x47\x01\x79\x52\xc6\x3c\x1e\x9d\xa7\x64\xcc\xf7\x94\xe4\x54\x58\xd6\x7c\x24\x05\x99\x86\xf2\x4e\x2d\xd9\x33\x52\xf2\x12\x23\x79\xfd\xf2\x07\xba\x0d\xf8\xf4\x7e\xa2\xa2\x8f\xa7\xa5\xc2\xd9\x1e\x97\xea\xd5\x35\x9b\x47\x8a\xcb\xbd\xe3\xb8\xf1\x43\xba\x1a\x75\xb7\x3f\xae\x57\x29\x82\x1a\x10\xb9\xc5\xdb\x2e\x3f\x2f\x0c\x78\xf6\xac\x6b\xd0\x5e\x30\x29\x59\xb9\x40\x70\x6e\xad\xef\xa0\xd8\xb7\x3c\x30\x16\x94\xe4\x58\x98\x55\x9b\x5d\x5f\xde\xc2\x9c\xb0\x02\x13\x01\xb4\x79\xdd\x0d\x93\x24\xa4\x2b\xb5\x07\x2f\x5c\x19\x03\xa2\x18\x87\xd6\xf2\x34\x8a\xb8\x11\x91\xda\xd1\x38\xe6\x6b\xe5\xb4\x57\x9a\x2e\xb8\xd9\x54\xc0\x55\x22\x43\x0a\xbd\x99\xe7\x72\x33\x7f\x5e\x6d\xe4\x7a\xa6\xf9\x31\x8a\xf7\x58\x81\xc6\xb4\xac\x9f\x60\x4d\xa7\xfd\x04\xcb\xb2\xf5\x1f\x25\x1e\x9b\x51\x47\x83\xa0\x69\x86\x35\x54\x53\xf5\xf8\x5b\x57\xcf\xac\x7f\x50\xda\x71\x4e\x2a\x72\x12\xa5\x67\x02\x9a\xa2\x78\xab\x6e\x6b\xcc\x52\x69\x17\x4b\xd3\xcc\x3b\x7c\x74\xe0\xe6\xf9\x6e\xeb\x37\xcf\x9f\xd4\xe8\x7d\x0c\x1e\x9f\x66\x30\x02\x0f\x3b\x3d\x52\x3f\xb3\xd6\x3a\x60\xc5\x14\x46\xb1\x4e\xca\xcd\x6a\x27\x6b\xca\xaa\xa1\xed\x09\x6b\x74\xff\xf6\xc0\xff\xf6\xc0\xff\x92\x1e\x98\x15\x54\xad\x04\x4b\x88\x39\xe2\x3c\x60\x9b\x30\x65\x39\x5a\xd9\xb0\x5e\xad\x43\x4d\x81\xd6\x6e\x91\x05\xf9\x42\x88\xd5\x2e\xa8\xa7\xdd\xa1\x0a\xf4\x3b\x8f\xfc\xf7\x06\xfd\x8f\x63\xf4\x8e\xb9\x3f\x85\x81\x66\xa3\xa3\x0d\x63\xfe\x95\x2d\xb5\x67\x82\xcd\x8f\x51\x67\x43\xc8\xdd\x1f\xb6\xb5\x0f\x63\x7e\xd9\xdc\xe5\x57\xdf\x13\xd5\x2b\x11\xa9\x99\xd2\x6c\xd2\x76\x8b\x25\xee\xd0\x42\x8c\x27\x51\xf2\x94\x8e\x3d\x0f\xae\x67\x7a\x17\x32\x73\x3a\xa7\xc2\x74\xc8\xce\x0a\x2e\x69\x92\x8e\xfa\xdb\xc4\xbd\xba\x8e\xf7\xea\xe2\x01\xcf\xc2\xd8\x5a\x2f\xa8\xab\xa0\x2e\x14\x45\x34\xd5\x6d\x7e\xd9\x1e\x9d\x6a\xf7\x80\xea\x1a\xab\x1c\x78\x6c\x54\xb7\x99\xd2\x4f\x7f\x88\x73\x53\xf6\x8c\xd8\x35\x6e\x8f\x3a\x86\x27\x9a\x00\x2b\xc9\x33\x5e\x62\xad\xc7\x24\xbc\x6c\x1e\x08\xb4\x3d\x99\x61\x59\xec\xc5\x52\xa6\xcd\x0c\x39\x3d\x05\xc6\xb3\x8b\x57\xdf\x19\x99\xe0\x7f\x48\xd7\xa9\x8d\x73\xed\x48\x5f\x75\x7b\x61\xad\x5f\x93\x4c\x47\xdd\x4d\x1f\x7f\x27\x67\xa7\xaa\x05\xb2\xd3\x7d\xd1\x66\x21\xb7\x3b\xb7\x2e\x3c\x0a\x4e\x4e\x3b\x2c\xb1\x3f\x1c\xc7\x9e\x21\x80\xf4\xeb\xa7\x64\x51\x94\x84\x2e\xbb\xf6\x06\xfe\xbb\xb8\xe8\xd8\x68\xe2\xd6\x10\x61\xb7\x08\x77\x22\xf3\x92\xde\xab\x4a\xcf\x05\x16\x4b\x3e\x15\xa3\x09\x8c\xc7\x26\x57\x39\x84\x79\x4d\x0f\x5f\x1b\x8f\xf9\x14\x68\x48\x41\x6c\xde\x39\xde\xd9\xf9\x5a\x89\xfb\x66\x44\x33\x0a\x21\x19\x4b\x15\x45\xc9\x07\xff\x79\x24\xd7\x40\x1f\xbd\xe1\x07\xff\xdb\x10\xf6\xa6\x46\xb7\xd2\x75\xc6\x57\x6b\x2e\x59\xe5\x5d\x70\xd0\x62\x15\x54\x66\x59\x66\x2d\xb5\x19\x54\xb2\x02\xcb\xc8\x78\x72\x6f\x56\x10\x29\x11\x73\x74\x33\x49\xc7\x78\xa6\xe6\x6a\xd4\x60\x45\xab\x93\xaf\xef\xa9\xae\x7a\x93\xb5\x85\xd5\x41\x37\x86\x79\x68\x18\x70\x4c\x60\xa9\x42\x0e\x88\x06\x22\x7e\x99\xd5\x94\x13\xb8\xf0\xb7\x84\xda\x8d\xa5\xa6\x91\xea\x52\xaf\x76\xbf\xac\xa0\xd9\x35\xa5\x1f\x92\xaf\x26\xb8\x94\xf1\xe7\x45\x99\x23\xcb\x62\x4d\xd7\x15\x11\x95\x35\x47\xfe\x3d\xff\x60\x7b\x4a\xc5\x85\x38\x09\xe0\x66\x9d\xff\x3e\x2a\xbe\x8b\x87\x19\xa5\xb9\x34\x5b\x74\x07\x1b\xd3\x49\x6f\xd3\x6b\x02\x73\x52\x48\xda\x7a\x66\x8b\xa1\xc1\x8f\x3c\x74\xf1\xfb\x56\xe1\x47\x1e\x0e\xc2\x8f\x3c\x7c\x0c\x7e\xe4\x61\x08\x3f\x1f\x43\x33\xa3\xd6\xcd\xbd\xd1\xea\x53\xaa\xda\x72\x77\x84\xdb\x55\xac\x98\x4d\x33\x6b\xd4\x40\x0a\xbe\xe1\x12\x72\xd2\xb7\x23\x07\xf1\xd0\x67\x55\xff\x3b\x38\x66\x41\xb8\xcb\x7c\x96\x14\x8d\x2b\xea\xaf\x0b\x44\x74\x53\xf6\x6a\x4d\xcb\x24\xf5\x5d\xa4\x75\x7a\xc6\xbe\xc6\x4b\xe8\x4f\x54\xc8\x0a\x8c\xf3\xbe\xf8\x4d\x49\xa7\x35\xc7\x07\x0e\x98\x44\x6b\x32\x88\x75\x5b\x83\xd1\xbc\x70\x57\xc5\x86\x55\xcf\x94\xec\xdb\xf8\xd5\x2d\x77\x7b\x15\x72\xcf\x69\xa7\xa7\xd5\x54\x41\xee\xb1\xc4\x04\xef\xde\x63\xd4\x58\x2e\x26\x78\xb1\xfe\x07\xba\x85\x1b\xce\x0b\x77\xd7\x16\x06\x76\xed\x3c\x0f\x6a\xc2\x5a\xbb\x21\xea\x72\xfd\x34\x50\x6c\x36\x87\x2f\xcc\x04\x4f\xac\xcb\x5e\xd2\x80\x31\x9c\x20\xf7\xe6\x00\xab\xe7\xef\x34\xad\x81\xcf\x23\xf7\x18\xcc\xeb\x86\x77\x7e\xa7\xe3\xff\x7c\x6f\x3f\xa5\x14\x54\x03\xeb\x3a\x20\x28\x60\x40\xb7\xe6\x33\x9d\xc2\xdf\x8a\x82\xdf\x5f\xac\xd6\xd5\x56\x6d\x07\xa9\x62\x5d\xd6\x79\xe9\xe7\x39\x75\x1d\x29\x96\x4c\xa7\xf0\xda\xd6\x4f\x81\x49\x75\xb7\x81\xe5\xfa\x52\xc4\x8c\x97\x7a\x57\x13\xa3\x26\x75\x46\x06\x37\x3a\x55\xd0\xee\x80\x3e\x4e\x4a\x5a\x84\x1d\x1c\xed\x05\xb4\xc8\x55\x6f\x3b\xd0\xdc\xd0\x3e\x54\x74\x13\x64\x7b\x3c\x33\xb4\x2a\xd1\x11\xae\x59\x41\x98\x29\x27\xd0\xa5\x00\x52\x48\xb8\x39\x0b\xe8\x68\x49\x77\xd1\x81\x2a\x72\x7a\x0a\xe3\x31\xd4\xf8\xa5\x1c\x8a\x7d\xcc\x6e\x31\xae\x13\x7d\x9a\x49\x9f\xed\x34\xb4\xe2\xed\xea\x81\x93\x0a\xea\xe4\x90\x7d\x63\x80\xb4\x3b\xcc\x6b\x41\xef\x18\xdf\xc8\x62\x1b\x6c\x36\xdf\x6c\xcd\x56\x73\x64\xc9\x26\x69\x28\x3e\xc3\x15\x74\x66\x11\x95\x6f\x9f\x51\x0d\xfd\x8b\x94\xe1\x35\xbf\xba\x0e\x5b\x13\x94\xc1\x28\x6e\xb8\x77\x5f\xd0\x3b\x4c\xc4\xdd\x46\x97\x51\x69\xe9\x1b\x52\xe2\xdf\xdf\x80\xd3\xe8\xde\x44\x7b\x92\x4d\x91\xd6\xd7\x8f\xa8\xcd\x9c\x4e\x83\xb3\x28\x9f\x78\x1f\xb2\x77\x13\x12\xfe\x08\x7c\xfc\x88\xa3\x2e\x2e\x16\xef\x1f\x75\xb1\xcf\x56\x34\xe1\x99\x13\xef\x02\xa5\x87\xad\xcb\x32\xeb\x1a\xa5\xd5\x34\x7b\x30\x1e\x92\xbc\x20\xf7\x83\x2b\xc0\xac\xce\x36\x6d\x91\x7b\x6b\xaa\xd6\x94\xc5\x1c\xa4\x51\x84\x47\x19\x2a\x8b\x50\x27\x0a\xb0\x64\x1b\xca\x94\x08\x7e\x9f\xde\x9c\xcd\x3f\xbf\xd7\x6e\x9f\x51\x58\xb7\x91\xc3\x65\x63\x95\xa4\x8d\x8d\x05\x57\xf7\x15\xc3\x1e\x83\xe7\xcc\x0e\xbc\x92\x79\x86\x0a\x62\x79\xe8\xe1\x67\x6e\x6f\x81\x39\x6e\x73\x7b\x17\xf2\xde\x8a\xeb\x80\x78\x63\x68\x68\x3c\x06\x81\x63\x08\xa2\x90\x5f\x85\xde\xc8\xe7\x24\x06\xb0\xec\xcb\x34\x32\x75\x1a\x5d\x91\x68\x7a\xf6\x46\x18\xbb\xbf\x36\x11\x41\xff\xd7\xc9\x8a\x5a\x99\x37\xcd\xc7\x60\x03\xbf\x8f\x10\xa1\x9b\xdf\xf5\x2a\x5d\x91\xcf\x71\x38\xd5\x89\xd0\x07\x87\x5d\x9e\xbe\x6a\xa7\x32\x4c\x88\x7c\xb2\xf5\xb7\x30\xc7\x71\x7b\xec\xf3\xa7\x4d\xc1\x3a\xfe\x63\x80\x8a\x8f\xb1\xdc\x07\xd0\xb6\xcb\x3c\x1f\x76\x13\x7d\xd0\xfb\xb4\xd4\xba\xc2\x37\x9b\x87\xe9\x68\x7b\x79\xa7\xff\xb5\x54\x3c\xe2\xe1\x7f\x18\xd4\x3b\x70\xaf\xce\x4d\xb6\x23\x82\xab\x72\xa6\xc0\x31\xf8\x79\x62\x17\x84\x84\xdf\x28\x36\x06\xc1\x83\x60\x37\x48\x3a\xe2\x31\xdf\x5a\xdb\xc1\xda\xe7\xde\xd7\x5f\xf1\x0e\x70\x19\x27\xe3\x89\x05\x8a\xb3\x1e\x22\xd4\xc8\xda\xdc\x23\xda\x90\x2b\x26\x94\xf0\x9b\xda\xc6\xe8\x27\x28\x82\x9e\x8f\xf0\x15\x71\xcb\xd0\x85\x75\x80\x89\x69\xff\x3d\x89\xb1\xb1\x04\x7b\x8b\xb9\xf7\xaa\xb7\x1c\x7a\x43\x82\xc7\x76\xb1\xd4\x35\xd0\x32\x87\xa6\x19\xfd\xff\x00\xa9\xf4\x4b\x5e\xd5\x5c\x00\x00")
 
 func templatesServerParameterGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -471,7 +808,7 @@ func templatesServerParameterGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/parameter.gotmpl", size: 22809, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	info := bindataFileInfo{name: "templates/server/parameter.gotmpl", size: 23765, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -496,7 +833,7 @@ func templatesServerResponsesGotmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesServerServerGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x7c\xff\x73\xdb\x36\xb2\xf8\xcf\xd2\x5f\xb1\xa7\xbb\x4b\xa9\x0e\x45\xe5\xcb\x35\x73\xe7\xab\x3e\x33\xaa\xe3\x24\xfe\xc4\x49\x34\x91\xda\xbe\x37\x9d\x8e\x03\x93\x90\x84\x67\x0a\x60\x01\xd0\xb2\xaa\xd1\xff\xfe\x66\x01\x90\x04\x29\xca\xb2\xdd\xb4\x7d\xa7\x99\x44\x22\xb9\x58\xec\x2e\x76\x17\xfb\x05\xf4\x70\x08\xa7\x22\xa1\xb0\xa0\x9c\x4a\xa2\x69\x02\x57\x1b\x58\x88\x81\x5a\x93\xc5\x82\xca\x7f\xc3\xab\x8f\xf0\xe1\xe3\x0c\xce\x5e\x9d\xcf\xa2\x6e\xb7\xbb\xdd\x02\x9b\x43\x74\x2a\xb2\x8d\x64\x8b\xa5\x86\xc1\x6e\x37\x1c\xc2\x76\x0b\xb1\x58\xad\x28\xd7\x8d\x67\xdb\x2d\x50\x9e\xc0\x6e\xd7\xed\x76\x33\x12\x5f\x93\x05\x45\xe0\x68\x3c\x39\x9f\xb8\x4b\x7c\xc6\x56\x99\x90\x1a\x82\x6e\xa7\x17\xcb\x4d\xa6\xc5\x50\xa7\xaa\xd7\xed\xf4\x52\xb1\xc0\x2f\x4e\xb5\xfb\x1a\x2e\xb5\xce\xf0\xb7\xd2\x32\x16\xfc\xc6\xfc\xdc\xf0\x78\x48\xb4\x58\xb1\x18\x2f\xa9\x94\x42\x9a\xd1\x9a\xad\x68\xaf\xdb\xed\x02\xf4\x16\x4c\x2f\xf3\xab\x28\x16\xab\xe1\x42\x0c\x44\x46\x39\xc9\xd8\x10\xd9\xec\x75\x01\x1c\x5b\xdf\x2b\xfa\x46\x4c\xb5\xcc\x63\xfd\x3a\x25\x0b\x05\xbb\xdd\xdc\x7c\xfb\xc3\xff\x87\x2a\x45\x6f\x92\x6b\xc4\x63\x9e\x3a\x04\xc8\xe7\x60\xb7\x3b\x3c\x99\xcc\x39\x12\x34\xc4\x41\xf4\x56\xd7\xe7\x9d\xf8\x13\xd6\x30\xa8\x6c\xfe\xec\xc5\x30\xc3\xfb\x7b\x33\x2d\x24\x89\xe9\x3c\x4f\x6b\x03\xf4\x26\xa5\xf2\x6a\x58\x3c\xeb\x75\xed\x28\x49\xf8\x82\x42\xf4\x8a\xce\x49\x9e\xea\x73\x23\x71\x9c\x70\xbb\x85\x4c\x32\xae\xe7\xd0\xfb\xfb\x2f\x3d\x88\xc0\x60\xae\x16\xce\x1b\xfc\xb7\x6b\xba\x09\xe1\x6f\x37\x24\xcd\x29\x9c\x8c\x20\xaa\x61\xc1\xa7\xb0\xdb\x41\x03\xa1\x03\x6f\x60\xed\x77\xbb\xb1\xe0\xca\xac\xb9\x8a\x97\x74\x45\xdf\xce\x66\x13\x80\x11\xf4\xdc\x0a\x57\x77\xa7\xc5\x5d\x55\xde\xfe\x9e\xb3\x5b\x03\x9c\x73\x76\xdb\x43\x6c\x37\x44\x42\x62\x79\x9b\x1a\x10\x05\x3f\xfd\xac\xb4\x64\x7c\xd1\xed\xce\x73\x1e\x03\xe3\x4c\x07\x7d\xd8\x76\x3b\x0d\xb8\x51\x09\xb9\x75\x2b\x12\x2c\x89\x3a\xe7\x8a\xc6\xb9\xa4\x10\x39\xb8\x3e\xd2\xdd\xf1\xe8\x0a\x2d\x3b\x86\x79\x37\x68\x7a\x64\xc8\x34\x2c\x45\xe0\x06\xc5\x82\x6b\xc2\xb8\x82\xe8\xec\x56\x4b\x52\xd0\x64\x19\xab\x8d\x47\x9e\xab\xe1\xdd\xce\xae\xbb\x2b\x0d\xd2\xd7\x20\x23\x8a\xc0\x3d\x38\xbb\x8d\xd3\x3c\xa1\xd3\x8c\xc6\x76\x09\x54\x46\xe3\xd7\x2c\xa5\x50\x7c\x9c\x8c\xbc\xc5\xa1\x9c\x5c\xa5\x34\xb9\x60\x4a\xa3\x5b\xf0\x04\x09\x10\xa7\x94\xf0\x3c\x9b\xb1\x95\xc8\x35\x0e\x47\x95\x8e\x5e\xe5\x92\x68\x26\x78\x17\x60\x45\x6e\xdf\x52\x92\x50\x39\x65\xbf\x9a\x49\x9c\xba\x47\xdf\x6d\x34\xc5\x7b\xa8\x8e\x4a\xc4\xd7\x54\x4f\x88\x5e\x16\xd3\x77\x01\x96\x42\xe9\x7d\xaa\x8c\x63\x28\x3e\x8c\xeb\x2e\x40\x6a\x08\xbb\x60\x2b\xa6\x8b\x5b\xd7\x94\x66\xe3\x94\xdd\xd0\x36\x92\x24\x25\xc9\x8c\xad\xa8\xa1\xb8\xf9\x70\x2d\x99\xa6\xc5\xd3\xfa\xc3\x2e\x80\x4e\xd5\x5b\x9f\x2c\x8f\x30\x9d\xaa\x89\x4f\x5b\x41\x8a\x4e\xd5\x85\x4f\xa0\x77\xff\x9d\x4f\xe5\x3e\x29\x3a\x55\x9f\x7c\x52\x5b\x21\x7e\xf4\xe9\x6d\x85\x38\xa5\x52\xb3\x39\x8b\x89\xa6\x4d\x82\xbd\x47\xef\xe8\xa6\xfe\x68\x5c\x1b\xe7\x1e\xf5\x9b\xb6\xd3\x5c\xe0\xd1\xde\xfa\x06\xcf\x9e\x9a\x4f\xff\x90\x06\xe2\x80\x68\x6a\xf0\xff\x40\xe4\x24\x78\x52\xa8\x64\x08\x3d\xfc\xd9\x0b\xa1\x57\xfc\xd3\x4b\x0a\x6e\x2f\x32\x9a\x6b\xe9\x63\x82\x83\x16\xa0\xa8\xbc\xa1\xbd\x7e\xcd\xaf\x74\x3b\x1e\xfa\x69\xca\x62\xfa\x03\x91\xc1\x93\xa6\x4a\xe3\x54\xc6\xa8\x7a\x61\xc3\x6b\xb8\x49\xd3\x52\xf9\xb5\x00\x3b\x3a\x04\xbd\x64\x0a\x62\xc2\xe1\x8a\x82\xa4\x19\x35\x1b\x26\xe1\x49\x81\xc2\x00\x1b\x92\x9d\x15\x33\x0e\x4d\x0e\x7a\x7d\x47\x62\xb1\x68\x86\xbe\x9a\x59\x85\xd0\x73\xd7\x03\x6d\x17\xba\x17\xc2\xb3\xa7\x5f\x9b\xb5\x9e\xd2\x58\xf0\x24\x84\x9e\xf1\xef\x90\x51\xc9\x44\x02\x73\x21\x61\xbd\x64\xf1\x12\x29\x58\x13\xa6\xe1\x8a\xce\x85\xa4\xa0\x96\xb9\xd6\x8c\x2f\x20\x11\x6b\x47\x0c\x4a\x4d\x96\x64\x98\xe9\x6b\x6b\x1a\x42\x6f\x45\x6e\x07\x4b\x73\x63\xa0\xd8\xaf\x28\xa4\x1e\xfa\x29\x29\x52\x65\x70\xac\xc8\x2d\x5b\xe5\x2b\xe0\xf9\xea\x8a\x4a\x10\x73\xb8\xda\x68\xaa\x3c\xfc\xb0\x66\x69\x6a\x2c\x0f\x32\x22\x15\x52\x80\x0f\x25\xfd\x25\xa7\x4a\x83\x45\xfe\x95\x82\x6b\xba\x51\x46\x84\x66\x97\x50\x21\x30\x8e\xea\xd2\x84\x4f\x19\xa7\x11\x9c\x6b\x48\x04\x55\xc0\x05\xde\x41\xeb\x32\x13\xa2\x1e\x8a\x79\x0d\xfe\x4a\x24\x9b\x5e\xbf\xae\x0d\x86\xd3\xca\xf3\xa0\x0a\x98\x8b\x41\x46\xf4\x12\x59\x1c\xde\x10\x89\xbb\xf4\x50\x8b\x44\x0c\x50\x03\x22\x84\x28\xf4\x10\x3d\xb2\xf3\x5c\x28\x65\xab\x21\x20\x78\xeb\x3c\xe8\xcc\x42\xe8\xe1\x17\x8e\x4f\x45\x4c\xd2\xe2\x02\x91\x9d\x4f\x9a\x38\x2c\x8a\x73\xae\xcd\x78\x74\x7b\x21\xf4\xf0\xab\x17\xc2\x53\x37\xca\x38\x43\x7f\x9c\x59\x78\x56\xec\x54\xb1\xe0\x9c\xc6\xa8\x54\x2a\xac\xe9\x24\xc1\x0d\x3c\x11\x2b\x2b\xe5\xbd\xc9\x3c\x87\x8a\xb4\x9a\xab\x81\x11\xb0\x9b\xbb\x12\x76\xb5\xe2\x22\xd7\x4a\x13\x6e\x96\xca\x89\x5d\xb5\x2b\x77\xe9\x9c\x43\xe8\xe1\xef\x01\xc1\x8b\x5e\x08\x2f\xac\x4a\xbf\x67\x3c\xd7\xc6\xfa\xa9\xb6\x3a\x34\x3b\x9d\x40\x05\x09\xce\x0a\x14\x32\x4c\xe2\x98\x66\x68\x77\x1e\xb3\x46\x33\x32\x99\x73\xaa\x20\x41\x95\xc3\xf1\xde\x73\x08\x80\x46\x8b\x08\xe2\x54\x18\x4d\x4c\x49\xa6\x45\x06\x2b\x96\x0c\xd0\x2c\x52\x41\x92\x7e\x3b\xe9\xde\xd6\x11\x42\x0f\xaf\x3c\x93\x7c\xd1\x34\xc9\xc2\x2c\x12\x87\xa2\x30\x42\xcd\x56\x38\x2d\x7a\x6c\x63\x11\x75\x65\x6d\x9f\xd9\xdf\x97\x42\xe8\x99\xcb\xdf\x38\xb7\xc1\x51\x4d\xae\x32\xc1\x15\x6d\xd5\x5e\xb7\xed\xa1\xd6\xa5\x6a\xf0\x68\x25\x76\x5b\xa4\x43\x73\x2f\x5d\x7e\xa4\x26\xd7\x69\xf7\x76\x32\x37\x77\x5c\xdd\xf1\xb7\x16\xef\x36\x22\xcf\x15\x3d\x40\xc4\xf1\x89\xde\x61\x68\x6c\xe6\xba\xa6\x1b\x7f\x8e\x4c\xb2\x1b\xc4\x8f\xd1\x71\xeb\x1c\x47\xa6\x18\xb7\x70\x43\x0e\x31\x41\x72\xbd\x14\x92\xe9\x0d\xcc\x31\xc6\xd3\x02\xb7\xaa\x5c\xd1\x04\xd6\x4c\x2f\x61\x95\xeb\x9c\xa4\xb8\xdd\x1b\xc8\xb6\x05\xbb\xa8\xf9\x02\x9c\xed\x8b\xfb\x03\x3f\x12\x2a\x65\xf6\x1f\xe5\x16\xea\x91\x9a\xe3\xe1\x8f\xf4\x0e\x8d\x40\xd0\x51\xf0\x7b\x3a\x89\x9d\x8b\x04\x6d\x60\x78\xc6\x6f\x3e\xde\x50\x29\x59\x42\x03\x21\xd9\xc2\xdd\x36\xb6\x5a\xfe\x36\x7b\x7b\x14\x45\xf6\xba\xef\xee\x63\x02\x86\x06\x70\x19\xc2\x35\x26\x91\x36\xb5\x34\xb0\xdb\x6e\xa7\xc3\xe6\x20\x54\xf4\x86\x6a\xca\x6f\x82\xeb\x3e\xfc\x65\x04\xbd\x9e\x79\xd2\x91\x54\xe7\x92\xd7\x1e\x77\x3b\x1d\x93\x09\xe1\x30\x9c\xda\x42\x3f\x79\x02\x86\xa8\x51\x39\xd6\x0d\x4d\xe8\xdc\x40\x17\x98\x24\x5b\x94\x8c\x31\xae\xf7\xb8\x62\x5c\x5b\x96\xcc\x8f\x26\x3f\x8c\xeb\xc7\x33\x73\x13\x02\x95\x12\xc7\xb8\x4a\x46\x34\xd6\x82\x05\x3e\x38\x72\x87\x28\x10\xee\x2f\x23\xe0\x2c\xb5\x43\x3b\xf3\x95\x8e\x5e\x9b\x1c\x3b\xe5\x38\x62\xaa\x13\x2a\x65\x08\xd7\x21\xf4\x98\x0d\x8f\x08\x3a\x48\x96\x38\xfb\xec\x19\x54\x1d\xa1\xa2\xb3\x5b\xa6\x83\x67\xe6\x72\xe7\xc9\xf4\xa6\x45\x90\x4f\x7d\x39\x3e\x3d\x2e\x46\x2f\x08\x1f\x0e\xe1\x03\x5d\x4f\x6d\x24\x18\x4b\x0c\x94\x15\x10\xe0\x74\x0d\x24\x63\x18\xae\x2f\xf3\x15\xe1\x18\xb8\x45\x1f\xc8\x8a\xc2\x6e\x57\xc4\x8d\x57\xb9\x17\xe4\xc5\x82\xcf\xd9\x02\xfd\x24\xd3\x76\x95\x4a\xb4\x01\x22\xfa\x7a\xbb\x85\xa8\x2a\x24\x45\xdb\x2d\x64\x44\xc5\x24\xf5\x31\x8f\x27\xe7\x7d\xf8\xda\x11\xb3\xed\x76\x14\x0a\x9d\xd3\x75\x60\x6f\xf5\xdb\xeb\x30\x98\xa5\x46\x67\xcd\x4c\x78\x04\xcd\x4c\xa2\xdb\x51\xd1\x69\x19\xbd\x9b\xac\x6c\x54\xcf\x92\x11\xe2\x7d\x23\x69\xaa\x05\xdc\x08\x30\xad\x32\xe2\x91\x97\x1e\xe3\x23\x93\x80\x8e\x5a\x0c\xcf\xc5\x98\xb8\x0f\xbc\xfd\x38\x9d\xe1\x22\xab\xc8\xe4\xa4\xa3\xa6\x36\xdb\x70\x12\x5d\xf7\xe4\xe3\x27\x07\xe9\x67\xa9\x23\x3f\xa9\xc6\x87\x55\xaa\x3a\xaa\x92\x6b\x7c\xe0\x67\xa8\x23\x3f\xb5\xc6\x87\xb5\xe4\x74\x54\xcb\xad\xf1\xf1\xec\x62\x7a\x90\x99\x32\xe4\xb0\x0c\x87\xd0\x9b\x5d\x4c\x2f\x0d\x5f\x35\xfe\x66\x17\xd3\x76\x16\xcb\x60\xe3\xa9\x1b\x5b\x71\x3a\xbb\x98\xfa\xc9\xed\x81\xe9\xeb\xfb\x6c\xcf\x61\x39\x3d\xfb\x34\x3b\x7f\x7d\x7e\x3a\x9e\x9d\xb5\x21\xc3\x34\xfa\x38\x3e\x1b\x1c\x14\x28\x27\x9f\xce\x7f\x18\xcf\xce\x2e\xdf\x9d\xfd\xb7\xc9\x5e\x2d\xce\xf1\x7d\x48\x1c\x1f\x20\x72\xdc\x4a\x67\x7d\x85\xeb\x9b\xbb\x03\xf1\xd7\xd9\xdf\x97\xdd\xe3\xfa\x6a\xd7\xb7\x3d\x07\xd2\x58\xf3\xc6\xce\x74\xb0\x0c\x15\x99\xdf\xa3\xb2\x1e\xe5\x67\xf1\x5e\x91\x4b\x45\x98\xc1\x9a\xe4\x15\xad\xe6\x9a\x06\xf1\x92\x70\x94\x4e\x1e\xeb\xed\xce\x70\x8a\x9e\x60\x84\x8e\xa5\xf4\x48\xaa\x6b\x9d\xd0\x69\xe1\x3f\xc6\x93\xf3\xca\x99\xd8\x60\x02\x6f\x61\xe2\xb9\x24\x3c\x49\xa9\x54\x91\x75\x30\x81\x2a\x7c\x45\xbf\x36\xdc\x55\x3f\x00\xd9\xb1\x53\x96\x2e\xb9\x2c\x0d\x45\x0e\x17\x7a\x00\x7f\xa8\x81\xef\x1b\xb8\x5d\x93\x32\xeb\x6f\x1a\xb4\x91\x24\x61\xb8\x3f\x93\x14\x6c\x01\x3a\xa1\x73\xc6\x6d\x75\x1e\x9f\x97\x34\xc3\x07\x4a\x13\xe5\xe2\xbc\x98\xa4\x29\xc2\xb8\x3d\x1d\x63\x4f\x22\x15\x95\xd1\x04\xbf\xee\x60\xcf\xd0\x70\x9c\xc1\xb8\x0e\xdf\xc2\x95\x73\xb2\xb8\x23\xe2\xf4\xad\x7e\x7e\x3c\x39\xef\xea\x4d\x46\x0b\x60\xbb\x94\xe8\x98\xf7\xbc\x6d\x59\x96\x3d\x58\x9e\x87\xcf\xa9\xe0\x8b\x93\xa2\x98\x03\x09\x55\xb1\x64\x19\xca\xee\xe4\x77\xae\xe3\x7c\xf6\xb4\xb4\xb1\x01\x34\xca\x72\x77\x90\x0f\x50\x70\xd0\xac\xf8\xd4\x59\xf9\x8d\xc5\x9e\x82\xb1\x93\xde\xb3\xa7\xaa\x46\xf9\xfb\x63\xe5\xda\xe3\xb2\x6f\x16\x8b\xea\x94\xff\xe7\xd5\x8d\x22\x5f\x5c\xef\xd9\x77\xbe\xbc\xba\x00\xde\x4e\xdd\x12\x36\x94\x0a\x4b\x53\x45\x8b\xfe\x51\x84\x0e\x8e\xa3\xfe\x17\xea\xea\x15\x9e\xf6\x75\xf6\x60\xa1\xa9\xa2\xab\x2c\x55\x6d\xb7\x90\x10\xb5\xa4\xd2\xb7\x31\x5b\xb6\xf2\x97\x39\x11\x2b\xc2\xb8\x25\xfd\x02\x38\xd5\x51\x61\x65\xdd\x6e\xc7\xec\xc9\xf7\xb5\x34\x93\xff\xef\xd3\xdc\x2c\x05\x54\xa4\x56\x55\x03\xa0\xfc\xe6\xc4\x6e\xe5\x3e\x6d\x66\x3b\x67\x5c\xdf\xcb\x4c\x4c\x11\x61\x7f\xfa\x2f\x54\x18\xb3\x14\x9a\xc0\xc1\xa7\xf0\xa2\xd1\x70\xb8\x9b\x52\xf7\x71\x04\xd7\xb2\xe7\x3a\xe1\xf7\xce\xa2\x7d\x5a\xde\x1d\xec\x74\x1c\x5f\x3b\x2f\xcb\xae\x53\xf2\xa7\x66\xd8\x95\xaa\xbc\x58\xd5\x58\xfd\x74\xb0\x6f\x73\x9c\xd5\x5a\x32\x5e\x67\xf6\x91\x79\xb8\x47\x66\xc3\x87\xfe\x78\xb0\x85\x74\x9c\xce\x7a\xca\xfe\x60\x42\xdb\xb3\xf5\x8a\xd4\x97\x0d\x52\x97\x5a\x67\x76\xdf\xbd\x00\x68\xfa\x81\x22\x3c\x6f\x36\xbb\xee\xa1\xee\x8e\x9b\xb2\x5a\x78\xd4\x41\xd8\x18\x21\x55\x21\xac\x97\x94\x1b\xcf\xec\xfa\x3b\x34\x01\xa6\xbf\x72\x7b\x02\xfa\x33\xa2\x60\x30\xf0\x1c\x48\x99\x17\xf8\x8c\x15\x69\x41\xad\x1b\x77\x2f\x3b\xf5\x69\x7f\x90\x77\x79\x94\x6f\x29\x13\x93\x06\xf1\x8d\xa6\xdd\xa3\x77\x96\x66\xb1\x73\x9f\x99\xfb\xd7\x3c\x2b\x8a\xfd\xe4\xe2\x30\xe1\x98\x0b\xfd\x26\xc2\xaf\xe9\xa6\x4d\xfa\xf7\x2d\xa0\x7a\x12\xf6\x32\xac\x26\xbd\xf5\x3e\xe7\x6f\x13\x34\x39\x22\xdf\x07\x96\x63\x3d\x81\x8f\x0f\xc9\x1c\xa0\x91\xd8\x3d\x52\xd5\xbf\xf4\xbe\x54\xcb\x25\xf7\xdb\xdb\x77\xd1\x57\xd3\x80\xff\x8b\x3b\x54\x83\xcf\xbb\x9a\xf4\xf7\xe4\xf3\xcb\x6f\x4f\x0d\x1a\xef\x3a\x26\x70\x4f\x1a\x7f\x97\xad\xa9\xb9\x19\xa9\x72\x37\x6a\x6c\x46\xad\x85\x03\xf3\xf5\xf8\xa8\x1b\xd3\xb6\x7d\x83\x3d\x72\xbe\xe0\x73\x5b\x59\x02\xd3\xe3\xfa\xe7\xbe\xa5\xc8\x6e\xa7\x28\x12\x54\x1f\x14\x44\xf4\xd6\xde\xc6\xe7\xaa\x4a\x81\xcd\xe7\x4a\x88\xb4\xdb\x29\x0b\x21\xe5\xa7\x56\x0a\xb1\x00\x98\xfe\xbd\x2a\x81\x18\xd7\x2f\x9e\xbb\xdc\xfc\x42\x2c\xe6\x90\x8a\x85\x82\x15\x55\x0a\xa9\xa4\x4c\x2f\xa9\x84\x1b\x46\xca\xfa\x42\xae\xa8\x44\x20\x94\x87\xb0\x8f\xd4\x46\x69\xba\x02\xc1\x29\x8a\x9d\x8b\x1a\x0c\x2b\x4b\x13\x2d\xe5\x13\x9c\x31\xa8\xda\x01\x44\x2e\x4c\xf9\x9c\x71\x4d\xe5\x9c\xc4\x74\xbb\x33\xa7\xb1\x9a\xf5\x86\x27\x4f\xec\x75\x74\x61\xe7\xf0\x4a\xdf\xfe\xfd\x60\x6e\x51\x46\x51\xd4\xef\x76\x76\x76\xe9\x11\x28\x15\x8b\x68\x62\x0e\xa0\x35\x40\x9c\x20\x5e\x13\x4d\xd2\xdf\x57\x14\xc3\x21\x9c\xdd\x32\xad\xac\x9b\xe7\x82\x0f\x7e\xa5\x52\x80\xd2\x44\xe7\x0a\xc8\x5c\x53\x69\xcf\xc8\x31\xbe\xd8\x97\x9b\x25\xf0\x0f\x92\x1c\xaa\x89\xdf\x17\x68\x08\xb2\xa0\xa5\x4d\x90\x53\xaa\x5b\xea\x6a\x65\x1e\xaf\x97\xf6\xba\x0c\xeb\xc6\x93\xf3\xbb\x0a\x56\x86\xfd\x7d\x69\xd8\x59\x1e\x58\xee\xb7\xc2\x31\x85\xc1\x86\x0c\xc0\x5c\x9b\xab\xaa\x5a\x67\xef\xd8\xe2\x21\xf2\xd7\xa8\x2a\xd6\x84\x3a\x82\x4a\xc1\xba\x9d\x83\x35\x3f\x53\x1b\x2b\x7a\x4b\x3e\x3f\x4b\xa2\xec\x51\xa3\xc0\x56\x97\xa0\x68\x27\xa1\x95\x9b\xfe\x84\x2b\x3a\x9d\x8c\x5a\x3a\x10\x86\xaf\x94\x72\x37\x58\xf5\xab\xe6\x8c\x2a\x8f\x33\xd6\x4f\x34\x59\x86\x5c\x97\xea\xa6\xea\x52\x15\xf0\xae\x51\x75\x83\x98\x1c\x49\x7e\xbb\x4d\xcb\x9c\x96\xdd\x21\x77\x6f\x4e\x52\x45\xfd\x9a\x9f\xad\x5a\x66\xac\x6d\xf9\xe4\x0d\x0d\xfa\x10\x50\x29\xc1\x1c\xcd\x2d\x56\xe7\x2f\x28\x3b\xcf\xd5\x39\x3a\x10\x0e\x39\xb7\x0f\x82\xfe\xbf\x9b\xfd\x2f\x00\x47\x05\x95\xb2\x20\xac\xdb\x19\x0e\x41\x51\x5d\xb0\x5e\x54\x48\x43\x6b\xa8\x68\xb0\x0a\x9f\x3b\x9b\x29\xd7\xac\xc2\x5a\xda\x92\x77\xaf\x53\x4d\x24\xa4\x8a\x3e\xd0\x75\xd0\x8b\x09\xff\x4a\xbb\x9e\x96\xe1\x7a\x6f\x46\xa2\xd0\x35\x20\x2a\x3b\x67\xcf\x76\x29\x8d\xca\x4d\xa9\x76\x7e\xde\x96\x4f\x23\x2b\x1e\xce\xd2\x7e\xdf\xf2\x71\x43\x24\xac\x17\xa0\x36\x3c\x8e\x7e\x24\x4c\xbf\x91\x22\xcf\xba\x25\xdd\x75\xdd\xf9\x9e\xb3\x5b\x23\xce\x5a\x81\x07\x97\xf8\x49\x71\xfc\xd7\xce\x20\xb7\xf6\xeb\xc4\x34\xbe\xcc\x7e\xe3\x16\x68\xd7\x18\x5c\xb5\xaa\xbe\x33\xa5\x39\xd3\x67\x09\x1a\x1d\xac\x7e\x73\xd0\xdb\xd2\x08\x4a\xe1\x36\x41\x2e\xc4\xe2\x35\x2a\x87\x59\x5b\xb1\x98\x5b\x99\x33\xae\x5f\xfe\x23\x68\xb6\xd0\xfa\xf0\xff\x9c\x52\xd7\x71\x54\x6d\x86\xe6\x88\x42\xc4\xa5\x0d\xba\x2e\xa1\x3f\x3c\x74\xa7\x6a\x43\x67\x72\x81\xdf\x76\xeb\xf7\x71\xf8\x7a\x11\x8d\x93\x24\x78\xde\x37\xab\x65\x36\xb0\x1e\x62\x32\x7d\xeb\xb6\x6a\x36\xd1\xa6\x5c\x77\x32\x1c\xfe\x5d\x21\x62\x1f\x63\xb7\xd3\x59\x08\x40\x8b\x08\xd2\x5a\x78\xd3\xb7\x6a\x9c\xd0\x39\x3a\xca\x45\xf4\x4a\x70\x1a\xf4\xcd\x3d\x67\x00\x27\x23\xa8\x31\x6e\xd5\x24\xdd\x37\x86\x8e\x2a\x5c\x74\xef\xef\x37\x3d\xd3\x53\xb6\x88\x76\xe6\xff\x92\x07\x2d\xb2\x8c\x26\xc6\x39\x3f\x96\x97\x5d\xa0\xa2\x5a\x1d\xd1\x31\x58\xac\xf8\xd4\xc5\x27\xc1\x93\xf5\x22\xac\x91\xef\x54\xbb\x55\x85\xdf\xce\x66\x13\xab\xc2\x55\x6d\xe2\xc1\x0a\x5c\x0d\xbd\xb7\xfa\x7a\x43\xea\x4d\xae\x5a\x8b\xb3\x0e\xd8\x68\x75\xd5\xfb\x9d\x75\xd0\x29\xd5\x65\x3a\xa4\x9c\x13\x0f\x0a\x65\x2f\x9f\x18\x3d\x6f\x50\x33\x3b\x9d\xf8\x4d\x39\x0f\xba\xf0\x52\x7e\xf6\x57\xda\x89\x87\xa1\xde\xf6\xab\xc1\x17\x66\x72\x1f\xcb\xf3\x69\x3a\x6a\x77\x1e\x70\xbb\x2f\xf0\xe9\x6b\x7a\x82\x16\xbb\xad\xc0\x43\xf7\x46\x00\x2a\xa4\x57\xbe\x42\x3b\x95\x41\xdf\x9d\x96\x0a\x1e\x6f\xbe\x88\xb3\x52\xf9\xfd\x19\xee\x30\x63\xe7\xa1\xf6\xcc\xb8\x53\x59\x71\x4d\x25\x0e\xd9\xf0\x01\x23\xb6\x27\x34\x1e\x6a\xc2\x3e\x3f\xa9\xc7\x03\x9a\xaf\xc7\xdd\x9d\xc6\x5b\xc1\x1d\x33\xdd\x69\x65\xbb\xea\x37\x18\xaf\x7a\x84\xf5\xaa\x03\xe6\x5b\xcf\xd0\x1b\xc0\x7b\x26\xdc\xc8\x95\x1b\xe0\x77\x9a\xb1\x5f\xf2\xa8\x59\xb2\x3a\x64\xca\xfe\x88\xc2\x9a\x1b\xe5\x9c\x9a\xf9\xa9\x03\x16\x5d\x1f\x63\x83\xa0\x7b\xdb\xb4\xba\x9f\x51\xd7\x81\x0f\x1b\xb5\x3a\x68\xd5\x98\x5a\x0c\x87\x70\xce\x55\xc6\xa4\x6d\x5e\x9b\x01\x27\xc3\xe1\x15\xc6\xd0\x57\xa8\x24\x57\x8c\x9b\xb7\x96\x48\xbc\x64\x14\x15\x7b\x90\x51\x39\xa7\xb1\x1e\x28\x95\x0e\x52\x72\xa5\x06\x2a\x16\x92\x0e\x30\xa1\x18\x2c\x44\x53\xc4\x17\x53\xdb\xc6\x86\x11\x3c\xd1\xa9\x8a\xec\x95\x61\x76\x38\x84\x53\x92\x2b\xaa\x5c\x4e\xa2\x8a\xf2\xe1\x1b\xf1\x95\x2a\xe3\xb6\x98\x65\x4b\x2a\x55\xce\x34\x85\x4c\xa2\x2d\x53\x1e\x53\x15\x3a\x0c\xb6\xd1\x4a\x24\x05\x9d\x63\x5a\xa8\x05\x90\x1b\xc1\x12\x20\x5a\x93\xf8\x5a\x45\xf0\xca\xb5\x16\x97\xa6\xf2\xc1\x21\x4e\x19\xe5\x5a\x45\x88\x60\x62\x10\x5a\x5a\x4f\xcd\x44\x53\x9c\x48\x9d\x98\xb0\xba\x98\xe3\x23\x4f\x37\x86\xb0\x38\x97\x37\x54\xb9\x39\x97\xe4\x86\x02\x51\x8a\xae\xae\xd2\x0d\xb0\x55\x96\xd2\x15\xe5\xda\x94\x27\x94\x1b\x59\xc8\xb3\xf6\xc6\x59\x4a\xf8\x62\xb8\x10\x43\x2d\x29\x1d\xae\x88\xd2\x54\x0e\x95\x8c\x87\xee\xd5\x3a\x9a\xa6\x2c\xd3\x2c\x46\x14\xa7\x38\xe1\xa4\xe2\xfa\x04\x7e\xfa\xd9\x48\x11\xef\x9f\xbf\xda\x96\xbf\x27\xcf\xbf\x79\xb9\x0b\xab\xd2\xcb\x7b\x91\x50\xc9\xf1\x7f\x5b\x94\x04\x43\xce\xf7\x8a\xc2\xca\x3c\x31\x45\x4d\xfc\x59\x92\xb8\x66\xd7\x2c\x5a\x89\x5f\x59\x9a\x92\x48\xc8\xc5\xd0\xbc\x2b\xc5\xf4\x66\x68\xc5\x73\x39\x65\x09\xbd\x9c\x5d\x4c\xff\x6a\x71\x5f\xc6\x62\x95\x11\xcd\xae\x58\xca\xf4\x06\x89\xfd\x40\x6f\xf5\x44\x0a\x2d\x0c\x99\xae\xe6\x63\x36\x87\xe1\xb3\xe8\x59\x0f\x37\x8a\xe7\xbd\x5d\xd8\x10\xcd\x7a\xbd\x8e\xc4\x9a\xa8\xcc\x4c\xca\x78\x42\x6f\xa3\x6c\x99\x0d\x67\x92\x70\x95\x09\xa9\x2f\x2f\xc8\x86\xca\x4b\xc4\x6c\x8b\x82\x97\xa7\x4b\x4a\xf4\xe5\x74\x49\xa9\xfe\xeb\xa7\x3c\xa5\x97\x83\x4b\x5c\xa2\xcb\x69\x9e\x99\x01\x53\x2d\x05\x5f\x98\x11\x22\x16\xa9\x59\x8c\xf7\x8c\xff\x40\xa5\x62\x82\x9f\x20\xef\x91\xbb\x98\x5d\x4c\x9f\x3d\x0f\xdd\x09\x8a\xe1\x10\x66\x4b\x8a\xeb\x5c\xe9\x9c\x02\x65\xb1\xc2\x6b\x21\xd7\x44\x26\x30\xa5\xb1\xa4\xf1\xe6\xa4\xe4\x80\xf2\x08\x85\x97\xd1\x84\x59\xc9\xe1\xd5\xd0\x81\x5f\x2a\x0b\x6e\x56\xb3\xa6\x61\x3f\xfd\x9c\x33\xae\x9f\xbd\xb4\x5b\x0c\xd2\x34\xbb\x98\x5e\x9e\x9d\xbe\x7a\x7b\x86\xff\x4f\xc7\x97\x3f\x9e\xcf\xde\x5e\x8e\xcf\xa6\x97\xcf\xbf\x79\x79\xf9\xe6\xf4\xfd\xe5\xf4\xed\xf8\xc5\x3f\xff\x11\xb6\x0c\xf8\xf4\x30\xf0\x06\xfe\x67\xcf\xff\x59\x0c\x78\xfe\xcd\xcb\xa3\xf8\x5b\xc0\x77\xfe\x9b\x6f\x65\x0c\xb3\x77\xb4\xab\x3c\xff\xd9\x76\x4e\xcb\x3b\x7d\xd9\xea\x42\x22\x0f\x5e\x15\xc7\x8d\x9c\x3d\xf8\xc7\xae\x9e\xf5\xbb\x55\xdd\xee\x6e\x2c\x3f\x3d\xfd\x39\x74\x19\x2d\xa2\xb9\x10\x24\xf9\xaf\x6f\x9e\xfe\xeb\x1d\xdd\x4c\x08\x93\xc1\xe1\x4a\xac\xcb\x50\x4a\xa6\x9b\xfc\x1c\x1e\xd9\x2f\xc7\x84\x77\xbc\xd7\x7a\x0c\xff\x3b\xba\xb9\xcf\x14\x2e\xb5\x2d\x8f\x0d\xed\x35\x58\x0a\x99\xbb\x82\x25\xc1\x67\xa1\xfb\x3e\xb3\x21\x12\x13\xb9\x66\xa9\xd9\xc6\x5f\xb3\x94\x3e\x58\x28\xe3\x07\x8a\xa5\x58\x3d\x36\xf7\xe8\xd8\x3b\xcc\x55\x16\xbf\x82\x12\xa8\x18\xb8\xab\x71\x33\x11\x22\x45\x36\x6e\xbf\x79\xfa\xaf\xe8\x03\x5d\x17\xf7\x82\xfe\x1e\x58\x34\xce\x32\xca\x13\xbc\x54\xaf\xa5\x58\x4d\xce\xde\x3b\xec\x47\x34\xca\xec\x28\xa7\x63\x54\xca\x0a\xdb\x3d\x86\x8c\x73\x73\x9e\x14\x55\xef\x13\xfd\x25\x67\x92\x8e\x79\xf2\x03\x95\x6c\xbe\x71\x38\xa9\xd4\xee\x04\x97\x1f\x84\xcf\x2e\xa6\x41\x2b\x5e\x4b\x67\xfb\x94\xdf\xe5\x2c\x4d\x30\x10\x9d\x09\x6f\x45\x82\x7e\xb7\xdb\x7a\x8a\xb9\x51\xc4\xb1\x40\x29\xe5\xed\x13\xd7\xac\xca\x2b\x7a\xb5\x7a\x81\xea\xdc\x77\xeb\x73\x73\x66\xd3\x03\xf1\xc2\xef\xa2\xa3\x62\xe2\x15\x7b\xf4\xee\xf3\x60\xd0\x68\xaa\x7e\x36\x07\x8f\xdc\xfd\x6b\xba\xf9\x0c\x6b\x2a\x69\xbd\x87\xed\x4e\x5c\xef\xba\x47\xf0\xb7\xa2\x5f\x13\xd5\x86\x6d\x77\x80\xdf\x26\x3f\xf7\x98\xce\x52\x7d\x78\x9a\xd6\x5a\x8a\xb7\x30\x61\xf1\x42\x76\x91\x33\xa9\xdf\x23\x2d\x53\xf5\xbc\x4c\x7d\xe9\xc4\x4c\xfd\xf1\x99\x99\x6a\x4f\xcd\xd0\x42\x3f\xd0\x75\xc1\x40\x50\x67\x38\x6c\xb7\xb8\xfe\xd1\x1c\x4e\x55\x47\xdc\x8d\x97\x5e\x2f\x4c\x4d\x11\x65\xe1\xcc\x8f\xb3\xb4\xe8\x0f\xd9\xc3\x0d\xc5\x69\xfd\xfa\xe1\xc9\xe2\x44\xa7\x0d\xa4\x5b\x5a\x3d\xae\x58\x6b\x4b\xa5\xc6\x8d\x16\x29\xa3\x57\xe0\xc5\x00\x88\xa4\x92\x92\x64\x03\x89\xe0\xd4\x1c\xc4\x34\x1e\xc5\xa3\xc6\x91\x7a\x77\xc6\xe9\xa2\x29\x8c\x37\x91\x4f\xfb\xee\x38\x9b\x5b\xa6\xed\xd5\x9a\x28\xfe\x95\x2e\x7a\x32\xb5\x2d\xca\x1e\x42\xaf\xed\x4c\xb5\xc3\xe9\xe6\x04\xba\x73\x8b\x65\x5c\x6b\xe6\x71\x27\x40\x6c\x87\xbc\x9c\xaf\x76\xb7\x31\x6f\x7b\x96\x57\xf9\xaf\x96\x83\xda\xfb\x15\x9b\x3a\x11\x3a\xce\x4c\x0b\x1c\x6c\x0b\xbc\x24\xa3\x71\xbf\x8d\x90\xf6\xd4\xb5\x41\xcd\xe1\xf2\x53\x93\x12\xd3\x88\x76\x1d\xe2\x8a\x8e\xda\xdd\x23\x54\x78\x99\xfa\x1e\x1d\x77\xd7\xe5\x9a\xb4\xd8\x7e\xf3\x1e\x31\xf5\xdb\x47\xa8\xf1\x2b\x01\x7b\xe4\x1c\xab\xfe\xed\xee\x54\xdd\xa2\x54\x8f\x5a\x95\x88\xd5\x54\xc4\xd7\x85\x65\x94\x6f\x02\x55\x3e\x2c\xb8\xbb\x52\xed\x94\x99\xee\x07\x2e\xde\xae\x5a\x85\x2a\x8d\xba\x2d\x8c\x9a\x14\x1c\x35\xba\x82\xf2\xf4\x2e\x92\x75\x9c\xf5\x42\x73\xe7\xff\x0b\xc6\xd1\x86\x26\x42\xea\xa0\x78\xa1\xa3\x78\xcf\xe9\x5c\x0b\x12\xd8\x17\x55\xfa\x0f\xe3\xc5\x06\x1f\x21\x64\xd5\xbb\x53\x6b\xb2\x88\xa6\x59\xca\x74\x39\x5d\x41\xe2\xfe\x4e\xf4\x60\xa9\x39\x7f\xb0\x74\x97\xee\xbd\x93\xcc\x5d\xfa\x67\xe9\x46\xa5\x64\xee\xef\xbf\xca\xf7\x31\x1e\x2a\x4e\xe7\xa9\xf6\x24\xea\xce\xc0\x3d\x46\xa8\x6a\x19\x82\xba\x53\xac\x1e\xb5\x5f\x40\xb2\x9e\xb3\x5d\x56\xb7\x9c\x80\x95\x2f\x61\x55\x89\xd8\x23\xa1\x90\x72\x63\x87\x19\xb9\x6e\xe5\xde\xe6\x56\x6c\x8d\x45\x77\x1a\x03\x38\x73\xe2\x1e\x72\xf4\x62\x4a\xe4\x32\xa6\xaa\xa5\x7b\x59\x6c\xa9\xd5\xce\x66\x1a\xcb\xe6\x8f\x0d\x99\x74\xee\x9c\xeb\x17\xcf\x83\x27\xf6\x3d\x95\xe2\xf4\x45\xdf\xbe\x3f\xb7\xf5\xe2\x9d\x62\xdf\xab\x9d\xd1\x37\x01\x97\x47\x6c\xa7\xfe\xc6\xcb\xb7\x83\xf2\x74\xc7\x76\xd7\xe4\xaa\xa5\xb1\x5c\x0b\x02\xd6\x0b\xf8\xba\xde\x45\x0c\x0b\xee\xbf\x6e\x94\x67\x8b\x3f\x8a\x53\x8f\x96\xe6\x96\xdd\x8e\xa2\x29\xb5\x2f\x68\x74\x62\xa2\x28\x7c\x3b\xa8\x48\x3c\x41\xef\xe8\xa4\x31\x4e\xda\x85\x61\x12\x66\x74\xa2\x2e\xd8\xd2\x22\xdb\x2f\x51\x22\xc0\xb7\x03\x0f\xe4\x74\x49\xb8\x8d\xd9\xbc\x46\xa9\xac\xd6\xa3\x4a\x21\x3c\xba\x9a\xc3\x1f\x44\xde\xf1\x69\x76\xd5\xd9\x87\x37\x65\x23\xd7\xa9\xb8\x02\x52\xb4\x80\x21\x57\xe6\x8f\x32\x99\xa0\x89\xaa\xf6\x43\x1e\x15\x82\xa0\x5f\x3b\x00\x84\x82\x2e\x5e\x63\x2a\x4b\xad\xe5\x81\x8b\x02\x88\xa4\xa9\x58\x2b\x77\x10\xd2\x2a\x14\x71\xa1\x90\x83\x10\xdc\xbe\xe7\x72\x28\x64\xf3\x5a\xd1\xc5\x10\x9f\x0c\xa3\x13\xfe\xe9\x86\x3a\x29\xb8\x9d\x15\x76\x57\x4a\xc0\xb4\xc3\xcd\x4e\x53\xbc\xbe\x50\x7a\xc5\xbd\xe9\x7d\x04\x41\x1f\x02\x3f\x7e\x0f\xef\x7d\x50\xe0\xe4\xce\x93\x02\x9e\xc5\x84\xfe\x69\x81\x4a\xbe\xb5\x7d\x31\xf4\x1c\x06\x7a\xea\x56\xfe\xbc\x30\xb0\x8d\x2d\x7f\xdc\x9f\xc7\x96\xb7\x35\xf9\x4c\x95\x91\x66\x0b\x4f\xea\x0e\xa6\xbc\x71\x7f\x2e\x4f\xaa\xc1\xd4\xff\x06\x00\x00\xff\xff\xce\x01\xf4\x58\xbf\x4e\x00\x00")
+var _templatesServerServerGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x7d\xff\x73\xe3\x36\xee\xe8\xcf\xf6\x5f\x81\xfa\x73\xdd\xca\x1d\x59\xde\x6d\xaf\x9d\xbb\xdc\xf3\x9b\x49\xb3\xd9\x6e\x5e\xb3\x5d\xcf\x3a\x6d\xdf\x9b\x4e\x27\x65\x24\xda\xe6\x45\x16\x75\x24\x1d\xc7\xcd\xf8\x7f\x7f\x03\x12\x94\x28\x59\xce\xb7\x6e\xaf\x77\x99\xe9\x26\x12\x49\x10\x00\x01\x10\x00\x41\x75\x3c\x86\x13\x99\x71\x58\xf0\x82\x2b\x66\x78\x06\x57\x5b\x58\xc8\x91\xde\xb0\xc5\x82\xab\x7f\xc0\xeb\xf7\xf0\xfd\xfb\x0b\x38\x7d\x7d\x76\x91\xf4\xfb\xfd\xbb\x3b\x10\x73\x48\x4e\x64\xb9\x55\x62\xb1\x34\x30\xda\xed\xc6\x63\xb8\xbb\x83\x54\xae\x56\xbc\x30\xad\xb6\xbb\x3b\xe0\x45\x06\xbb\x5d\xbf\xdf\x2f\x59\x7a\xcd\x16\x1c\x3b\x27\xc7\xd3\xb3\x29\x3d\x62\x9b\x58\x95\x52\x19\x88\xfa\xbd\x41\xaa\xb6\xa5\x91\x63\x93\xeb\x41\xbf\x37\xc8\xe5\x02\x7f\x15\xdc\xd0\xaf\xf1\xd2\x98\x12\xff\xd6\x46\xa5\xb2\xb8\xb1\x7f\x6e\x8b\x74\xcc\x8c\x5c\x89\x14\x1f\xb9\x52\x52\xd9\xd1\x46\xac\xf8\xa0\xdf\xef\x03\x0c\x16\xc2\x2c\xd7\x57\x49\x2a\x57\xe3\x85\x1c\xc9\x92\x17\xac\x14\x63\x24\x73\xd0\x07\x20\xb2\x7e\xd0\xfc\x5b\x39\x33\x6a\x9d\x9a\x37\x39\x5b\x68\xd8\xed\xe6\xf6\x77\x38\xfc\x9f\x5c\x6b\x7e\x93\x5d\x23\x1c\xdb\x4a\x00\x90\xce\xd1\x6e\x77\x78\x32\xb5\x2e\x10\xa1\x31\x0e\xe2\xb7\x66\xf0\x70\xcf\x95\xc8\xb2\x9c\x6f\x98\xe2\x4d\x24\xa7\x21\x76\x0d\x20\xba\x9c\xbf\xfa\x72\x5c\xe2\xfb\x0e\xb4\x64\xce\x8a\x45\x22\xd5\x62\x7c\x3b\x46\x5e\x16\xdc\xac\x8d\xc8\x07\xc8\xa1\xbb\x3b\x50\xac\x58\x70\x48\x5e\xf3\x39\x5b\xe7\xe6\xcc\xae\x09\xce\x72\x77\x07\xa5\x12\x85\x99\xc3\xe0\xd3\x7f\x0d\x20\xc1\xe5\xac\x60\xfb\xbf\xdd\xe0\xbf\x5c\xf3\x6d\x0c\x7f\xb9\x61\xf9\x9a\xc3\xd1\x04\x92\x06\x14\x6c\x85\xdd\x0e\x5a\x00\xa9\x7b\x0b\xea\xb0\xdf\x4f\x65\xa1\xad\x54\xe8\x74\xc9\x57\xfc\xed\xc5\xc5\x14\x60\x02\x03\x92\x81\xfa\xed\xcc\xbf\xd5\xd5\xeb\x1f\x0a\x71\x6b\x3b\xaf\x0b\x71\x3b\xe8\x0f\xfb\xfd\x1b\xa6\x20\x73\xb4\xcd\xec\x48\x0d\x3f\xff\xa2\x8d\x12\xc5\xa2\xdf\x9f\xaf\x8b\x14\x44\x21\x4c\x34\x84\xbb\x7e\xaf\xd5\x6f\x52\xf5\xbc\xa3\x65\x88\x96\x4c\x9f\x15\x9a\xa7\x6b\xc5\x21\xa1\x7e\x43\xe4\x4c\x8f\x10\x40\xbc\x62\xc7\xa4\xdd\xae\x1e\x34\x7b\x60\xc8\x8c\xc6\x40\x35\x28\x95\x85\x61\xa2\xd0\x90\x9c\xde\x1a\xc5\x68\x20\x11\xd6\x18\x8f\x34\xd7\xc3\xfb\xbd\x5d\x7f\xd7\xef\x77\x88\x8d\x65\x45\x44\x0d\xa7\xb7\x69\xbe\xce\xf8\xac\xe4\x29\x36\x01\xe8\x92\xa7\x6f\x44\xce\xc1\xff\x10\x8f\x82\xc5\xe1\x05\xbb\xca\x79\x76\x2e\xb4\x41\xc3\x11\x30\x12\x20\xcd\x39\x2b\xd6\xe5\x85\x58\xc9\xb5\xc1\xe1\x28\xca\xc9\xeb\xb5\x62\x46\xc8\xa2\x0f\xb0\x62\xb7\x6f\x39\xcb\xb8\x9a\x89\xdf\xec\x24\xa4\x10\xc9\x37\x5b\xc3\xf1\x5d\x1f\x60\xc9\x59\x6e\x96\x53\x66\x96\x6d\x1c\x14\x67\xd9\x36\x68\xa8\x5b\x56\xdc\x28\x91\xea\xba\xad\xdd\xf2\x56\x6a\xd3\xdd\x32\x45\xeb\x63\x5b\x44\x61\xfa\x00\x37\x2c\x17\x19\x33\xfc\x03\xd7\xa5\x2c\x34\xd7\x7e\x44\x1f\x40\xcb\xf4\x9a\x1b\x3b\x4b\xfd\x72\x59\x81\x0e\xa1\x97\x15\xd8\x0a\x72\x6e\x59\x76\x2e\x56\xc2\xf8\x57\xd7\x9c\x97\xc7\xb9\xb8\xe1\x5d\xcc\x42\x72\x2f\xc4\x8a\x5b\x5e\xb6\x1b\x37\x4a\x18\xee\x5b\x9b\x8d\x7d\x00\x93\x07\x14\x37\x11\x33\x79\x40\x72\x80\x9d\xc9\xf5\x79\x88\x60\xf0\xfe\xbb\x10\xcb\x7d\x54\x4c\xae\x3f\x84\xa8\x76\xf6\xf8\x29\xc4\xb7\xb3\xc7\x09\x57\x46\xcc\x45\xca\x0c\x6f\x23\x1c\x34\x7d\xc7\xb7\xcd\xa6\xe3\xc6\x38\x6a\x1a\xb6\xb5\xba\x2d\x7a\x93\x3d\xc9\x8b\x5e\xbd\xb4\x3f\xc3\x43\xba\x81\x03\x92\x99\x85\xff\x23\x53\xd3\xe8\x85\x57\x96\x18\x06\xf8\xe7\x20\x86\x81\xff\xcf\x2c\x39\xd0\x3e\x6a\x75\xca\xe1\x27\x64\x01\x46\x82\xe6\xea\x86\x0f\x86\x0d\x8b\xd7\xef\x05\xe0\x67\xb9\x48\xf9\x8f\x4c\x45\x2f\xda\xca\x86\x53\x59\x75\x1f\xc4\x2d\x7b\x46\x93\xe6\x95\x5a\x1a\x09\x6e\x74\x0c\x66\x29\x34\xa4\xac\x80\x2b\x0e\x8a\x97\xdc\x6e\xf6\xac\xc8\x3c\x08\xdb\xd9\xa2\x4c\xf6\x45\x14\xd0\xa6\x60\x30\x24\x14\xfd\xa2\x59\xfc\x1a\x0a\x1f\xc3\x80\x9e\x47\xb8\xbc\x72\x6d\x06\x31\xbc\x7a\xf9\x39\x3e\x24\x33\x9e\xca\x22\x8b\x61\xb0\x50\x2c\xe5\x50\x72\x25\x64\x06\x73\xa9\x60\xb3\x14\xe9\x12\x31\xd8\x30\x61\xe0\x8a\xcf\xa5\xe2\xa0\x97\x6b\x63\x44\xb1\x80\x4c\x6e\x08\x19\xe4\x9a\xaa\xd0\xb0\xd3\x37\xd6\x34\x86\xc1\x8a\xdd\x8e\x96\xf6\xc5\x48\x8b\xdf\x38\xae\x04\x5a\x50\x25\x73\x6d\x61\xac\xd8\xad\x58\xad\x57\x50\xac\x57\x57\x5c\x81\x9c\xc3\xd5\xd6\x70\x1d\xc0\x87\x8d\xc8\x73\xab\x79\x50\x32\xa5\x11\x03\x6c\x54\xfc\x5f\x6b\xae\x0d\xda\xa6\x8c\xab\xcf\x34\x5c\xf3\xad\xb6\x2c\xb4\xfb\x97\x8e\x41\x14\x68\x4a\xdb\xfd\x73\x51\xf0\x04\xce\x0c\x64\x92\x6b\x28\x24\xbe\x41\xed\xc2\x3e\x88\x21\xa2\x10\xf6\xbf\x92\xd9\xb6\x22\xb1\x92\xb5\xe8\x45\x6d\x13\x63\x18\xb8\x87\x51\xc9\xcc\x32\x94\x37\x7c\xf6\xf0\x50\x59\x0b\xae\x35\xda\xec\x52\x8a\xc2\xc4\xc0\x93\x45\x02\x63\x37\xf6\xb7\xd8\x09\x61\x06\x72\x6d\xb4\xc8\x2a\x3c\x8e\xa7\x67\x31\x64\x42\xa3\xdc\x64\xb0\x59\xf2\x02\xf8\xaa\x34\x9d\x38\x55\xd6\x38\x86\x81\xfd\xfb\x5e\x8c\xb0\x87\xe8\x44\x09\x5b\xb6\x1f\x05\x23\x6f\xd1\x1d\x4e\xf4\x74\x2f\x56\xd4\xa7\xb5\x0c\x76\x39\x6d\xfb\x54\xc9\x15\x37\x4b\xbe\xd6\x60\xf8\xad\x41\x79\x5d\x31\xf3\x54\x84\xd0\x14\x07\x08\xe1\x86\x11\x22\x74\x36\x45\xe9\x77\x9a\x0b\xb2\xc0\x49\x42\xe4\x62\x37\x09\x0a\x0f\x99\x12\x9e\x81\x30\x9f\x91\xd8\xb2\x15\x07\xa6\x61\xe4\xc0\x7a\x24\xce\x0a\xd3\x60\x89\x54\x21\x06\xb8\x39\x0d\x62\x78\xe9\x39\x82\xfb\xc1\x43\x28\xa0\x92\x32\x45\x7a\x92\x21\x9e\x4d\x7b\x43\x2c\x3c\x9e\x9e\x75\xe0\xdb\xc5\x9c\xbd\x8d\x36\x86\x81\x7f\x37\x52\xfe\xa5\x67\x54\xba\xe4\xe9\x35\x2d\x12\x35\x01\x5b\xa0\x6f\x44\xda\x54\xf2\x34\xb6\x1a\x99\x4b\xab\x83\xab\x18\x58\x96\x01\x83\x0d\x53\x05\xea\xa5\xd3\x5d\x90\x0a\xe6\x4c\xe4\x0e\x49\xb3\xe4\x5b\xc8\xa4\xd5\xcc\x15\x33\xe9\xf2\x08\x72\xb9\x88\xed\x18\xdf\x73\x30\xec\xef\x23\x5f\x7b\x02\x31\x0c\xdc\x43\x25\x68\xe3\x1b\xa6\xd0\x8f\x1f\x1b\x99\xc9\x11\x72\x28\xc1\x1e\x7e\xb9\xd1\x77\x23\x4f\xa2\xc1\xf4\xce\x79\x70\x51\x51\xe5\x49\x64\x72\x99\xb2\xdc\x3f\x74\xc8\xce\xde\xfa\xe3\x4a\xc7\x30\x78\xd4\x82\x0b\xef\xd3\xa6\xb2\x28\x78\x8a\x46\x5e\x57\xdb\x8c\xdd\x23\x18\xc6\x09\x99\x5c\xa1\x93\xb4\xe6\x7b\x93\x05\x0e\x0e\xe2\x6a\x9f\x46\xd6\xe0\xd1\xdc\xb5\xf1\xab\x2d\x30\xea\xbb\x61\x45\x86\x4b\xe4\xf5\xaf\x7b\xb3\xa9\x9c\xa5\x18\x06\xf8\xf7\x88\xa1\x99\x1b\xc4\xf0\xa5\xdb\x62\xde\x89\x62\x6d\x78\x0c\x03\xcd\x8d\x53\x8e\x8b\x93\x29\xd4\x3d\x81\x76\x25\x8d\xc2\xcb\xd2\x94\x97\xb8\x0f\x06\xc4\x5a\x4b\x5d\xaa\x75\xc1\x35\x64\xb8\x05\xe0\xf8\xa0\x1d\x22\x67\xb2\xd2\x5c\xda\x9d\x21\x67\xa5\x91\x25\xac\x44\x36\xc2\x6d\x2a\x97\x2c\x1b\x76\xa3\x1e\xb8\x72\x64\x2d\x83\x2d\xf2\xcb\xf6\x16\xe9\xb7\xa9\x8c\x40\xf8\x4d\xd1\x88\x15\x4e\x8b\x1e\x14\x02\x6c\x59\xad\xee\x99\x43\x3f\x31\x86\x81\x7d\xfc\x9d\x73\x5b\x18\xf5\xe4\x4e\x1b\x3b\xa5\x97\xdc\x50\x94\xba\x5c\x8f\x9e\x2d\xc4\xe4\xb2\x12\x98\x47\xc9\xf2\x33\x25\xb9\x89\x7b\xe0\x59\xd2\xdc\x69\xfd\x26\xb4\xe0\xc1\x6b\x04\xbe\xd6\xfc\x00\x12\x0f\x4f\xf4\x1d\x06\xd1\x76\xae\x6b\xbe\x0d\xe7\x28\x95\xb8\x41\xf8\x18\x47\x77\xce\xf1\xc0\x14\xc7\x1d\xd4\xb0\x43\x44\xb0\xb5\x59\x4a\x25\xcc\x16\xe6\x18\x0d\x1a\x89\xae\xe3\x5a\xe3\x86\x27\xcc\x12\x56\x6b\xb3\x66\x39\x46\x19\xb6\x67\xd7\x82\x05\xb1\x04\xcd\xf6\xd1\xed\x41\x18\x99\xd0\x1c\xff\x65\x66\xa1\x19\x39\x11\x0d\xff\x4e\xeb\xd0\x0a\xcc\x08\x83\x3f\xd2\x48\xec\x28\x32\x73\x81\xda\x69\x71\xf3\xfe\x86\x2b\x25\x32\x1e\x49\x25\x16\x14\xda\x59\x5d\xad\xfe\xb6\xbe\x76\x92\x24\xee\x79\x48\xef\x31\x55\x83\x4a\x76\x19\xc3\x35\xa6\x9b\x5c\x12\xca\xf6\xbd\xeb\xf7\x7a\x62\x0e\x52\x27\xdf\x72\xc3\x8b\x9b\xe8\x7a\x08\x9f\x4c\x60\x30\xc0\x31\xbd\x9e\xe2\x66\xad\x8a\x46\x73\xbf\xd7\xb3\x39\x13\x1c\x96\xf1\x39\xf5\x7e\xf1\x02\x2c\x52\x93\x6a\x2c\x0d\xcd\xf8\xdc\xf6\xf6\x90\x94\x58\x54\x84\x89\xc2\xec\x51\x65\xbd\x5d\x84\x6b\xff\x68\xd3\x23\x0a\xf3\x7c\x62\x6e\x62\xe0\x4a\xe1\x18\xca\x8a\x26\xc7\x46\x8a\x28\xec\x3e\xc4\x7e\x62\x6e\xfb\x7d\x32\x81\x42\xe4\x6e\x68\x6f\xbe\x32\xc9\x1b\x9b\x8d\xcb\x0b\x1c\x31\x33\x19\x57\x2a\x86\xeb\x18\x06\xc2\x85\x2b\x0c\x0d\xa4\xc8\x48\x3f\x51\x88\x7a\xbd\x9e\xd4\xc9\xe9\xad\x30\xd1\x2b\xfb\xb8\x0b\x78\x7a\xd3\xc1\xc8\x97\x21\x1f\x5f\x3e\xcc\xc6\x20\x28\x1e\x8f\xe1\x7b\xbe\x99\xa1\xc7\xa9\x20\x55\x18\xb8\x6a\x60\x50\xf0\x0d\xb0\x52\x60\xf8\xbc\x5c\xaf\x58\x81\x81\x54\xf2\x3d\x3a\xc2\xbb\x9d\x8f\xe3\xae\xd6\x41\xd0\x95\xca\x62\x2e\x16\x68\x27\x85\x71\xe2\x57\x81\x8d\x10\xd0\xe7\x98\x97\xae\x93\xd2\x09\x26\x29\x99\x4e\x59\x1e\x42\x3e\x9e\x9e\x0d\xe1\x73\x42\xe6\xae\xdf\xd3\xc8\xf4\x82\x6f\x22\xf7\x6a\xd8\x9d\xa6\xc5\xac\x51\x72\xda\xce\x99\x4d\x80\xb7\x5e\xf5\x7b\x3a\x39\xa9\xa2\x69\xd4\x7d\x98\x34\xf3\x69\xd8\xe3\x5d\x2b\x89\xd1\x08\x80\xb1\xc3\xdb\x3a\x79\x36\x09\x32\x69\xd8\xf4\xa1\xca\x9e\x4d\xea\x4c\x1a\x36\xbc\xab\xc3\x26\x04\x59\x3f\x05\x8d\x18\xc2\xd4\x8d\xf8\x14\x8e\x94\x2a\x68\xc4\xec\x12\x36\xfe\xd8\xf6\xef\x61\xb2\x9f\x5c\xc3\x8e\xb3\x3a\xab\x36\x09\x52\x6c\xd8\x44\xd3\xee\x1b\x0b\xf2\x8b\x71\xef\x7a\xfb\x7e\x76\x81\x82\xa9\x13\xc2\xa3\xa5\x81\xe8\x2f\x38\xf7\x73\xfa\xfe\x03\xf5\x0c\x33\x5d\x13\x72\x1d\xec\x13\x82\xa9\xd3\x5d\x93\x3a\x41\xe7\x39\x58\x2f\x4e\xe0\xd3\x61\x63\x68\x47\x61\xd2\xc8\xcf\x61\xf3\xc5\xf9\xec\x20\x31\x95\x9b\xe4\x08\x8e\x61\x70\x71\x3e\xbb\xb4\x74\x35\xe8\xbb\x38\x9f\x75\x93\x58\x39\x48\x2f\x69\x6c\x4d\xe9\xc5\xf9\x2c\xd8\xf8\x0f\x4d\xdf\xf4\x0d\x06\x04\xe5\xe4\xf4\xc3\xc5\xd9\x9b\xb3\x93\xe3\x8b\xd3\x2e\x60\x98\x8a\x7b\x18\x9e\x73\x68\x3c\xc8\xe9\x87\xb3\x1f\x8f\x2f\x4e\x2f\xbf\x3b\xfd\x7f\x36\x03\xe6\x60\x1e\x3f\x06\xc5\xe3\x03\x48\x1e\x77\xe2\xd9\x5c\xe1\xa6\x43\x42\x5d\xc2\x75\x0e\x7d\x09\x6a\x6e\xae\x76\x73\xab\xa6\x2e\xad\x35\x6f\xed\xa6\x87\x12\x89\x3a\xb1\x7f\x4f\xaa\x6c\x7b\x98\x09\xac\xad\x5f\x4f\x27\x98\x05\x43\x17\x02\x35\x8f\x5d\xf3\x28\x5d\xb2\x02\xb9\xb3\x4e\xcd\xdd\xce\xae\x08\x5a\xaf\x09\x1a\xc3\xca\x8a\x6a\xdc\x89\xec\xd9\x1e\xd9\x3c\x8c\xc8\x2b\x03\xa8\xab\x20\x1d\x43\xe5\x25\x2b\xb2\x9c\x2b\x9d\x38\xa3\x18\x69\x6f\xdf\x86\x8d\xe1\x94\x41\x05\x24\xc7\x4d\x59\x6d\x23\x3e\x87\xac\x13\x82\x05\x93\x7a\x32\x1c\x6a\xfb\xe3\x4a\x03\xec\xda\x98\xb9\xa3\xac\x16\x6e\x2c\xcb\x04\xba\x35\x2c\xb7\x29\x5a\x8c\xc4\xe6\xa2\x70\xa7\x93\x88\x7b\x85\x33\x7c\xcf\x79\xa6\xc9\x37\x4d\x59\x8e\x19\x2b\xef\x87\x60\x5c\xc0\x94\xe6\x2a\x99\xe2\xaf\x7b\xc8\xb3\x38\x3c\x4c\x60\x85\xa4\xeb\xdf\x41\x15\x6d\x0c\x3e\x73\xd2\xb9\x37\x1d\x4f\xcf\xfa\x66\x5b\x72\xdf\xd9\x2d\x25\x6e\x89\xa7\x87\x4e\x55\x0e\x1f\x4f\xc2\xaf\xb9\x2c\x16\x47\x3e\x21\x0c\x19\xd7\xa9\x12\x25\xf2\xee\xe8\x0f\xce\x05\xff\x1a\x48\x69\x6b\xd3\x6a\xa5\xf6\xef\x41\x1f\xc0\x53\xd0\xce\x1a\x37\x49\xf9\x9d\x09\x63\x4f\xd8\xd1\xe0\xd5\x4b\xdd\xc0\xfc\xdd\x43\x87\x51\x0f\xf3\xbe\x9d\x70\x6e\x62\xfe\xdf\x97\x7b\x4e\x42\x76\xbd\x13\xdf\x34\xf8\x15\xf8\x16\xa4\xf4\x0f\x49\x68\xfd\x43\xfc\x0a\x53\xd7\x4d\x5e\xfd\xe1\x19\xec\x90\x92\xda\x15\xa2\x9f\x07\x75\x6d\x8f\x92\x20\xe3\x7d\x3f\x21\x1f\x3d\xf1\x1d\x12\xe2\x1d\xb0\x8a\x94\xa7\x13\x42\x5e\xdb\x63\x48\xa1\xae\x2d\xc1\x79\x5e\xb6\xbc\x83\x8a\xfa\xc4\xf2\xf9\x54\xa0\x5f\xd8\x41\xc5\xd9\xf4\xc1\xfc\xf6\xa3\x53\xec\x5d\xfc\xaf\x0e\x54\x45\x61\x1e\x85\x76\x07\xff\xa5\xea\xc2\xbc\x3b\xbd\xf5\x51\x73\xf3\x21\x41\x04\xd4\xed\x4f\xe7\x00\x50\x70\x93\xf8\x5d\xa9\xdf\xdb\xf7\xe9\x1f\xbf\x54\x44\x6f\x47\x8a\xbf\x49\xf5\xbf\x29\xd3\x3f\x80\x74\x29\x45\xca\x8f\x6c\xa1\x51\xf5\x80\x30\xea\x26\x1c\x1f\x8a\x6a\x1f\x20\x88\x56\x3a\xc2\xbd\x8a\x1d\x3c\xd7\xdc\xd7\x10\x25\x78\x4a\x5c\xa0\x0f\x40\x3c\x08\x0f\x0c\xf6\x97\xfc\xe0\x01\x41\x6d\x9b\xab\x23\x86\xbb\x3b\xc8\x98\x5e\x72\x15\xfa\x19\xee\xb8\x21\x5c\xd6\x4c\xae\x98\x28\x1c\xea\xe7\xcd\x35\xed\xf7\xac\xde\x3d\xb8\x8e\x84\xfa\xe3\x14\x2c\xd8\x46\xea\x6c\x2f\xf0\xe2\xe6\xc8\x85\x33\x21\x6e\x36\xa4\x79\x50\x6f\x68\x7a\x54\x87\x47\x6b\xc9\x93\x0f\x34\x1c\x86\x36\x78\x0a\x31\x0c\x63\x89\x47\x6b\x38\x21\xdc\xc8\x7a\x36\x11\x7f\x74\xf6\x33\xc4\xa5\x0e\x5a\xda\x55\x14\xf7\x60\x45\xb8\x04\xd9\xd1\x26\x26\x7f\x6a\x66\xb4\x16\x95\x2f\x57\x0d\xc1\x08\x03\xb0\xa7\x92\xda\x48\xa2\x36\x89\x7d\x66\xfe\x34\x40\xb3\xe5\x47\x86\x31\xdf\x53\xf1\x6c\xa6\x5a\x9f\x8c\x68\x77\x96\xb5\x46\xf5\xeb\x16\xaa\x4b\x63\xca\x03\xb6\xbd\xdf\xf3\x29\x8a\xfa\xe7\x41\xa3\xe0\x3b\x12\x35\xd5\x29\xcf\x83\x06\xc2\xea\xa7\xc9\x9f\xb6\xf3\x3a\xf5\xac\x72\x23\x21\x61\x3e\x35\x52\xff\x3c\x56\x4f\x43\xdc\x9f\x64\x5d\x9e\x65\x5b\xaa\xe4\x4c\x0b\xf9\x30\x01\x02\x9d\x89\xc4\xc7\xed\x2c\xed\x43\xaa\x7d\x62\xc2\x63\x9e\xfb\xcf\xaa\x6a\x8c\xc3\x04\xcb\x61\xc4\x31\x1f\xf4\xbb\x10\xc7\x13\xaf\x0e\xee\x3f\xf6\xe0\x2b\xe0\x70\x90\x65\x6a\xe3\x7b\xdc\x60\xf5\xef\x63\x34\x7b\x80\xbf\x4f\x3c\x46\x0b\x18\x7e\x7c\x88\xe7\x00\xad\xe4\xd6\x33\x45\xfd\x63\xef\x4b\x8d\x7c\xda\x7e\x99\xe0\x7d\xf8\x05\x58\xfd\x67\xee\x50\x2d\x3a\x1b\xfb\xd2\xf3\xe8\xfc\xf8\xdb\x53\x0b\xc7\xc6\x9e\xf4\x3c\x1c\xff\x90\xad\x29\x44\x13\x37\x23\x5d\xed\x46\xad\xcd\xa8\x33\x79\x6a\x7f\x3d\x5b\x65\x71\x83\x69\xd1\xf1\x88\x3a\xcd\x1a\xe3\x00\x75\x4c\x11\x36\x7f\x1e\x7b\x84\xd4\xef\xf9\x44\x69\xfd\x83\x8c\x48\xde\xba\xd7\xd8\x4e\xb9\x6a\x3c\x28\xc2\x66\xb8\x92\x32\xef\xf7\xaa\x64\xb0\x1f\x06\x8d\x74\xb0\xeb\x80\x29\xb0\xd7\x55\x27\x51\x98\x2f\xbf\xa8\x82\x3a\x3f\x0c\x00\x3e\xa7\xc0\xb5\x6a\x7b\x5f\xa4\xa4\xb6\xa0\xb7\x45\x9a\xe0\x33\x25\x36\xcf\xe5\x62\x0e\xb9\x5c\x68\x58\x71\xad\xf1\x84\x8c\x0b\xb3\xe4\x0a\x6e\x04\xab\x92\xb3\x6b\xcd\x15\x76\x42\x46\x4a\xd7\xa4\xb7\xda\xf0\x15\xc8\x82\xe3\x7a\x15\xb2\xd1\x47\x54\x79\xdd\x8e\xdc\x33\xce\x18\xcd\xc9\xfb\x88\x81\xa9\x85\x3d\x2f\x15\x85\xe1\x6a\xce\x52\x7e\xb7\xc3\x7c\x6d\xaf\x9d\xac\x7d\xf1\xc2\x3d\x27\xe7\x6e\x8e\x2a\x87\xdb\xeb\x85\xef\xa3\xb9\x03\x99\x24\xc9\xb0\xdf\xdb\xb9\xfd\x14\x4f\x25\x73\xb9\x48\xa6\x78\x1a\x3a\x6f\x75\x21\x46\xbc\x61\x86\xe5\x7f\x2c\x2b\xc6\x63\xc0\x93\x55\xed\xca\x2c\x0a\x59\x8c\x7e\xe3\x4a\x82\x36\xcc\xac\x35\xb0\xb9\xe1\xca\xdd\xc7\xc0\xfa\xe7\x3d\xbe\x39\x04\xff\x4d\x9c\x43\x51\x09\x0f\x82\x5b\x8c\xf4\xb8\x74\x31\x72\xc6\x4d\xc7\xa1\x44\x95\x04\x35\xcb\x2a\xce\x77\xfe\xe0\xf1\xf4\xec\xbe\x6c\xbf\x35\x21\xfb\xdc\x70\xb3\x3c\xf1\x7c\xd7\x31\x07\xc7\x4c\x5a\x3c\x00\xfb\x8c\xf7\x2d\x82\xa3\x0e\xf7\xc6\x1d\x67\x23\x7d\xad\x23\x99\x06\x53\x27\x50\x0b\x58\xbf\x01\xa5\x62\x04\xe1\x5b\x57\x49\x84\xf4\x2c\x99\x76\xb5\xde\x91\xcb\xf8\xd3\x2a\x0f\xad\x79\x40\xbe\xfb\x8c\xfd\xd1\xa4\xe3\xc8\xd9\xd2\x95\xf3\x82\x06\xeb\x61\x7d\x1a\xef\xc7\x4d\x5a\x25\xe5\x8e\x20\x2a\x4b\xb8\xa9\xcb\x12\x7c\x7f\xaa\x4c\xb8\x41\x48\x84\xd2\x5d\x50\x0b\x60\xd4\x9a\x57\xe5\x00\xf4\x6e\xce\x72\xed\xed\x8a\xa5\xcb\xae\x34\x2b\x45\x0c\x78\xef\x29\xb7\x8f\x98\x36\xc7\xf4\xa1\x3b\xa8\x48\xb9\x5d\x6b\xa9\x60\xe6\x0d\xa0\x6d\xc0\xb7\xa8\x30\x08\xea\xa2\x91\xed\xc2\x2a\x55\x83\x51\x05\x86\x9d\x3c\x73\xb9\x22\x04\x2c\x8a\xd1\x3c\xb7\x57\xd7\x68\xd7\xd4\x36\x6b\x96\x29\x86\xda\x67\xdd\x60\x66\x60\x85\x31\x50\xeb\x54\xc3\x6f\x6f\x4b\x8e\xd3\x85\xfe\x03\x02\x70\xf3\x74\x18\x32\x4b\x62\x94\x9a\x5b\x4f\x53\x72\xe2\x7e\x0f\x21\xc2\x6a\x0c\x7b\x5d\xcd\x0b\xdd\x27\x3a\x69\x98\x7e\x62\x2f\xf6\xc3\x05\x75\x2b\x19\x0d\xff\xd1\xae\xe3\xc0\x7b\x31\x96\xb9\x5c\x29\xcf\x6f\x52\xf1\xfd\x74\x5d\x55\xd8\xd2\xd0\xff\x60\x2a\x2b\x3c\xac\x14\xc9\x8c\x9b\xbd\xe1\x51\x07\xc8\x7d\x84\x7a\x5d\xf8\x8c\xc7\xa0\xb9\xf1\x12\xe6\x4f\xf1\x62\xb7\x35\xe0\x16\xa1\xb1\x9d\xf0\xae\x54\xa3\x81\x1e\xa9\x56\xe7\x44\x52\xe9\xe4\x7b\xbe\x89\x06\x29\x2b\x3e\x33\x54\x2b\x82\xeb\xb5\x3f\x23\xc3\xc3\x10\x3c\x32\xa5\x39\xf1\xc8\xd8\xa2\x88\xa5\x08\xdc\xd0\x3e\x1c\x79\x2e\xe0\x0a\x16\x22\x1f\x0e\x2b\xbe\xe2\x7a\x06\xd6\xc1\x3e\x56\x0b\xfc\x0d\x4b\xaf\x17\x4a\xae\x8b\x2c\xb2\x23\x7a\x37\x4c\xc1\x66\xe1\x76\xd5\x9f\x98\x30\xdf\x2a\xb9\x2e\xdd\x6b\x67\xf1\xf0\x06\xd5\xe7\xd6\x05\xb0\x93\x61\x46\xce\xd3\x6f\x17\x83\x1e\x88\x35\x3e\xe3\x8c\x19\xff\xba\x0c\xc8\xef\xf0\x47\x93\xba\x0b\xce\x5f\x41\xaa\xaa\x33\x92\x77\xd5\xc5\x42\x47\x62\xec\x39\x33\xf4\x5c\xa6\x9e\xde\x33\x0b\x48\x0d\xc0\x55\xdd\x3c\xbf\xe8\xb1\x01\x2e\xd8\x13\x3c\x8a\x74\xf2\x49\xa5\x33\x01\xd9\xc3\xbd\x4e\x75\xb5\x0b\xde\xd1\x41\xfb\x24\x0a\x13\xb5\x8a\x60\x3a\x86\xbd\x7d\x0c\x92\x38\xf1\xf7\xd2\xbc\xc1\x95\xf2\xad\xb8\xc6\xbd\x9e\x5f\x15\xb4\xe1\x25\x2f\xb2\x88\x5e\xc4\x9e\x85\x1e\x5f\xec\xbc\x59\x24\xc7\x59\x46\x95\x50\x1a\x3d\x8c\x79\x34\xc0\x0e\xfe\x34\x8e\xc6\xa0\x23\xdc\x79\x24\xcc\x0c\x20\x13\x8e\xc6\xe3\x4f\xf5\xa7\x7a\x10\xef\xf1\x1f\xe1\xab\x68\x18\x37\xd7\xde\xce\xb7\x90\x80\x26\x27\xca\x1b\x99\x9c\x21\x55\x77\x65\x7c\x8e\xdb\xe9\x22\x79\x2d\x0b\x6e\x85\x21\x54\xf2\xc6\x24\x24\xe6\x79\x53\x97\x5f\xbc\xf0\x4f\x88\x61\x72\xaa\x94\xed\xa6\x4e\xac\xb9\xa3\x59\x7a\xda\x6f\xf5\x83\x4f\x6f\x06\xb6\x18\xcd\x4d\xb5\xeb\xf7\x42\x96\x18\x59\x96\x3c\x03\xfd\x4c\xd6\x0c\x62\xc8\x89\x13\x16\xfc\x2e\x6a\xf3\x69\xe8\x6d\x4d\x43\x48\xdd\x81\xa3\x5f\xe0\x4a\x34\x2b\x3b\xd3\xdc\x52\x7f\x28\xc4\xad\x63\x5f\x98\x30\x3f\x20\xac\x61\x97\x47\x8b\x6a\x63\x10\xa1\x05\x13\xaf\x33\x68\x84\x2a\x67\x80\xea\xd3\xc2\x11\x31\xdd\xfc\x8c\x69\xef\x8f\xc2\xe2\x29\x2b\xbd\x87\x85\x37\x84\x63\x65\x37\x14\xdd\xb6\xe4\x1e\x5a\x0f\x9c\xdd\xaf\x47\x63\xee\xfe\x41\x69\x44\x6e\x02\xec\x0b\x23\x40\x2d\x8c\x21\x6e\xcf\x97\xc5\x6e\x49\xc4\xba\x13\xfc\xf7\x90\x24\x3e\x87\x54\x94\xbe\x10\xe7\xf3\x7a\x73\xd8\x17\xaa\xb7\x17\x17\x53\x27\x54\x75\xf6\xf5\x80\x48\xd5\x1d\x1e\x2d\x50\xc1\x90\x30\x2f\x81\xb6\x2f\x78\x6e\x76\x6c\x24\x07\xb0\x67\xf8\xa2\xd9\x75\xc6\x4d\x95\xd6\xd1\xe4\x53\x46\xa2\x30\x5f\xff\x35\x0a\x0a\xe5\x86\xf0\xbf\xe1\x65\x0b\x9b\x47\x09\x77\xdd\x3f\xa6\xab\xdd\xc8\xec\xfa\xed\x39\xe9\x3c\x15\xb3\x47\x0f\xc8\x78\x3d\xf0\xd9\x12\x1e\x5a\x9c\x2e\x4c\xee\x91\x74\xbb\xc6\x5d\x66\xb7\x16\xf4\x1a\xde\xef\x30\xb9\x07\x2c\xee\xae\x7f\x8f\xbd\x7d\xaa\x81\xdd\x45\x26\x2d\x3d\x65\x51\x83\x11\x31\x04\x2b\x1f\x57\x9e\xa9\x3d\x1e\x1b\x3e\xa4\x08\xb3\x5a\x13\xf4\x83\xaa\xa0\x9f\xa1\x0b\xfa\x80\x32\x34\xf3\x76\xad\xce\x7b\x0a\xd1\xca\xa0\xb5\xba\xdf\xab\x14\x61\x22\xb4\xa1\x17\xfa\xb0\x62\x60\x2c\x3d\x1e\xc3\x59\xa1\x4b\xa1\xb0\x8c\x6d\x6b\x9d\x02\x7d\x34\x1e\x5f\x61\xd0\x78\x85\x25\x50\x57\xa2\xb0\xdf\x79\x60\xe9\x52\x70\x5c\xd4\x51\xc9\xd5\x9c\xa7\x66\xa4\x75\x3e\xca\xd9\x95\x1e\xe9\x54\x2a\x3e\xc2\xdc\xc1\x68\x21\x5b\xd3\x62\xee\xdb\x6a\x1f\x4c\x00\x2f\x68\x60\x28\x32\x17\x0b\x5c\x8d\xde\x78\x0c\x27\x6c\x8d\xf1\x81\xd7\x2d\x4a\xb4\x7f\x2b\x3f\xd3\x95\x07\x9d\x8a\x72\xc9\x95\x5e\xe3\x91\x53\xa9\x50\xcc\x79\x91\x72\x1d\x13\x84\xba\x0c\xc1\xac\x31\x9c\xc2\xfb\x62\x37\x52\x64\xc0\x8c\x61\xe9\xb5\x4e\xe0\x35\x15\x22\x2d\xd1\xee\x4a\x8c\xcf\x04\x2f\x8c\x4e\x10\xc0\xd4\x02\x24\x69\xb7\x13\xcd\x70\x22\x7d\x64\xe3\x48\x3f\xc7\xfb\x22\xdf\x5a\xc4\xd2\xb5\xba\xe1\x9a\x4a\x1f\x96\xec\x06\x8f\x89\x34\x5f\x5d\xe5\x5b\x10\xab\x32\xe7\xf8\x3d\x12\x9b\xc8\xd3\x34\xd2\xf3\xb3\xf1\xd5\x0d\xfc\x26\xc6\x78\x21\xc7\x46\x71\x3e\x5e\x31\x6d\xb8\x1a\x6b\x95\x8e\xe9\x43\x24\x3c\xcf\x31\xe1\x99\x22\x88\x13\x9c\x70\x5a\x53\x7d\x04\x3f\xff\x62\xb9\x88\xef\xcf\x5e\xdf\x55\x7f\x4f\xbf\xf8\xea\xeb\x5d\x5c\x27\x29\xdf\xc9\x8c\xab\x02\xff\xc5\xcc\x21\x00\x58\x74\x7e\xd0\x1c\x56\xb6\xc5\xde\xa2\xc1\x3f\xab\x25\xdf\x88\x6b\x91\xac\xe4\x6f\x22\xcf\x99\xfd\x62\x87\xfd\x6e\x84\x30\xdb\xb1\x63\xcf\xe5\x4c\x64\xfc\xf2\xe2\x7c\xf6\x3f\x08\x55\x15\x97\xa9\x5c\x95\xcc\x88\x2b\x91\x0b\xb3\x45\x64\xbf\xe7\xb7\x66\xaa\xa4\x91\xfa\xa8\x2e\x24\xb4\xf6\x75\xfc\x2a\x79\x85\xb5\xb8\xcb\x2f\x06\xbb\xb8\xc5\x9a\xcd\x66\x93\xc8\x0d\xd3\xa5\x9d\x54\x14\x19\xbf\x4d\xca\x65\x39\xbe\x50\xac\xd0\x78\x34\x76\x79\xce\xb6\x5c\x5d\x22\x64\x17\xfe\x5e\x9e\x2c\x39\x33\x97\xb3\x25\xe7\xe6\x7f\x3e\xac\x73\x7e\x39\xba\xc4\x25\xba\x9c\xad\x4b\x3b\x60\x66\x94\x2c\x16\x76\x84\x4c\x65\x6e\x17\xe3\x9d\x28\x7e\xe4\x4a\x63\xfe\x15\x69\x4f\xe8\xe1\xe2\x7c\xf6\xea\x8b\x98\xea\x2d\x5d\x4c\xaf\x79\x28\x73\x1a\xb4\x83\x0a\x6f\xa4\xda\x30\x95\xc1\x8c\xa7\x8a\xa7\xdb\xa3\x8a\x02\x5e\x24\xc8\xbc\x92\x67\xc2\x71\x0e\x9f\xc6\xd4\xfd\x52\xbb\xee\x88\x43\x53\xc2\x7e\xfe\x65\x2d\x0a\xf3\xea\x6b\xab\x0b\x3d\xc4\x09\xcf\x60\x4e\x4f\x5e\xbf\x3d\xbd\x3c\x3d\x79\x3d\x3b\xbe\xfc\xe9\xec\xe2\xed\xe5\xf1\xe9\xec\xf2\x8b\xaf\xbe\xbe\xfc\xf6\xe4\xdd\xe5\xec\xed\xf1\x97\x7f\xfb\x6b\xdc\x31\xe0\xc3\xd3\xba\xb7\xe0\xbf\xfa\xe2\x6f\x7e\xc0\x17\x5f\x7d\xfd\x20\xfc\x8e\xee\xbb\xf0\x2b\x20\xd6\x2b\x71\x6e\x49\xeb\x90\x31\x48\x04\xec\x9f\xe2\x05\xf7\x4b\x3a\x4d\x48\x12\xf4\xd7\xbe\x38\x99\xf4\xa1\x6e\x89\xe1\xd5\x90\xd6\xf3\x61\x28\x3f\xbf\xfc\xc5\x06\x0f\xae\x8c\x3a\x39\x97\x2c\xfb\xbf\x5f\xbd\xfc\xfb\x77\x7c\x3b\x65\x42\x45\x87\xcf\x2c\xc8\x13\xae\x88\x6e\xd3\x73\x78\xe4\xb0\x1a\x13\xc3\xe1\x5e\x0f\xc1\xff\x8e\x6f\x1f\x33\x05\x25\x19\xaa\x22\xe3\xbd\xa3\x48\xcf\x73\x4a\xed\x33\x64\x4e\x4c\xbf\x4f\x9d\xf7\x20\x24\x7e\xb2\xc7\x6e\x6d\x78\xee\xfb\x64\xa6\x84\xf3\x3d\x0e\x67\x3a\x46\x98\x07\x78\xb4\x2b\xa3\xa1\xca\xf6\x46\x55\x27\x3f\x70\x47\xbf\x5d\xc3\x14\xf3\x94\x47\x13\xb8\xfd\xea\xe5\xdf\x31\x59\xe3\xdf\x45\xc3\xbd\x6e\xc9\xb1\xf5\xec\xf0\x51\xbf\x51\x72\x35\x3d\x7d\x47\xd0\x1f\x90\x28\xbb\xa3\x9c\x1c\xa3\x50\xd6\xd0\x1e\x31\xe4\x78\x6d\x2f\xbf\xa0\x04\x7f\xe0\xff\x5a\x0b\xc5\x8f\x8b\xec\x47\xae\xc4\x7c\xeb\x3a\x20\x2c\xaa\xf7\x0e\xfd\xd8\x8b\xf3\x59\xd4\x09\x77\xd8\x3f\x3c\xe5\x37\x6b\x91\x67\xe8\x73\x5e\xc8\x60\x45\xa2\x21\xe9\xea\x03\xe9\x34\xd7\x09\x53\xb9\xdd\xd0\x03\x90\x61\x96\xb7\xd3\x0a\xd4\x37\xdb\x3a\xdb\xd1\x16\x84\x5d\x82\x10\xcb\x9f\x3d\x5a\x7f\xc5\xd6\x22\xc0\xaf\xa3\x51\xab\xfc\xe0\x57\x9b\x7a\xa5\xf7\xd7\x7c\xfb\x2b\x6c\xb8\xe2\xad\xf2\xc3\x66\xba\xe0\x20\xfc\x4e\xf0\x1b\xa6\xbb\xa0\xed\xfa\x8f\xa3\xe7\x11\xd3\x39\xac\x0f\x4f\xb3\x3b\x14\xd6\xe8\x46\x5c\x53\x87\x13\xba\x19\x4f\x3c\x21\xb2\xd1\x1f\x21\xb4\xd1\xcd\xd8\x46\x7f\xec\xe0\x46\xff\xc7\x45\x37\xfa\x40\x78\x93\xdb\x84\x71\x15\xe2\xec\x87\x3b\x9e\x37\x31\x34\x9d\x7a\x7a\x0e\xa3\x9e\x18\x3a\x55\x71\x88\x46\x80\xc2\x21\xd4\x57\x5a\x4d\x1b\x15\xc0\x5d\x6b\x15\x17\xb2\xca\xf5\xfa\x83\x0e\x3c\x39\x88\xe1\xc5\x66\x41\x35\xe2\x4a\xdb\xf0\x0a\x30\xba\xc4\x54\x32\x46\x97\x64\x1c\xf0\x20\x8a\x8e\x6b\x2d\x5e\xd5\x6d\xc9\x66\x21\xb0\xaf\x1d\x76\xe0\xf6\x0f\x2c\xfc\x21\x03\xb2\x5d\x2a\x6b\xe4\x7d\x28\xe7\xb9\xa3\xe1\x0e\xc6\x63\x60\x39\x56\x21\xe0\xc7\x33\x0a\x3c\x6d\x11\xda\xda\xbb\x00\x1b\x4b\x39\xc0\xfd\x91\x20\xf9\x7a\xe8\x0d\x23\x07\x01\x8b\xc7\x70\x08\x3e\x68\xf7\xb4\x61\x1a\x33\xfa\x74\x44\x5a\xdf\xd2\xa9\x2e\xd4\x91\x3e\xd3\xe1\x75\xfd\x9e\x6e\xd3\x91\xd1\xae\xbc\x6e\x04\x4d\x1c\xa1\x0b\x11\xd5\x7c\x8d\xb7\xad\x79\x6b\x7b\x12\x2c\x7c\x60\x5d\xf7\x9b\x9a\x91\x31\x0a\x5d\x0b\x09\x93\x96\xb6\xd8\x12\x5c\xb1\x65\x85\x46\xeb\x7d\x17\x22\xdd\xc1\x66\x0b\x9b\xaa\xc5\xe2\x52\x3d\x75\x60\x82\x4b\xe9\x4b\x69\x6a\x3c\x1a\x6f\x1f\xc0\x22\x88\xad\xf7\xf0\xb8\x3f\x2f\xd5\xc6\xc5\x96\x9d\xec\x23\xd3\x7c\xfd\x00\x36\x61\xec\xbe\x87\x4e\xd8\xd8\x95\xfd\xda\xdd\x2b\xba\x3e\x45\x8c\x52\x95\xc9\x15\xe6\xff\xbc\x66\x54\x37\xb1\x6b\xc3\x19\xdd\x9f\xaf\x25\x61\xe6\xfb\x6e\x15\x29\x12\x1e\xa1\xd5\x8e\x54\x2b\xe9\x08\x93\x36\x06\xf7\x62\xee\xf3\x90\x08\x29\xbf\x0f\x65\x93\x62\x1a\x0e\x89\xf8\x3f\x52\x14\xa8\x43\x58\xa2\x1d\xf9\xcb\xa9\xfe\x9e\xf9\x99\x91\x2c\x72\x97\x6e\x87\x4f\xa3\xc5\xbe\x5f\xc6\x50\x56\xd3\x63\x0d\x4e\x32\x2b\x73\x61\xaa\xe9\x3c\x8a\xfb\xfb\xe4\x93\xb9\x46\xf6\x60\x49\x8f\x74\x87\xb6\xa4\xc7\x20\xb5\x55\xdd\x05\xe6\xea\xf1\xf6\xab\xba\x5b\xfa\x54\x76\x92\xa5\xda\xe3\x28\xd5\xb2\x3e\x87\xa9\x7a\x19\x83\xbe\x97\xad\x01\xb6\x1f\x81\xb3\x81\xb1\xf5\xdc\xf5\x95\xb8\x78\xbd\x95\x5e\x85\xbb\x69\x78\x19\xb7\xc5\xe5\xfd\xa3\xcd\x17\x2f\x82\xd7\x08\xd4\x7e\x4e\x20\xb8\xaa\x49\x6d\x9d\x1b\x41\xa3\xad\xb9\x19\xe0\xbf\x74\x7a\xf4\xbc\xa5\x0b\x60\xef\x2d\x1f\xb5\x3d\x63\x09\xf1\x65\xfb\x58\x0b\x26\x6d\x4c\x3d\xcf\x5a\xbb\xf2\x84\x0a\x2e\xf6\x1c\x82\xaa\x6c\x42\x1b\x59\x86\xa5\x36\x47\x80\x95\x46\x5e\xe0\xc3\x52\x06\x57\x32\x81\xad\xcf\x2e\x99\xe8\x28\x86\xf0\x5e\x4d\xed\x5d\xa0\xd9\xfe\xc4\x7d\xbd\x37\x39\xc1\x24\x96\x0d\xbb\x66\x1b\x56\x9e\x61\xe5\x5a\xf4\x42\x27\x61\x51\x9b\xbd\xc5\xff\x6a\x48\x35\x39\xce\x15\xf4\x7e\x88\xef\x07\x48\xaa\x75\xcf\x03\x46\xe0\x86\x67\x29\x8b\xea\x3b\xd5\x5d\xbe\x53\xd3\xfd\xda\x63\x98\xae\xae\x12\x3d\xa1\x46\x65\x9f\x11\xfb\x4e\x5e\xbb\x3c\x24\xc6\x8a\x81\xcf\x9b\x25\x03\xf1\x81\x72\x01\xff\xcd\xda\xa6\x5f\xae\x79\xce\xdd\xcd\xe2\x94\x69\x0e\xff\x6b\x94\x9a\x5b\x6a\x3c\xaa\xde\xd5\xcc\x38\x42\xbf\x12\x5d\x82\xee\x1b\x8b\xf8\xd5\x48\xfb\x41\xe0\x9a\x17\x40\x21\x11\x76\xde\x62\x1e\x16\xeb\x9b\x97\xbc\x53\x4c\x7c\x21\xd4\x8c\x1b\xdc\xfb\xb7\x91\x2d\x03\xc2\x70\xd7\x76\x40\x4b\xd5\x5d\x3e\x11\xec\xea\x4d\xe9\xaa\x5d\x68\xac\xa3\x70\x2b\x50\x33\xd1\x3e\xbe\x59\x17\x98\xb8\xb5\x33\xc4\xbe\x4b\x3d\xd1\x4f\xc2\x2c\x09\x58\x44\x7d\xf6\x26\xe9\xfb\x80\xc7\x8d\x8e\xe8\x70\x03\xa7\xd4\x3e\x02\x69\x95\x75\x50\xb5\x14\xf1\xa8\x2e\x99\xa2\xa5\x43\x8c\x69\x68\xc3\xf3\x47\x19\xa1\xf0\x00\xf6\x16\xd7\x63\xe1\x07\x76\xc5\x5e\x9a\xc2\x2e\x2f\x54\x96\xa4\x66\xf4\x65\x21\xd5\x6b\x4c\xcb\x54\x05\xe4\x5e\xa5\xf1\xdb\x9f\x62\xc5\x29\x16\x73\x4a\x86\xae\x03\x4d\x51\x49\xf8\x11\x34\xe2\x33\x8a\x5d\x13\x7b\x52\x15\x55\x21\xdb\x8e\xa8\xb2\xac\xab\x48\xf7\xc1\x4b\x50\x63\xa3\x2a\xd4\x87\x5e\x19\xc3\xa3\x7b\x37\xbb\x6e\x5e\x0d\x46\x23\xb5\x2f\xac\x1a\xd8\x32\x28\x90\xb6\xf7\x68\xb5\x04\xb3\x64\x06\x87\x6f\xad\x01\x43\xd1\xcd\x78\x9a\x33\x4c\x5f\x88\xc2\x5a\x42\x4c\x22\x74\x68\x6b\xa3\x82\xa0\xc0\xc2\xb4\xb0\x5e\x77\xd8\x78\x22\x9b\xd6\xfc\x0e\x4b\xbd\x95\x05\x9f\x60\xa1\xcd\xaa\x36\x53\xfc\xd6\x84\x95\x72\x21\x54\x14\xe5\x08\x11\x8b\x36\x6e\x36\x5f\x7c\x65\x5d\x59\x15\x83\x17\x1a\x4c\x5d\x71\x6d\x9c\xd4\xd0\x4a\x4b\x5b\xab\x8b\x5f\xb1\x94\x99\xd3\xca\x9c\xcf\x8d\xbf\xe5\x8f\x5f\x24\xb0\x72\xa4\x70\x9f\x5d\xca\xac\x8a\xce\xdd\xe3\xb7\xdc\x20\xea\x07\x5a\xf1\xf8\xcd\xce\xd5\x43\xbe\xb8\x23\x4d\x94\x95\x68\x13\x83\x3d\xd0\x26\x6a\xc8\xcd\xd7\x1b\x61\xd2\xa5\x1d\x60\x2d\x50\x83\x4d\xd5\x8e\xaf\x92\x1f\x3e\x9c\x27\x9e\x4b\x61\x9f\x23\x84\x68\x03\x01\xf7\x6e\x66\xcb\x62\x71\x32\x8b\x93\x7b\x7c\xff\x5d\x4c\x6b\xe6\x9e\xef\xdc\xaf\x23\x18\xc8\xeb\x01\x7e\x34\xc3\xcf\x5d\x2f\xc6\xc1\xa9\xab\x2e\x76\x66\xaa\xc2\x3d\x9a\x1c\x80\x8f\xa2\xb8\x1d\xa0\xd4\xf7\x52\x3c\xcf\xa1\x94\x48\x85\x17\x69\xec\x5e\xe5\x1d\xca\x00\xcb\xb6\x31\xd8\x9b\xaf\x54\xc4\xc5\x4a\x91\x9c\xe0\xb3\x23\x35\x52\x7e\x6f\xa0\x02\x18\x5c\xb3\x4f\xec\x38\x82\x41\xe8\xd1\x6c\xf8\xf5\xf3\xca\x3c\x0f\x5c\x07\x8b\x54\x03\x27\x5c\x30\x91\xf2\x1f\x0a\x76\xc3\x44\x8e\x87\xfb\x41\x2e\x8e\x92\x16\x0e\xa9\xda\xe0\xd6\x33\x59\xf4\xaa\xf4\xff\x8a\x95\x3f\xbb\x28\x87\x0e\x9c\xe2\x70\xb8\xc3\xd9\x9a\x47\xbc\xae\x54\x79\x5b\xae\xa2\x94\x08\x27\xf0\x4d\xf8\x3f\x63\xff\x5f\x90\x1e\x79\x4d\x84\x78\xbb\xd7\xe0\xe1\xc1\x71\x5c\x29\x3c\x48\x97\x8a\x6a\xa0\x3c\x7d\xf4\x6b\x57\x65\x97\x3a\x25\x0b\x99\x16\x53\x01\x36\x8e\xa7\xc3\xd0\xa3\x7b\x84\x1e\xab\x90\xbc\x11\x23\x67\x10\x16\xfe\xfe\x0a\x39\x73\xde\x3a\x55\x76\x98\xae\x6e\x5f\x6d\x03\x7f\x23\x06\xc5\x53\xa9\x32\x7f\x73\xde\x7b\x96\x28\x9f\x54\x2f\xb9\x67\xae\xa8\x4f\x34\xac\x6a\xfc\x91\x3f\x95\x57\xf9\xbe\x48\x79\xf2\x5a\x3a\x6b\xe2\x3d\x29\x8f\xd3\x04\xbf\xb6\x55\x01\xb0\x54\x78\x73\x54\x75\x22\xba\xe8\xa9\xc3\x3a\x53\x4b\xd3\x08\xa3\x79\x20\xbf\x47\x3d\xc2\x1a\xfb\xf2\x6f\x3f\xfc\x78\x7a\xb6\x6f\x98\xbb\x4b\xfb\x2a\xb2\x63\x9a\xf4\x5e\x5b\xfd\xd1\x8c\xad\x98\xef\x19\x0f\xc2\x03\x0d\x57\xa3\x02\xf2\x41\x33\xd9\x2d\x56\x95\x48\x85\xc6\x07\xfd\x4f\x64\x10\x99\x26\xc5\x8b\x8c\x53\x01\x01\xbe\x76\x5d\xeb\x9d\xd1\x7d\x7c\xa6\x01\xa0\xfe\x04\x4d\xfd\x02\x53\xaa\xc1\xcf\xaf\xff\xd4\x78\xe7\xc7\xcd\x31\xf8\xb5\xdf\x23\xc5\xdf\xd3\x78\xdf\xd3\xa9\x73\x2c\x57\xc2\xd0\xc7\x1e\xaa\x82\xf6\x0e\x1d\xeb\xe6\x32\xea\x1d\x3a\x7f\x5e\xf7\x1a\x58\x5b\xc1\xdd\xe0\xce\x90\x71\x1b\xc1\x72\x13\x0d\xac\x7d\x2c\xcc\xe8\x62\x5b\xda\x2f\x7f\xb3\xb2\xcc\xe9\x36\xcf\x18\xf1\x1a\x0c\x3b\xc6\xb0\x74\xc9\x47\x38\x52\xc9\x1c\x07\x15\x72\x94\xe2\x3b\xd7\xf9\x27\xc2\x16\x67\x41\x84\x86\xfd\x1e\x42\xc2\xc4\xf1\x69\x81\x2f\x54\xb4\x19\x26\xee\xcf\xc8\xdb\x08\xa7\x20\x41\x3e\x19\x0e\x5d\x5e\xa3\x6f\xda\x90\x90\x37\xaa\xca\xfd\x55\xb6\xab\x2d\xb0\x2a\x44\xa3\xaf\x1e\x88\x15\x7a\x2b\x66\xc9\x85\xa2\x1b\x7a\x4e\x39\xc2\x14\x76\x33\x7b\x1f\x07\xff\xa3\x81\xc6\x55\xb0\xd8\xa7\x40\x0b\x33\x6c\x8c\x20\x47\xc6\xe4\x31\xc8\x6b\xdc\x91\xf2\x24\xfa\x1c\x3b\x5c\x9c\x4c\x7d\x9f\xe1\x3f\xb0\xed\xc5\x0b\xf2\xd1\xab\x29\xea\xbd\x22\xc7\x68\x3f\x2d\xab\xd4\xa3\x1f\x79\x17\x40\x39\xb2\x93\x38\x56\x1c\xd5\x78\x56\x5f\x11\x74\x08\x86\x10\xe9\x7f\x5d\x92\xd8\x14\xac\x07\x13\xe5\x44\xcb\x30\xf4\xa2\x6c\x60\x67\x85\xbe\x0b\x0d\xba\x3b\x85\xb4\xb6\x69\xeb\xf7\x68\x71\x1a\xec\xaa\xef\x65\xe4\x9d\x74\x0d\xe1\xd8\x2e\x5c\x34\x84\x08\x01\x9e\xc8\xa2\x88\x83\x2a\xff\xd4\x3f\x3b\x8e\xba\xce\x17\x27\x53\x8a\x75\x5a\x7b\x1a\xd1\x50\x88\xdc\x8e\xb1\x74\x21\x84\x46\x51\x5e\x84\x51\xff\xb0\xa3\x61\x6a\xf1\x8f\xf2\xc4\x11\x52\x9b\x72\xec\x19\x07\x41\xef\xb7\x55\xcd\x3b\x65\x24\xf0\x13\x8b\x64\xab\xb1\xd6\x66\xbe\xce\x6d\x9c\x6f\xb8\xee\xbe\x76\x54\x03\x88\x0e\x5a\xd8\xba\x8c\xdd\x5f\xfe\xa8\x26\x65\x79\x2e\x37\x9a\xee\xf4\xda\x58\x1f\xe7\xc7\x54\xb0\x47\xc2\x7e\xcf\x44\xf8\xe2\xa4\x7d\x04\x82\xaa\x7d\x3f\x24\x44\xc3\x5a\x8b\x0a\x81\xa0\xee\xca\xa1\x82\x19\x5d\xbf\x80\x15\x07\x50\x5b\x5d\xb2\xd5\x7f\x89\xc3\x2b\xe1\xfe\xf4\x21\x00\xbf\xf2\xfe\x39\x7e\xf4\x1d\x8f\xa3\x7b\x2f\x79\xec\x0b\x43\xf3\x62\x4d\x2b\x35\x1c\xae\xef\xdb\x8b\x8b\x69\x27\x7d\xc1\x49\x48\x17\x59\xe1\xb8\x3f\x8f\xac\x46\xe1\x61\x4d\x54\x75\xd8\xd2\x41\x93\xbe\x87\xa8\x60\xdc\x9f\x4b\x93\xee\x20\x8a\x3c\x86\x4e\xc2\x3c\x49\xde\x25\x22\x67\xc2\x0e\x0f\xbe\x70\xf3\xb8\xaf\x00\xed\xf3\xa5\x35\xf5\x9f\xc7\x1b\xa2\xab\xcd\x9d\xbb\x3b\x40\x5f\x22\xc7\x5b\x3d\x03\x4b\xa0\xa2\x9e\x03\x48\x60\xb7\xeb\xff\xff\x01\x00\xbd\x6a\x0e\xe1\xce\x6c\x00\x00")
 
 func templatesServerServerGotmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -511,7 +848,7 @@ func templatesServerServerGotmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/server/server.gotmpl", size: 20159, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	info := bindataFileInfo{name: "templates/server/server.gotmpl", size: 27854, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -536,6 +873,26 @@ func templatesServerUrlbuilderGotmpl() (*asset, error) {
 	return a, nil
 }
 
+var _templatesServerValidationGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x5a\x7b\x6f\xdc\xc6\x11\xff\xff\x3e\xc5\x98\x80\x0c\xd2\x65\x68\xb7\x68\x0b\xe4\x8c\x2b\xe0\x47\x5d\x2b\x75\x2c\xc1\x72\x9a\x3f\x0c\x23\x58\x91\xc3\xbb\x8d\x49\x2e\xbd\xbb\xa7\x8b\x7a\xb9\xef\x5e\xcc\xbe\xb8\x3c\xf2\x24\xd7\x30\x10\x25\x80\xc4\xe5\xec\xbc\x1f\xbf\x5d\x7a\xbf\x87\x0a\x6b\xde\x21\x24\x0a\xe5\x0d\x4a\x89\xaa\x17\x9d\xc2\x1b\xd6\xf0\x8a\x69\x2e\xba\x04\x0e\x87\xc5\xe3\xc7\xf0\x7e\x83\xa0\xb4\xe4\xa5\xee\x50\x29\x54\x20\x6a\xd0\x1b\x84\x81\xd2\xaf\x78\x1e\x0a\xd8\x9a\xf1\x4e\x69\x43\xa7\x7a\x2c\x73\x50\xa8\x61\xc7\xf5\x06\xae\x50\xff\xc7\xee\xc4\x77\x9e\x7e\x51\x0a\xa2\x4e\x17\x00\x8f\x1f\xc3\xe4\xf5\x45\x5d\x43\xc5\x15\xbb\x6e\x50\xdd\x27\x7a\x01\xf3\xfb\x57\x90\x24\x27\xd8\xbf\x11\x6b\x68\xc4\x5a\x1d\xd9\xb0\xdb\xf0\x72\x03\x95\x80\x4e\x68\x68\x99\x2e\x37\xc1\x9c\x39\x21\xc4\x65\x05\x49\x23\xd6\xa7\xe4\xfc\xcc\x64\xf7\x7f\x0a\xca\x81\x75\x15\xb0\xaa\x52\xc0\x80\xf6\xf3\x6e\x0d\x1b\x64\x15\x4a\xd0\x82\xc8\xda\x39\x5d\x88\x12\x56\x90\xec\x98\xec\x4e\x69\xf3\x8a\xf1\xe6\xab\xb4\x91\xd8\x37\xac\xb4\x91\x68\x6d\x4c\x19\xfc\xed\xc9\x13\x40\x29\x85\x9c\x53\xc7\x88\x5a\x41\x52\x33\xde\x24\x8b\x6c\x41\x59\x75\xc9\xa4\xc2\x09\x25\xf4\xb4\x6c\x58\x47\x39\xf7\x05\x19\xb7\x24\x53\x72\x20\x83\x73\x20\x39\x39\x08\x09\xa2\xae\x61\xb7\xc1\x0e\xb0\xed\xf5\xed\xa2\xde\x76\xe5\x09\xc1\xe9\x0d\x6b\xb6\x36\xcf\xbb\x75\x06\xa9\xfd\x23\xb7\x36\x65\xb0\x5f\x00\xa8\x1d\xa7\x1c\x88\xd4\x5a\xae\xdc\x06\x55\xbc\x17\x6f\xc4\x0e\xa5\x65\x93\x3d\x8d\xa9\x68\x6f\xc9\x14\x4e\xfd\x72\x51\xd7\xf9\x74\xf5\x8d\x58\xcf\xac\x52\x48\x67\x96\xc9\xb5\xcb\x05\x00\x80\x44\xbd\x95\x5d\x24\x38\x87\x8e\x37\x5e\x76\x22\xea\x3a\x19\x11\x4e\x58\x19\x6d\xec\x96\x0a\x6b\xb6\x6d\xf4\x17\xd0\xd7\xad\x2e\xfe\x49\x3e\xaa\xd3\x84\x77\x26\x40\xb1\xed\x67\x9f\xa1\x16\xf2\xbe\xe0\xe5\x80\xbf\xf5\x58\x6a\xac\x8e\xa3\xe8\x82\x98\xe4\xb4\x7d\x8b\xd9\x02\xe0\xb0\x38\x98\x04\x9a\xeb\x23\xd4\x65\xbe\x22\x79\x66\xda\x55\x8b\xac\xd3\x46\xf7\x0a\x6f\xb0\x11\x7d\x8b\x9d\x26\xb1\x54\x8f\xa5\xe8\xb4\x64\xa5\x06\x8d\x4a\xab\xe5\xb7\xe8\x28\x27\x22\x6e\x24\x36\x4a\xdc\xdd\x02\x6c\x97\x98\x30\xa0\xdc\xb8\xa7\x5a\x8b\xc5\xe3\xc7\xbe\xc7\x47\xee\x90\x68\xaa\xdf\xb9\x0c\x2b\xd8\x76\x9a\x37\xc0\x35\x70\x05\x25\x6b\x1a\xac\x0a\x78\xd6\xc1\x4c\xc4\xb9\x02\x89\xbf\xda\x60\x92\x56\x0d\xb2\x1b\x57\xd0\xe5\x56\x4a\xec\x34\x88\x0e\x49\xe6\xb6\x2b\x37\xac\x5b\x13\xab\x73\x72\xc7\x2d\x5c\xa3\x63\x4e\x9e\x6a\xd0\x6c\x7a\x76\x79\x4e\x42\xcd\x90\xaa\x0a\x5b\xc2\xe9\x7e\x5f\xbc\xc3\x12\xf9\x0d\xca\xb7\xac\xc5\xc3\x01\x1e\xed\xf7\xd0\x33\x55\xb2\x86\xff\x17\xa1\xa0\x55\x38\x1c\x9e\x5d\x9e\x67\xb3\x03\xe7\xa8\xdc\x4d\x95\xdb\x22\x0f\x96\x98\xda\x87\xe5\xea\xce\x86\x41\x09\xc9\x6b\x43\xf9\x60\x45\xf5\x06\xfb\xb8\x66\x50\x4a\x93\xb1\x00\x13\x8d\x0b\xef\xee\xab\x20\xb1\xb8\xd2\x42\x62\x3a\xa8\x90\x2d\x02\x27\xaa\x4b\x9b\xf7\x13\x4d\x1c\xc9\xb7\xc8\xfb\xaf\x71\xef\xd4\x33\x99\xf3\xeb\xc4\xa1\xbf\x90\x3b\xbf\xc8\x13\x6f\x04\xab\xd2\xac\x70\x6d\x38\xf2\xc3\xc0\xce\xb9\xe3\xe6\x58\x3c\xec\x24\xeb\xad\x33\x5a\x5e\x55\x0d\xee\x98\x1c\x40\x8b\xe8\x51\x9a\x26\xa4\xec\xe4\x9a\xf5\x10\x97\x83\x8f\x72\xf2\xb9\x9d\x89\x94\x86\x9f\x78\xdf\x8f\xf2\x73\x50\x88\xd2\x74\xe2\x8c\x8b\xba\xfe\x1a\xa7\x4e\xac\x4a\xaf\xb7\xbc\xa1\xa9\x3f\xd8\x54\x3c\xb7\x4b\xd9\xcc\x9a\x71\x3d\xaf\xc1\xef\x5a\xc5\xc9\x19\x16\xe3\x8d\x97\x4c\x29\xbd\x91\x62\xbb\xde\x38\x1e\x2e\x73\x9d\xe3\xc9\x88\xb4\xc3\xdf\x34\x6c\xb4\xee\x8b\xd7\xac\xab\x1a\x92\x1d\x3f\x39\xfe\x1b\xf7\xb4\x5c\x79\x51\x66\x63\x16\x57\x46\xbc\xed\x15\xb1\x36\xfc\xe5\xce\x72\xf7\x66\xff\x2c\xb9\x46\x99\x83\x84\x47\x6e\xfd\xf3\x16\x95\xce\x9c\xa0\x38\xbb\xe6\x53\x6b\x26\x39\xdd\x4e\x29\xb6\x1a\x61\x39\xf2\xc1\x8f\x84\x76\xb0\x7a\x47\xaf\x5e\x49\xd1\xa6\xd2\x53\xf3\x3a\x16\xb5\x5a\xcd\x06\x1a\x7e\xff\xdd\xb1\x1d\xb9\x3b\x72\x49\x71\x45\x6d\xec\xf5\xfb\xf7\x97\xa9\xdc\xe5\x10\xd8\x7b\xb7\xb8\xc7\xc3\xc2\xfd\x21\xb1\x14\x92\x42\xb5\x5c\x41\x87\x3b\x2f\xec\x9d\x5b\x4e\xe5\xae\x78\x6d\xc0\x60\x9a\x65\x8b\x93\x92\x1c\xb5\x91\xe7\xa8\x7a\xa6\x37\x23\xf8\x22\x79\x7b\x29\xb1\xe6\xbf\xa5\xc6\x82\xe2\x92\xe9\xcd\x25\xd3\x1a\x69\x12\xdb\xa5\xe7\x4c\x21\x2d\x7b\x49\x28\xe5\x17\x7a\x3d\x95\xc5\x8f\xa8\x37\xa2\xca\x8d\xe0\x3c\xd8\x55\x94\xa2\xc2\xe8\xd1\x8e\xb5\x68\xe1\x5a\x54\xb7\xc5\xf3\x5b\x8d\x6a\xb0\x90\xd7\xd0\x60\x97\x92\xf8\x0c\x56\x2b\x78\x12\xf9\x39\x6c\xdc\x51\xe6\xbc\x17\xa9\xdc\xdd\xe7\xe3\x16\x95\x62\x6b\x34\xa6\xb4\xec\x13\xa6\x1f\x3e\x7a\xf0\xf7\x24\x1f\x24\x79\x36\x04\x09\x7e\x09\xb3\x41\xd2\x00\xa3\x07\x15\x29\x11\x38\xae\x80\xf5\x3d\x76\x55\xea\x57\xcc\x3e\x8b\x97\x06\x7b\x0e\x8b\xd1\x36\xd2\x23\x89\xdb\x34\x54\x02\xd5\x0c\x64\x58\x42\x02\x7f\x0a\x11\xfc\x41\xf0\x2e\x92\x93\x3c\x85\xc4\x0b\xe0\xf5\x4c\x8c\xde\x88\xf5\x1a\x8f\xa6\x16\xfd\x7f\x8a\x32\x4d\xce\x14\x9c\xa9\x25\x9c\xa9\x24\x87\x21\xa0\xb2\xf8\xe9\xdd\x1b\x93\x2e\xb9\xb7\x20\x18\x06\xd8\x28\x8c\x78\x37\x62\x5d\x5c\x4a\xde\xe9\xfa\x6b\xb8\xf9\x80\x4d\x71\xb8\x97\x30\x8f\xb2\x07\x88\x4c\xff\x85\x01\x70\xfe\xd2\x78\x3a\x09\x6f\x78\xed\x12\xfd\xc2\x93\x4c\xbd\x33\xde\xbf\x3a\xde\x50\x9c\xbf\x0c\xa4\x87\x3b\x5c\x6a\x8a\xd3\xa4\xc1\x2b\x21\xd3\x88\x65\x66\xfb\x82\x3b\x77\xa8\xe2\x2d\xee\x52\xd3\xf9\xae\x34\xd3\x5b\x75\xde\x51\x41\xb2\xc6\xec\x97\x86\x41\x0e\x89\x89\x88\xf7\xd6\x89\x74\x9f\x77\x0d\xc1\xc9\xe5\xb4\x78\x6c\x19\x16\xcf\xaa\x2a\x4d\x1c\xe2\x4c\x2c\xd4\xbf\xea\x5d\xf8\xfe\xfc\xfd\xf7\xf0\x1d\x9c\x7d\x9e\x91\x7c\x58\x1c\xb1\x3b\xae\xc5\x43\x0c\xe3\x8f\x75\x82\x72\x83\xe5\x27\xc2\xba\x21\xff\x8f\x47\x37\x30\x0d\xcc\x74\x12\x3f\xd5\xe3\xc3\xe9\x80\x85\x76\xec\x56\x11\x66\x3d\x55\x3f\x24\x9e\x6b\x05\xca\xf8\x16\xa8\x17\x41\xbb\x55\x9a\x70\x68\x85\x65\xc3\x24\x56\xb9\x21\xb7\x0e\x51\x61\xd5\x60\x07\xb1\x25\x35\xdc\x51\xc9\x9e\x4f\xc2\xf6\x70\xdb\x61\x06\xb9\x7d\xa9\x72\x7f\x7e\x60\xf0\xc3\xd5\xc5\x5b\xa0\xe6\x16\x76\x58\xc2\x11\x20\x2b\x37\xd8\x32\x6f\xa2\xf7\x86\x47\xec\xe7\xda\x7b\x2a\x7e\xab\x80\x8d\x17\x3c\x92\x30\xe7\x28\xf3\x66\xcd\xf5\x66\x7b\x5d\x94\xa2\x7d\xbc\x16\xdf\xa9\x1d\xa3\xea\x8e\xff\x0c\xa7\x9b\x9e\x95\x9f\xd8\xda\xf6\x9f\xaf\x42\xde\xc7\xb1\x4d\x5b\x57\xe6\x26\x76\xbe\xcb\x1a\xbf\xf3\x4e\xe7\xfe\x54\x63\x12\xfe\xb5\x1b\x05\xc6\x49\x1f\x3e\x5e\xdf\x6a\xcc\xe0\xc3\xc7\x01\xa9\x4f\xab\xca\xdb\x7c\xd5\x63\x79\xd1\x95\x58\xbc\x14\x29\x69\x9d\x7a\xbc\x70\xf7\x16\x58\x01\xeb\x58\x73\xab\xb8\xad\xbb\x29\x35\xa5\x59\x41\xa4\xb6\x79\x9b\x3c\x0e\x59\x99\x83\xf8\x34\x3f\x0e\x63\x21\x43\xaf\xa0\xca\x8f\xfd\xe1\x8e\x12\x0f\xc4\x27\xd8\xc7\x48\xc9\xd9\xbc\x8f\x4f\xda\x43\x29\x98\x36\x4a\xc8\x93\xe6\x83\x4f\xcf\x24\x1f\x06\xbb\xf8\xa9\xef\xd1\x4b\xca\x9c\x28\x2a\x51\x03\x32\x6e\xd8\x00\x75\xe1\x91\xb1\xcf\x07\xcb\xaa\x13\x24\x05\x4c\xa6\xc6\x5d\x91\xba\x26\xaa\xde\x5b\x3f\x43\xef\x7a\xd7\x0b\x51\x85\x44\x50\x1f\x28\xe8\x1f\x9f\x42\x30\x16\x06\x35\x56\xf0\x90\xfe\x5e\xcc\x8c\x90\x88\x66\x4e\xd0\x4b\x5b\x8b\x0b\xdf\x85\x0e\x8b\xa0\x1f\x11\x8c\x61\xd9\x1d\xee\x8d\x1b\xc2\x59\x35\xe3\x5d\xd2\x3e\x9b\x91\x50\xbc\xc3\xba\xa0\x63\x4c\xb7\x4e\x33\xf2\x53\x92\x04\x69\x4a\x34\x37\x58\x05\xe4\xe0\x7d\x4d\xab\xde\x82\xbb\x53\x2e\x1f\x89\xc9\xbc\xf7\x27\x67\xcf\x89\x69\x28\xe5\x21\xf8\x64\xe4\x44\xaf\x95\x4f\x87\x8e\xb5\x77\x01\xa1\x20\xdf\x16\xa7\x05\x45\x04\x88\x68\xdf\x00\x87\x8e\xc9\x9c\x5e\x44\x14\x61\x22\xf3\x98\x03\xfd\xca\x9c\x27\x95\x90\xda\xb9\x4f\xa5\xf4\x42\x65\x2e\x47\x0d\xc2\x72\xf6\x38\x99\xbf\xe4\x47\x62\xe9\xc9\xcb\xf2\xb1\x32\x6f\x8f\xd4\xf9\x40\x84\x1f\x17\x01\xc2\x06\x8d\x48\x48\x3e\x53\xbf\xc7\x87\x31\x07\xb9\x89\x4d\x0e\x0f\xbd\x28\xdf\xbe\xb2\xa2\x28\x32\xef\x50\x5e\x83\xad\x3c\xca\x3d\xd3\xd7\x2c\xd2\x21\x16\x74\x5c\x20\xaf\x52\x87\x1b\x01\x59\x17\x3e\x52\x67\x26\xc5\xae\xec\x58\x98\xcb\xe5\x91\x1d\x71\x42\x8f\x26\xc2\x86\xd1\x74\x25\xa9\x39\x5c\x6f\x35\x74\xc2\x8f\x1a\x1e\x4d\x38\x0a\xeb\xb8\x0e\x7c\xde\x07\xe3\x7a\x29\xaa\x6d\x89\x27\x0e\x01\x5e\xde\x15\x65\xf0\xa5\x23\x1d\xc1\x1d\x62\xd4\x62\xc5\xd9\xfb\xdb\xde\x1c\xc5\x3a\x21\x5b\x33\x46\xbc\xa7\x7f\xf4\x6f\x53\x07\x49\xfe\x85\x3a\x95\x74\x19\xd5\xfa\x70\xbe\x10\x9d\xc6\x4e\x13\x51\xe6\x5a\x28\x39\xd5\xeb\x96\xc1\x3f\xe0\x09\x3c\x7c\x08\x0f\x68\xac\xd1\x70\x9d\xf2\xf6\xb4\xf9\xa0\x8d\x9f\x18\x73\x09\x72\xec\x58\x62\x4c\xd7\x5a\x9a\xac\x38\xfb\xec\xdb\x85\xe3\x5a\xc1\xf5\xed\x18\xbe\x24\xb1\x1c\x9f\xfa\xd4\xf9\x7d\xcf\x7e\xe1\x34\x4d\x03\x59\x0e\xc9\xaf\x4a\x74\x49\x36\x8e\x77\xc8\x10\x57\x25\x15\xd3\x0c\x38\x61\xc4\x9a\x95\xb8\x77\x7c\x5d\xc3\x21\x06\xc5\x4f\x5d\xcb\xa4\xda\xb0\x26\xb5\xf1\x7f\x48\x5b\xb2\xa7\x27\x2f\xb0\xee\x34\x9c\x58\x78\x6b\x4d\x8d\x18\x58\xb3\x84\xb3\x9b\xc4\xb4\xb9\x2c\x1b\xa5\x2f\xa1\xa4\xe5\x2a\x5c\x6d\xd0\x8c\xb5\xb9\xec\x60\x82\x90\x43\x83\xb1\x2f\x72\xb8\xa7\x21\x26\xc9\x1c\xc9\x2b\x4a\x23\x4d\x27\xc6\x70\x12\x4d\x9d\x9d\x4e\x8d\x91\xa9\x73\x31\xb6\x74\xd6\x5a\x15\x0a\x7a\xec\xf8\xf9\xfb\x27\x9b\x95\x03\x36\x33\x58\xd5\x01\x9b\x7b\xe1\x23\xdd\x30\xa1\xb6\x48\xd6\x6c\x25\x78\x1a\x5e\x19\x51\xdf\xe2\x36\x29\x6a\x60\x0e\x28\xe4\x43\xe1\x5b\x14\xe0\xe1\xd7\x14\x92\x8d\x51\x98\x55\x6e\xb9\x72\x84\xa6\x40\x7d\x4b\xe7\xb5\x7b\xbd\x8a\x06\x21\xaf\x83\x28\x3f\xaf\xc7\xbd\x2c\x38\x79\x6e\x32\x0f\x60\x3c\x82\x3d\x0a\x75\xe2\x06\xc9\x78\xce\x85\x9b\xd3\x3b\x6a\x04\x4c\x46\x6e\x71\xf8\xc0\x13\xd4\xa3\x02\x1d\xbe\xde\x24\xb4\x69\x8d\xd2\x7d\x45\xe1\xc3\x24\xdf\xb1\x75\xf1\x42\x74\x37\x28\xf5\x79\xa7\xff\xfe\xd7\xe1\x6a\xf8\xcb\x07\x34\xa5\xd9\xb9\xbd\x4d\x27\xb1\x26\x36\x39\x24\xd6\xd6\x64\x08\x4f\x41\x6f\xfd\xd7\x90\xd8\x5a\x4a\x70\x58\x01\x0f\xea\x76\xdb\xf6\x3a\x68\x5b\xcf\x6a\xfb\xaa\x11\xec\x0f\xd6\xb7\x0e\xfa\x5e\x0b\xd1\x20\xeb\x9c\xc2\xd7\xb3\x0a\x3f\x17\xa2\xf9\x23\xb5\xbd\x0e\xda\x32\x29\xd9\xad\xd3\xd5\x28\xa4\x82\xae\x57\x7d\xc3\xf5\xf3\x5b\xdb\x85\xac\xb6\x91\x84\x17\xa2\x69\xb0\x74\x07\x80\x96\x69\x67\x88\xc6\x36\x06\x5e\x51\x82\x06\xf4\x65\x38\xf9\x8b\x28\x87\x80\xb8\xc6\x76\x40\x40\x4e\x11\xef\x07\x7a\x19\x75\x36\xf3\x98\x03\xfd\xca\xa6\xa6\xd1\xb2\xba\xaf\x5f\xdb\x0e\x30\xf4\x6b\xeb\x47\x6f\xdb\x37\xe8\xc5\x2e\x74\xa3\xf6\x3b\x6e\xbd\xee\x43\x88\xe9\x82\x77\xe0\x85\x30\x3a\x5d\x83\x1b\x7d\x93\x70\xac\x86\x33\x92\xfd\x76\x1b\x9e\x25\x6f\xaf\x7a\x56\x62\x58\x31\x21\x7d\x3b\x9a\xc7\x4f\x93\x1c\xfe\x92\x7d\x78\xf2\x31\xcb\x82\x3e\xa7\x21\x46\xd8\xa9\x60\xb8\x5e\x9c\xea\x48\x35\x00\xfb\x01\xe0\xb6\x7a\x08\x6e\xc4\x22\x74\xd2\xbb\x1c\xa0\x33\xea\xab\x83\x8c\xa3\xea\xd0\x72\x8b\xa3\x83\x92\x5b\xaf\x59\xa3\xd0\xcd\x36\x79\x74\xdf\x0c\xd7\xdb\xba\x46\x39\xba\x9c\x19\x7d\x19\xa4\xa1\x87\xd5\xc2\x20\xa1\xc9\x66\xa5\xe5\xb6\xd4\xc6\x3c\xd7\xc2\x49\x9d\x78\xb4\x50\x75\x11\xd6\x74\x3f\xbc\xd3\x0b\xb0\xb7\x24\xee\x87\x6e\x01\x54\xf1\xdc\x68\xb1\x00\xd8\x49\xa1\xfd\xc0\x25\xd7\x0d\x89\x31\x73\x57\x3e\x37\xc9\x1e\x4d\x94\xdc\x2f\x86\x8b\x2b\xf2\xfd\xc3\x63\x8a\xbd\xe5\xb3\xb4\x95\x1a\x71\xb3\x47\x24\x77\x06\xc8\x2c\x56\x5e\x42\x74\x79\x77\xf1\xef\x83\x8b\xac\x2d\x9b\xa1\x6d\xd8\xea\x75\x0a\xee\x17\xa3\xcb\x33\xbb\x6a\xcf\x2c\x43\x2d\xfb\x24\x4a\x3b\xde\x64\x9e\xd5\x14\xa7\x78\x2e\xc1\x31\xa9\x9c\xda\x9c\x81\xc3\x03\xfe\x63\xce\xa0\x87\x67\xe3\xb4\xb8\x87\x8d\xf9\x5a\xe3\x78\xf9\x4b\x9d\xcc\x7f\x89\x7a\x20\x8b\x38\x5c\xce\x4a\xf3\x01\x00\x56\xc6\x59\x6e\x25\xa6\x5a\xf9\x34\x3d\x7c\x89\xe8\xb4\x0f\x17\x45\xa9\xb9\x4f\x8a\xfe\xf1\xc6\x09\xbe\xc1\x40\xca\xb2\xc2\x71\xc9\x5c\xfa\xbb\x7b\x4b\xfb\x9b\xb0\x1c\xba\x02\xc0\x2a\xe4\xff\x5d\x4a\x0d\xf7\x9e\x73\x5f\xb5\xb2\xb8\x12\xa8\xca\x87\x6f\x39\x51\x9a\x9c\x48\x0f\x5e\x53\x7b\xb0\x57\x2e\xe3\x1c\x79\x1a\xdd\x20\x79\xf6\xc5\x4b\x6c\x02\x2a\x1b\x2a\xfe\x74\x2a\xca\x62\x24\xed\x28\x07\x2d\xb5\xcf\xb4\x5d\x11\xc7\xdd\x46\xd4\xa1\xbf\xe8\x3b\x49\xe4\x60\xfb\x55\x66\xe6\xcc\x11\x7f\x1e\x28\xc5\xb6\xa9\x0c\xba\x33\x5e\x1c\xdd\x69\x46\x27\x0c\x97\x1a\xfb\x3d\x60\x57\xc1\xe1\xb0\xf8\xdf\x00\x13\x2f\x84\x52\xd8\x26\x00\x00")
+
+func templatesServerValidationGotmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServerValidationGotmpl,
+		"templates/server/validation.gotmpl",
+	)
+}
+
+func templatesServerValidationGotmpl() (*asset, error) {
+	bytes, err := templatesServerValidationGotmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server/validation.gotmpl", size: 9944, mode: os.FileMode(420), modTime: time.Unix(1482416923, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _templatesStructfieldGotmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x54\x4b\x8b\xdb\x30\x10\xbe\xfb\x57\x0c\x22\x87\x18\x6a\xe7\x9e\x5b\x9f\x34\xd0\x36\xd0\x84\xd2\x63\x84\x3c\x4e\x55\xf4\xaa\x24\x97\xf5\x0a\xfd\xf7\x45\x8e\xf3\xf0\xe2\x24\xec\x2e\x4b\xf6\x26\x34\xf3\xf9\x7b\x8c\xc6\x21\x40\x85\x35\x57\x08\xc4\x79\xdb\x30\x5f\x73\x14\x15\x81\x18\x33\x80\x10\x0a\xe0\x35\x28\xed\x61\x52\x2e\xdc\x07\xea\x70\xdd\x1a\x84\xa2\xab\x02\xcc\x66\x10\x02\x78\x94\x46\x50\x8f\x40\x2a\xcd\x9c\xb7\x5c\x6d\x09\x94\xd0\xf7\xa4\x6f\x1c\x3b\x8c\xd5\x06\xad\x6f\x7f\x51\xc1\x2b\xea\xb9\x56\x9f\x34\x5b\xed\x31\x07\x52\x54\x55\x8c\x59\x08\x60\xa8\x63\x54\xf0\x7b\x84\xf2\x07\x95\x18\xe3\x90\xd0\xb1\x3f\x28\x69\xd2\xb4\x63\x84\xcd\x5f\xa7\xd5\x9c\x64\xbd\xf2\x49\xf9\x95\x3e\x96\x5d\x74\x45\x14\x0e\x8f\x26\xcb\xa5\xe5\x5b\xae\xa8\x48\x24\x03\xef\x54\x55\x30\x4d\x01\x94\x3f\xf1\x5f\xc3\x2d\x56\x39\x4c\xb5\xed\xef\x16\xee\xbd\xb5\xb4\xcd\xd3\xe9\xb3\x34\xbe\x5d\x4a\xee\x7d\xea\x89\xf1\x9d\x96\x3c\x29\xf5\x6d\x08\xc9\x10\x74\x8e\x8a\xfe\x78\x90\x58\xfe\xfe\xfe\xad\x67\x85\x3b\x29\xe6\x24\x84\xd3\x3b\x32\x04\x27\xc0\xc7\xc6\x79\x2d\xd7\x74\x0b\xbb\x38\x06\x17\x87\xf6\x4d\x76\x44\x76\xd0\xfd\x98\x7d\x63\x04\xde\x78\xca\x43\x53\xcf\x1c\x72\x41\x9e\x1a\x49\xb2\xc2\xba\x0a\x38\xb4\xbc\xe3\xb4\xe7\x72\x3a\x59\x87\x45\x4d\x19\xbe\x81\x9d\x80\x33\x4b\x31\xcd\x2f\x27\x96\xad\xd0\x8f\xe2\x2e\xa2\xf2\xc1\x98\x46\xde\xcf\x2d\x63\x81\xeb\xaf\xe8\xf5\x53\x19\xbc\x17\x63\xf9\xff\xf1\x5f\x28\xa3\x12\x4f\x09\xbe\xa4\xfa\x15\x6d\x17\x48\x46\x17\xf8\x65\x1c\x0f\x01\x00\x00\xff\xff\xce\x54\xf7\x99\x06\x06\x00\x00")
 
 func templatesStructfieldGotmplBytes() ([]byte, error) {
@@ -729,12 +1086,25 @@ func AssetNames() []string {
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
 	"templates/additionalpropertiesserializer.gotmpl": templatesAdditionalpropertiesserializerGotmpl,
+	"templates/cli/cli.gotmpl": templatesCliCliGotmpl,
+	"templates/cli/commands.gotmpl": templatesCliCommandsGotmpl,
+	"templates/cli/main.gotmpl": templatesCliMainGotmpl,
+	"templates/cli/params.gotmpl": templatesCliParamsGotmpl,
+	"templates/client/auth.gotmpl": templatesClientAuthGotmpl,
+	"templates/client/cassette.gotmpl": templatesClientCassetteGotmpl,
 	"templates/client/client.gotmpl": templatesClientClientGotmpl,
 	"templates/client/facade.gotmpl": templatesClientFacadeGotmpl,
+	"templates/client/files.gotmpl": templatesClientFilesGotmpl,
+	"templates/client/interceptors.gotmpl": templatesClientInterceptorsGotmpl,
+	"templates/client/mock.gotmpl": templatesClientMockGotmpl,
+	"templates/client/options.gotmpl": templatesClientOptionsGotmpl,
 	"templates/client/parameter.gotmpl": templatesClientParameterGotmpl,
 	"templates/client/response.gotmpl": templatesClientResponseGotmpl,
+	"templates/client/retry.gotmpl": templatesClientRetryGotmpl,
+	"templates/client/signature.gotmpl": templatesClientSignatureGotmpl,
 	"templates/docstring.gotmpl": templatesDocstringGotmpl,
 	"templates/header.gotmpl": templatesHeaderGotmpl,
+	"templates/markdown/docs.gotmpl": templatesMarkdownDocsGotmpl,
 	"templates/model.gotmpl": templatesModelGotmpl,
 	"templates/modelvalidator.gotmpl": templatesModelvalidatorGotmpl,
 	"templates/schema.gotmpl": templatesSchemaGotmpl,
@@ -744,12 +1114,16 @@ var _bindata = map[string]func() (*asset, error){
 	"templates/server/builder.gotmpl": templatesServerBuilderGotmpl,
 	"templates/server/configureapi.gotmpl": templatesServerConfigureapiGotmpl,
 	"templates/server/doc.gotmpl": templatesServerDocGotmpl,
+	"templates/server/handlers.gotmpl": templatesServerHandlersGotmpl,
+	"templates/server/health.gotmpl": templatesServerHealthGotmpl,
 	"templates/server/main.gotmpl": templatesServerMainGotmpl,
+	"templates/server/metrics.gotmpl": templatesServerMetricsGotmpl,
 	"templates/server/operation.gotmpl": templatesServerOperationGotmpl,
 	"templates/server/parameter.gotmpl": templatesServerParameterGotmpl,
 	"templates/server/responses.gotmpl": templatesServerResponsesGotmpl,
 	"templates/server/server.gotmpl": templatesServerServerGotmpl,
 	"templates/server/urlbuilder.gotmpl": templatesServerUrlbuilderGotmpl,
+	"templates/server/validation.gotmpl": templatesServerValidationGotmpl,
 	"templates/structfield.gotmpl": templatesStructfieldGotmpl,
 	"templates/swagger_json_embed.gotmpl": templatesSwagger_json_embedGotmpl,
 	"templates/tuplefield.gotmpl": templatesTuplefieldGotmpl,
@@ -801,14 +1175,31 @@ type bintree struct {
 var _bintree = &bintree{nil, map[string]*bintree{
 	"templates": &bintree{nil, map[string]*bintree{
 		"additionalpropertiesserializer.gotmpl": &bintree{templatesAdditionalpropertiesserializerGotmpl, map[string]*bintree{}},
+		"cli": &bintree{nil, map[string]*bintree{
+			"cli.gotmpl": &bintree{templatesCliCliGotmpl, map[string]*bintree{}},
+			"commands.gotmpl": &bintree{templatesCliCommandsGotmpl, map[string]*bintree{}},
+			"main.gotmpl": &bintree{templatesCliMainGotmpl, map[string]*bintree{}},
+			"params.gotmpl": &bintree{templatesCliParamsGotmpl, map[string]*bintree{}},
+		}},
 		"client": &bintree{nil, map[string]*bintree{
+			"auth.gotmpl": &bintree{templatesClientAuthGotmpl, map[string]*bintree{}},
+			"cassette.gotmpl": &bintree{templatesClientCassetteGotmpl, map[string]*bintree{}},
 			"client.gotmpl": &bintree{templatesClientClientGotmpl, map[string]*bintree{}},
 			"facade.gotmpl": &bintree{templatesClientFacadeGotmpl, map[string]*bintree{}},
+			"files.gotmpl": &bintree{templatesClientFilesGotmpl, map[string]*bintree{}},
+			"interceptors.gotmpl": &bintree{templatesClientInterceptorsGotmpl, map[string]*bintree{}},
+			"mock.gotmpl": &bintree{templatesClientMockGotmpl, map[string]*bintree{}},
+			"options.gotmpl": &bintree{templatesClientOptionsGotmpl, map[string]*bintree{}},
 			"parameter.gotmpl": &bintree{templatesClientParameterGotmpl, map[string]*bintree{}},
 			"response.gotmpl": &bintree{templatesClientResponseGotmpl, map[string]*bintree{}},
+			"retry.gotmpl": &bintree{templatesClientRetryGotmpl, map[string]*bintree{}},
+			"signature.gotmpl": &bintree{templatesClientSignatureGotmpl, map[string]*bintree{}},
 		}},
 		"docstring.gotmpl": &bintree{templatesDocstringGotmpl, map[string]*bintree{}},
 		"header.gotmpl": &bintree{templatesHeaderGotmpl, map[string]*bintree{}},
+		"markdown": &bintree{nil, map[string]*bintree{
+			"docs.gotmpl": &bintree{templatesMarkdownDocsGotmpl, map[string]*bintree{}},
+		}},
 		"model.gotmpl": &bintree{templatesModelGotmpl, map[string]*bintree{}},
 		"modelvalidator.gotmpl": &bintree{templatesModelvalidatorGotmpl, map[string]*bintree{}},
 		"schema.gotmpl": &bintree{templatesSchemaGotmpl, map[string]*bintree{}},
@@ -819,12 +1210,16 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"builder.gotmpl": &bintree{templatesServerBuilderGotmpl, map[string]*bintree{}},
 			"configureapi.gotmpl": &bintree{templatesServerConfigureapiGotmpl, map[string]*bintree{}},
 			"doc.gotmpl": &bintree{templatesServerDocGotmpl, map[string]*bintree{}},
+			"handlers.gotmpl": &bintree{templatesServerHandlersGotmpl, map[string]*bintree{}},
+			"health.gotmpl": &bintree{templatesServerHealthGotmpl, map[string]*bintree{}},
 			"main.gotmpl": &bintree{templatesServerMainGotmpl, map[string]*bintree{}},
+			"metrics.gotmpl": &bintree{templatesServerMetricsGotmpl, map[string]*bintree{}},
 			"operation.gotmpl": &bintree{templatesServerOperationGotmpl, map[string]*bintree{}},
 			"parameter.gotmpl": &bintree{templatesServerParameterGotmpl, map[string]*bintree{}},
 			"responses.gotmpl": &bintree{templatesServerResponsesGotmpl, map[string]*bintree{}},
 			"server.gotmpl": &bintree{templatesServerServerGotmpl, map[string]*bintree{}},
 			"urlbuilder.gotmpl": &bintree{templatesServerUrlbuilderGotmpl, map[string]*bintree{}},
+			"validation.gotmpl": &bintree{templatesServerValidationGotmpl, map[string]*bintree{}},
 		}},
 		"structfield.gotmpl": &bintree{templatesStructfieldGotmpl, map[string]*bintree{}},
 		"swagger_json_embed.gotmpl": &bintree{templatesSwagger_json_embedGotmpl, map[string]*bintree{}},
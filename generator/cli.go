@@ -0,0 +1,114 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GenerateCLI generates a command line client for a swagger spec document.
+//
+// The command line is built on the client library, which is generated along with it:
+// it has a command per operation group, with a subcommand per operation.
+func GenerateCLI(name string, modelNames, operationIDs []string, opts *GenOpts) error {
+	if opts == nil {
+		return errors.New("gen opts are required")
+	}
+	opts.IsClient = true
+	CLISectionOpts(opts)
+	return GenerateClient(name, modelNames, operationIDs, opts)
+}
+
+// CLISectionOpts sets the default template layout to render a command line client along with the client library,
+// unless some application or operation group templates are already configured (e.g. from a config file).
+//
+// The commands are rendered in the cli package of the target, and the main function in cmd/{name}-cli.
+func CLISectionOpts(gen *GenOpts) {
+	if len(gen.Sections.Application) > 0 || len(gen.Sections.OperationGroups) > 0 {
+		return
+	}
+	gen.IsClient = true
+	DefaultSectionOpts(gen)
+	gen.Sections.Application = append(gen.Sections.Application,
+		TemplateOpts{
+			Name:     "cli",
+			Source:   "asset:cliCli",
+			Target:   "{{ joinFilePath .Target \"cli\" }}",
+			FileName: "cli.go",
+		},
+		TemplateOpts{
+			Name:     "climain",
+			Source:   "asset:cliMain",
+			Target:   "{{ joinFilePath .Target \"cmd\" (dasherize (pascalize .Name)) }}-cli",
+			FileName: "main.go",
+		},
+	)
+	gen.Sections.OperationGroups = append(gen.Sections.OperationGroups, TemplateOpts{
+		Name:     "clicommands",
+		Source:   "asset:cliCommands",
+		Target:   "{{ joinFilePath .Target \"cli\" }}",
+		FileName: "{{ (snakize (pascalize .Name)) }}_commands.go",
+	})
+}
+
+// cliFlagDescription describes the flag of a param: its description, and how to give its value
+func cliFlagDescription(param GenParameter) string {
+	description := strings.Join(strings.Fields(param.Description), " ")
+	if description == "" && param.Name == param.Location {
+		description = fmt.Sprintf("the %s param", param.Name)
+	} else if description == "" {
+		description = fmt.Sprintf("the %s %s param", param.Name, param.Location)
+	}
+
+	var hint string
+	switch {
+	case param.IsBodyParam() && param.Schema != nil && param.Schema.IsStream:
+		hint = "read from a file, - for the standard input"
+	case param.IsBodyParam():
+		hint = "JSON read from a file, - for the standard input"
+	case param.IsFileParam():
+		hint = "file to upload, - for the standard input"
+	case param.IsFileArrayParam():
+		hint = "files to upload, repeatable, - for the standard input"
+	case param.IsArray:
+		switch param.CollectionFormat {
+		case "multi":
+			hint = "repeatable"
+		case "ssv":
+			hint = "repeatable, values may be separated by spaces"
+		case "tsv":
+			hint = "repeatable, values may be separated by tabs"
+		case "pipes":
+			hint = "repeatable, values may be separated by |"
+		default:
+			hint = "repeatable, values may be separated by commas"
+		}
+	case param.IsCustomFormatter && param.SwaggerFormat != "":
+		hint = param.SwaggerFormat
+	default:
+		hint = param.GoType
+	}
+	return description + " (" + hint + ")"
+}
+
+// cliTagValue quotes a value for a struct tag of a flag, e.g. a description or a choice.
+//
+// Backquotes are escaped, since the struct tags are rendered as raw strings.
+func cliTagValue(value interface{}) string {
+	return strings.Replace(strconv.Quote(fmt.Sprint(value)), "`", `\x60`, -1)
+}
@@ -0,0 +1,136 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCLI_SectionOpts(t *testing.T) {
+	opts := new(GenOpts)
+	CLISectionOpts(opts)
+	assert.True(t, opts.IsClient)
+	if assert.Len(t, opts.Sections.Application, 3) {
+		assert.Equal(t, "facade", opts.Sections.Application[0].Name)
+		assert.Equal(t, "asset:cliCli", opts.Sections.Application[1].Source)
+		assert.Equal(t, "asset:cliMain", opts.Sections.Application[2].Source)
+	}
+	if assert.Len(t, opts.Sections.OperationGroups, 2) {
+		assert.Equal(t, "asset:cliCommands", opts.Sections.OperationGroups[1].Source)
+	}
+
+	// a layout from a config file is kept as is
+	opts = new(GenOpts)
+	opts.Sections.Application = []TemplateOpts{{Name: "custom", Source: "asset:clientFacade"}}
+	CLISectionOpts(opts)
+	assert.Len(t, opts.Sections.Application, 1)
+	assert.Empty(t, opts.Sections.OperationGroups)
+
+	assert.Error(t, GenerateCLI("todo", nil, nil, nil))
+}
+
+func TestCLI_FlagDescription(t *testing.T) {
+	param := GenParameter{Name: "tags", Location: "query", Description: "the tags\nof the `tasks`", CollectionFormat: "pipes"}
+	param.IsArray = true
+	assert.Equal(t, "the tags of the `tasks` (repeatable, values may be separated by |)", cliFlagDescription(param))
+	assert.Equal(t, "\"the tags of the \\x60tasks\\x60 (repeatable, values may be separated by |)\"", cliTagValue(cliFlagDescription(param)))
+
+	param = GenParameter{Name: "body", Location: "body"}
+	assert.Equal(t, "the body param (JSON read from a file, - for the standard input)", cliFlagDescription(param))
+
+	param = GenParameter{Name: "since", Location: "query"}
+	param.GoType = "strfmt.DateTime"
+	param.IsCustomFormatter = true
+	param.SwaggerFormat = "date-time"
+	assert.Equal(t, "the since query param (date-time)", cliFlagDescription(param))
+}
+
+func TestCLI_Commands(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	opts := testGenOpts()
+	opts.Spec = "../fixtures/codegen/todolist.cli.yml"
+	opts.IsClient = true
+	appGen, err := newAppGenerator("todo", nil, nil, &opts)
+	if assert.NoError(t, err) {
+		app, err := appGen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("cliCli").Execute(buf, app)) {
+				ff, err := appGen.GenOpts.LanguageOpts.FormatContent("cli.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "package cli", res)
+					assertInCode(t, `Scheme   string        `+"`"+`long:"scheme" env:"TODO_SCHEME" description:"the scheme of the API" choice:"http" default:"http"`+"`", res)
+					assertInCode(t, `Output   string        `+"`"+`long:"output" short:"o"`, res)
+					assertInCode(t, `BasicUsername string `+"`"+`long:"basic-username" env:"TODO_BASIC_USERNAME"`, res)
+					assertInCode(t, `APIKey string `+"`"+`long:"api-key" env:"TODO_API_KEY"`, res)
+					assertInCode(t, `PetstoreAuthToken string `+"`"+`long:"petstore-auth-token"`, res)
+					assertInCode(t, `*TasksCommands       `+"`"+`command:"tasks" description:"the tasks of the list"`+"`", res)
+					assertInCode(t, `Attachments *AttachmentsCommands `+"`"+`command:"attachments" description:"the attachments commands"`+"`", res)
+					assertInCode(t, "credentials[apiclient.BasicScheme] = apiclient.BasicAuth(a.BasicUsername, a.BasicPassword)", res)
+					assertInCode(t, "credentials[apiclient.PetstoreAuthScheme] = httptransport.BearerToken(a.PetstoreAuthToken)", res)
+					assertInCode(t, "a.client = apiclient.New(apiclient.WithCredentials(rt, credentials), strfmt.Default)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			buf = bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("cliMain").Execute(buf, app)) {
+				ff, err := appGen.GenOpts.LanguageOpts.FormatContent("main.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					assertInCode(t, "cli.New().Parser().Parse()", string(ff))
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			for _, group := range app.OperationGroups {
+				if group.Name != "tasks" {
+					continue
+				}
+				buf := bytes.NewBuffer(nil)
+				if assert.NoError(t, templates.MustGet("cliCommands").Execute(buf, group)) {
+					ff, err := appGen.GenOpts.LanguageOpts.FormatContent("tasks_commands.go", buf.Bytes())
+					if assert.NoError(t, err) {
+						res := string(ff)
+						assertInCode(t, `ListTasks  *ListTasksCommand  `+"`"+`command:"list-tasks" description:"lists the tasks"`+"`", res)
+						assertInCode(t, `Status     *string  `+"`"+`long:"status" choice:"open" choice:"closed"`, res)
+						assertInCode(t, `Completed  *string  `+"`"+`long:"completed" choice:"true" choice:"false"`, res)
+						assertInCode(t, `Limit      *int32   `+"`"+`long:"limit" description:"the limit query param (int32)"`+"`", res)
+						assertInCode(t, `\x60any\x60`, res)
+						assertInCode(t, `if err := convertValues(c.Ids, "pipes", &params.Ids); err != nil {`, res)
+						assertInCode(t, "if err := convertValue(*c.Since, &params.Since); err != nil {", res)
+						assertInCode(t, `Body string `+"`"+`long:"body"`, res)
+						assertInCode(t, "if err := json.Unmarshal(data, &params.Body); err != nil {", res)
+						assertInCode(t, `ID *int64 `+"`"+`long:"id" required:"yes"`, res)
+						assertInCode(t, "res0, err := c.app.Client().Tasks.GetTask(params, nil)", res)
+						assertInCode(t, "return c.app.print(res0.Payload)", res)
+					} else {
+						fmt.Println(buf.String())
+					}
+				}
+			}
+		}
+	}
+}
@@ -151,77 +151,60 @@ func (c *clientGenerator) Generate() error {
 		return nil
 	}
 
-	// errChan := make(chan error, 100)
-	// wg := nsync.NewControlWaitGroup(20)
-
+	// models and operations are rendered in parallel, the template repository being safe for concurrent use
 	if c.GenOpts.IncludeModel {
+		pool := newWorkerPool(c.GenOpts.Concurrency)
 		for _, mod := range app.Models {
-			// if len(errChan) > 0 {
-			// 	wg.Wait()
-			// 	return <-errChan
-			// }
+			if mod.IsStream {
+				continue
+			}
 			modCopy := mod
-			// wg.Do(func() {
 			modCopy.IncludeValidator = true
-			if !mod.IsStream {
-				if err := c.GenOpts.renderDefinition(&modCopy); err != nil {
-					return err
-				}
-			}
-			// })
+			pool.Do(func() error {
+				return c.GenOpts.renderDefinition(&modCopy)
+			})
+		}
+		if err := pool.Wait(); err != nil {
+			return err
 		}
 	}
 
-	// wg.Wait()
 	if c.GenOpts.IncludeHandler {
 		sort.Sort(app.OperationGroups)
+		pool := newWorkerPool(c.GenOpts.Concurrency)
 		for i := range app.OperationGroups {
 			opGroup := app.OperationGroups[i]
-			opGroup.DefaultImports = app.DefaultImports
+			// each operation group gets its own copy of the imports known so far
+			opGroup.DefaultImports = append([]string{}, app.DefaultImports...)
 			opGroup.RootPackage = c.ClientPackage
 			opGroup.GenOpts = c.GenOpts
 			app.OperationGroups[i] = opGroup
 			sort.Sort(opGroup.Operations)
 			for _, op := range opGroup.Operations {
-				// if len(errChan) > 0 {
-				// 	wg.Wait()
-				// 	return <-errChan
-				// }
 				opCopy := op
 				if opCopy.Package == "" {
 					opCopy.Package = c.Package
 				}
-				// wg.Do(func() {
-				if err := c.GenOpts.renderOperation(&opCopy); err != nil {
-					return err
-				}
-				// })
+				pool.Do(func() error {
+					return c.GenOpts.renderOperation(&opCopy)
+				})
 			}
 			app.DefaultImports = append(app.DefaultImports, filepath.ToSlash(filepath.Join(baseImport, c.ClientPackage, opGroup.Name)))
 
-			// wg.Do(func() {
-			if err := c.GenOpts.renderOperationGroup(&opGroup); err != nil {
-				// errChan <- err
-				return err
-			}
-			// })
+			pool.Do(func() error {
+				return c.GenOpts.renderOperationGroup(&opGroup)
+			})
+		}
+		if err := pool.Wait(); err != nil {
+			return err
 		}
-		// wg.Wait()
 	}
 
 	if c.GenOpts.IncludeSupport {
-		// wg.Do(func() {
 		if err := c.GenOpts.renderApplication(&app); err != nil {
 			return err
 		}
-		// })
 	}
 
-	// wg.Wait()
-
-	// if len(errChan) > 0 {
-	// 	return <-errChan
-	// }
-
 	return nil
 }
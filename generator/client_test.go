@@ -15,6 +15,8 @@
 package generator
 
 import (
+	"bytes"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
@@ -46,3 +48,445 @@ func TestClient_BaseImportDisabled(t *testing.T) {
 	opts.LanguageOpts.BaseImportFunc = nil
 	assert.NoError(t, GenerateClient("foo", nil, nil, &opts))
 }
+
+func TestClient_APIErrorHelpers(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	opts := testGenOpts()
+	opts.Spec = "../fixtures/codegen/todolist.responses.yml"
+	opts.IsClient = true
+	appGen, err := newAppGenerator("todo", nil, nil, &opts)
+	if assert.NoError(t, err) {
+		app, err := appGen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("clientFacade").Execute(buf, app)) {
+				ff, err := appGen.GenOpts.LanguageOpts.FormatContent("todo_client.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "type APIError interface {", res)
+					assertInCode(t, "func StatusCode(err error) int {", res)
+					assertInCode(t, "case *runtime.APIError:", res)
+					assertInCode(t, "func IsNotFound(err error) bool {\n\treturn StatusCode(err) == http.StatusNotFound\n}", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestClient_RetryPolicy(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	opts := testGenOpts()
+	opts.Spec = "../fixtures/codegen/todolist.retry.yml"
+	opts.IsClient = true
+	appGen, err := newAppGenerator("todo", nil, nil, &opts)
+	if assert.NoError(t, err) {
+		app, err := appGen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("clientFacade").Execute(buf, app)) {
+				ff, err := appGen.GenOpts.LanguageOpts.FormatContent("todo_client.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "type RetryPolicy struct {", res)
+					assertInCode(t, "func DefaultRetryPolicy() *RetryPolicy {", res)
+					assertInCode(t, "Retry *RetryPolicy", res)
+					assertInCode(t, "func (cfg *TransportConfig) WithRetry(policy *RetryPolicy) *TransportConfig {", res)
+					assertInCode(t, "transport = WithRetry(transport, cfg.Retry)", res)
+					assertInCode(t, "\"createTask\":       true,", res)
+					assertInCode(t, "\"uploadAttachment\": true,", res)
+					assertInCode(t, "\"replaceTasks\":     false,", res)
+					assertNotInCode(t, "\"listTasks\"", res)
+					assertNotInCode(t, "\"appendAttachment\"", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestClient_ClientServiceMock(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	opts := testGenOpts()
+	opts.Spec = "../fixtures/codegen/todolist.retry.yml"
+	opts.IsClient = true
+
+	appGen, err := newAppGenerator("todo", nil, nil, &opts)
+	if assert.NoError(t, err) {
+		app, err := appGen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			var group *GenOperationGroup
+			for i := range app.OperationGroups {
+				if app.OperationGroups[i].Name == "tasks" {
+					group = &app.OperationGroups[i]
+				}
+			}
+			if !assert.NotNil(t, group) {
+				return
+			}
+
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("clientClient").Execute(buf, group)) {
+				ff, err := appGen.GenOpts.LanguageOpts.FormatContent("tasks_client.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {", res)
+					assertInCode(t, "type ClientService interface {", res)
+					assertInCode(t, "\tCreateTask(params *CreateTaskParams) (*CreateTaskCreated, error)\n", res)
+					assertInCode(t, "\tSetTransport(transport runtime.ClientTransport)\n", res)
+					assertInCode(t, "func (a *Client) CreateTask(params *CreateTaskParams) (*CreateTaskCreated, error) {", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			buf = bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("clientMock").Execute(buf, group)) {
+				ff, err := appGen.GenOpts.LanguageOpts.FormatContent("tasks_client_mock.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "var _ ClientService = new(ClientServiceMock)", res)
+					assertInCode(t, "CreateTaskFunc func(params *CreateTaskParams) (*CreateTaskCreated, error)", res)
+					assertInCode(t, "func (m *ClientServiceMock) CreateTask(params *CreateTaskParams) (*CreateTaskCreated, error) {", res)
+					assertInCode(t, "m.record(\"CreateTask\", params, nil)", res)
+					assertInCode(t, "func (m *ClientServiceMock) CallsTo(operation string) []ClientServiceMockCall {", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			buf = bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("clientFacade").Execute(buf, app)) {
+				ff, err := appGen.GenOpts.LanguageOpts.FormatContent("todo_client.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					assertInCode(t, "Tasks tasks.ClientService", string(ff))
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestClient_AuthHelpers(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	opts := testGenOpts()
+	opts.Spec = "../fixtures/bugs/1214/fixture-1214.yaml"
+	opts.IsClient = true
+	appGen, err := newAppGenerator("security", nil, nil, &opts)
+	if assert.NoError(t, err) {
+		app, err := appGen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("clientFacade").Execute(buf, app)) {
+				ff, err := appGen.GenOpts.LanguageOpts.FormatContent("security_client.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "AScheme = \"A\"", res)
+					assertInCode(t, "func AAuth(username, password string) runtime.ClientAuthInfoWriter {", res)
+					assertInCode(t, "func BAuth(key string) runtime.ClientAuthInfoWriter {\n\treturn httptransport.APIKeyAuth(\"K1\", \"header\", key)", res)
+					assertInCode(t, "func CAuth(key string) runtime.ClientAuthInfoWriter {\n\treturn httptransport.APIKeyAuth(\"K2\", \"query\", key)", res)
+					assertInCode(t, "var EScopes = []string{\"s0\", \"s1\", \"s2\", \"s3\", \"s4\", \"s5\", \"s6\", \"s7\", \"s8\", \"s9\"}", res)
+					assertInCode(t, "TokenURL: \"https://fake.example.com/token\",", res)
+					assertInCode(t, "func EAuth(source oauth2.TokenSource) runtime.ClientAuthInfoWriter {", res)
+					assertInCode(t, "\"asecOp\": {{\"A\", \"B\", \"E\"}, {\"C\", \"D\", \"E\"}},", res)
+					assertInCode(t, "\"bsecOp\": {{\"A\", \"E\"}, {\"D\", \"E\"}},", res)
+					assertNotInCode(t, "\"csecOp\":", res)
+					assertInCode(t, "transport = WithCredentials(transport, cfg.Credentials)", res)
+					assertInCode(t, "func (cfg *TransportConfig) WithCredentials(scheme string, auth runtime.ClientAuthInfoWriter) *TransportConfig {", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestClient_Pagination(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	opts := testGenOpts()
+	opts.Spec = "../fixtures/codegen/todolist.pagination.yml"
+	opts.IsClient = true
+	opts.FlattenSpec = true
+	appGen, err := newAppGenerator("todo", nil, nil, &opts)
+	if assert.NoError(t, err) {
+		app, err := appGen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			for _, group := range app.OperationGroups {
+				buf := bytes.NewBuffer(nil)
+				if assert.NoError(t, templates.MustGet("clientClient").Execute(buf, group)) {
+					ff, err := appGen.GenOpts.LanguageOpts.FormatContent(group.Name+"_client.go", buf.Bytes())
+					if !assert.NoError(t, err) {
+						fmt.Println(buf.String())
+						continue
+					}
+					res := string(ff)
+					switch group.Name {
+					case "tasks":
+						assertInCode(t, "\tListTasksAll(ctx context.Context, params *ListTasksParams) *ListTasksPager\n", res)
+						assertInCode(t, "func (a *Client) ListTasksAll(ctx context.Context, params *ListTasksParams) *ListTasksPager {", res)
+						assertInCode(t, "if page.Payload != nil {\n\t\ttoken = page.Payload.Next\n\t}", res)
+						assertInCode(t, "p.params.Cursor = &token", res)
+					case "comments":
+						assertInCode(t, "func (a *Client) ListCommentsAll(ctx context.Context, params *ListCommentsParams, authInfo runtime.ClientAuthInfoWriter) *ListCommentsPager {", res)
+						assertInCode(t, "token = page.XNextPage", res)
+						assertInCode(t, "p.params.Page = token", res)
+					}
+				}
+
+				buf = bytes.NewBuffer(nil)
+				if assert.NoError(t, templates.MustGet("clientMock").Execute(buf, group)) {
+					ff, err := appGen.GenOpts.LanguageOpts.FormatContent(group.Name+"_client_mock.go", buf.Bytes())
+					if assert.NoError(t, err) && group.Name == "tasks" {
+						assertInCode(t, "func (m *ClientServiceMock) ListTasksAll(ctx context.Context, params *ListTasksParams) *ListTasksPager {", string(ff))
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestClient_Interceptors(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	opts := testGenOpts()
+	opts.Spec = "../fixtures/codegen/todolist.retry.yml"
+	opts.IsClient = true
+	appGen, err := newAppGenerator("todo", nil, nil, &opts)
+	if assert.NoError(t, err) {
+		app, err := appGen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("clientFacade").Execute(buf, app)) {
+				ff, err := appGen.GenOpts.LanguageOpts.FormatContent("todo_client.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "type Interceptor = func(op *runtime.ClientOperation, next Submitter) (interface{}, error)", res)
+					assertInCode(t, "Interceptors []Interceptor", res)
+					assertInCode(t, "func (cfg *TransportConfig) WithInterceptors(interceptors ...Interceptor) *TransportConfig {", res)
+					assertInCode(t, "cli.Use(cfg.Interceptors...)", res)
+					assertInCode(t, "transport = WithInterceptors(transport, c.interceptors...)", res)
+					assertInCode(t, "func DumpInterceptor(log logger.Logger) Interceptor {", res)
+					assertInCode(t, "func LatencyInterceptor(observe func(operationID string, latency time.Duration)) Interceptor {", res)
+					assertInCode(t, "func NewLatencyHistograms(buckets ...time.Duration) *LatencyHistograms {", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			for _, group := range app.OperationGroups {
+				if group.Name != "tasks" {
+					continue
+				}
+				buf := bytes.NewBuffer(nil)
+				if assert.NoError(t, templates.MustGet("clientClient").Execute(buf, group)) {
+					ff, err := appGen.GenOpts.LanguageOpts.FormatContent("tasks_client.go", buf.Bytes())
+					if assert.NoError(t, err) {
+						res := string(ff)
+						assertInCode(t, "type Interceptor = func(op *runtime.ClientOperation, next func(*runtime.ClientOperation) (interface{}, error)) (interface{}, error)", res)
+						assertInCode(t, "}, params.Interceptors)", res)
+						assertNotInCode(t, "a.transport.Submit(&runtime.ClientOperation{", res)
+					} else {
+						fmt.Println(buf.String())
+					}
+				}
+
+				for _, op := range group.Operations {
+					if op.Name != "createTask" {
+						continue
+					}
+					buf := bytes.NewBuffer(nil)
+					if assert.NoError(t, templates.MustGet("clientParameter").Execute(buf, op)) {
+						ff, err := appGen.GenOpts.LanguageOpts.FormatContent("create_task_parameters.go", buf.Bytes())
+						if assert.NoError(t, err) {
+							res := string(ff)
+							assertInCode(t, "Interceptors []Interceptor", res)
+							assertInCode(t, "func (o *CreateTaskParams) WithInterceptors(interceptors ...Interceptor) *CreateTaskParams {", res)
+						} else {
+							fmt.Println(buf.String())
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestClient_Cassette(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	opts := testGenOpts()
+	opts.Spec = "../fixtures/codegen/todolist.cli.yml"
+	opts.IsClient = true
+	appGen, err := newAppGenerator("todo", nil, nil, &opts)
+	if assert.NoError(t, err) {
+		app, err := appGen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("clientFacade").Execute(buf, app)) {
+				ff, err := appGen.GenOpts.LanguageOpts.FormatContent("todo_client.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, `const CassetteModeEnv = "TODO_CASSETTE_MODE"`, res)
+					assertInCode(t, "func (cfg *TransportConfig) WithCassette(path string, mode CassetteMode) *TransportConfig {", res)
+					assertInCode(t, "cassette := NewCassette(rt, cfg.Cassette, cfg.CassetteMode, formats)", res)
+					assertInCode(t, "func NewCassette(transport runtime.ClientTransport, path string, mode CassetteMode, formats strfmt.Registry) *Cassette {", res)
+					assertInCode(t, "func (c *Cassette) Submit(op *runtime.ClientOperation) (interface{}, error) {", res)
+					assertInCode(t, "func (r *cassetteRequest) SetBodyParam(payload interface{}) error {", res)
+					assertInCode(t, "return op.Reader.ReadResponse(replayedResponse{response}, consumer)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestClient_WithContext(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	opts := testGenOpts()
+	opts.Spec = "../fixtures/codegen/todolist.cli.yml"
+	opts.IsClient = true
+	opts.WithContext = true
+	appGen, err := newAppGenerator("todo", nil, nil, &opts)
+	if assert.NoError(t, err) {
+		app, err := appGen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("clientFacade").Execute(buf, app)) {
+				ff, err := appGen.GenOpts.LanguageOpts.FormatContent("todo_client.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "type ClientOption = func(op *runtime.ClientOperation)", res)
+					assertInCode(t, "func WithAuthInfo(authInfo runtime.ClientAuthInfoWriter) ClientOption {", res)
+					assertInCode(t, "func WithHeader(name string, values ...string) ClientOption {", res)
+					assertInCode(t, "func WithTimeout(timeout time.Duration) ClientOption {", res)
+					assertInCode(t, "func WithHTTPClient(client *http.Client) ClientOption {", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			for _, group := range app.OperationGroups {
+				if group.Name != "tasks" {
+					continue
+				}
+				buf := bytes.NewBuffer(nil)
+				if assert.NoError(t, templates.MustGet("clientClient").Execute(buf, group)) {
+					ff, err := appGen.GenOpts.LanguageOpts.FormatContent("tasks_client.go", buf.Bytes())
+					if assert.NoError(t, err) {
+						res := string(ff)
+						assertInCode(t, "GetTask(ctx context.Context, params *GetTaskParams, opts ...ClientOption) (*GetTaskOK, error)", res)
+						assertInCode(t, "func (a *Client) CreateTask(ctx context.Context, params *CreateTaskParams, opts ...ClientOption) (*CreateTaskCreated, error) {", res)
+						assertInCode(t, "Context:            ctx,", res)
+						assertInCode(t, "opt(op)", res)
+						assertInCode(t, "cancel := withTimeout(op, params.timeout)", res)
+						assertInCode(t, "result, err := a.submit(op, params.Interceptors)", res)
+						assertNotInCode(t, "authInfo runtime.ClientAuthInfoWriter", res)
+					} else {
+						fmt.Println(buf.String())
+					}
+				}
+
+				buf = bytes.NewBuffer(nil)
+				if assert.NoError(t, templates.MustGet("clientMock").Execute(buf, group)) {
+					ff, err := appGen.GenOpts.LanguageOpts.FormatContent("tasks_client_mock.go", buf.Bytes())
+					if assert.NoError(t, err) {
+						res := string(ff)
+						assertInCode(t, "GetTaskFunc func(ctx context.Context, params *GetTaskParams, opts ...ClientOption) (*GetTaskOK, error)", res)
+						assertInCode(t, "return m.GetTaskFunc(ctx, params, opts...)", res)
+						assertInCode(t, `m.record(ctx, "GetTask", params, opts)`, res)
+					} else {
+						fmt.Println(buf.String())
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestClient_Files(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	opts := testGenOpts()
+	opts.Spec = "../fixtures/codegen/todolist.cli.yml"
+	opts.IsClient = true
+	appGen, err := newAppGenerator("todo", nil, nil, &opts)
+	if assert.NoError(t, err) {
+		app, err := appGen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("clientFacade").Execute(buf, app)) {
+				ff, err := appGen.GenOpts.LanguageOpts.FormatContent("todo_client.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "rt.Producers[runtime.MultipartFormMime] = runtime.TextProducer()", res)
+					assertInCode(t, "type ProgressFunc func(transferred, total int64)", res)
+					assertInCode(t, "func NewUploadFile(name string, content io.Reader) *UploadFile {", res)
+					assertInCode(t, "func OpenUploadFile(path string) (*UploadFile, error) {", res)
+					assertInCode(t, "func (f *UploadFile) WithProgress(progress ProgressFunc) *UploadFile {", res)
+					assertInCode(t, "func (f *UploadFile) ContentType() string {", res)
+					assertInCode(t, "func NewProgressWriter(writer io.Writer, total int64, progress ProgressFunc) io.Writer {", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+
+			for _, group := range app.OperationGroups {
+				if group.Name != "attachments" {
+					continue
+				}
+				buf := bytes.NewBuffer(nil)
+				if assert.NoError(t, templates.MustGet("clientClient").Execute(buf, group)) {
+					ff, err := appGen.GenOpts.LanguageOpts.FormatContent("attachments_client.go", buf.Bytes())
+					if assert.NoError(t, err) {
+						res := string(ff)
+						assertInCode(t, "DownloadAttachmentStream(params *DownloadAttachmentParams, authInfo runtime.ClientAuthInfoWriter) (io.ReadCloser, error)", res)
+						assertInCode(t, "func (a *Client) DownloadAttachmentStream(params *DownloadAttachmentParams, authInfo runtime.ClientAuthInfoWriter) (io.ReadCloser, error) {", res)
+						assertInCode(t, "_, err := a.DownloadAttachment(params, authInfo, writer)", res)
+						assertNotInCode(t, "UploadAttachmentStream", res)
+					} else {
+						fmt.Println(buf.String())
+					}
+				}
+
+				buf = bytes.NewBuffer(nil)
+				if assert.NoError(t, templates.MustGet("clientMock").Execute(buf, group)) {
+					ff, err := appGen.GenOpts.LanguageOpts.FormatContent("attachments_client_mock.go", buf.Bytes())
+					if assert.NoError(t, err) {
+						res := string(ff)
+						assertInCode(t, "func (m *ClientServiceMock) DownloadAttachmentStream(params *DownloadAttachmentParams, authInfo runtime.ClientAuthInfoWriter) (io.ReadCloser, error) {", res)
+					} else {
+						fmt.Println(buf.String())
+					}
+				}
+
+				for _, op := range group.Operations {
+					if op.Name != "uploadAttachment" {
+						continue
+					}
+					buf := bytes.NewBuffer(nil)
+					if assert.NoError(t, templates.MustGet("clientParameter").Execute(buf, op)) {
+						ff, err := appGen.GenOpts.LanguageOpts.FormatContent("upload_attachment_parameters.go", buf.Bytes())
+						if assert.NoError(t, err) {
+							res := string(ff)
+							assertInCode(t, "Extras []runtime.NamedReadCloser", res)
+							assertInCode(t, "func (o *UploadAttachmentParams) SetExtras(extras []runtime.NamedReadCloser) {", res)
+							assertInCode(t, `if err := r.SetFileParam("extras", o.Extras...); err != nil {`, res)
+						} else {
+							fmt.Println(buf.String())
+						}
+					}
+				}
+			}
+		}
+	}
+}
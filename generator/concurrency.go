@@ -0,0 +1,94 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// renderErrors aggregates the errors returned by templates rendered in parallel
+type renderErrors []error
+
+func (e renderErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// workerPool runs rendering tasks with a bounded number of goroutines.
+//
+// Once a task has failed, tasks which are not started yet are skipped.
+// All errors from tasks already running are collected.
+type workerPool struct {
+	sem  chan struct{}
+	wg   sync.WaitGroup
+	lock sync.Mutex
+	errs renderErrors
+}
+
+// newWorkerPool builds a pool running at most size tasks at once.
+// When size is zero or negative, the number of CPUs is used.
+func newWorkerPool(size int) *workerPool {
+	if size <= 0 {
+		size = runtime.NumCPU()
+	}
+	return &workerPool{sem: make(chan struct{}, size)}
+}
+
+func (p *workerPool) failed() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return len(p.errs) > 0
+}
+
+// Do schedules a task, waiting for a free worker
+func (p *workerPool) Do(task func() error) {
+	p.sem <- struct{}{}
+	if p.failed() {
+		<-p.sem
+		return
+	}
+	p.wg.Add(1)
+	go func() {
+		defer func() {
+			<-p.sem
+			p.wg.Done()
+		}()
+		if err := task(); err != nil {
+			p.lock.Lock()
+			p.errs = append(p.errs, err)
+			p.lock.Unlock()
+		}
+	}()
+}
+
+// Wait for all scheduled tasks to complete and returns their errors, if any
+func (p *workerPool) Wait() error {
+	p.wg.Wait()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	switch len(p.errs) {
+	case 0:
+		return nil
+	case 1:
+		return p.errs[0]
+	default:
+		return append(renderErrors{}, p.errs...)
+	}
+}
@@ -0,0 +1,61 @@
+package generator
+
+import (
+	"errors"
+	"runtime"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerPool(t *testing.T) {
+	pool := newWorkerPool(3)
+	var count int32
+	for i := 0; i < 50; i++ {
+		pool.Do(func() error {
+			atomic.AddInt32(&count, 1)
+			return nil
+		})
+	}
+	assert.NoError(t, pool.Wait())
+	assert.Equal(t, int32(50), count)
+
+	assert.Equal(t, runtime.NumCPU(), cap(newWorkerPool(0).sem))
+}
+
+func TestWorkerPool_Errors(t *testing.T) {
+	pool := newWorkerPool(1)
+	var count int32
+	pool.Do(func() error {
+		atomic.AddInt32(&count, 1)
+		return errors.New("first failure")
+	})
+	// with a single worker, the first task is complete when the next one is scheduled
+	pool.Do(func() error {
+		atomic.AddInt32(&count, 1)
+		return nil
+	})
+	err := pool.Wait()
+	if assert.Error(t, err) {
+		assert.Equal(t, "first failure", err.Error())
+	}
+	assert.Equal(t, int32(1), count)
+
+	pool = newWorkerPool(2)
+	blocker := make(chan struct{})
+	for i := 0; i < 2; i++ {
+		msg := []string{"failure A", "failure B"}[i]
+		pool.Do(func() error {
+			<-blocker
+			return errors.New(msg)
+		})
+	}
+	close(blocker)
+	err = pool.Wait()
+	if assert.Error(t, err) && assert.IsType(t, renderErrors{}, err) {
+		assert.Len(t, err.(renderErrors), 2)
+		assert.Contains(t, err.Error(), "failure A")
+		assert.Contains(t, err.Error(), "failure B")
+	}
+}
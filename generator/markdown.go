@@ -0,0 +1,264 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const defaultMarkdownFile = "markdown.md"
+
+// GenDocumentation holds the data used to render the reference documentation of an API
+type GenDocumentation struct {
+	GenApp
+	// OperationsByTag lists the operations of the API grouped by tag, in the order of declaration in the spec.
+	// Operations without a tag are grouped in a "default" tag.
+	OperationsByTag GenOperationGroups
+}
+
+// GenerateMarkdown generates the reference documentation of an API as a markdown document.
+//
+// The output is the path of the generated file: when empty, it defaults to "markdown.md" in the target directory.
+func GenerateMarkdown(output string, modelNames, operationIDs []string, opts *GenOpts) error {
+	if opts == nil {
+		return errors.New("gen opts are required")
+	}
+	if opts.LanguageOpts == nil {
+		opts.LanguageOpts = MarkdownOpts()
+	}
+	MarkdownSectionOpts(opts, output)
+	if err := opts.EnsureDefaults(); err != nil {
+		return err
+	}
+
+	generator, err := newAppGenerator(opts.Name, modelNames, operationIDs, opts)
+	if err != nil {
+		return err
+	}
+	return generator.GenerateMarkdown()
+}
+
+// MarkdownSectionOpts sets the default template layout to render a markdown document,
+// unless some application templates are already configured (e.g. from a config file).
+//
+// Only the application section is used to render documentation.
+func MarkdownSectionOpts(gen *GenOpts, output string) {
+	if len(gen.Sections.Application) > 0 {
+		return
+	}
+	if output == "" || output == "." {
+		output = defaultMarkdownFile
+	}
+	dir, fname := filepath.Split(output)
+	target := "{{ .Target }}"
+	if dir != "" {
+		target = filepath.ToSlash(filepath.Clean(dir))
+		if !filepath.IsAbs(dir) {
+			target = "{{ joinFilePath .Target \"" + target + "\" }}"
+		}
+	}
+	gen.Sections.Application = []TemplateOpts{
+		{
+			Name:       "markdowndocs",
+			Source:     "asset:markdownDocs",
+			Target:     target,
+			FileName:   fname,
+			SkipFormat: true,
+		},
+	}
+}
+
+// GenerateMarkdown renders the documentation templates for this API
+func (a *appGenerator) GenerateMarkdown() error {
+	app, err := a.makeCodegenApp()
+	if err != nil {
+		return err
+	}
+
+	doc := GenDocumentation{
+		GenApp:          app,
+		OperationsByTag: a.makeOperationsByTag(app.Operations),
+	}
+
+	if a.DumpData {
+		bb, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(os.Stdout, string(bb))
+		return nil
+	}
+
+	log.Printf("rendering %d templates for documentation of %s", len(a.GenOpts.Sections.Application), app.Name)
+	for _, templ := range a.GenOpts.Sections.Application {
+		if err := a.GenOpts.write(&templ, &doc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// makeOperationsByTag groups operations by tag: tags declared in the spec come first,
+// then undeclared tags in alphabetical order, then untagged operations.
+func (a *appGenerator) makeOperationsByTag(ops GenOperations) GenOperationGroups {
+	const untagged = "default"
+
+	byTag := make(map[string]GenOperations)
+	for _, op := range ops {
+		if len(op.Tags) == 0 {
+			byTag[untagged] = append(byTag[untagged], op)
+			continue
+		}
+		for _, tag := range op.Tags {
+			byTag[tag] = append(byTag[tag], op)
+		}
+	}
+
+	groups := make(GenOperationGroups, 0, len(byTag))
+	makeGroup := func(name, description string) {
+		tagged := byTag[name]
+		sort.Sort(tagged)
+		groups = append(groups, GenOperationGroup{
+			Name:        name,
+			Description: description,
+			Operations:  tagged,
+			GenOpts:     a.GenOpts,
+		})
+		delete(byTag, name)
+	}
+
+	for _, tag := range a.SpecDoc.Spec().Tags {
+		if _, ok := byTag[tag.Name]; ok {
+			makeGroup(tag.Name, tag.Description)
+		}
+	}
+
+	var undeclared []string
+	for tag := range byTag {
+		if tag != untagged {
+			undeclared = append(undeclared, tag)
+		}
+	}
+	sort.Strings(undeclared)
+	for _, tag := range undeclared {
+		makeGroup(tag, "")
+	}
+
+	if _, ok := byTag[untagged]; ok {
+		makeGroup(untagged, "")
+	}
+	return groups
+}
+
+// markdownTableCell escapes a string to fit in a single cell of a markdown table
+func markdownTableCell(str string) string {
+	str = strings.TrimSpace(str)
+	str = strings.Replace(str, "\r\n", "\n", -1)
+	str = strings.Replace(str, "|", "\\|", -1)
+	return strings.Replace(str, "\n", "<br>", -1)
+}
+
+// markdownValidations summarizes the validations carried by a schema, a parameter, a header or some items
+func markdownValidations(data interface{}) string {
+	var v sharedValidations
+	switch tpe := data.(type) {
+	case GenSchema:
+		v = tpe.sharedValidations
+	case *GenSchema:
+		v = tpe.sharedValidations
+	case GenDefinition:
+		v = tpe.sharedValidations
+	case *GenDefinition:
+		v = tpe.sharedValidations
+	case GenParameter:
+		v = tpe.sharedValidations
+	case *GenParameter:
+		v = tpe.sharedValidations
+	case GenHeader:
+		v = tpe.sharedValidations
+	case *GenHeader:
+		v = tpe.sharedValidations
+	case GenItems:
+		v = tpe.sharedValidations
+	case *GenItems:
+		v = tpe.sharedValidations
+	default:
+		return ""
+	}
+
+	var res []string
+	if v.MinLength != nil {
+		res = append(res, fmt.Sprintf("min length: %d", *v.MinLength))
+	}
+	if v.MaxLength != nil {
+		res = append(res, fmt.Sprintf("max length: %d", *v.MaxLength))
+	}
+	if v.Pattern != "" {
+		res = append(res, fmt.Sprintf("pattern: `%s`", v.Pattern))
+	}
+	if v.Minimum != nil {
+		if v.ExclusiveMinimum {
+			res = append(res, fmt.Sprintf("minimum: > %v", *v.Minimum))
+		} else {
+			res = append(res, fmt.Sprintf("minimum: %v", *v.Minimum))
+		}
+	}
+	if v.Maximum != nil {
+		if v.ExclusiveMaximum {
+			res = append(res, fmt.Sprintf("maximum: < %v", *v.Maximum))
+		} else {
+			res = append(res, fmt.Sprintf("maximum: %v", *v.Maximum))
+		}
+	}
+	if v.MultipleOf != nil {
+		res = append(res, fmt.Sprintf("multiple of: %v", *v.MultipleOf))
+	}
+	if len(v.Enum) > 0 {
+		res = append(res, "enum: "+markdownEnum(v.Enum))
+	}
+	if v.MinItems != nil {
+		res = append(res, fmt.Sprintf("min items: %d", *v.MinItems))
+	}
+	if v.MaxItems != nil {
+		res = append(res, fmt.Sprintf("max items: %d", *v.MaxItems))
+	}
+	if v.UniqueItems {
+		res = append(res, "unique items")
+	}
+	if len(v.ItemsEnum) > 0 {
+		res = append(res, "items enum: "+markdownEnum(v.ItemsEnum))
+	}
+	return strings.Join(res, ", ")
+}
+
+func markdownEnum(enum []interface{}) string {
+	values := make([]string, 0, len(enum))
+	for _, e := range enum {
+		b, err := json.Marshal(e)
+		if err != nil {
+			values = append(values, fmt.Sprintf("`%v`", e))
+			continue
+		}
+		values = append(values, "`"+string(b)+"`")
+	}
+	return strings.Join(values, ", ")
+}
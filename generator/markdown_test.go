@@ -0,0 +1,110 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkdown_Generate(t *testing.T) {
+	targetdir, err := ioutil.TempDir(os.TempDir(), "swagger_markdown")
+	require.NoError(t, err)
+	log.SetOutput(ioutil.Discard)
+	defer func() {
+		os.RemoveAll(targetdir)
+		log.SetOutput(os.Stdout)
+	}()
+
+	opts := &GenOpts{
+		Spec:        "../fixtures/codegen/todolist.discriminators.yml",
+		Target:      targetdir,
+		FlattenSpec: true,
+	}
+	require.NoError(t, GenerateMarkdown("docs/api.md", nil, nil, opts))
+
+	// the target is not required to be a go package
+	assert.Nil(t, opts.LanguageOpts.BaseImportFunc)
+
+	content, err := ioutil.ReadFile(filepath.Join(targetdir, "docs", "api.md"))
+	require.NoError(t, err)
+	res := string(content)
+
+	assertInCode(t, "# Private to-do list", res)
+	assertInCode(t, "### testcgen\n", res)
+	assertInCode(t, "| POST | /models | [model-op](#model-op) | many model variations |", res)
+	assertInCode(t, `### <span id="model-op"></span> many model variations (modelOp)`, res)
+	assertInCode(t, "| pet | `body` | [Pet](#pet) |", res)
+	assertInCode(t, "| [200](#model-op-o-k) | OK | [Pet](#pet) |", res)
+	assertInCode(t, `### <span id="kennel"></span> Kennel`, res)
+	assertInCode(t, "| pets | [][Pet](#pet) | ✓ |", res)
+	assertInCode(t, "* [Pet](#pet)", res)
+	assertInCode(t, "**Discriminator**: petType", res)
+	assertInCode(t, "| packSize | integer (int32) | ✓ |  | the size of the pack the dog is from | minimum: 0 |", res)
+	assertInCode(t, "enum: `\"clueless\"`, `\"lazy\"`, `\"adventurous\"`, `\"aggressive\"`", res)
+}
+
+func TestMarkdown_SectionOpts(t *testing.T) {
+	opts := &GenOpts{}
+	MarkdownSectionOpts(opts, "")
+	if assert.Len(t, opts.Sections.Application, 1) {
+		assert.Equal(t, "{{ .Target }}", opts.Sections.Application[0].Target)
+		assert.Equal(t, "markdown.md", opts.Sections.Application[0].FileName)
+		assert.True(t, opts.Sections.Application[0].SkipFormat)
+	}
+
+	opts = &GenOpts{}
+	MarkdownSectionOpts(opts, "docs/api.md")
+	if assert.Len(t, opts.Sections.Application, 1) {
+		assert.Equal(t, `{{ joinFilePath .Target "docs" }}`, opts.Sections.Application[0].Target)
+		assert.Equal(t, "api.md", opts.Sections.Application[0].FileName)
+	}
+
+	// templates from a config file are retained
+	opts = &GenOpts{}
+	opts.Sections.Application = []TemplateOpts{{Name: "custom", Source: "custom.gotmpl"}}
+	MarkdownSectionOpts(opts, "")
+	if assert.Len(t, opts.Sections.Application, 1) {
+		assert.Equal(t, "custom", opts.Sections.Application[0].Name)
+	}
+}
+
+func TestMarkdown_TableCell(t *testing.T) {
+	assert.Equal(t, `a \| b<br>c`, markdownTableCell(" a | b\r\nc\n"))
+}
+
+func TestMarkdown_Validations(t *testing.T) {
+	minLength, maxItems, min := int64(1), int64(10), float64(2)
+	var sch GenSchema
+	sch.MinLength = &minLength
+	sch.Pattern = "^a+$"
+	sch.Minimum = &min
+	sch.ExclusiveMinimum = true
+	sch.MaxItems = &maxItems
+	sch.UniqueItems = true
+	sch.Enum = []interface{}{"a", 1}
+	assert.Equal(t, "min length: 1, pattern: `^a+$`, minimum: > 2, enum: `\"a\"`, `1`, max items: 10, unique items", markdownValidations(sch))
+	assert.Equal(t, "min length: 1, pattern: `^a+$`, minimum: > 2, enum: `\"a\"`, `1`, max items: 10, unique items", markdownValidations(&sch))
+
+	var param GenParameter
+	assert.Empty(t, markdownValidations(param))
+	assert.Empty(t, markdownValidations("not a schema"))
+}
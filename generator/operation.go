@@ -346,10 +346,9 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 			qp = append(qp, cp)
 		}
 		if cp.IsFormParam() {
-			if p.Type == file {
+			if p.Type == file || cp.IsFileArrayParam() {
 				hasFileParams = true
-			}
-			if p.Type != file {
+			} else {
 				hasFormValueParams = true
 			}
 			hasFormParams = true
@@ -438,6 +437,7 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 		extraSchemes = concatUnique(ess1, extraSchemes)
 	}
 	sort.Strings(extraSchemes)
+	retryable, hasRetryable := operation.Extensions.GetBool(xRetryable)
 	schemes := concatUnique(swsp.Schemes, operation.Schemes)
 	sort.Strings(schemes)
 	produces := producesOrDefault(operation.Produces, swsp.Produces, b.DefaultProduces)
@@ -471,6 +471,11 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 		}
 	}
 
+	pagination, err := b.makePagination(params, successResponses, extra)
+	if err != nil {
+		return GenOperation{}, err
+	}
+
 	return GenOperation{
 		GenCommon: GenCommon{
 			Copyright:        b.GenOpts.Copyright,
@@ -516,10 +521,118 @@ func (b *codeGenOpBuilder) MakeOperation() (GenOperation, error) {
 		ExtraSchemes:         extraSchemes,
 		WithContext:          b.WithContext,
 		TimeoutName:          timeoutName,
+		HasRetryable:         hasRetryable,
+		Retryable:            retryable,
+		Pagination:           pagination,
 		Extensions:           operation.Extensions,
 	}, nil
 }
 
+// makePagination reads the x-pagination extension of an operation: the param receiving the token of the next page,
+// and either the property of the payload (nextField) or the header (nextHeader) of the success response carrying it
+func (b *codeGenOpBuilder) makePagination(params GenParameters, successResponses []GenResponse, extra GenSchemaList) (*GenPagination, error) {
+	ext, ok := b.Operation.Extensions[xPagination]
+	if !ok {
+		return nil, nil
+	}
+	settings, ok := ext.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s of operation %q must be an object", xPagination, b.Name)
+	}
+	paramName, _ := settings["param"].(string)
+	nextField, _ := settings["nextField"].(string)
+	nextHeader, _ := settings["nextHeader"].(string)
+	if paramName == "" || (nextField == "") == (nextHeader == "") {
+		return nil, fmt.Errorf("%s of operation %q must have a param, and either a nextField or a nextHeader", xPagination, b.Name)
+	}
+	if len(successResponses) != 1 {
+		return nil, fmt.Errorf("%s of operation %q requires a single success response", xPagination, b.Name)
+	}
+	response := successResponses[0]
+
+	var param *GenParameter
+	for i := range params {
+		if params[i].Name == paramName {
+			param = &params[i]
+		}
+	}
+	if param == nil || param.IsBodyParam() || param.IsFileParam() || !param.IsPrimitive {
+		return nil, fmt.Errorf("%s of operation %q: %q is not a primitive param of the operation", xPagination, b.Name, paramName)
+	}
+	pagination := &GenPagination{
+		Param:           pascalize(param.ID),
+		ParamIsNullable: param.IsNullable,
+		GoType:          param.GoType,
+	}
+
+	var token resolvedType
+	if nextHeader != "" {
+		for _, header := range response.Headers {
+			if strings.EqualFold(header.Name, nextHeader) {
+				token = header.resolvedType
+				pagination.Token = "page." + pascalize(header.Name)
+			}
+		}
+		if pagination.Token == "" {
+			return nil, fmt.Errorf("%s of operation %q: %q is not a header of the %s response", xPagination, b.Name, nextHeader, response.Name)
+		}
+	} else {
+		property, err := b.payloadProperty(response, nextField, extra)
+		if err != nil {
+			return nil, err
+		}
+		token = property.resolvedType
+		pagination.Token = "page.Payload." + pascalize(property.Name)
+		if response.Schema.IsComplexObject {
+			pagination.TokenGuard = "page.Payload != nil"
+		}
+		pagination.TokenIsNullable = property.IsNullable
+	}
+	if !token.IsPrimitive || token.GoType != param.GoType {
+		return nil, fmt.Errorf("%s of operation %q: the token of the next page must have the type %s of the %q param", xPagination, b.Name, param.GoType, paramName)
+	}
+	return pagination, nil
+}
+
+// payloadProperty finds a property of the object returned by a response
+func (b *codeGenOpBuilder) payloadProperty(response GenResponse, name string, extra GenSchemaList) (*GenSchema, error) {
+	payload := response.Schema
+	if payload == nil || payload.IsArray || payload.IsMap || payload.IsPrimitive || payload.IsInterface || payload.IsBaseType || payload.IsStream {
+		return nil, fmt.Errorf("%s of operation %q: the payload of the %s response is not an object", xPagination, b.Name, response.Name)
+	}
+
+	properties := payload.Properties
+	if len(properties) == 0 {
+		// the payload is an inline object rendered with the operation
+		for _, schema := range extra {
+			if schema.Name == payload.GoType {
+				properties = schema.Properties
+			}
+		}
+	}
+	if len(properties) == 0 {
+		// the payload refers to a model: its properties are found with the definition of the model
+		resp := b.Operation.Responses.StatusCodeResponses[response.Code]
+		if resp.Schema != nil && resp.Schema.Ref.String() != "" {
+			fragment := resp.Schema.Ref.GetURL().Fragment
+			modelName := fragment[strings.LastIndex(fragment, "/")+1:]
+			if schema, ok := b.Doc.Spec().Definitions[modelName]; ok {
+				definition, err := makeGenDefinition(modelName, b.ModelsPackage, schema, b.Doc, b.GenOpts)
+				if err != nil {
+					return nil, err
+				}
+				properties = definition.Properties
+			}
+		}
+	}
+	for i := range properties {
+		if properties[i].OriginalName == name {
+			return &properties[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%s of operation %q: %q is not a property of the payload of the %s response", xPagination, b.Name, name, response.Name)
+}
+
 func producesOrDefault(produces []string, fallback []string, defaultProduces string) []string {
 	if len(produces) > 0 {
 		return produces
@@ -581,6 +694,7 @@ func (b *codeGenOpBuilder) MakeResponse(receiver, name string, isSuccess bool, r
 		Code:           code,
 		Method:         b.Method,
 		Path:           b.Path,
+		OperationID:    b.Name,
 		Extensions:     resp.Extensions,
 	}
 
@@ -1002,3 +1002,67 @@ func TestGenSecurityRequirements(t *testing.T) {
 	genRequirements = b.makeSecurityRequirements("o")
 	assert.Nil(t, genRequirements)
 }
+
+func TestMakeOperation_Retryable(t *testing.T) {
+	for _, tc := range []struct {
+		operation    string
+		hasRetryable bool
+		retryable    bool
+	}{
+		{"listTasks", false, false},
+		{"createTask", true, true},
+		{"replaceTasks", true, false},
+	} {
+		b, err := opBuilder(tc.operation, "../fixtures/codegen/todolist.retry.yml")
+		if assert.NoError(t, err) {
+			gO, err := b.MakeOperation()
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.hasRetryable, gO.HasRetryable, tc.operation)
+				assert.Equal(t, tc.retryable, gO.Retryable, tc.operation)
+			}
+		}
+	}
+}
+
+func TestMakeOperation_Pagination(t *testing.T) {
+	for _, tc := range []struct {
+		operation string
+		expected  GenPagination
+	}{
+		{"listTasks", GenPagination{Param: "Cursor", ParamIsNullable: true, Token: "page.Payload.Next", TokenGuard: "page.Payload != nil", GoType: "string"}},
+		{"listComments", GenPagination{Param: "Page", Token: "page.XNextPage", GoType: "int64"}},
+	} {
+		b, err := opBuilder(tc.operation, "../fixtures/codegen/todolist.pagination.yml")
+		if assert.NoError(t, err) {
+			gO, err := b.MakeOperation()
+			if assert.NoError(t, err) && assert.NotNil(t, gO.Pagination, tc.operation) {
+				assert.Equal(t, tc.expected, *gO.Pagination, tc.operation)
+			}
+		}
+	}
+
+	b, err := opBuilderWithFlatten("listArchivedTasks", "../fixtures/codegen/todolist.pagination.yml")
+	if assert.NoError(t, err) {
+		gO, err := b.MakeOperation()
+		if assert.NoError(t, err) && assert.NotNil(t, gO.Pagination) {
+			assert.Equal(t, "page.Payload.NextToken", gO.Pagination.Token)
+			assert.True(t, gO.Pagination.TokenIsNullable)
+		}
+	}
+
+	for _, settings := range []map[string]interface{}{
+		{"param": "cursor"},
+		{"param": "cursor", "nextField": "next", "nextHeader": "X-Next"},
+		{"param": "unknown", "nextField": "next"},
+		{"param": "limit", "nextField": "next"},
+		{"param": "cursor", "nextField": "unknown"},
+		{"param": "cursor", "nextHeader": "X-Unknown"},
+	} {
+		b, err := opBuilder("listTasks", "../fixtures/codegen/todolist.pagination.yml")
+		if assert.NoError(t, err) {
+			b.Operation.Extensions[xPagination] = settings
+			_, err := b.MakeOperation()
+			assert.Error(t, err, "%v", settings)
+		}
+	}
+}
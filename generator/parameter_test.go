@@ -2059,3 +2059,101 @@ func TestGenParameter_Issue1536(t *testing.T) {
 		}
 	}
 }
+
+func TestGenParameter_ClientValidate(t *testing.T) {
+	assert := assert.New(t)
+	gen, err := opBuilder("arrayQueryParams", "../fixtures/codegen/todolist.arrayquery.yml")
+	if assert.NoError(err) {
+		op, err := gen.MakeOperation()
+		if assert.NoError(err) {
+			buf := bytes.NewBuffer(nil)
+			opts := opts()
+			err := templates.MustGet("clientParameter").Execute(buf, op)
+			if assert.NoError(err) {
+				ff, err := opts.LanguageOpts.FormatContent("array_query_params_parameters.go", buf.Bytes())
+				if assert.NoError(err) {
+					res := string(ff)
+					assertInCode(t, "func (o *ArrayQueryParamsParams) Validate(formats strfmt.Registry) error {", res)
+					assertInCode(t, "if err := o.validateSiNested(formats); err != nil {\n\t\tres = append(res, err)\n\t}", res)
+					assertInCode(t, "return errors.CompositeValidationError(res...)", res)
+					assertInCode(t, "if len(o.SiNested) == 0 {\n\t\treturn errors.Required(\"siNested\", \"query\")\n\t}", res)
+					assertInCode(t, "for i, siNestedI := range o.SiNested {", res)
+					assertInCode(t, "for ii, siNestedII := range siNestedI {", res)
+					assertInCode(t, "validate.MaxItems(fmt.Sprintf(\"%s.%v\", \"siNested\", i), \"query\", int64(len(siNestedI)), 20)", res)
+					assertInCode(t, "validate.MinimumInt(\"id\", \"path\", int64(o.ID), 1, false)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+
+	gen, err = opBuilderWithFlatten("createTask", "../fixtures/codegen/todolist.bodyparams.yml")
+	if assert.NoError(err) {
+		op, err := gen.MakeOperation()
+		if assert.NoError(err) {
+			buf := bytes.NewBuffer(nil)
+			opts := opts()
+			err := templates.MustGet("clientParameter").Execute(buf, op)
+			if assert.NoError(err) {
+				ff, err := opts.LanguageOpts.FormatContent("create_task_parameters.go", buf.Bytes())
+				if assert.NoError(err) {
+					assertInCode(t, "if o.Body == nil {\n\t\treturn nil\n\t}\n\tif err := o.Body.Validate(formats); err != nil {", string(ff))
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestGenClient_ValidatesParams(t *testing.T) {
+	assert := assert.New(t)
+	gen, err := opBuilder("simpleQueryParams", "../fixtures/codegen/todolist.simplequery.yml")
+	if assert.NoError(err) {
+		op, err := gen.MakeOperation()
+		if assert.NoError(err) {
+			buf := bytes.NewBuffer(nil)
+			opts := opts()
+			err := templates.MustGet("clientClient").Execute(buf, GenOperationGroup{Name: "testcgen", Operations: GenOperations{op}})
+			if assert.NoError(err) {
+				ff, err := opts.LanguageOpts.FormatContent("testcgen_client.go", buf.Bytes())
+				if assert.NoError(err) {
+					res := string(ff)
+					assertNotInCode(t, "TODO", res)
+					assertInCode(t, "if !skipsValidation(a.transport) {\n\t\tif err := params.Validate(a.formats); err != nil {\n\t\t\treturn nil, err", res)
+					assertInCode(t, "transport.(interface{ SkipsValidation() bool })", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestGenParameter_FileArray(t *testing.T) {
+	assert := assert.New(t)
+	gen, err := opBuilderWithFlatten("uploadAttachment", "../fixtures/codegen/todolist.cli.yml")
+	if assert.NoError(err) {
+		op, err := gen.MakeOperation()
+		if assert.NoError(err) {
+			assert.True(op.HasFileParams)
+			buf := bytes.NewBuffer(nil)
+			opts := opts()
+			err := templates.MustGet("serverParameter").Execute(buf, op)
+			if assert.NoError(err) {
+				ff, err := opts.LanguageOpts.FormatContent("upload_attachment_parameters.go", buf.Bytes())
+				if assert.NoError(err) {
+					res := string(ff)
+					assertInCode(t, "Extras []io.ReadCloser", res)
+					assertInCode(t, `extrasHeaders = r.MultipartForm.File["extras"]`, res)
+					assertInCode(t, "func (o *UploadAttachmentParams) bindExtras(headers []*multipart.FileHeader) error {", res)
+					assertInCode(t, "o.Extras = append(o.Extras, &runtime.File{Data: file, Header: header})", res)
+					assertNotInCode(t, "fds := runtime.Values(r.Form)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
@@ -515,3 +515,49 @@ func TestGenResponses_Issue1013(t *testing.T) {
 		}
 	}
 }
+
+func TestGenClientResponses_APIError(t *testing.T) {
+	b, err := opBuilder("updateTask", "../fixtures/codegen/todolist.responses.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			var buf bytes.Buffer
+			opts := opts()
+			if assert.NoError(t, templates.MustGet("clientResponse").Execute(&buf, op)) {
+				ff, err := opts.LanguageOpts.FormatContent("update_task_responses.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "func (o *UpdateTaskOK) Code() int {\n\treturn 200\n}", res)
+					assertInCode(t, "func (o *UpdateTaskDefault) Code() int {\n\treturn o._statusCode\n}", res)
+					assertInCode(t, "func (o *UpdateTaskDefault) OperationID() string {\n\treturn \"updateTask\"\n}", res)
+					assertInCode(t, "func (o *UpdateTaskDefault) ErrorPayload() interface{} {\n\treturn o.Payload\n}", res)
+					assertInCode(t, "func (o *UpdateTaskDefault) RawHeaders() http.Header {", res)
+					assertInCode(t, `for _, name := range []string{"X-Error-Code"} {`, res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestGenClientResponses_AbsentHeader(t *testing.T) {
+	b, err := opBuilder("listComments", "../fixtures/codegen/todolist.pagination.yml")
+	if assert.NoError(t, err) {
+		op, err := b.MakeOperation()
+		if assert.NoError(t, err) {
+			var buf bytes.Buffer
+			opts := opts()
+			if assert.NoError(t, templates.MustGet("clientResponse").Execute(&buf, op)) {
+				ff, err := opts.LanguageOpts.FormatContent("list_comments_responses.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(ff)
+					assertInCode(t, "if hdrXNextPage := response.GetHeader(\"X-Next-Page\"); hdrXNextPage != \"\" {", res)
+					assertInCode(t, "xNextPage, err := swag.ConvertInt64(hdrXNextPage)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
@@ -396,3 +396,248 @@ func TestServer_Issue1557(t *testing.T) {
 		}
 	}
 }
+
+func TestServer_Lifecycle(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/simplesearch.yml", "search")
+	if assert.NoError(t, err) {
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverServer").Execute(buf, &app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("server.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "func (s *Server) Serve(ctx context.Context) (err error) {", res)
+					assertInCode(t, "httpServer := new(http.Server)", res)
+					assertInCode(t, "go s.handleShutdown(ctx, &wg, servers)", res)
+					assertInCode(t, "if err := server.Shutdown(drain); err != nil {", res)
+					assertInCode(t, "drain, cancel = context.WithTimeout(drain, s.CleanupTimeout)", res)
+					assertInCode(t, "l = netutil.LimitListener(l, limit)", res)
+					assertNotInCode(t, "graceful", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+			buf = bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverConfigureapi").Execute(buf, app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("configure_search_api.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "func configureServer(s *http.Server, scheme, addr string) {", res)
+					assertNotInCode(t, "graceful", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+			buf = bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverMain").Execute(buf, &app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("main.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "ctx, cancel := interruptContext()", res)
+					assertInCode(t, "if err := server.Serve(ctx); err != nil {", res)
+					assertInCode(t, "signal.Notify(signals, os.Interrupt, syscall.SIGTERM)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestServer_Health(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/simplesearch.yml", "search")
+	if assert.NoError(t, err) {
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverBuilder").Execute(buf, app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("search_api.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "func (o *SearchAPI) AddHealthCheck(name string, timeout time.Duration, check func(ctx context.Context) error) {", res)
+					assertInCode(t, "func (o *SearchAPI) SetReady(ready bool) {", res)
+					assertInCode(t, "func (o *SearchAPI) CheckHealth(ctx context.Context) (bool, map[string]error) {", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+			buf = bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverServer").Execute(buf, &app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("server.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, `long:"health-path"`, res)
+					assertInCode(t, `long:"ready-path"`, res)
+					// the endpoints are opt-in, and answer GET and HEAD only
+					assertNotInCode(t, `default:"/healthz"`, res)
+					assertNotInCode(t, `default:"/readyz"`, res)
+					assertInCode(t, "if r.Method != http.MethodGet && r.Method != http.MethodHead {", res)
+					assertInCode(t, "handler = s.healthHandler(handler)", res)
+					assertInCode(t, "s.api.SetReady(false)", res)
+					assertInCode(t, "ready, checks := s.api.CheckHealth(r.Context())", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestServer_Metrics(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/simplesearch.yml", "search")
+	if assert.NoError(t, err) {
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			for _, strategy := range []string{"go-flags", "pflag"} {
+				app.GenOpts.FlagStrategy = strategy
+				buf := bytes.NewBuffer(nil)
+				if assert.NoError(t, templates.MustGet("serverServer").Execute(buf, &app)) {
+					formatted, err := app.GenOpts.LanguageOpts.FormatContent("server.go", buf.Bytes())
+					if assert.NoError(t, err) {
+						res := string(formatted)
+						if strategy == "pflag" {
+							assertInCode(t, `flag.StringVar(&metricsPath, "metrics-path", "",`, res)
+							assertInCode(t, "s.MetricsPort = metricsPort", res)
+						} else {
+							assertInCode(t, `long:"metrics-path"`, res)
+							assertInCode(t, `long:"metrics-port"`, res)
+						}
+						assertInCode(t, "handler = metrics.Middleware(s.api, handler)", res)
+						assertInCode(t, "func (s *Server) MetricsListener() (net.Listener, error) {", res)
+						assertInCode(t, "func NewMetrics() *Metrics {", res)
+						assertInCode(t, "func (m *Metrics) Middleware(api *operations.SearchAPI, next http.Handler) http.Handler {", res)
+						assertInCode(t, "route := middleware.MatchedRouteFrom(r)", res)
+						assertInCode(t, "route, _ = api.Context().LookupRoute(r)", res)
+						assertInCode(t, `w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")`, res)
+						assertInCode(t, "# TYPE http_requests_total counter", res)
+						assertInCode(t, "# TYPE http_request_duration_seconds histogram", res)
+						assertInCode(t, "# TYPE http_response_size_bytes histogram", res)
+						assertInCode(t, "# TYPE http_requests_in_flight gauge", res)
+					} else {
+						fmt.Println(buf.String())
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestServer_ValidateResponses(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/simplesearch.yml", "search")
+	if assert.NoError(t, err) {
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverBuilder").Execute(buf, app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("search_api.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "responseStrictness atomic.Value", res)
+					assertInCode(t, `ValidateResponsesFail = "fail"`, res)
+					assertInCode(t, "func ParseValidateResponses(value string) (string, error) {", res)
+					assertInCode(t, "func (o *SearchAPI) SetValidateResponses(value string) error {", res)
+					assertInCode(t, "strictness := o.ValidateResponses()", res)
+					assertNotInCode(t, "panic(", res)
+					assertInCode(t, "builder = o.validateResponses(builder)", res)
+					assertInCode(t, "func (o *SearchAPI) validateResponses(builder middleware.Builder) middleware.Builder {", res)
+					assertInCode(t, "func (o *SearchAPI) ValidateResponse(method, path string, code int, header http.Header, body []byte) []error {", res)
+					assertInCode(t, "o.ServeErrorFor(operationID)(rw, r, errors.New(http.StatusInternalServerError, \"%s\", message))", res)
+					assertInCode(t, `recorder.header.Add("Warning", fmt.Sprintf("199 - %q", message))`, res)
+					assertInCode(t, "validate.NewSchemaValidator(response.Schema, o.spec.Spec(), \"\", o.Formats()).Validate(data)", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+			buf = bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverServer").Execute(buf, &app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("server.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, `long:"validate-responses"`, res)
+					assertInCode(t, `choice:"log" choice:"warn" choice:"fail"`, res)
+					assertInCode(t, "if err := s.api.SetValidateResponses(s.ValidateResponses); err != nil {", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
+
+func TestServer_HandlerInterface(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+	gen, err := testAppGenerator(t, "../fixtures/codegen/simplesearch.yml", "search")
+	if assert.NoError(t, err) {
+		gen.GenOpts.HandlerInterface = "tag"
+		app, err := gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverHandlers").Execute(buf, &app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("search_handlers.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					// the search group is named after the API
+					assertInCode(t, "type SearchOperationsAPI interface {", res)
+					assertInCode(t, "Search(params search.SearchParams) middleware.Responder", res)
+					assertInCode(t, "type TasksAPI interface {", res)
+					assertInCode(t, "type UnimplementedTasksAPI struct{}", res)
+					assertInCode(t, "func (o *SearchAPI) RegisterTasksAPI(handlers TasksAPI) {", res)
+					assertInCode(t, "o.SearchSearchHandler = search.SearchHandlerFunc(handlers.Search)", res)
+					assertNotInCode(t, "type SearchHandlers interface {", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+			buf = bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverConfigureapi").Execute(buf, &app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("configure_search.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "--handler-interface tag", res)
+					assertInCode(t, "api.RegisterSearchOperationsAPI(operations.UnimplementedSearchOperationsAPI{})", res)
+					assertInCode(t, "api.RegisterTasksAPI(operations.UnimplementedTasksAPI{})", res)
+					assertNotInCode(t, "api.SearchSearchHandler = ", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+
+		gen.GenOpts.HandlerInterface = "api"
+		app, err = gen.makeCodegenApp()
+		if assert.NoError(t, err) {
+			buf := bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverHandlers").Execute(buf, &app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("search_handlers.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					res := string(formatted)
+					assertInCode(t, "type SearchHandlers interface {", res)
+					assertInCode(t, "func (UnimplementedSearchHandlers) Search(params search.SearchParams) middleware.Responder {", res)
+					assertInCode(t, "func (o *SearchAPI) RegisterSearchHandlers(handlers SearchHandlers) {", res)
+					assertNotInCode(t, "type TasksAPI interface {", res)
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+			buf = bytes.NewBuffer(nil)
+			if assert.NoError(t, templates.MustGet("serverConfigureapi").Execute(buf, &app)) {
+				formatted, err := app.GenOpts.LanguageOpts.FormatContent("configure_search.go", buf.Bytes())
+				if assert.NoError(t, err) {
+					assertInCode(t, "api.RegisterSearchHandlers(operations.UnimplementedSearchHandlers{})", string(formatted))
+				} else {
+					fmt.Println(buf.String())
+				}
+			}
+		}
+	}
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
+	"github.com/go-swagger/go-swagger/openapi3"
 	"golang.org/x/tools/imports"
 )
 
@@ -123,11 +124,22 @@ func GoLangOpts() *LanguageOpts {
 		if err != nil {
 			log.Fatalf("could not evaluate base import path with target \"%s\": %v", tgt, err)
 		}
+
+		// When the target lives inside a go module, the import path is
+		// derived from the module path declared in the enclosing go.mod.
+		// GOPATH resolution is only used as a fallback.
+		if pth, ok := goModuleImportPath(tgtAbsPath); ok {
+			return pth
+		}
+
 		var tgtAbsPathExtended string
 		tgtAbsPathExtended, err = filepath.EvalSymlinks(tgtAbsPath)
 		if err != nil {
 			log.Fatalf("could not evaluate base import path with target \"%s\" (with symlink resolution): %v", tgtAbsPath, err)
 		}
+		if pth, ok := goModuleImportPath(tgtAbsPathExtended); ok {
+			return pth
+		}
 
 		gopath := os.Getenv("GOPATH")
 		if gopath == "" {
@@ -183,7 +195,7 @@ func GoLangOpts() *LanguageOpts {
 		}
 
 		if pth == "" {
-			log.Fatalln("target must reside inside a location in the $GOPATH/src")
+			log.Fatalln("target must reside inside a go module or inside a location in the $GOPATH/src")
 		}
 		return pth
 	}
@@ -191,6 +203,15 @@ func GoLangOpts() *LanguageOpts {
 	return opts
 }
 
+// MarkdownOpts for rendering a spec as markdown documentation.
+//
+// No formatting is applied and the target is not required to be a go package.
+func MarkdownOpts() *LanguageOpts {
+	opts := new(LanguageOpts)
+	opts.Init()
+	return opts
+}
+
 func findSwaggerSpec(nm string) (string, error) {
 	specs := []string{"swagger.json", "swagger.yml", "swagger.yaml"}
 	if nm != "" {
@@ -297,6 +318,14 @@ func DefaultSectionOpts(gen *GenOpts) {
 					FileName: "{{ (snakize (pascalize .Name)) }}_client.go",
 				},
 			}
+			if gen.WithMocks {
+				sec.OperationGroups = append(sec.OperationGroups, TemplateOpts{
+					Name:     "mock",
+					Source:   "asset:clientMock",
+					Target:   "{{ joinFilePath .Target .ClientPackage .Name }}",
+					FileName: "{{ (snakize (pascalize .Name)) }}_client_mock.go",
+				})
+			}
 		} else {
 			sec.OperationGroups = []TemplateOpts{}
 		}
@@ -352,6 +381,14 @@ func DefaultSectionOpts(gen *GenOpts) {
 					FileName: "doc.go",
 				},
 			}
+			if gen.HandlerInterface != "" {
+				sec.Application = append(sec.Application, TemplateOpts{
+					Name:     "handlers",
+					Source:   "asset:serverHandlers",
+					Target:   "{{ joinFilePath .Target .ServerPackage .Package }}",
+					FileName: "{{ snakize (pascalize .Name) }}_handlers.go",
+				})
+			}
 		}
 	}
 	gen.Sections = sec
@@ -389,6 +426,7 @@ type GenOpts struct {
 	ExcludeSpec        bool
 	DumpData           bool
 	WithContext        bool
+	WithMocks          bool
 	ValidateSpec       bool
 	FlattenSpec        bool
 	FlattenDefinitions bool
@@ -418,6 +456,12 @@ type GenOpts struct {
 	CompatibilityMode string
 	ExistingModels    string
 	Copyright         string
+	// HandlerInterface generates an interface for the handlers of each operation group ("tag"),
+	// or of the whole API ("api"), with an adapter registering an implementation of it.
+	HandlerInterface string
+	// Concurrency is the maximum number of models or operations rendered in parallel.
+	// When not set, the number of CPUs is used.
+	Concurrency int
 }
 
 // TargetPath returns the target generation path relative to the server package
@@ -772,6 +816,20 @@ func loadSpec(specFile string) (string, *loads.Document, error) {
 	if err != nil {
 		return "", nil, err
 	}
+
+	// OpenAPI 3.0 documents are converted to swagger 2.0
+	if openapi3.IsOpenAPI3(specDoc.Raw()) {
+		converted, warnings, err := openapi3.Convert(specDoc.Raw())
+		if err != nil {
+			return "", nil, err
+		}
+		for _, w := range warnings {
+			log.Printf("openapi 3 document %s: %s", specPath, w)
+		}
+		if specDoc, err = loads.Analyzed(converted, ""); err != nil {
+			return "", nil, err
+		}
+	}
 	return specPath, specDoc, nil
 }
 
@@ -928,6 +986,10 @@ func validateAndFlattenSpec(opts *GenOpts, specDoc *loads.Document) (*loads.Docu
 	}
 
 	absBasePath := specDoc.SpecFilePath()
+	if absBasePath == "" {
+		// the documents converted from OpenAPI 3.0 are not loaded from a file
+		absBasePath = opts.Spec
+	}
 	if !filepath.IsAbs(absBasePath) {
 		cwd, _ := os.Getwd()
 		absBasePath = filepath.Join(cwd, absBasePath)
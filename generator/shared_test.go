@@ -8,6 +8,8 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/go-openapi/loads"
+	"github.com/go-swagger/go-swagger/openapi3"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -407,3 +409,41 @@ func TestShared_LoadTemplate(t *testing.T) {
 	assert.Nil(t, buf, "Upon error, GenOpts.render() should return nil buffer")
 
 }
+
+func TestShared_LoadOpenAPI3(t *testing.T) {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stdout)
+
+	// OpenAPI 3.0 documents are converted to swagger 2.0
+	_, specDoc, err := loadSpec("../fixtures/openapi3/petstore.yaml")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "2.0", specDoc.Version())
+		assert.Contains(t, specDoc.Spec().Definitions, "Pet")
+		assert.Equal(t, "/v1", specDoc.BasePath())
+	}
+
+	// the generator leaves the loaders of the go-openapi packages as they are
+	doc, err := loads.Spec("../fixtures/openapi3/petstore.yaml")
+	if assert.NoError(t, err) {
+		assert.True(t, openapi3.IsOpenAPI3(doc.Raw()))
+	}
+
+	opts := &GenOpts{Spec: "../fixtures/openapi3/petstore.yaml", ValidateSpec: false, FlattenSpec: true}
+	flat, err := validateAndFlattenSpec(opts, specDoc)
+	if assert.NoError(t, err) {
+		assert.Contains(t, flat.Spec().Definitions, "Owner")
+	}
+}
+
+func TestShared_ClientMockSectionOpts(t *testing.T) {
+	opts := &GenOpts{IsClient: true}
+	DefaultSectionOpts(opts)
+	assert.Len(t, opts.Sections.OperationGroups, 1)
+
+	opts = &GenOpts{IsClient: true, WithMocks: true}
+	DefaultSectionOpts(opts)
+	if assert.Len(t, opts.Sections.OperationGroups, 2) {
+		assert.Equal(t, "asset:clientMock", opts.Sections.OperationGroups[1].Source)
+		assert.Equal(t, "{{ (snakize (pascalize .Name)) }}_client_mock.go", opts.Sections.OperationGroups[1].FileName)
+	}
+}
@@ -14,7 +14,8 @@ import (
 // GenCommon contains common properties needed across
 // definitions, app and operations
 // TargetImportPath may be used by templates to import other (possibly
-// generated) packages in the generation path (e.g. relative to GOPATH).
+// generated) packages in the generation path (e.g. relative to the enclosing
+// go module or to GOPATH).
 // TargetImportPath is NOT used by standard templates.
 type GenCommon struct {
 	Copyright        string
@@ -142,6 +143,7 @@ type GenResponse struct {
 	Code               int
 	Method             string
 	Path               string
+	OperationID        string
 	Headers            GenHeaders
 	Schema             *GenSchema
 	AllowsForStreaming bool
@@ -284,6 +286,11 @@ func (g *GenParameter) IsFileParam() bool {
 	return g.SwaggerType == "file"
 }
 
+// IsFileArrayParam returns true when this parameter is an array of files, uploaded as several parts of the same form field
+func (g *GenParameter) IsFileArrayParam() bool {
+	return g.IsArray && g.Child != nil && g.Child.SwaggerType == file
+}
+
 // ItemsDepth returns a string "items.items..." with as many items as the level of nesting of the array.
 // For a parameter object, it always returns "".
 func (g *GenParameter) ItemsDepth() string {
@@ -457,6 +464,14 @@ type GenOperation struct {
 	WithContext        bool
 	TimeoutName        string
 
+	// HasRetryable is true when the retry of the operation is set with the x-retryable extension,
+	// otherwise clients retry the operations with an idempotent method
+	HasRetryable bool
+	Retryable    bool
+
+	// Pagination is set when the pages of the operation are declared with the x-pagination extension
+	Pagination *GenPagination
+
 	Extensions map[string]interface{}
 }
 
@@ -529,6 +544,29 @@ func (g *GenApp) UseModernMode() bool {
 	return g.GenOpts == nil || g.GenOpts.CompatibilityMode == "" || g.GenOpts.CompatibilityMode == "modern"
 }
 
+// HandlerInterfacePerTag returns true when an interface is generated for the handlers of each operation group
+func (g GenApp) HandlerInterfacePerTag() bool {
+	return g.GenOpts != nil && g.GenOpts.HandlerInterface == "tag"
+}
+
+// HandlerInterfacePerAPI returns true when an interface is generated for the handlers of the whole API
+func (g GenApp) HandlerInterfacePerAPI() bool {
+	return g.GenOpts != nil && g.GenOpts.HandlerInterface == "api"
+}
+
+// HandlerInterfaceName returns the name of the interface of the handlers of an operation group,
+// or of the whole API when the group is empty
+func (g GenApp) HandlerInterfaceName(group string) string {
+	if group == "" {
+		return pascalize(g.Name) + "Handlers"
+	}
+	if pascalize(group) == pascalize(g.Name) {
+		// the group is named after the API, which type is already named with the API suffix
+		return pascalize(group) + "OperationsAPI"
+	}
+	return pascalize(group) + "API"
+}
+
 // GenSerGroups sorted representation of serializer groups
 type GenSerGroups []GenSerGroup
 
@@ -563,6 +601,22 @@ type GenSerializer struct {
 	Implementation string
 }
 
+// GenPagination represents the x-pagination extension of an operation,
+// with which clients iterate over the pages of its success response
+type GenPagination struct {
+	// Param is the name of the field of the params which receives the token of the next page
+	Param           string
+	ParamIsNullable bool
+
+	// Token is the expression of the token of the next page in the success response,
+	// which is read when the TokenGuard expression is true
+	Token           string
+	TokenGuard      string
+	TokenIsNullable bool
+
+	GoType string
+}
+
 // GenSecurityScheme represents a security scheme for code generation
 type GenSecurityScheme struct {
 	AppName      string
@@ -19,12 +19,15 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
 	goruntime "runtime"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/go-openapi/analysis"
@@ -188,6 +191,68 @@ func checkPrefixAndFetchRelativePath(childpath string, parentpath string) (bool,
 
 }
 
+// goModuleImportPath resolves the import path of a target directory
+// from the go.mod file found in that directory or in any of its parents.
+//
+// Module resolution is disabled when GO111MODULE=off.
+func goModuleImportPath(tgtAbsPath string) (string, bool) {
+	if os.Getenv("GO111MODULE") == "off" {
+		return "", false
+	}
+	modDir, modPath, ok := findGoModule(tgtAbsPath)
+	if !ok {
+		return "", false
+	}
+	ok, rel := checkPrefixAndFetchRelativePath(tgtAbsPath, modDir)
+	if !ok {
+		return "", false
+	}
+	if rel == "." {
+		return modPath, true
+	}
+	return path.Join(modPath, filepath.ToSlash(rel)), true
+}
+
+// findGoModule walks up from dir until it finds a go.mod file declaring a module path.
+//
+// It returns the directory holding the go.mod file and the declared module path.
+func findGoModule(dir string) (string, string, bool) {
+	for {
+		content, err := ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			if modPath := modulePath(content); modPath != "" {
+				return dir, modPath, true
+			}
+			return "", "", false
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", "", false
+		}
+		dir = parent
+	}
+}
+
+// modulePath extracts the module path from the content of a go.mod file
+func modulePath(mod []byte) string {
+	for _, line := range strings.Split(string(mod), "\n") {
+		line = strings.TrimSpace(line)
+		if idx := strings.Index(line, "//"); idx >= 0 {
+			line = strings.TrimSpace(line[:idx])
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 || fields[0] != "module" {
+			continue
+		}
+		line = fields[1]
+		if unquoted, err := strconv.Unquote(line); err == nil {
+			line = unquoted
+		}
+		return line
+	}
+	return ""
+}
+
 func (a *appGenerator) Generate() error {
 
 	app, err := a.makeCodegenApp()
@@ -204,65 +269,56 @@ func (a *appGenerator) Generate() error {
 		return nil
 	}
 
-	// IPC removed concurrent execution because of the FuncMap that is being shared
-	// templates are now lazy loaded so there is concurrent map access I can't guard
-
-	// errChan := make(chan error, 100)
-	// wg := nsync.NewControlWaitGroup(20)
-
+	// models and operations are rendered in parallel, the template repository being safe for concurrent use
 	if a.GenOpts.IncludeModel {
 		log.Printf("rendering %d models", len(app.Models))
+		pool := newWorkerPool(a.GenOpts.Concurrency)
 		for _, mod := range app.Models {
-			// if len(errChan) > 0 {
-			// 	wg.Wait()
-			// 	return <-errChan
-			// }
 			modCopy := mod
-			// wg.Do(func() {
 			modCopy.IncludeValidator = true // a.GenOpts.IncludeValidator
 			modCopy.IncludeModel = true
-			if err := a.GenOpts.renderDefinition(&modCopy); err != nil {
-				return err
-			}
-			// })
+			pool.Do(func() error {
+				return a.GenOpts.renderDefinition(&modCopy)
+			})
+		}
+		if err := pool.Wait(); err != nil {
+			return err
 		}
 	}
-	// wg.Wait()
 
 	if a.GenOpts.IncludeHandler {
 		log.Printf("rendering %d operation groups (tags)", app.OperationGroups.Len())
+		pool := newWorkerPool(a.GenOpts.Concurrency)
 		for _, opg := range app.OperationGroups {
 			opgCopy := opg
 			log.Printf("rendering %d operations for %s", opg.Operations.Len(), opg.Name)
 			for _, op := range opgCopy.Operations {
 				opCopy := op
-
-				if err := a.GenOpts.renderOperation(&opCopy); err != nil {
-					return err
-				}
+				pool.Do(func() error {
+					return a.GenOpts.renderOperation(&opCopy)
+				})
 			}
 			// Optional OperationGroups templates generation
 			opGroup := opg
 			opGroup.DefaultImports = app.DefaultImports
-			if err := a.GenOpts.renderOperationGroup(&opGroup); err != nil {
-				return fmt.Errorf("error while rendering operation group: %v", err)
-			}
+			pool.Do(func() error {
+				if err := a.GenOpts.renderOperationGroup(&opGroup); err != nil {
+					return fmt.Errorf("error while rendering operation group: %v", err)
+				}
+				return nil
+			})
+		}
+		if err := pool.Wait(); err != nil {
+			return err
 		}
 	}
 
 	if a.GenOpts.IncludeSupport {
 		log.Printf("rendering support")
-		// wg.Do(func() {
 		if err := a.GenerateSupport(&app); err != nil {
-			// errChan <- err
 			return err
 		}
-		// })
 	}
-	// wg.Wait()
-	// if len(errChan) > 0 {
-	// 	return <-errChan
-	// }
 	return nil
 }
 
@@ -653,6 +709,13 @@ func (a *appGenerator) makeCodegenApp() (GenApp, error) {
 			WithContext:    a.GenOpts != nil && a.GenOpts.WithContext,
 			GenOpts:        a.GenOpts,
 		}
+		// the description of a group is the description of its tag, if any
+		for _, tag := range a.SpecDoc.Spec().Tags {
+			if a.GenOpts.LanguageOpts.MangleName(swag.ToFileName(tag.Name), a.APIPackage) == k {
+				opGroup.Description = tag.Description
+				break
+			}
+		}
 		opGroups = append(opGroups, opGroup)
 		var importPath string
 		if k == a.APIPackage {
@@ -1,12 +1,15 @@
 package generator
 
 import (
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
 	goruntime "runtime"
 	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 var checkprefixandfetchrelativepathtests = []struct {
@@ -115,3 +118,38 @@ func TestBaseImport(t *testing.T) {
 	}
 
 }
+
+func TestBaseImportModules(t *testing.T) {
+	oldmodules := os.Getenv("GO111MODULE")
+	defer os.Setenv("GO111MODULE", oldmodules)
+	_ = os.Setenv("GO111MODULE", "on")
+
+	root := filepath.Join(tempdir, "modroot")
+	defer os.RemoveAll(root)
+
+	target := filepath.Join(root, "api", "gen")
+	if err := os.MkdirAll(target, 0777); err != nil {
+		t.Fatal(err)
+	}
+	mod := "// my module\nmodule \"github.com/example/api\" // with a comment\n\nrequire github.com/go-openapi/runtime v0.17.0\n"
+	if err := ioutil.WriteFile(filepath.Join(root, "go.mod"), []byte(mod), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "github.com/example/api/api/gen", golang.baseImport(target))
+	assert.Equal(t, "github.com/example/api", golang.baseImport(root))
+
+	pth, ok := goModuleImportPath(tempdir)
+	assert.False(t, ok)
+	assert.Empty(t, pth)
+
+	_ = os.Setenv("GO111MODULE", "off")
+	_, ok = goModuleImportPath(target)
+	assert.False(t, ok)
+}
+
+func TestModulePath(t *testing.T) {
+	assert.Equal(t, "github.com/example/api", modulePath([]byte("module github.com/example/api\n")))
+	assert.Equal(t, "github.com/example/api", modulePath([]byte("\n// comment\nmodule \"github.com/example/api\"\ngo 1.11\n")))
+	assert.Equal(t, "", modulePath([]byte("go 1.11\n")))
+}
@@ -9,6 +9,7 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"sync"
 	"text/template"
 	"text/template/parse"
 
@@ -100,9 +101,13 @@ var FuncMap template.FuncMap = map[string]interface{}{
 	"mediaTypeName": func(orig string) string {
 		return strings.SplitN(orig, ";", 2)[0]
 	},
-	"goSliceInitializer": goSliceInitializer,
-	"hasPrefix":          strings.HasPrefix,
-	"stringContains":     strings.Contains,
+	"goSliceInitializer":  goSliceInitializer,
+	"hasPrefix":           strings.HasPrefix,
+	"stringContains":      strings.Contains,
+	"markdownTableCell":   markdownTableCell,
+	"markdownValidations": markdownValidations,
+	"cliTagValue":         cliTagValue,
+	"cliFlagDescription":  cliFlagDescription,
 }
 
 func init() {
@@ -134,12 +139,31 @@ var assets = map[string][]byte{
 	"server/server.gotmpl":       MustAsset("templates/server/server.gotmpl"),
 	"server/configureapi.gotmpl": MustAsset("templates/server/configureapi.gotmpl"),
 	"server/main.gotmpl":         MustAsset("templates/server/main.gotmpl"),
+	"server/health.gotmpl":       MustAsset("templates/server/health.gotmpl"),
+	"server/metrics.gotmpl":      MustAsset("templates/server/metrics.gotmpl"),
+	"server/validation.gotmpl":   MustAsset("templates/server/validation.gotmpl"),
+	"server/handlers.gotmpl":     MustAsset("templates/server/handlers.gotmpl"),
 	"server/doc.gotmpl":          MustAsset("templates/server/doc.gotmpl"),
 
-	"client/parameter.gotmpl": MustAsset("templates/client/parameter.gotmpl"),
-	"client/response.gotmpl":  MustAsset("templates/client/response.gotmpl"),
-	"client/client.gotmpl":    MustAsset("templates/client/client.gotmpl"),
-	"client/facade.gotmpl":    MustAsset("templates/client/facade.gotmpl"),
+	"client/parameter.gotmpl":    MustAsset("templates/client/parameter.gotmpl"),
+	"client/response.gotmpl":     MustAsset("templates/client/response.gotmpl"),
+	"client/client.gotmpl":       MustAsset("templates/client/client.gotmpl"),
+	"client/facade.gotmpl":       MustAsset("templates/client/facade.gotmpl"),
+	"client/retry.gotmpl":        MustAsset("templates/client/retry.gotmpl"),
+	"client/signature.gotmpl":    MustAsset("templates/client/signature.gotmpl"),
+	"client/mock.gotmpl":         MustAsset("templates/client/mock.gotmpl"),
+	"client/auth.gotmpl":         MustAsset("templates/client/auth.gotmpl"),
+	"client/interceptors.gotmpl": MustAsset("templates/client/interceptors.gotmpl"),
+	"client/cassette.gotmpl":     MustAsset("templates/client/cassette.gotmpl"),
+	"client/files.gotmpl":        MustAsset("templates/client/files.gotmpl"),
+	"client/options.gotmpl":      MustAsset("templates/client/options.gotmpl"),
+
+	"cli/cli.gotmpl":      MustAsset("templates/cli/cli.gotmpl"),
+	"cli/commands.gotmpl": MustAsset("templates/cli/commands.gotmpl"),
+	"cli/main.gotmpl":     MustAsset("templates/cli/main.gotmpl"),
+	"cli/params.gotmpl":   MustAsset("templates/cli/params.gotmpl"),
+
+	"markdown/docs.gotmpl": MustAsset("templates/markdown/docs.gotmpl"),
 }
 
 var protectedTemplates = map[string]bool{
@@ -239,10 +263,14 @@ func NewRepository(funcs template.FuncMap) *Repository {
 }
 
 // Repository is the repository for the generator templates.
+//
+// A repository is safe for concurrent use: templates returned by Get
+// may be executed in parallel while other templates are being retrieved.
 type Repository struct {
 	files     map[string]string
 	templates map[string]*template.Template
 	funcs     template.FuncMap
+	mux       sync.Mutex
 }
 
 // LoadDefaults will load the embedded templates
@@ -285,6 +313,9 @@ func (t *Repository) LoadDir(templatePath string) error {
 }
 
 func (t *Repository) addFile(name, data string, allowOverride bool) error {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
 	fileName := name
 	name = swag.ToJSONName(strings.TrimSuffix(name, ".gotmpl"))
 
@@ -449,18 +480,30 @@ func (t *Repository) addDependencies(templ *template.Template) (*template.Templa
 
 // Get will return the named template from the repository, ensuring that all dependent templates are loaded.
 // It will return an error if a dependent template is not defined in the repository.
+//
+// The returned template is a copy which is not affected by further changes to the repository.
 func (t *Repository) Get(name string) (*template.Template, error) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
 	templ, found := t.templates[name]
 
 	if !found {
 		return templ, fmt.Errorf("Template doesn't exist %s", name)
 	}
 
-	return t.addDependencies(templ)
+	templ, err := t.addDependencies(templ)
+	if err != nil {
+		return templ, err
+	}
+	return templ.Clone()
 }
 
 // DumpTemplates prints out a dump of all the defined templates, where they are defined and what their dependencies are.
 func (t *Repository) DumpTemplates() {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintln(buf, "\n# Templates")
 	for name, templ := range t.templates {
@@ -2,9 +2,11 @@ package generator
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
+	"sync"
 	"testing"
 
 	"github.com/go-openapi/loads"
@@ -150,6 +152,41 @@ func TestTemplates_RepoLoadsAllTemplatesDefined(t *testing.T) {
 	assert.Equal(t, "T1", b.String())
 }
 
+func TestTemplates_RepoConcurrentGet(t *testing.T) {
+
+	repo := NewRepository(nil)
+
+	err := repo.AddFile("multiple", multipleDefinitions)
+	assert.NoError(t, err)
+	err = repo.AddFile("dependant", dependantTemplate)
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	results := make(chan string, 40)
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			templ, err := repo.Get("dependant")
+			if assert.NoError(t, err) {
+				var b bytes.Buffer
+				assert.NoError(t, templ.Execute(&b, nil))
+				results <- b.String()
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, repo.AddFile(fmt.Sprintf("extra%d", i), singleTemplate))
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	for res := range results {
+		assert.Equal(t, "T1D1", res)
+	}
+}
+
 type testData struct {
 	Children []testData
 	Name     string
@@ -50,7 +50,9 @@ const (
 	xIsNullable  = "x-isnullable"
 	xNullable    = "x-nullable" // turns the schema into a pointer
 	xOmitEmpty   = "x-omitempty"
-	xSchemes     = "x-schemes" // additional schemes supported for operations (server generation)
+	xPagination  = "x-pagination" // iterates over the pages of an operation (client generation)
+	xRetryable   = "x-retryable"  // retries an operation whatever its method (client generation)
+	xSchemes     = "x-schemes"    // additional schemes supported for operations (server generation)
 )
 
 // swaggerTypeMapping contains a mapping from go type to swagger type or format
@@ -0,0 +1,824 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+)
+
+// Warning reports a construct which could not be represented in swagger 2.0
+type Warning struct {
+	// Pointer is the JSON pointer to the construct in the OpenAPI 3.0 document
+	Pointer string `json:"pointer"`
+	Message string `json:"message"`
+}
+
+func (w Warning) String() string {
+	return fmt.Sprintf("%s: %s", w.Pointer, w.Message)
+}
+
+const (
+	formURLEncoded = "application/x-www-form-urlencoded"
+	multipartForm  = "multipart/form-data"
+)
+
+var (
+	methods = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+
+	// validations shared by schemas, parameters, items and headers
+	simpleSchemaKeys = []string{
+		"type", "format", "default", "enum",
+		"maximum", "exclusiveMaximum", "minimum", "exclusiveMinimum", "multipleOf",
+		"maxLength", "minLength", "pattern",
+		"maxItems", "minItems", "uniqueItems",
+	}
+
+	refPrefixes = [][2]string{
+		{"/components/schemas/", "/definitions/"},
+		{"/components/parameters/", "/parameters/"},
+		{"/components/responses/", "/responses/"},
+	}
+
+	oauth2Flows = [][2]string{
+		{"authorizationCode", "accessCode"},
+		{"implicit", "implicit"},
+		{"password", "password"},
+		{"clientCredentials", "application"},
+	}
+)
+
+// IsOpenAPI3 tells if a JSON document is an OpenAPI 3 document
+func IsOpenAPI3(data json.RawMessage) bool {
+	var doc struct {
+		OpenAPI string `json:"openapi"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return false
+	}
+	return strings.HasPrefix(doc.OpenAPI, "3.")
+}
+
+// Convert converts an OpenAPI 3.0 JSON document to a swagger 2.0 JSON document.
+//
+// The warnings list the constructs which could not be represented in swagger 2.0.
+func Convert(data json.RawMessage) (json.RawMessage, []Warning, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc map[string]interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, nil, err
+	}
+	version, _ := doc["openapi"].(string)
+	if !strings.HasPrefix(version, "3.0") {
+		return nil, nil, fmt.Errorf("OpenAPI version %q is not supported, only 3.0 documents may be converted", version)
+	}
+
+	c := &converter{doc: doc, circular: make(map[string]bool)}
+	b, err := json.Marshal(c.document())
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, c.warnings, nil
+}
+
+type converter struct {
+	doc      map[string]interface{}
+	warnings []Warning
+	circular map[string]bool
+}
+
+func (c *converter) warn(ptr, format string, args ...interface{}) {
+	c.warnings = append(c.warnings, Warning{Pointer: ptr, Message: fmt.Sprintf(format, args...)})
+}
+
+func (c *converter) document() map[string]interface{} {
+	res := map[string]interface{}{"swagger": "2.0"}
+	for _, k := range []string{"info", "externalDocs", "tags", "security"} {
+		if v, ok := c.doc[k]; ok {
+			res[k] = v
+		}
+	}
+	copyExtensions(c.doc, res)
+	c.servers(res)
+
+	components := object(c.doc["components"])
+	if schemas := object(components["schemas"]); len(schemas) > 0 {
+		definitions := make(map[string]interface{}, len(schemas))
+		for _, name := range sortedKeys(schemas) {
+			definitions[name] = c.schema(schemas[name], pointer("components", "schemas", name))
+		}
+		res["definitions"] = definitions
+	}
+	if params := object(components["parameters"]); len(params) > 0 {
+		parameters := make(map[string]interface{}, len(params))
+		for _, name := range sortedKeys(params) {
+			if p := c.parameter(params[name], pointer("components", "parameters", name)); p != nil {
+				parameters[name] = p
+			}
+		}
+		res["parameters"] = parameters
+	}
+	if resps := object(components["responses"]); len(resps) > 0 {
+		responses := make(map[string]interface{}, len(resps))
+		for _, name := range sortedKeys(resps) {
+			responses[name], _ = c.response(resps[name], pointer("components", "responses", name))
+		}
+		res["responses"] = responses
+	}
+	if schemes := object(components["securitySchemes"]); len(schemes) > 0 {
+		definitions := make(map[string]interface{}, len(schemes))
+		for _, name := range sortedKeys(schemes) {
+			if def := c.securityScheme(schemes[name], pointer("components", "securitySchemes", name)); def != nil {
+				definitions[name] = def
+			}
+		}
+		res["securityDefinitions"] = definitions
+	}
+	for _, k := range []string{"examples", "links", "callbacks"} {
+		if len(object(components[k])) > 0 {
+			c.warn(pointer("components", k), "%s cannot be represented in swagger 2.0", k)
+		}
+	}
+
+	res["paths"] = c.paths(object(c.doc["paths"]))
+	return res
+}
+
+// servers converts the first server to host, basePath and schemes
+func (c *converter) servers(res map[string]interface{}) {
+	servers, _ := c.doc["servers"].([]interface{})
+	if len(servers) == 0 {
+		return
+	}
+
+	var host, basePath string
+	var schemes []interface{}
+	for i, s := range servers {
+		u, err := url.Parse(serverURL(object(s)))
+		if err != nil {
+			c.warn(pointer("servers", fmt.Sprint(i)), "invalid server url: %v", err)
+			continue
+		}
+		path := strings.TrimSuffix(u.Path, "/")
+		if i == 0 {
+			host, basePath = u.Host, path
+		} else if u.Host != host || path != basePath {
+			c.warn(pointer("servers", fmt.Sprint(i)), "swagger 2.0 supports a single host and base path, server %q is dropped", u.String())
+			continue
+		}
+		if u.Scheme != "" && !contains(schemes, u.Scheme) {
+			schemes = append(schemes, u.Scheme)
+		}
+	}
+	if host != "" {
+		res["host"] = host
+	}
+	if basePath != "" {
+		res["basePath"] = basePath
+	}
+	if len(schemes) > 0 {
+		res["schemes"] = schemes
+	}
+}
+
+// serverURL substitutes the default values of the variables of a server url
+func serverURL(server map[string]interface{}) string {
+	u, _ := server["url"].(string)
+	vars := object(server["variables"])
+	for _, name := range sortedKeys(vars) {
+		if def, ok := object(vars[name])["default"]; ok {
+			u = strings.Replace(u, "{"+name+"}", fmt.Sprint(def), -1)
+		}
+	}
+	return u
+}
+
+func (c *converter) paths(paths map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(paths))
+	for _, path := range sortedKeys(paths) {
+		ptr := pointer("paths", path)
+		item := object(paths[path])
+		if _, ok := item["$ref"]; ok {
+			c.warn(ptr, "references to path items cannot be represented in swagger 2.0")
+			continue
+		}
+
+		out := make(map[string]interface{})
+		copyExtensions(item, out)
+		if params := c.parameters(item["parameters"], ptr); len(params) > 0 {
+			out["parameters"] = params
+		}
+		for _, method := range methods {
+			if op, ok := item[method]; ok {
+				out[method] = c.operation(object(op), ptr+"/"+method)
+			}
+		}
+		for _, k := range []string{"trace", "servers", "summary", "description"} {
+			if _, ok := item[k]; ok {
+				c.warn(ptr+"/"+k, "%s of path items cannot be represented in swagger 2.0", k)
+			}
+		}
+		res[path] = out
+	}
+	return res
+}
+
+func (c *converter) operation(op map[string]interface{}, ptr string) map[string]interface{} {
+	res := make(map[string]interface{})
+	for _, k := range []string{"tags", "summary", "description", "externalDocs", "operationId", "deprecated", "security"} {
+		if v, ok := op[k]; ok {
+			res[k] = v
+		}
+	}
+	copyExtensions(op, res)
+
+	params := c.parameters(op["parameters"], ptr)
+	if rb, ok := op["requestBody"]; ok {
+		bodyParams, consumes := c.requestBody(rb, ptr+"/requestBody")
+		params = append(params, bodyParams...)
+		if len(consumes) > 0 {
+			res["consumes"] = consumes
+		}
+	}
+	if len(params) > 0 {
+		res["parameters"] = params
+	}
+
+	var produces []interface{}
+	responses := make(map[string]interface{})
+	resps := object(op["responses"])
+	for _, code := range sortedKeys(resps) {
+		if strings.HasSuffix(strings.ToUpper(code), "XX") {
+			c.warn(ptr+"/responses/"+jsonpointer.Escape(code), "status code ranges cannot be represented in swagger 2.0")
+			continue
+		}
+		resp, mediaTypes := c.response(resps[code], ptr+"/responses/"+jsonpointer.Escape(code))
+		responses[code] = resp
+		for _, mt := range mediaTypes {
+			if !contains(produces, mt) {
+				produces = append(produces, mt)
+			}
+		}
+	}
+	res["responses"] = responses
+	if len(produces) > 0 {
+		res["produces"] = produces
+	}
+
+	for _, k := range []string{"callbacks", "servers"} {
+		if _, ok := op[k]; ok {
+			c.warn(ptr+"/"+k, "%s of operations cannot be represented in swagger 2.0", k)
+		}
+	}
+	return res
+}
+
+func (c *converter) parameters(params interface{}, ptr string) []interface{} {
+	list, _ := params.([]interface{})
+	res := make([]interface{}, 0, len(list))
+	for i, p := range list {
+		if param := c.parameter(p, fmt.Sprintf("%s/parameters/%d", ptr, i)); param != nil {
+			res = append(res, param)
+		}
+	}
+	return res
+}
+
+func (c *converter) parameter(p interface{}, ptr string) map[string]interface{} {
+	param := object(p)
+	if ref, ok := param["$ref"].(string); ok {
+		if resolved, ok := c.resolve(ref); ok && resolved["in"] == "cookie" {
+			c.warn(ptr, "cookie parameters cannot be represented in swagger 2.0")
+			return nil
+		}
+		return map[string]interface{}{"$ref": convertRef(ref)}
+	}
+
+	in, _ := param["in"].(string)
+	if in == "cookie" {
+		c.warn(ptr, "cookie parameters cannot be represented in swagger 2.0")
+		return nil
+	}
+
+	res := make(map[string]interface{})
+	for _, k := range []string{"name", "in", "description", "required", "allowEmptyValue"} {
+		if v, ok := param[k]; ok {
+			res[k] = v
+		}
+	}
+	copyExtensions(param, res)
+	if ex, ok := param["example"]; ok {
+		res["x-example"] = ex
+	}
+	if _, ok := param["deprecated"]; ok {
+		c.warn(ptr+"/deprecated", "deprecated parameters cannot be represented in swagger 2.0")
+	}
+
+	schema, hasSchema := param["schema"]
+	if !hasSchema {
+		if _, ok := param["content"]; ok {
+			c.warn(ptr+"/content", "parameters with a content cannot be represented in swagger 2.0, the parameter is a string")
+		}
+		res["type"] = "string"
+		return res
+	}
+	c.simpleSchema(schema, res, ptr+"/schema")
+
+	if res["type"] == "array" {
+		style, _ := param["style"].(string)
+		explode, hasExplode := param["explode"].(bool)
+		if style == "" || style == "form" {
+			// form is the default style of query parameters, and explodes by default
+			if in == "query" && (!hasExplode || explode) {
+				res["collectionFormat"] = "multi"
+			} else {
+				res["collectionFormat"] = "csv"
+			}
+		}
+		switch style {
+		case "simple":
+			res["collectionFormat"] = "csv"
+		case "spaceDelimited":
+			res["collectionFormat"] = "ssv"
+		case "pipeDelimited":
+			res["collectionFormat"] = "pipes"
+		case "form", "":
+		default:
+			c.warn(ptr+"/style", "style %q cannot be represented in swagger 2.0", style)
+		}
+	}
+	return res
+}
+
+// simpleSchema copies the validations of a schema to a parameter, items or header
+func (c *converter) simpleSchema(s interface{}, res map[string]interface{}, ptr string) {
+	schema := object(s)
+	if ref, ok := schema["$ref"].(string); ok {
+		resolved, ok := c.resolve(ref)
+		if !ok {
+			c.warn(ptr, "the reference %q cannot be resolved, the value is a string", ref)
+			res["type"] = "string"
+			return
+		}
+		schema = resolved
+	}
+
+	for _, k := range simpleSchemaKeys {
+		if v, ok := schema[k]; ok {
+			res[k] = v
+		}
+	}
+	if nullable, ok := schema["nullable"]; ok {
+		res["x-nullable"] = nullable
+	}
+	switch res["type"] {
+	case "object":
+		c.warn(ptr, "object values cannot be represented in swagger 2.0, the value is a string")
+		res["type"] = "string"
+	case "array":
+		items := make(map[string]interface{})
+		c.simpleSchema(schema["items"], items, ptr+"/items")
+		res["items"] = items
+	case nil:
+		res["type"] = "string"
+	}
+}
+
+func (c *converter) requestBody(rb interface{}, ptr string) ([]interface{}, []interface{}) {
+	body := object(rb)
+	if ref, ok := body["$ref"].(string); ok {
+		resolved, ok := c.resolve(ref)
+		if !ok {
+			c.warn(ptr, "the reference %q cannot be resolved", ref)
+			return nil, nil
+		}
+		body = resolved
+	}
+
+	content := object(body["content"])
+	var forms, others []string
+	for _, mt := range sortedKeys(content) {
+		if isForm(mt) {
+			forms = append(forms, mt)
+		} else {
+			others = append(others, mt)
+		}
+	}
+
+	var consumes []interface{}
+	if len(others) == 0 && len(forms) > 0 {
+		for _, mt := range forms {
+			consumes = append(consumes, mt)
+		}
+		mt := forms[0]
+		return c.formParameters(object(content[mt])["schema"], ptr+"/content/"+jsonpointer.Escape(mt)), consumes
+	}
+
+	for _, mt := range forms {
+		c.warn(ptr+"/content/"+jsonpointer.Escape(mt), "swagger 2.0 does not support a form along with a body, the form is dropped")
+	}
+	for _, mt := range others {
+		consumes = append(consumes, mt)
+	}
+
+	name := "body"
+	if nm, ok := body["x-codegen-request-body-name"].(string); ok && nm != "" {
+		name = nm
+	}
+	param := map[string]interface{}{"name": name, "in": "body"}
+	for _, k := range []string{"description", "required"} {
+		if v, ok := body[k]; ok {
+			param[k] = v
+		}
+	}
+	copyExtensions(body, param)
+	if mt := preferredMediaType(others); mt != "" {
+		param["schema"] = c.schema(object(content[mt])["schema"], ptr+"/content/"+jsonpointer.Escape(mt)+"/schema")
+	} else {
+		param["schema"] = map[string]interface{}{}
+	}
+	return []interface{}{param}, consumes
+}
+
+// formParameters converts the properties of the schema of a form to formData parameters
+func (c *converter) formParameters(s interface{}, ptr string) []interface{} {
+	schema := object(s)
+	if ref, ok := schema["$ref"].(string); ok {
+		resolved, ok := c.resolve(ref)
+		if !ok {
+			c.warn(ptr+"/schema", "the reference %q cannot be resolved", ref)
+			return nil
+		}
+		schema = resolved
+	}
+
+	required, _ := schema["required"].([]interface{})
+	props := object(schema["properties"])
+	res := make([]interface{}, 0, len(props))
+	for _, name := range sortedKeys(props) {
+		prop := object(props[name])
+		param := map[string]interface{}{"name": name, "in": "formData"}
+		if contains(required, name) {
+			param["required"] = true
+		}
+		if desc, ok := prop["description"]; ok {
+			param["description"] = desc
+		}
+		c.simpleSchema(prop, param, ptr+"/schema/properties/"+jsonpointer.Escape(name))
+		if param["type"] == "string" && (param["format"] == "binary" || param["format"] == "base64") {
+			param["type"] = "file"
+			delete(param, "format")
+		}
+		res = append(res, param)
+	}
+	return res
+}
+
+// response converts a response, and returns the media types it produces
+func (c *converter) response(r interface{}, ptr string) (map[string]interface{}, []interface{}) {
+	resp := object(r)
+	if ref, ok := resp["$ref"].(string); ok {
+		var mediaTypes []interface{}
+		if resolved, ok := c.resolve(ref); ok {
+			for _, mt := range sortedKeys(object(resolved["content"])) {
+				mediaTypes = append(mediaTypes, mt)
+			}
+		}
+		return map[string]interface{}{"$ref": convertRef(ref)}, mediaTypes
+	}
+
+	res := map[string]interface{}{"description": ""}
+	if desc, ok := resp["description"]; ok {
+		res["description"] = desc
+	}
+	copyExtensions(resp, res)
+
+	headers := object(resp["headers"])
+	if len(headers) > 0 {
+		out := make(map[string]interface{}, len(headers))
+		for _, name := range sortedKeys(headers) {
+			hptr := ptr + "/headers/" + jsonpointer.Escape(name)
+			header := object(headers[name])
+			if ref, ok := header["$ref"].(string); ok {
+				resolved, ok := c.resolve(ref)
+				if !ok {
+					c.warn(hptr, "the reference %q cannot be resolved", ref)
+					continue
+				}
+				header = resolved
+			}
+			h := make(map[string]interface{})
+			if desc, ok := header["description"]; ok {
+				h["description"] = desc
+			}
+			c.simpleSchema(header["schema"], h, hptr+"/schema")
+			out[name] = h
+		}
+		res["headers"] = out
+	}
+
+	content := object(resp["content"])
+	mediaTypes := sortedKeys(content)
+	if mt := preferredMediaType(mediaTypes); mt != "" {
+		if schema, ok := object(content[mt])["schema"]; ok {
+			res["schema"] = c.schema(schema, ptr+"/content/"+jsonpointer.Escape(mt)+"/schema")
+		}
+	}
+	examples := make(map[string]interface{})
+	for _, mt := range mediaTypes {
+		media := object(content[mt])
+		if ex, ok := media["example"]; ok {
+			examples[mt] = ex
+			continue
+		}
+		named := object(media["examples"])
+		if names := sortedKeys(named); len(names) > 0 {
+			if value, ok := object(named[names[0]])["value"]; ok {
+				examples[mt] = value
+			}
+			if len(names) > 1 {
+				c.warn(ptr+"/content/"+jsonpointer.Escape(mt)+"/examples", "swagger 2.0 supports a single example per media type, only %q is kept", names[0])
+			}
+		}
+	}
+	if len(examples) > 0 {
+		res["examples"] = examples
+	}
+
+	if _, ok := resp["links"]; ok {
+		c.warn(ptr+"/links", "links cannot be represented in swagger 2.0")
+	}
+
+	produces := make([]interface{}, 0, len(mediaTypes))
+	for _, mt := range mediaTypes {
+		produces = append(produces, mt)
+	}
+	return res, produces
+}
+
+func (c *converter) schema(s interface{}, ptr string) interface{} {
+	schema, ok := s.(map[string]interface{})
+	if !ok {
+		return s
+	}
+	if ref, ok := schema["$ref"].(string); ok {
+		return map[string]interface{}{"$ref": convertRef(ref)}
+	}
+
+	res := make(map[string]interface{}, len(schema))
+	for _, k := range sortedKeys(schema) {
+		v := schema[k]
+		switch k {
+		case "nullable":
+			res["x-nullable"] = v
+		case "properties":
+			props := object(v)
+			out := make(map[string]interface{}, len(props))
+			for _, name := range sortedKeys(props) {
+				out[name] = c.schema(props[name], ptr+"/properties/"+jsonpointer.Escape(name))
+			}
+			res[k] = out
+		case "items", "additionalProperties":
+			res[k] = c.schema(v, ptr+"/"+k)
+		case "allOf":
+			res[k] = c.schemas(v, ptr+"/"+k)
+		case "oneOf", "anyOf":
+			alternatives, _ := v.([]interface{})
+			if len(alternatives) == 1 {
+				// a single alternative is equivalent to a composition
+				// allOf sorts before anyOf and oneOf, so it is already converted
+				composed, _ := res["allOf"].([]interface{})
+				res["allOf"] = append(composed, c.schema(alternatives[0], ptr+"/"+k+"/0"))
+				continue
+			}
+			c.warn(ptr+"/"+k, "%s with several alternatives cannot be represented in swagger 2.0, the schema accepts any value", k)
+		case "discriminator":
+			disc := object(v)
+			res[k] = disc["propertyName"]
+			if _, ok := disc["mapping"]; ok {
+				c.warn(ptr+"/discriminator/mapping", "discriminator mappings cannot be represented in swagger 2.0, values are the names of definitions")
+			}
+		case "not", "writeOnly", "deprecated":
+			c.warn(ptr+"/"+k, "%s cannot be represented in swagger 2.0", k)
+		default:
+			res[k] = v
+		}
+	}
+	return res
+}
+
+func (c *converter) schemas(s interface{}, ptr string) []interface{} {
+	list, _ := s.([]interface{})
+	res := make([]interface{}, 0, len(list))
+	for i, sch := range list {
+		res = append(res, c.schema(sch, fmt.Sprintf("%s/%d", ptr, i)))
+	}
+	return res
+}
+
+func (c *converter) securityScheme(s interface{}, ptr string) map[string]interface{} {
+	scheme := object(s)
+	res := make(map[string]interface{})
+	if desc, ok := scheme["description"]; ok {
+		res["description"] = desc
+	}
+	copyExtensions(scheme, res)
+
+	switch tpe, _ := scheme["type"].(string); tpe {
+	case "http":
+		switch httpScheme, _ := scheme["scheme"].(string); strings.ToLower(httpScheme) {
+		case "basic":
+			res["type"] = "basic"
+		case "bearer":
+			c.warn(ptr, "bearer authentication is represented as an api key in the Authorization header")
+			res["type"] = "apiKey"
+			res["name"] = "Authorization"
+			res["in"] = "header"
+		default:
+			c.warn(ptr, "http authentication scheme %q cannot be represented in swagger 2.0", httpScheme)
+			return nil
+		}
+	case "apiKey":
+		if scheme["in"] == "cookie" {
+			c.warn(ptr, "api keys in cookies cannot be represented in swagger 2.0")
+			return nil
+		}
+		res["type"] = "apiKey"
+		res["name"] = scheme["name"]
+		res["in"] = scheme["in"]
+	case "oauth2":
+		flows := object(scheme["flows"])
+		for _, flow := range oauth2Flows {
+			f, ok := flows[flow[0]]
+			if !ok {
+				continue
+			}
+			if _, done := res["flow"]; done {
+				c.warn(ptr+"/flows/"+flow[0], "swagger 2.0 supports a single flow per security scheme, the %s flow is dropped", flow[0])
+				continue
+			}
+			res["type"] = "oauth2"
+			res["flow"] = flow[1]
+			for _, k := range []string{"authorizationUrl", "tokenUrl", "scopes"} {
+				if v, ok := object(f)[k]; ok {
+					res[k] = v
+				}
+			}
+			if _, ok := res["scopes"]; !ok {
+				res["scopes"] = map[string]interface{}{}
+			}
+		}
+		if _, ok := res["flow"]; !ok {
+			c.warn(ptr, "oauth2 security scheme without a flow cannot be represented in swagger 2.0")
+			return nil
+		}
+	default:
+		c.warn(ptr, "security scheme of type %q cannot be represented in swagger 2.0", tpe)
+		return nil
+	}
+	return res
+}
+
+// resolve looks up a local reference, following the references it points to.
+//
+// A circular reference is not resolved, and yields a warning.
+func (c *converter) resolve(ref string) (map[string]interface{}, bool) {
+	visited := make(map[string]bool)
+	for {
+		if visited[ref] {
+			if !c.circular[ref] {
+				c.circular[ref] = true
+				c.warn(strings.TrimPrefix(ref, "#"), "the reference %q is circular", ref)
+			}
+			return nil, false
+		}
+		visited[ref] = true
+
+		obj, ok := c.lookup(ref)
+		if !ok {
+			return nil, false
+		}
+		next, isRef := obj["$ref"].(string)
+		if !isRef {
+			return obj, true
+		}
+		ref = next
+	}
+}
+
+// lookup returns the object at a local reference
+func (c *converter) lookup(ref string) (map[string]interface{}, bool) {
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, false
+	}
+	var node interface{} = c.doc
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if node, ok = obj[jsonpointer.Unescape(token)]; !ok {
+			return nil, false
+		}
+	}
+	obj, ok := node.(map[string]interface{})
+	return obj, ok
+}
+
+// convertRef points references to components at their swagger 2.0 counterpart
+func convertRef(ref string) string {
+	parts := strings.SplitN(ref, "#", 2)
+	if len(parts) != 2 {
+		return ref
+	}
+	for _, prefix := range refPrefixes {
+		if strings.HasPrefix(parts[1], prefix[0]) {
+			return parts[0] + "#" + prefix[1] + strings.TrimPrefix(parts[1], prefix[0])
+		}
+	}
+	return ref
+}
+
+func isForm(mediaType string) bool {
+	mt := strings.ToLower(mediaType)
+	return strings.HasPrefix(mt, formURLEncoded) || strings.HasPrefix(mt, multipartForm)
+}
+
+// preferredMediaType picks the media type which provides the schema, favoring JSON
+func preferredMediaType(mediaTypes []string) string {
+	for _, mt := range mediaTypes {
+		if strings.HasPrefix(strings.ToLower(mt), "application/json") {
+			return mt
+		}
+	}
+	for _, mt := range mediaTypes {
+		if strings.Contains(strings.ToLower(mt), "json") {
+			return mt
+		}
+	}
+	if len(mediaTypes) > 0 {
+		return mediaTypes[0]
+	}
+	return ""
+}
+
+func copyExtensions(src, dst map[string]interface{}) {
+	for k, v := range src {
+		if strings.HasPrefix(strings.ToLower(k), "x-") {
+			dst[k] = v
+		}
+	}
+}
+
+func object(v interface{}) map[string]interface{} {
+	obj, _ := v.(map[string]interface{})
+	return obj
+}
+
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func contains(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func pointer(tokens ...string) string {
+	if len(tokens) == 0 {
+		return ""
+	}
+	escaped := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		escaped = append(escaped, jsonpointer.Escape(token))
+	}
+	return "/" + strings.Join(escaped, "/")
+}
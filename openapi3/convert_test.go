@@ -0,0 +1,194 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi3
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var petstore = filepath.Join("..", "fixtures", "openapi3", "petstore.yaml")
+
+func convertFixture(t *testing.T) (*spec.Swagger, []Warning) {
+	data, warnings, err := Load(petstore)
+	require.NoError(t, err)
+	var sw spec.Swagger
+	require.NoError(t, json.Unmarshal(data, &sw))
+	return &sw, warnings
+}
+
+func TestConvert_Document(t *testing.T) {
+	sw, _ := convertFixture(t)
+
+	assert.Equal(t, "2.0", sw.Swagger)
+	assert.Equal(t, "openapi 3 petstore", sw.Info.Title)
+	assert.Equal(t, "eu.example.com", sw.Host)
+	assert.Equal(t, "/v1", sw.BasePath)
+	assert.Equal(t, []string{"https", "http"}, sw.Schemes)
+
+	assert.Contains(t, sw.Definitions, "Pet")
+	assert.Contains(t, sw.Parameters, "limit")
+	assert.Contains(t, sw.Responses, "error")
+	assert.Equal(t, "#/definitions/Error", sw.Responses["error"].Schema.Ref.String())
+
+	bearer := sw.SecurityDefinitions["bearer"]
+	if assert.NotNil(t, bearer) {
+		assert.Equal(t, "apiKey", bearer.Type)
+		assert.Equal(t, "Authorization", bearer.Name)
+	}
+	oauth := sw.SecurityDefinitions["oauth"]
+	if assert.NotNil(t, oauth) {
+		assert.Equal(t, "implicit", oauth.Flow)
+		assert.Equal(t, "https://example.com/auth", oauth.AuthorizationURL)
+	}
+}
+
+func TestConvert_Operations(t *testing.T) {
+	sw, _ := convertFixture(t)
+
+	list := sw.Paths.Paths["/pets"].Get
+	require.NotNil(t, list)
+	if assert.Len(t, list.Parameters, 2) {
+		assert.Equal(t, "#/parameters/limit", list.Parameters[0].Ref.String())
+		assert.Equal(t, "array", list.Parameters[1].Type)
+		assert.Equal(t, "multi", list.Parameters[1].CollectionFormat)
+		assert.Equal(t, "string", list.Parameters[1].Items.Type)
+	}
+	assert.Equal(t, []string{"application/json", "application/xml"}, list.Produces)
+	ok := list.Responses.StatusCodeResponses[200]
+	assert.Equal(t, "#/definitions/Pet", ok.Schema.Items.Schema.Ref.String())
+	assert.Equal(t, "integer", ok.Headers["X-Total"].Type)
+	assert.Contains(t, ok.Examples, "application/json")
+	assert.Equal(t, "#/responses/error", list.Responses.Default.Ref.String())
+	assert.Len(t, list.Responses.StatusCodeResponses, 1)
+
+	add := sw.Paths.Paths["/pets"].Post
+	require.NotNil(t, add)
+	if assert.Len(t, add.Parameters, 1) {
+		assert.Equal(t, "body", add.Parameters[0].In)
+		assert.True(t, add.Parameters[0].Required)
+		assert.Equal(t, "#/definitions/Pet", add.Parameters[0].Schema.Ref.String())
+	}
+	assert.Equal(t, []string{"application/json"}, add.Consumes)
+
+	upload := sw.Paths.Paths["/pets/{id}/photo"]
+	if assert.Len(t, upload.Parameters, 1) {
+		assert.Equal(t, "int64", upload.Parameters[0].Format)
+	}
+	require.NotNil(t, upload.Put)
+	assert.Equal(t, []string{"multipart/form-data"}, upload.Put.Consumes)
+	if assert.Len(t, upload.Put.Parameters, 2) {
+		assert.Equal(t, "caption", upload.Put.Parameters[0].Name)
+		assert.Equal(t, "formData", upload.Put.Parameters[0].In)
+		assert.Equal(t, "photo", upload.Put.Parameters[1].Name)
+		assert.Equal(t, "file", upload.Put.Parameters[1].Type)
+		assert.True(t, upload.Put.Parameters[1].Required)
+	}
+}
+
+func TestConvert_Schemas(t *testing.T) {
+	sw, _ := convertFixture(t)
+
+	pet := sw.Definitions["Pet"]
+	assert.Equal(t, true, pet.Properties["tag"].Extensions["x-nullable"])
+	owner := pet.Properties["owner"]
+	if assert.Len(t, owner.AllOf, 1) {
+		assert.Equal(t, "#/definitions/Owner", owner.AllOf[0].Ref.String())
+	}
+	toy := pet.Properties["toy"]
+	assert.Empty(t, toy.Type)
+	assert.Empty(t, toy.AllOf)
+}
+
+func TestConvert_Warnings(t *testing.T) {
+	_, warnings := convertFixture(t)
+
+	pointers := make([]string, 0, len(warnings))
+	for _, w := range warnings {
+		assert.NotEmpty(t, w.Message)
+		pointers = append(pointers, w.Pointer)
+	}
+	assert.ElementsMatch(t, []string{
+		"/servers/2",
+		"/components/schemas/Pet/properties/toy/anyOf",
+		"/components/securitySchemes/bearer",
+		"/components/securitySchemes/oauth/flows/clientCredentials",
+		"/paths/~1pets/get/parameters/2",
+		"/paths/~1pets/get/responses/4XX",
+		"/paths/~1pets/post/callbacks",
+	}, pointers)
+}
+
+func TestConvert_Loader(t *testing.T) {
+	// OpenAPI 3 documents are converted
+	data, err := Loader(petstore)
+	require.NoError(t, err)
+	doc, err := loads.Analyzed(data, "")
+	require.NoError(t, err)
+	assert.Equal(t, "2.0", doc.Version())
+
+	// swagger documents are left unchanged
+	data, warnings, err := Load(filepath.Join("..", "fixtures", "codegen", "todolist.simple.yml"))
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+	assert.False(t, IsOpenAPI3(data))
+
+	_, _, err = Convert(json.RawMessage(`{"openapi": "3.1.0"}`))
+	assert.Error(t, err)
+}
+
+func TestConvert_Refs(t *testing.T) {
+	assert.Equal(t, "#/definitions/Pet", convertRef("#/components/schemas/Pet"))
+	assert.Equal(t, "other.yaml#/responses/error", convertRef("other.yaml#/components/responses/error"))
+	assert.Equal(t, "other.yaml", convertRef("other.yaml"))
+}
+
+func TestConvert_CircularRefs(t *testing.T) {
+	data, warnings, err := Convert(json.RawMessage(`{
+  "openapi": "3.0.0",
+  "info": {"title": "circular", "version": "1.0.0"},
+  "paths": {
+    "/pets": {
+      "get": {
+        "parameters": [{"$ref": "#/components/parameters/A"}],
+        "responses": {"200": {"description": "ok"}}
+      }
+    }
+  },
+  "components": {
+    "parameters": {
+      "A": {"$ref": "#/components/parameters/B"},
+      "B": {"$ref": "#/components/parameters/A"}
+    }
+  }
+}`))
+	require.NoError(t, err)
+	assert.True(t, json.Valid(data))
+
+	messages := make([]string, 0, len(warnings))
+	for _, w := range warnings {
+		messages = append(messages, w.String())
+	}
+	assert.ElementsMatch(t, []string{
+		`/components/parameters/A: the reference "#/components/parameters/A" is circular`,
+		`/components/parameters/B: the reference "#/components/parameters/B" is circular`,
+	}, messages)
+}
@@ -0,0 +1,36 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package openapi3 converts OpenAPI 3.0 documents to swagger 2.0 documents.
+
+The conversion lets OpenAPI 3.0 documents flow into the tooling built for
+swagger 2.0, such as the code generator. The loader of this package is
+registered with the go-openapi loads package, so that OpenAPI 3.0 documents
+are converted whenever they are loaded.
+
+The following constructs are converted:
+
+	servers           host, basePath and schemes (of the first server)
+	components        definitions, parameters, responses and securityDefinitions
+	requestBody       a body parameter, or formData parameters for forms
+	content           consumes, produces, schemas and examples
+	nullable          the x-nullable extension
+	oneOf, anyOf      an allOf, when there is a single alternative
+
+Other constructs cannot be represented in swagger 2.0, e.g. callbacks, links,
+cookie parameters or oneOf with several alternatives: they are dropped, and
+reported as warnings pointing at the original document.
+*/
+package openapi3
@@ -0,0 +1,618 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/spec"
+)
+
+// Version is the OpenAPI version of exported documents
+const Version = "3.0.3"
+
+var (
+	// the order of the keys of an exported document
+	documentKeys = []string{"openapi", "info", "externalDocs", "servers", "tags", "security", "paths", "components"}
+
+	exportRefPrefixes = [][2]string{
+		{"/definitions/", "/components/schemas/"},
+		{"/responses/", "/components/responses/"},
+	}
+
+	exportOAuth2Flows = map[string]string{
+		"accessCode":  "authorizationCode",
+		"implicit":    "implicit",
+		"password":    "password",
+		"application": "clientCredentials",
+	}
+)
+
+// Export converts a swagger 2.0 document to an OpenAPI 3.0 JSON document.
+//
+// The warnings list the constructs which could not be represented in OpenAPI 3.0.
+func Export(sw *spec.Swagger) (json.RawMessage, []Warning, error) {
+	b, err := json.Marshal(sw)
+	if err != nil {
+		return nil, nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var doc map[string]interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, nil, err
+	}
+
+	e := &exporter{converter: converter{doc: doc, circular: make(map[string]bool)}}
+	res, err := marshalDocument(e.document())
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, e.warnings, nil
+}
+
+type exporter struct {
+	converter
+}
+
+func (e *exporter) document() map[string]interface{} {
+	res := map[string]interface{}{"openapi": Version}
+	for _, k := range []string{"info", "externalDocs", "tags", "security"} {
+		if v, ok := e.doc[k]; ok {
+			res[k] = v
+		}
+	}
+	copyExtensions(e.doc, res)
+	if servers := e.servers(); len(servers) > 0 {
+		res["servers"] = servers
+	}
+
+	components := make(map[string]interface{})
+	if defs := object(e.doc["definitions"]); len(defs) > 0 {
+		schemas := make(map[string]interface{}, len(defs))
+		for _, name := range sortedKeys(defs) {
+			schemas[name] = e.schema(defs[name], pointer("definitions", name))
+		}
+		components["schemas"] = schemas
+	}
+	if params := object(e.doc["parameters"]); len(params) > 0 {
+		parameters := make(map[string]interface{})
+		requestBodies := make(map[string]interface{})
+		for _, name := range sortedKeys(params) {
+			param := object(params[name])
+			ptr := pointer("parameters", name)
+			switch param["in"] {
+			case "body":
+				requestBodies[name] = e.requestBody([]map[string]interface{}{param}, e.mediaTypes("consumes", nil), ptr)
+			case "formData":
+				// form parameters are merged into the request body of the operations using them
+			default:
+				parameters[name] = e.parameter(param, ptr)
+			}
+		}
+		if len(parameters) > 0 {
+			components["parameters"] = parameters
+		}
+		if len(requestBodies) > 0 {
+			components["requestBodies"] = requestBodies
+		}
+	}
+	if resps := object(e.doc["responses"]); len(resps) > 0 {
+		responses := make(map[string]interface{}, len(resps))
+		for _, name := range sortedKeys(resps) {
+			responses[name] = e.response(object(resps[name]), e.mediaTypes("produces", nil), pointer("responses", name))
+		}
+		components["responses"] = responses
+	}
+	if defs := object(e.doc["securityDefinitions"]); len(defs) > 0 {
+		schemes := make(map[string]interface{}, len(defs))
+		for _, name := range sortedKeys(defs) {
+			schemes[name] = e.securityScheme(object(defs[name]))
+		}
+		components["securitySchemes"] = schemes
+	}
+	if len(components) > 0 {
+		res["components"] = components
+	}
+
+	res["paths"] = e.paths(object(e.doc["paths"]))
+	return res
+}
+
+// servers builds a server per scheme from host and basePath
+func (e *exporter) servers() []interface{} {
+	host, _ := e.doc["host"].(string)
+	basePath, _ := e.doc["basePath"].(string)
+	if host == "" {
+		if basePath == "" {
+			return nil
+		}
+		return []interface{}{map[string]interface{}{"url": basePath}}
+	}
+
+	schemes, _ := e.doc["schemes"].([]interface{})
+	if len(schemes) == 0 {
+		schemes = []interface{}{"http"}
+	}
+	servers := make([]interface{}, 0, len(schemes))
+	for _, scheme := range schemes {
+		servers = append(servers, map[string]interface{}{"url": fmt.Sprintf("%s://%s%s", scheme, host, basePath)})
+	}
+	return servers
+}
+
+// mediaTypes returns the media types of an operation, or of the document
+func (e *exporter) mediaTypes(key string, op map[string]interface{}) []string {
+	list, ok := op[key].([]interface{})
+	if !ok {
+		list, _ = e.doc[key].([]interface{})
+	}
+	res := make([]string, 0, len(list))
+	for _, mt := range list {
+		if s, ok := mt.(string); ok {
+			res = append(res, s)
+		}
+	}
+	if len(res) == 0 {
+		res = append(res, "application/json")
+	}
+	return res
+}
+
+func (e *exporter) paths(paths map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(paths))
+	for _, path := range sortedKeys(paths) {
+		ptr := pointer("paths", path)
+		item := object(paths[path])
+		out := make(map[string]interface{})
+		copyExtensions(item, out)
+		if ref, ok := item["$ref"]; ok {
+			out["$ref"] = ref
+		}
+
+		// body and form parameters of the path item go to the request body of its operations
+		pathParams, pathBody := e.splitParameters(item["parameters"], ptr)
+		if len(pathParams) > 0 {
+			out["parameters"] = pathParams
+		}
+		for _, method := range methods {
+			if op, ok := item[method]; ok {
+				out[method] = e.operation(object(op), pathBody, ptr+"/"+method)
+			}
+		}
+		res[path] = out
+	}
+	return res
+}
+
+func (e *exporter) operation(op map[string]interface{}, pathBody []map[string]interface{}, ptr string) map[string]interface{} {
+	res := make(map[string]interface{})
+	for _, k := range []string{"tags", "summary", "description", "externalDocs", "operationId", "deprecated", "security"} {
+		if v, ok := op[k]; ok {
+			res[k] = v
+		}
+	}
+	copyExtensions(op, res)
+	if _, ok := op["schemes"]; ok {
+		e.warn(ptr+"/schemes", "schemes of operations cannot be represented in OpenAPI 3.0, the servers of the document apply")
+	}
+
+	params, body := e.splitParameters(op["parameters"], ptr)
+	if len(params) > 0 {
+		res["parameters"] = params
+	}
+	body = mergeBodyParameters(pathBody, body)
+	if len(body) > 0 {
+		res["requestBody"] = e.requestBody(body, e.mediaTypes("consumes", op), ptr)
+	}
+
+	produces := e.mediaTypes("produces", op)
+	responses := make(map[string]interface{})
+	resps := object(op["responses"])
+	for _, code := range sortedKeys(resps) {
+		responses[code] = e.response(object(resps[code]), produces, ptr+"/responses/"+code)
+	}
+	res["responses"] = responses
+	return res
+}
+
+// splitParameters separates the parameters from the body and form parameters, which make the request body
+func (e *exporter) splitParameters(params interface{}, ptr string) ([]interface{}, []map[string]interface{}) {
+	list, _ := params.([]interface{})
+	var res []interface{}
+	var body []map[string]interface{}
+	for i, p := range list {
+		param := object(p)
+		pptr := fmt.Sprintf("%s/parameters/%d", ptr, i)
+		if ref, ok := param["$ref"].(string); ok {
+			resolved, found := e.resolve(ref)
+			switch {
+			case !found:
+				res = append(res, map[string]interface{}{"$ref": strings.Replace(ref, "#/parameters/", "#/components/parameters/", 1)})
+			case resolved["in"] == "body":
+				// shared body parameters are exported as request bodies
+				body = append(body, map[string]interface{}{"$ref": ref, "in": "body"})
+			case resolved["in"] == "formData":
+				body = append(body, resolved)
+			default:
+				res = append(res, map[string]interface{}{"$ref": strings.Replace(ref, "#/parameters/", "#/components/parameters/", 1)})
+			}
+			continue
+		}
+		switch param["in"] {
+		case "body", "formData":
+			body = append(body, param)
+		default:
+			res = append(res, e.parameter(param, pptr))
+		}
+	}
+	return res, body
+}
+
+// mergeBodyParameters overrides the body and form parameters of a path item with those of an operation
+func mergeBodyParameters(pathBody, opBody []map[string]interface{}) []map[string]interface{} {
+	if len(pathBody) == 0 {
+		return opBody
+	}
+	res := append([]map[string]interface{}{}, opBody...)
+	for _, p := range pathBody {
+		overridden := false
+		for _, o := range opBody {
+			if o["in"] == p["in"] && (p["in"] == "body" || o["name"] == p["name"]) {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+func (e *exporter) requestBody(params []map[string]interface{}, consumes []string, ptr string) map[string]interface{} {
+	for _, param := range params {
+		if param["in"] != "body" {
+			continue
+		}
+		if ref, ok := param["$ref"].(string); ok {
+			return map[string]interface{}{"$ref": strings.Replace(ref, "#/parameters/", "#/components/requestBodies/", 1)}
+		}
+		res := make(map[string]interface{})
+		for _, k := range []string{"description", "required"} {
+			if v, ok := param[k]; ok {
+				res[k] = v
+			}
+		}
+		copyExtensions(param, res)
+		if name, ok := param["name"].(string); ok && name != "body" {
+			res["x-codegen-request-body-name"] = name
+		}
+		schema := e.schema(param["schema"], ptr)
+		content := make(map[string]interface{}, len(consumes))
+		for _, mt := range consumes {
+			content[mt] = map[string]interface{}{"schema": schema}
+		}
+		res["content"] = content
+		return res
+	}
+
+	// form parameters
+	properties := make(map[string]interface{}, len(params))
+	var required []interface{}
+	hasFile := false
+	for _, param := range params {
+		name, _ := param["name"].(string)
+		prop := e.simpleSchema(param)
+		if param["type"] == "file" {
+			hasFile = true
+		}
+		if desc, ok := param["description"]; ok {
+			prop["description"] = desc
+		}
+		properties[name] = prop
+		if req, _ := param["required"].(bool); req {
+			required = append(required, name)
+		}
+	}
+	schema := map[string]interface{}{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	var forms []string
+	for _, mt := range consumes {
+		if isForm(mt) {
+			forms = append(forms, mt)
+		}
+	}
+	if len(forms) == 0 {
+		if hasFile {
+			forms = []string{multipartForm}
+		} else {
+			forms = []string{formURLEncoded}
+		}
+	}
+	content := make(map[string]interface{}, len(forms))
+	for _, mt := range forms {
+		content[mt] = map[string]interface{}{"schema": schema}
+	}
+	return map[string]interface{}{"content": content}
+}
+
+func (e *exporter) parameter(param map[string]interface{}, ptr string) map[string]interface{} {
+	res := make(map[string]interface{})
+	for _, k := range []string{"name", "in", "description", "required", "allowEmptyValue"} {
+		if v, ok := param[k]; ok {
+			res[k] = v
+		}
+	}
+	for k, v := range param {
+		if strings.HasPrefix(strings.ToLower(k), "x-") && k != "x-example" && k != "x-nullable" {
+			res[k] = v
+		}
+	}
+	if ex, ok := param["x-example"]; ok {
+		res["example"] = ex
+	}
+	res["schema"] = e.simpleSchema(param)
+
+	if param["type"] == "array" {
+		format, _ := param["collectionFormat"].(string)
+		in, _ := param["in"].(string)
+		switch format {
+		case "multi":
+			res["style"] = "form"
+			res["explode"] = true
+		case "", "csv":
+			if in == "query" {
+				res["style"] = "form"
+				res["explode"] = false
+			} else {
+				res["style"] = "simple"
+			}
+		case "ssv":
+			res["style"] = "spaceDelimited"
+		case "pipes":
+			res["style"] = "pipeDelimited"
+		default:
+			e.warn(ptr+"/collectionFormat", "collection format %q cannot be represented in OpenAPI 3.0", format)
+		}
+	}
+	return res
+}
+
+// simpleSchema builds the schema of a parameter, items or header
+func (e *exporter) simpleSchema(simple map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{})
+	for _, k := range simpleSchemaKeys {
+		if v, ok := simple[k]; ok {
+			res[k] = v
+		}
+	}
+	if nullable, ok := simple["x-nullable"]; ok {
+		res["nullable"] = nullable
+	}
+	if res["type"] == "file" {
+		res["type"] = "string"
+		res["format"] = "binary"
+	}
+	if items, ok := simple["items"].(map[string]interface{}); ok {
+		res["items"] = e.simpleSchema(items)
+	}
+	return res
+}
+
+func (e *exporter) response(resp map[string]interface{}, produces []string, ptr string) map[string]interface{} {
+	if ref, ok := resp["$ref"].(string); ok {
+		return map[string]interface{}{"$ref": exportRef(ref)}
+	}
+
+	res := map[string]interface{}{"description": resp["description"]}
+	if res["description"] == nil {
+		res["description"] = ""
+	}
+	copyExtensions(resp, res)
+
+	if headers := object(resp["headers"]); len(headers) > 0 {
+		out := make(map[string]interface{}, len(headers))
+		for _, name := range sortedKeys(headers) {
+			header := object(headers[name])
+			h := map[string]interface{}{"schema": e.simpleSchema(header)}
+			if desc, ok := header["description"]; ok {
+				h["description"] = desc
+			}
+			out[name] = h
+		}
+		res["headers"] = out
+	}
+
+	examples := object(resp["examples"])
+	schema, hasSchema := resp["schema"]
+	if !hasSchema && len(examples) == 0 {
+		return res
+	}
+	content := make(map[string]interface{})
+	if hasSchema {
+		converted := e.schema(schema, ptr+"/schema")
+		for _, mt := range produces {
+			content[mt] = map[string]interface{}{"schema": converted}
+		}
+	}
+	for _, mt := range sortedKeys(examples) {
+		media := object(content[mt])
+		if media == nil {
+			media = make(map[string]interface{})
+			content[mt] = media
+		}
+		media["example"] = examples[mt]
+	}
+	res["content"] = content
+	return res
+}
+
+func (e *exporter) schema(s interface{}, ptr string) interface{} {
+	schema, ok := s.(map[string]interface{})
+	if !ok {
+		return s
+	}
+	if ref, ok := schema["$ref"].(string); ok {
+		return map[string]interface{}{"$ref": exportRef(ref)}
+	}
+
+	res := make(map[string]interface{}, len(schema))
+	for _, k := range sortedKeys(schema) {
+		v := schema[k]
+		switch k {
+		case "x-nullable":
+			res["nullable"] = v
+		case "properties":
+			props := object(v)
+			out := make(map[string]interface{}, len(props))
+			for _, name := range sortedKeys(props) {
+				out[name] = e.schema(props[name], ptr+"/properties/"+jsonpointer.Escape(name))
+			}
+			res[k] = out
+		case "items":
+			if list, isList := v.([]interface{}); isList {
+				e.warn(ptr+"/items", "tuples cannot be represented in OpenAPI 3.0, only the first item schema is kept")
+				if len(list) > 0 {
+					res[k] = e.schema(list[0], ptr+"/items/0")
+				}
+				continue
+			}
+			res[k] = e.schema(v, ptr+"/"+k)
+		case "additionalProperties":
+			res[k] = e.schema(v, ptr+"/"+k)
+		case "allOf":
+			list, _ := v.([]interface{})
+			out := make([]interface{}, 0, len(list))
+			for i, sch := range list {
+				out = append(out, e.schema(sch, fmt.Sprintf("%s/allOf/%d", ptr, i)))
+			}
+			res[k] = out
+		case "discriminator":
+			res[k] = map[string]interface{}{"propertyName": v}
+		case "additionalItems":
+			e.warn(ptr+"/additionalItems", "additionalItems cannot be represented in OpenAPI 3.0")
+		case "type":
+			if v == "file" {
+				res["type"] = "string"
+				res["format"] = "binary"
+				continue
+			}
+			res[k] = v
+		default:
+			res[k] = v
+		}
+	}
+	return res
+}
+
+func (e *exporter) securityScheme(def map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{})
+	if desc, ok := def["description"]; ok {
+		res["description"] = desc
+	}
+	copyExtensions(def, res)
+
+	switch def["type"] {
+	case "basic":
+		res["type"] = "http"
+		res["scheme"] = "basic"
+	case "apiKey":
+		res["type"] = "apiKey"
+		res["name"] = def["name"]
+		res["in"] = def["in"]
+	case "oauth2":
+		flow := make(map[string]interface{})
+		for _, k := range []string{"authorizationUrl", "tokenUrl"} {
+			if v, ok := def[k]; ok {
+				flow[k] = v
+			}
+		}
+		flow["scopes"] = def["scopes"]
+		if flow["scopes"] == nil {
+			flow["scopes"] = map[string]interface{}{}
+		}
+		name, _ := def["flow"].(string)
+		res["type"] = "oauth2"
+		res["flows"] = map[string]interface{}{exportOAuth2Flows[name]: flow}
+	}
+	return res
+}
+
+// exportRef points references to definitions and responses at their OpenAPI 3.0 counterpart
+func exportRef(ref string) string {
+	parts := strings.SplitN(ref, "#", 2)
+	if len(parts) != 2 {
+		return ref
+	}
+	for _, prefix := range exportRefPrefixes {
+		if strings.HasPrefix(parts[1], prefix[0]) {
+			return parts[0] + "#" + prefix[1] + strings.TrimPrefix(parts[1], prefix[0])
+		}
+	}
+	return ref
+}
+
+// marshalDocument renders the keys of a document in the usual order of OpenAPI documents
+func marshalDocument(doc map[string]interface{}) (json.RawMessage, error) {
+	keys := make([]string, 0, len(doc))
+	for _, k := range documentKeys {
+		if _, ok := doc[k]; ok {
+			keys = append(keys, k)
+		}
+	}
+	for _, k := range sortedKeys(doc) {
+		if !containsString(documentKeys, k) {
+			keys = append(keys, k)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		kb, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		vb, err := json.Marshal(doc[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(kb)
+		buf.WriteByte(':')
+		buf.Write(vb)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
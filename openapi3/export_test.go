@@ -0,0 +1,159 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi3
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exportFixture(t *testing.T) (json.RawMessage, map[string]interface{}) {
+	doc, err := loads.Spec(filepath.Join("..", "fixtures", "openapi3", "swagger.yaml"))
+	require.NoError(t, err)
+	data, warnings, err := Export(doc.Spec())
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	var res map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &res))
+	return data, res
+}
+
+// at walks a document along a path of tokens separated by "|"
+func at(doc interface{}, path string) interface{} {
+	for _, token := range strings.Split(path, "|") {
+		switch node := doc.(type) {
+		case map[string]interface{}:
+			doc = node[token]
+		case []interface{}:
+			idx, err := strconv.Atoi(token)
+			if err != nil || idx >= len(node) {
+				return nil
+			}
+			doc = node[idx]
+		default:
+			return nil
+		}
+	}
+	return doc
+}
+
+func TestExport_Document(t *testing.T) {
+	data, doc := exportFixture(t)
+
+	assert.True(t, strings.HasPrefix(string(data), `{"openapi":"3.0.3","info":`))
+	assert.Equal(t, "https://petstore.example.com/v1", at(doc, "servers|0|url"))
+	assert.Equal(t, "http://petstore.example.com/v1", at(doc, "servers|1|url"))
+	assert.Equal(t, "kind", at(doc, "components|schemas|Pet|discriminator|propertyName"))
+	assert.Equal(t, true, at(doc, "components|schemas|Pet|properties|tag|nullable"))
+	assert.Equal(t, "#/components/schemas/Pet", at(doc, "components|schemas|Dog|allOf|0|$ref"))
+	assert.Equal(t, "query", at(doc, "components|parameters|limit|in"))
+	assert.Equal(t, "#/components/schemas/Pet", at(doc, "components|requestBodies|pet|content|application/json|schema|$ref"))
+	assert.Equal(t, "#/components/schemas/Error", at(doc, "components|responses|error|content|application/json|schema|$ref"))
+
+	assert.Equal(t, "http", at(doc, "components|securitySchemes|basic|type"))
+	assert.Equal(t, "basic", at(doc, "components|securitySchemes|basic|scheme"))
+	assert.Equal(t, "X-API-Key", at(doc, "components|securitySchemes|key|name"))
+	assert.Equal(t, "https://example.com/token", at(doc, "components|securitySchemes|oauth|flows|authorizationCode|tokenUrl"))
+}
+
+func TestExport_Operations(t *testing.T) {
+	_, doc := exportFixture(t)
+
+	list := at(doc, "paths|/pets|get")
+	assert.Equal(t, "#/components/parameters/limit", at(list, "parameters|0|$ref"))
+	assert.Equal(t, "form", at(list, "parameters|1|style"))
+	assert.Equal(t, true, at(list, "parameters|1|explode"))
+	assert.Equal(t, false, at(list, "parameters|2|explode"))
+	assert.Equal(t, "integer", at(list, "parameters|2|schema|items|type"))
+	assert.Equal(t, "integer", at(list, "responses|200|headers|X-Total|schema|type"))
+	assert.Equal(t, "#/components/schemas/Pet", at(list, "responses|200|content|application/json|schema|items|$ref"))
+	assert.Equal(t, "rex", at(list, "responses|200|content|application/json|example|0|name"))
+	assert.Equal(t, "#/components/responses/error", at(list, "responses|default|$ref"))
+
+	assert.Equal(t, "#/components/requestBodies/pet", at(doc, "paths|/pets|post|requestBody|$ref"))
+	assert.Equal(t, "pet", at(doc, "paths|/pets/{id}|put|requestBody|x-codegen-request-body-name"))
+	assert.Equal(t, "int64", at(doc, "paths|/pets/{id}|parameters|0|schema|format"))
+
+	form := at(doc, "paths|/pets/{id}/photo|post|requestBody|content|multipart/form-data|schema")
+	assert.Equal(t, "binary", at(form, "properties|photo|format"))
+	assert.Equal(t, "a caption", at(form, "properties|caption|description"))
+	assert.Equal(t, []interface{}{"photo"}, at(form, "required"))
+	if params, ok := at(doc, "paths|/pets/{id}/photo|post|parameters").([]interface{}); assert.True(t, ok) {
+		assert.Len(t, params, 1)
+	}
+}
+
+func TestExport_RoundTrip(t *testing.T) {
+	data, _ := exportFixture(t)
+
+	converted, warnings, err := Convert(data)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	var sw spec.Swagger
+	require.NoError(t, json.Unmarshal(converted, &sw))
+	assert.Equal(t, "petstore.example.com", sw.Host)
+	assert.Equal(t, "/v1", sw.BasePath)
+	assert.Equal(t, "kind", sw.Definitions["Pet"].Discriminator)
+	assert.Equal(t, "multi", sw.Paths.Paths["/pets"].Get.Parameters[1].CollectionFormat)
+	put := sw.Paths.Paths["/pets/{id}"].Put
+	if assert.Len(t, put.Parameters, 1) {
+		assert.Equal(t, "pet", put.Parameters[0].Name)
+		assert.Equal(t, "body", put.Parameters[0].In)
+	}
+	upload := sw.Paths.Paths["/pets/{id}/photo"].Post
+	if assert.Len(t, upload.Parameters, 3) {
+		assert.Equal(t, "file", upload.Parameters[2].Type)
+	}
+	assert.Equal(t, "accessCode", sw.SecurityDefinitions["oauth"].Flow)
+}
+
+func TestExport_Warnings(t *testing.T) {
+	sw := new(spec.Swagger)
+	sw.Swagger = "2.0"
+	sw.Paths = &spec.Paths{Paths: map[string]spec.PathItem{
+		"/a": {PathItemProps: spec.PathItemProps{Get: &spec.Operation{OperationProps: spec.OperationProps{
+			Schemes: []string{"ws"},
+			Parameters: []spec.Parameter{
+				*spec.QueryParam("q").CollectionOf(spec.NewItems().Typed("string", ""), "tsv"),
+			},
+		}}}},
+	}}
+	_, warnings, err := Export(sw)
+	require.NoError(t, err)
+	pointers := make([]string, 0, len(warnings))
+	for _, w := range warnings {
+		pointers = append(pointers, w.Pointer)
+	}
+	assert.ElementsMatch(t, []string{"/paths/~1a/get/schemes", "/paths/~1a/get/parameters/0/collectionFormat"}, pointers)
+}
+
+func TestExport_YAML(t *testing.T) {
+	b, err := ToYAML(json.RawMessage(`{"openapi":"3.0.3","info":{"title":"t","version":"1"},"paths":{},"x-big":12345678901,"x-float":1.5,"x-list":[true,null]}`))
+	require.NoError(t, err)
+	assert.Equal(t, "openapi: 3.0.3\ninfo:\n  title: t\n  version: \"1\"\npaths: {}\nx-big: 12345678901\nx-float: 1.5\nx-list:\n- true\n- null\n", string(b))
+
+	_, err = ToYAML(json.RawMessage(`{"a":`))
+	assert.Error(t, err)
+}
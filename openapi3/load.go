@@ -0,0 +1,60 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi3
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/swag"
+)
+
+// Matcher matches all the documents handled by Loader, to register it with loads.AddLoader
+func Matcher(_ string) bool {
+	return true
+}
+
+// Loader loads a json or yaml document, and converts it to swagger 2.0 when it is an OpenAPI 3.0 document.
+//
+// The constructs which could not be converted are logged.
+func Loader(path string) (json.RawMessage, error) {
+	converted, warnings, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range warnings {
+		log.Printf("openapi 3 document %s: %s", path, w)
+	}
+	return converted, nil
+}
+
+// Load loads a json or yaml document, and converts it to swagger 2.0 when it is an OpenAPI 3.0 document
+func Load(path string) (json.RawMessage, []Warning, error) {
+	var data json.RawMessage
+	var err error
+	if swag.YAMLMatcher(path) {
+		data, err = swag.YAMLDoc(path)
+	} else {
+		data, err = loads.JSONDoc(path)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if !IsOpenAPI3(data) {
+		return data, nil, nil
+	}
+	return Convert(data)
+}